@@ -6,11 +6,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	cdptypes "github.com/mokitanetwork/aether/x/cdp/types"
+	hardtypes "github.com/mokitanetwork/aether/x/hard/types"
 )
 
 var _ sdk.AnteDecorator = AuthzLimiterDecorator{}
 
 // AuthzLimiterDecorator blocks certain msg types from being granted or executed within authz.
+// Msgs executed within a cdp flash mint or a hard flash loan are blocked in the same way.
 type AuthzLimiterDecorator struct {
 	// disabledMsgTypes is the type urls of the msgs to block.
 	disabledMsgTypes []string
@@ -33,7 +37,7 @@ func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 // checkForDisabledMsg iterates through the msgs and returns an error if it finds any unauthorized msgs.
 //
-// When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec are blocked, if they contain unauthorized msg types.
+// When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec, cdp MsgFlashMint and hard MsgFlashLoan, are blocked if they contain unauthorized msg types.
 // Otherwise any msg matching the disabled types are blocked, regardless of being in an authz msg or not.
 //
 // This method is recursive as MsgExec's can wrap other MsgExecs.
@@ -66,6 +70,32 @@ func (ald AuthzLimiterDecorator) checkForDisabledMsg(msgs []sdk.Msg, searchOnlyI
 			if err := ald.checkForDisabledMsg(innerMsgs, false); err != nil {
 				return err
 			}
+
+		case typeURL == sdk.MsgTypeURL(&cdptypes.MsgFlashMint{}):
+			m, ok := msg.(*cdptypes.MsgFlashMint)
+			if !ok {
+				panic("unexpected msg type")
+			}
+			innerMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := ald.checkForDisabledMsg(innerMsgs, false); err != nil {
+				return err
+			}
+
+		case typeURL == sdk.MsgTypeURL(&hardtypes.MsgFlashLoan{}):
+			m, ok := msg.(*hardtypes.MsgFlashLoan)
+			if !ok {
+				panic("unexpected msg type")
+			}
+			innerMsgs, err := m.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := ald.checkForDisabledMsg(innerMsgs, false); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
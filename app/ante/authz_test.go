@@ -15,6 +15,8 @@ import (
 
 	"github.com/mokitanetwork/aether/app"
 	"github.com/mokitanetwork/aether/app/ante"
+	cdptypes "github.com/mokitanetwork/aether/x/cdp/types"
+	hardtypes "github.com/mokitanetwork/aether/x/hard/types"
 )
 
 func newMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, a authz.Authorization, expiration time.Time) *authz.MsgGrant {
@@ -30,6 +32,22 @@ func newMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) *authz.MsgExec {
 	return &msg
 }
 
+func newMsgFlashMint(sender sdk.AccAddress, msgs []sdk.Msg) *cdptypes.MsgFlashMint {
+	msg, err := cdptypes.NewMsgFlashMint(sender, sdk.NewInt64Coin("usdx", 100e6), msgs)
+	if err != nil {
+		panic(err)
+	}
+	return &msg
+}
+
+func newMsgFlashLoan(sender sdk.AccAddress, msgs []sdk.Msg) *hardtypes.MsgFlashLoan {
+	msg, err := hardtypes.NewMsgFlashLoan(sender, sdk.NewInt64Coin("usdx", 100e6), msgs)
+	if err != nil {
+		panic(err)
+	}
+	return &msg
+}
+
 func TestAuthzLimiterDecorator(t *testing.T) {
 	testPrivKeys, testAddresses := app.GeneratePrivKeyAddressPairs(5)
 	distantFuture := time.Date(9000, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -205,6 +223,66 @@ func TestAuthzLimiterDecorator(t *testing.T) {
 			checkTx:     false,
 			expectedErr: sdkerrors.ErrUnauthorized,
 		},
+		{
+			name: "when a MsgFlashMint contains a non blocked msg, it passes",
+			msgs: []sdk.Msg{
+				newMsgFlashMint(
+					testAddresses[0],
+					[]sdk.Msg{
+						banktypes.NewMsgSend(
+							testAddresses[0],
+							testAddresses[3],
+							sdk.NewCoins(sdk.NewInt64Coin("usdx", 100e6)),
+						),
+					},
+				),
+			},
+			checkTx: false,
+		},
+		{
+			name: "when a MsgFlashMint contains a blocked msg, it is blocked",
+			msgs: []sdk.Msg{
+				newMsgFlashMint(
+					testAddresses[0],
+					[]sdk.Msg{
+						&evmtypes.MsgEthereumTx{},
+					},
+				),
+			},
+			checkTx:     false,
+			expectedErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "a MsgFlashMint in a MsgExec containing a blocked msg is still blocked",
+			msgs: []sdk.Msg{
+				newMsgExec(
+					testAddresses[1],
+					[]sdk.Msg{
+						newMsgFlashMint(
+							testAddresses[0],
+							[]sdk.Msg{
+								&evmtypes.MsgEthereumTx{},
+							},
+						),
+					},
+				),
+			},
+			checkTx:     false,
+			expectedErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "when a MsgFlashLoan contains a blocked msg, it is blocked",
+			msgs: []sdk.Msg{
+				newMsgFlashLoan(
+					testAddresses[0],
+					[]sdk.Msg{
+						&evmtypes.MsgEthereumTx{},
+					},
+				),
+			},
+			checkTx:     false,
+			expectedErr: sdkerrors.ErrUnauthorized,
+		},
 	}
 
 	txConfig := app.MakeEncodingConfig().TxConfig
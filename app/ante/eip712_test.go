@@ -730,6 +730,7 @@ func (suite *EIP712TestSuite) TestEIP712Tx_DepositAndWithdraw() {
 	cdpWithdrawMsg := cdptypes.NewMsgRepayDebt(
 		suite.testAddr,
 		USDCCDPType,
+		0,
 		sdk.NewCoin(cdptypes.DefaultStableDenom, usdxAmt),
 	)
 	hardWithdrawMsg := hardtypes.NewMsgWithdraw(
@@ -100,6 +100,7 @@ import (
 	bep3keeper "github.com/mokitanetwork/aether/x/bep3/keeper"
 	bep3types "github.com/mokitanetwork/aether/x/bep3/types"
 	"github.com/mokitanetwork/aether/x/cdp"
+	cdpclient "github.com/mokitanetwork/aether/x/cdp/client"
 	cdpkeeper "github.com/mokitanetwork/aether/x/cdp/keeper"
 	cdptypes "github.com/mokitanetwork/aether/x/cdp/types"
 	"github.com/mokitanetwork/aether/x/committee"
@@ -130,6 +131,7 @@ import (
 	liquidkeeper "github.com/mokitanetwork/aether/x/liquid/keeper"
 	liquidtypes "github.com/mokitanetwork/aether/x/liquid/types"
 	pricefeed "github.com/mokitanetwork/aether/x/pricefeed"
+	pricefeedclient "github.com/mokitanetwork/aether/x/pricefeed/client"
 	pricefeedkeeper "github.com/mokitanetwork/aether/x/pricefeed/keeper"
 	pricefeedtypes "github.com/mokitanetwork/aether/x/pricefeed/types"
 	"github.com/mokitanetwork/aether/x/router"
@@ -174,6 +176,8 @@ var (
 			committeeclient.ProposalHandler,
 			earnclient.DepositProposalHandler,
 			earnclient.WithdrawProposalHandler,
+			pricefeedclient.ClearStaleMarketProposalHandler,
+			cdpclient.GlobalSettlementProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -224,6 +228,7 @@ var (
 		swaptypes.ModuleName:            nil,
 		cdptypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 		cdptypes.LiquidatorMacc:         {authtypes.Minter, authtypes.Burner},
+		cdptypes.PsmMacc:                nil,
 		hardtypes.ModuleAccountName:     {authtypes.Minter},
 		savingstypes.ModuleAccountName:  nil,
 		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
@@ -540,13 +545,6 @@ func NewApp(
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
 	app.ibcKeeper.SetRouter(ibcRouter)
 
-	app.auctionKeeper = auctionkeeper.NewKeeper(
-		appCodec,
-		keys[auctiontypes.StoreKey],
-		auctionSubspace,
-		app.bankKeeper,
-		app.accountKeeper,
-	)
 	app.issuanceKeeper = issuancekeeper.NewKeeper(
 		appCodec,
 		keys[issuancetypes.StoreKey],
@@ -562,26 +560,36 @@ func NewApp(
 		bep3Subspace,
 		app.ModuleAccountAddrs(),
 	)
+	swapKeeper := swapkeeper.NewKeeper(
+		appCodec,
+		keys[swaptypes.StoreKey],
+		swapSubspace,
+		app.accountKeeper,
+		app.bankKeeper,
+	)
 	app.pricefeedKeeper = pricefeedkeeper.NewKeeper(
 		appCodec,
 		keys[pricefeedtypes.StoreKey],
 		pricefeedSubspace,
+		swapKeeper,
 	)
-	swapKeeper := swapkeeper.NewKeeper(
+	app.auctionKeeper = auctionkeeper.NewKeeper(
 		appCodec,
-		keys[swaptypes.StoreKey],
-		swapSubspace,
-		app.accountKeeper,
+		keys[auctiontypes.StoreKey],
+		auctionSubspace,
 		app.bankKeeper,
+		app.accountKeeper,
+		app.pricefeedKeeper,
 	)
 	cdpKeeper := cdpkeeper.NewKeeper(
 		appCodec,
 		keys[cdptypes.StoreKey],
 		cdpSubspace,
 		app.pricefeedKeeper,
-		app.auctionKeeper,
+		&app.auctionKeeper,
 		app.bankKeeper,
 		app.accountKeeper,
+		app.BaseApp.MsgServiceRouter(),
 		mAccPerms,
 	)
 	hardKeeper := hardkeeper.NewKeeper(
@@ -591,7 +599,8 @@ func NewApp(
 		app.accountKeeper,
 		app.bankKeeper,
 		app.pricefeedKeeper,
-		app.auctionKeeper,
+		&app.auctionKeeper,
+		app.BaseApp.MsgServiceRouter(),
 	)
 	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
 		appCodec,
@@ -649,7 +658,9 @@ func NewApp(
 		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(pricefeedtypes.RouterKey, pricefeed.NewClearStaleMarketProposalHandler(app.pricefeedKeeper)).
+		AddRoute(cdptypes.RouterKey, cdp.NewGlobalSettlementProposalHandler(cdpKeeper)) // starting global settlement does not call cdp hooks, so the keeper can be used before its hooks are set
 	// Note: the committee proposal handler is not registered on the committee router. This means committees cannot create or update other committees.
 	// Adding the committee proposal handler to the router is possible but awkward as the handler depends on the keeper which depends on the handler.
 	app.committeeKeeper = committeekeeper.NewKeeper(
@@ -671,6 +682,8 @@ func NewApp(
 	app.hardKeeper = *hardKeeper.SetHooks(hardtypes.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
 	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
 	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
+	// no modules consume auction hooks yet, they are added here as they are implemented
+	app.auctionKeeper.SetHooks(auctiontypes.NewMultiAuctionHooks())
 
 	// create gov keeper with router
 	// NOTE this must be done after any keepers referenced in the gov router (ie committee) are defined
@@ -683,6 +696,8 @@ func NewApp(
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(aethdisttypes.RouterKey, aethdist.NewCommunityPoolMultiSpendProposalHandler(app.aethdistKeeper)).
 		AddRoute(earntypes.RouterKey, earn.NewCommunityPoolProposalHandler(app.earnKeeper)).
+		AddRoute(pricefeedtypes.RouterKey, pricefeed.NewClearStaleMarketProposalHandler(app.pricefeedKeeper)).
+		AddRoute(cdptypes.RouterKey, cdp.NewGlobalSettlementProposalHandler(app.cdpKeeper)).
 		AddRoute(committeetypes.RouterKey, committee.NewProposalHandler(app.committeeKeeper))
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
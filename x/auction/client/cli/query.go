@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryAuction(),
 		GetCmdQueryAuctions(),
+		GetCmdQueryAuctionBids(),
 	}
 
 	for _, cmd := range cmds {
@@ -96,12 +98,63 @@ func GetCmdQueryAuction() *cobra.Command {
 	}
 }
 
+// GetCmdQueryAuctionBids queries the bid log of an auction
+func GetCmdQueryAuctionBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bids [auction-id]",
+		Short: "get the recent bids on an auction",
+		Long:  fmt.Sprintf("Get the bids placed on an auction, oldest first. Only the last %d bids are kept.", types.MaxAuctionBids),
+		Example: strings.Join([]string{
+			fmt.Sprintf("  $ %s q %s bids 1", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s bids 1 --page=2 --limit=10", version.AppName, types.ModuleName),
+		}, "\n"),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AuctionBids(context.Background(), &types.QueryAuctionBidsRequest{
+				AuctionId:  auctionID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "bids")
+
+	return cmd
+}
+
 // Query auction flags
 const (
-	flagType  = "type"
-	flagDenom = "denom"
-	flagPhase = "phase"
-	flagOwner = "owner"
+	flagType         = "type"
+	flagDenom        = "denom"
+	flagPhase        = "phase"
+	flagOwner        = "owner"
+	flagLotDenom     = "lot-denom"
+	flagBidDenom     = "bid-denom"
+	flagBidder       = "bidder"
+	flagInitiator    = "initiator"
+	flagEndingBefore = "ending-before"
 )
 
 // GetCmdQueryAuctions queries the auctions in the store
@@ -115,6 +168,10 @@ func GetCmdQueryAuctions() *cobra.Command {
 			fmt.Sprintf("  $ %s q %s auctions --owner=aeth1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --denom=bnb", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --phase=(forward|reverse)", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s auctions --lot-denom=bnb --bid-denom=usdx", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s auctions --bidder=aeth1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s auctions --initiator=liquidator", version.AppName, types.ModuleName),
+			fmt.Sprintf("  $ %s q %s auctions --ending-before=2022-01-01T00:00:00Z", version.AppName, types.ModuleName),
 			fmt.Sprintf("  $ %s q %s auctions --page=2 --limit=100", version.AppName, types.ModuleName),
 		}, "\n"),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -134,6 +191,26 @@ func GetCmdQueryAuctions() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			lotDenom, err := cmd.Flags().GetString(flagLotDenom)
+			if err != nil {
+				return err
+			}
+			bidDenom, err := cmd.Flags().GetString(flagBidDenom)
+			if err != nil {
+				return err
+			}
+			bidder, err := cmd.Flags().GetString(flagBidder)
+			if err != nil {
+				return err
+			}
+			initiator, err := cmd.Flags().GetString(flagInitiator)
+			if err != nil {
+				return err
+			}
+			endingBeforeStr, err := cmd.Flags().GetString(flagEndingBefore)
+			if err != nil {
+				return err
+			}
 
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
@@ -145,13 +222,14 @@ func GetCmdQueryAuctions() *cobra.Command {
 
 				if auctionType != types.CollateralAuctionType &&
 					auctionType != types.SurplusAuctionType &&
-					auctionType != types.DebtAuctionType {
+					auctionType != types.DebtAuctionType &&
+					auctionType != types.DutchAuctionType {
 					return fmt.Errorf("invalid auction type %s", auctionType)
 				}
 			}
 
 			if len(owner) != 0 {
-				if auctionType != types.CollateralAuctionType {
+				if auctionType != types.CollateralAuctionType && auctionType != types.DutchAuctionType {
 					return fmt.Errorf("cannot apply owner flag to non-collateral auction type")
 				}
 				_, err := sdk.AccAddressFromBech32(owner)
@@ -178,6 +256,28 @@ func GetCmdQueryAuctions() *cobra.Command {
 				}
 			}
 
+			for _, d := range []string{lotDenom, bidDenom} {
+				if len(d) != 0 {
+					if err := sdk.ValidateDenom(d); err != nil {
+						return err
+					}
+				}
+			}
+
+			if len(bidder) != 0 {
+				if _, err := sdk.AccAddressFromBech32(bidder); err != nil {
+					return fmt.Errorf("cannot parse address from auction bidder %s", bidder)
+				}
+			}
+
+			var endingBefore time.Time
+			if len(endingBeforeStr) != 0 {
+				endingBefore, err = time.Parse(time.RFC3339, endingBeforeStr)
+				if err != nil {
+					return fmt.Errorf("cannot parse auction ending before time %s", endingBeforeStr)
+				}
+			}
+
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -185,11 +285,16 @@ func GetCmdQueryAuctions() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 			request := types.QueryAuctionsRequest{
-				Type:       auctionType,
-				Owner:      owner,
-				Denom:      denom,
-				Phase:      phase,
-				Pagination: pageReq,
+				Type:         auctionType,
+				Owner:        owner,
+				Denom:        denom,
+				Phase:        phase,
+				Pagination:   pageReq,
+				LotDenom:     lotDenom,
+				BidDenom:     bidDenom,
+				Bidder:       bidder,
+				Initiator:    initiator,
+				EndingBefore: endingBefore,
 			}
 
 			res, err := queryClient.Auctions(context.Background(), &request)
@@ -203,10 +308,15 @@ func GetCmdQueryAuctions() *cobra.Command {
 
 	flags.AddPaginationFlagsToCmd(cmd, "auctions")
 
-	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus")
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus, dutch")
 	cmd.Flags().String(flagOwner, "", "(optional) filter by collateral auction owner")
 	cmd.Flags().String(flagDenom, "", "(optional) filter by auction denom")
 	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse")
+	cmd.Flags().String(flagLotDenom, "", "(optional) filter by auction lot denom")
+	cmd.Flags().String(flagBidDenom, "", "(optional) filter by auction bid denom")
+	cmd.Flags().String(flagBidder, "", "(optional) filter by current auction bidder")
+	cmd.Flags().String(flagInitiator, "", "(optional) filter by the name of the module that started the auction")
+	cmd.Flags().String(flagEndingBefore, "", "(optional) filter by auctions ending before a time, in RFC3339 format")
 
 	return cmd
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPlaceBid(),
+		GetCmdPlaceBids(),
 	}
 
 	for _, cmd := range cmds {
@@ -68,3 +70,67 @@ func GetCmdPlaceBid() *cobra.Command {
 		},
 	}
 }
+
+const flagAtomic = "atomic"
+
+// GetCmdPlaceBids cli command for placing bids on several auctions at once
+func GetCmdPlaceBids() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bids [auction-id:amount[:max-price]]...",
+		Short: "place bids on several auctions",
+		Long: `Place bids on several auctions at once. Each bid may set a max price, in bid units per lot unit, up to which the bid is automatically raised when outbid.
+Bids that fail are skipped, unless --atomic is set.`,
+		Example: fmt.Sprintf("  $ %s tx %s bids 34:1000usdx 35:500usdx:0.25 --atomic --from myKeyName", version.AppName, types.ModuleName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bids := make([]types.BidOrder, len(args))
+			for i, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 2 && len(parts) != 3 {
+					return fmt.Errorf("bid '%s' not of the form auction-id:amount[:max-price]", arg)
+				}
+
+				id, err := strconv.ParseUint(parts[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("auction-id '%s' not a valid uint", parts[0])
+				}
+
+				amt, err := sdk.ParseCoinNormalized(parts[1])
+				if err != nil {
+					return err
+				}
+
+				maxPrice := sdk.ZeroDec()
+				if len(parts) == 3 {
+					maxPrice, err = sdk.NewDecFromStr(parts[2])
+					if err != nil {
+						return fmt.Errorf("max-price '%s' not a valid decimal", parts[2])
+					}
+				}
+
+				bids[i] = types.NewBidOrder(id, amt, maxPrice)
+			}
+
+			atomic, err := cmd.Flags().GetBool(flagAtomic)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBids(clientCtx.GetFromAddress().String(), bids, atomic)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagAtomic, false, "fail all bids if any bid fails")
+
+	return cmd
+}
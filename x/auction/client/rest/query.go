@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -97,19 +98,25 @@ func queryAuctionsHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		var auctionOwner sdk.AccAddress
 		var auctionDenom string
 		var auctionPhase string
+		var auctionLotDenom string
+		var auctionBidDenom string
+		var auctionBidder sdk.AccAddress
+		var auctionInitiator string
+		var auctionEndingBefore time.Time
 
 		if x := r.URL.Query().Get(RestType); len(x) != 0 {
 			auctionType = strings.ToLower(strings.TrimSpace(x))
 			if auctionType != types.CollateralAuctionType &&
 				auctionType != types.SurplusAuctionType &&
-				auctionType != types.DebtAuctionType {
+				auctionType != types.DebtAuctionType &&
+				auctionType != types.DutchAuctionType {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid auction type %s", x))
 				return
 			}
 		}
 
 		if x := r.URL.Query().Get(RestOwner); len(x) != 0 {
-			if auctionType != types.CollateralAuctionType {
+			if auctionType != types.CollateralAuctionType && auctionType != types.DutchAuctionType {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, "cannot apply owner flag to non-collateral auction type")
 			}
 			auctionOwnerStr := strings.ToLower(strings.TrimSpace(x))
@@ -140,7 +147,49 @@ func queryAuctionsHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			}
 		}
 
-		params := types.NewQueryAllAuctionParams(page, limit, auctionType, auctionDenom, auctionPhase, auctionOwner)
+		if x := r.URL.Query().Get(RestLotDenom); len(x) != 0 {
+			auctionLotDenom = strings.TrimSpace(x)
+			err := sdk.ValidateDenom(auctionLotDenom)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		if x := r.URL.Query().Get(RestBidDenom); len(x) != 0 {
+			auctionBidDenom = strings.TrimSpace(x)
+			err := sdk.ValidateDenom(auctionBidDenom)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		if x := r.URL.Query().Get(RestBidder); len(x) != 0 {
+			auctionBidderStr := strings.ToLower(strings.TrimSpace(x))
+			auctionBidder, err = sdk.AccAddressFromBech32(auctionBidderStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("cannot parse address from auction bidder %s", auctionBidderStr))
+				return
+			}
+		}
+
+		if x := r.URL.Query().Get(RestInitiator); len(x) != 0 {
+			auctionInitiator = strings.TrimSpace(x)
+		}
+
+		if x := r.URL.Query().Get(RestEndingBefore); len(x) != 0 {
+			auctionEndingBefore, err = time.Parse(time.RFC3339, strings.TrimSpace(x))
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("cannot parse auction ending before time %s", x))
+				return
+			}
+		}
+
+		params := types.NewQueryAllAuctionParams(
+			page, limit, auctionType, auctionDenom, auctionPhase, auctionOwner,
+			auctionLotDenom, auctionBidDenom, auctionBidder, auctionInitiator, auctionEndingBefore,
+		)
 		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
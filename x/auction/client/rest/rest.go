@@ -11,10 +11,15 @@ import (
 // REST Variable names
 // nolint
 const (
-	RestType  = "type"
-	RestOwner = "owner"
-	RestDenom = "denom"
-	RestPhase = "phase"
+	RestType         = "type"
+	RestOwner        = "owner"
+	RestDenom        = "denom"
+	RestPhase        = "phase"
+	RestLotDenom     = "lot_denom"
+	RestBidDenom     = "bid_denom"
+	RestBidder       = "bidder"
+	RestInitiator    = "initiator"
+	RestEndingBefore = "ending_before"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
@@ -31,6 +31,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, bankKeeper types.BankKee
 		totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins()...)
 	}
 
+	for _, b := range gs.Bids {
+		keeper.AppendAuctionBid(ctx, b)
+	}
+
+	for _, b := range gs.LimitBids {
+		keeper.SetLimitBid(ctx, b)
+	}
+
 	// check if the module account exists
 	moduleAcc := accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
@@ -56,12 +64,18 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	params := keeper.GetParams(ctx)
 
 	genAuctions := []types.GenesisAuction{} // return empty list instead of nil if no auctions
+	var (
+		bids      []types.AuctionBid
+		limitBids []types.LimitBid
+	)
 	keeper.IterateAuctions(ctx, func(a types.Auction) bool {
 		ga, ok := a.(types.GenesisAuction)
 		if !ok {
 			panic("could not convert stored auction to GenesisAuction type")
 		}
 		genAuctions = append(genAuctions, ga)
+		bids = append(bids, keeper.GetAuctionBids(ctx, a.GetID())...)
+		limitBids = append(limitBids, keeper.GetLimitBids(ctx, a.GetID())...)
 		return false
 	})
 
@@ -69,6 +83,8 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	gs.Bids = bids
+	gs.LimitBids = limitBids
 
 	return gs
 }
@@ -41,6 +41,8 @@ func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin
 			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
 		),
 	)
+
+	k.AfterAuctionStarted(ctx, auction.WithID(auctionID))
 	return auctionID, nil
 }
 
@@ -80,6 +82,8 @@ func (k Keeper) StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, in
 			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
 		),
 	)
+
+	k.AfterAuctionStarted(ctx, auction.WithID(auctionID))
 	return auctionID, nil
 }
 
@@ -126,11 +130,113 @@ func (k Keeper) StartCollateralAuction(
 			sdk.NewAttribute(types.AttributeKeyMaxBid, auction.MaxBid.String()),
 		),
 	)
+
+	k.AfterAuctionStarted(ctx, auction.WithID(auctionID))
+	return auctionID, nil
+}
+
+// StartDutchAuction starts a new dutch (descending price) auction.
+// The lot is priced using the lotMarketID market, divided by the bidMarketID market if the bid is not valued at the
+// quote asset of the lot market, and multiplied by priceConversion to give bid base units per lot base unit.
+func (k Keeper) StartDutchAuction(
+	ctx sdk.Context, seller string, lot, maxBid sdk.Coin,
+	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin,
+	lotMarketID, bidMarketID string, priceConversion sdk.Dec,
+) (uint64, error) {
+	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	startPrice, err := k.getDutchStartPrice(ctx, lotMarketID, bidMarketID, priceConversion)
+	if err != nil {
+		return 0, err
+	}
+	auction := types.NewDutchAuction(
+		seller,
+		lot,
+		k.getDutchResetTime(ctx, ctx.BlockTime()),
+		maxBid,
+		weightedAddresses,
+		debt,
+		lotMarketID,
+		bidMarketID,
+		priceConversion,
+		startPrice,
+		ctx.BlockTime(),
+	)
+	if err := auction.Validate(); err != nil {
+		return 0, err
+	}
+
+	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(debt))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, &auction)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBid, auction.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, auction.MaxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.StartPrice.String()),
+		),
+	)
+
+	k.AfterAuctionStarted(ctx, auction.WithID(auctionID))
 	return auctionID, nil
 }
 
-// PlaceBid places a bid on any auction.
+// getDutchStartPrice returns the current oracle price of a dutch auction lot multiplied by the price buffer.
+func (k Keeper) getDutchStartPrice(ctx sdk.Context, lotMarketID, bidMarketID string, priceConversion sdk.Dec) (sdk.Dec, error) {
+	lotPrice, err := k.pricefeedKeeper.GetCurrentPrice(ctx, lotMarketID)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoValidPrice, "%s: %s", lotMarketID, err)
+	}
+	price := lotPrice.Price
+	if bidMarketID != "" {
+		bidPrice, err := k.pricefeedKeeper.GetCurrentPrice(ctx, bidMarketID)
+		if err != nil {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoValidPrice, "%s: %s", bidMarketID, err)
+		}
+		if !bidPrice.Price.IsPositive() {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoValidPrice, "%s price must be positive", bidMarketID)
+		}
+		price = price.Quo(bidPrice.Price)
+	}
+	return price.Mul(priceConversion).Mul(k.GetParams(ctx).DutchPriceBuffer), nil
+}
+
+// getDutchResetTime returns the time at which the price of a dutch auction started at startTime decays to the reset ratio.
+func (k Keeper) getDutchResetTime(ctx sdk.Context, startTime time.Time) time.Time {
+	params := k.GetParams(ctx)
+	decayed := sdk.OneDec().Sub(params.DutchResetRatio).MulInt64(int64(params.DutchDecayDuration)).TruncateInt64()
+	return startTime.Add(time.Duration(decayed))
+}
+
+// PlaceBid places a bid on any auction, then raises the limit bids of bidders it outbids.
 func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) error {
+	if err := k.placeBid(ctx, auctionID, bidder, newAmount); err != nil {
+		return err
+	}
+	k.applyLimitBids(ctx, auctionID)
+	return nil
+}
+
+// placeBid places a bid on any auction type, without raising the limit bids of outbid bidders.
+func (k Keeper) placeBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) error {
 	auction, found := k.GetAuction(ctx, auctionID)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
@@ -141,6 +247,10 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		return sdkerrors.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
 	}
 
+	// auctions are updated in place, so note what the bid changes for the bid log
+	phase := auction.GetPhase()
+	previousBid, previousLot := auction.GetBid(), auction.GetLot()
+
 	// move coins and return updated auction
 	var (
 		err            error
@@ -157,6 +267,8 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		} else {
 			updatedAuction, err = k.PlaceReverseBidCollateral(ctx, auctionType, bidder, newAmount)
 		}
+	case *types.DutchAuction:
+		updatedAuction, err = k.PlaceBidDutch(ctx, auctionType, bidder, newAmount)
 	default:
 		err = sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
 	}
@@ -167,6 +279,20 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 
 	k.SetAuction(ctx, updatedAuction)
 
+	// dutch auction bids buy part of the lot, so log what was paid and bought rather than the auction totals
+	bid, lot := updatedAuction.GetBid(), updatedAuction.GetLot()
+	if _, ok := updatedAuction.(*types.DutchAuction); ok {
+		bid, lot = bid.Sub(previousBid), previousLot.Sub(lot)
+	}
+	auctionBid := types.NewAuctionBid(auctionID, bidder, bid, lot, phase, ctx.BlockHeight(), ctx.BlockTime())
+	k.AppendAuctionBid(ctx, auctionBid)
+	k.AfterBidPlaced(ctx, updatedAuction, auctionBid)
+
+	// dutch auctions close as soon as they are sold, without waiting for their end time
+	if dutchAuction, ok := updatedAuction.(*types.DutchAuction); ok && dutchAuction.IsSold() {
+		return k.closeAuction(ctx, dutchAuction)
+	}
+
 	return nil
 }
 
@@ -188,7 +314,9 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction *types.SurplusAuction,
 
 	// New bidder pays back old bidder
 	// Catch edge cases of a bidder replacing their own bid, or the amount being zero (sending zero coins produces meaningless send events).
-	if !bidder.Equals(auction.Bidder) && !auction.Bid.IsZero() { // bidder isn't same as before AND previous auction bid must exist
+	previousBidder, refund := auction.Bidder, auction.Bid
+	outbid := !bidder.Equals(auction.Bidder) && !auction.Bid.IsZero() // bidder isn't same as before AND previous auction bid must exist
+	if outbid {
 		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(auction.Bid))
 		if err != nil {
 			return auction, err
@@ -219,6 +347,10 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction *types.SurplusAuction,
 	}
 	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
+	if outbid {
+		k.emitOutbidEvent(ctx, auction, previousBidder, refund)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeAuctionBid,
@@ -257,7 +389,9 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction *types.Collat
 
 	// New bidder pays back old bidder
 	// Catch edge cases of a bidder replacing their own bid, and the amount being zero (sending zero coins produces meaningless send events).
-	if !bidder.Equals(auction.Bidder) && !auction.Bid.IsZero() {
+	previousBidder, refund := auction.Bidder, auction.Bid
+	outbid := !bidder.Equals(auction.Bidder) && !auction.Bid.IsZero()
+	if outbid {
 		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(auction.Bid))
 		if err != nil {
 			return auction, err
@@ -301,6 +435,10 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction *types.Collat
 		auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 	}
 
+	if outbid {
+		k.emitOutbidEvent(ctx, auction, previousBidder, refund)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeAuctionBid,
@@ -338,7 +476,9 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.Collat
 
 	// New bidder pays back old bidder
 	// Catch edge cases of a bidder replacing their own bid
-	if !bidder.Equals(auction.Bidder) {
+	previousBidder, refund := auction.Bidder, auction.Bid
+	outbid := !bidder.Equals(auction.Bidder)
+	if outbid {
 		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(auction.Bid))
 		if err != nil {
 			return auction, err
@@ -375,6 +515,10 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction *types.Collat
 	}
 	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ReverseBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
+	if outbid {
+		k.emitOutbidEvent(ctx, auction, previousBidder, refund)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeAuctionBid,
@@ -409,6 +553,8 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder
 
 	// New bidder pays back old bidder
 	// Catch edge cases of a bidder replacing their own bid
+	previousBidder, refund := auction.Bidder, auction.Bid
+	outbid := !bidder.Equals(auction.Bidder) && auction.HasReceivedBids // only user accounts are outbid
 	if !bidder.Equals(auction.Bidder) {
 		// Bidder sends coins to module
 		err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(auction.Bid))
@@ -449,6 +595,10 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder
 	}
 	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).ForwardBidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
+	if outbid {
+		k.emitOutbidEvent(ctx, auction, previousBidder, refund)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeAuctionBid,
@@ -462,7 +612,116 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction *types.DebtAuction, bidder
 	return auction, nil
 }
 
+// emitOutbidEvent emits an event for a bidder refunded by a new bid. The auction must already hold the new bid.
+func (k Keeper) emitOutbidEvent(ctx sdk.Context, auction types.Auction, previousBidder sdk.AccAddress, refund sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionOutbid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.GetID())),
+			sdk.NewAttribute(types.AttributeKeyPreviousBidder, previousBidder.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+			sdk.NewAttribute(types.AttributeKeyBidder, auction.GetBidder().String()),
+			sdk.NewAttribute(types.AttributeKeyBid, auction.GetBid().String()),
+			sdk.NewAttribute(types.AttributeKeyLot, auction.GetLot().String()),
+			sdk.NewAttribute(types.AttributeKeyPhase, auction.GetPhase()),
+		),
+	)
+}
+
+// PlaceBidDutch buys part of the lot of a dutch auction at the current price, moving coins and returning the updated auction.
+// The lot bought is capped so the amount paid does not raise more than the max bid.
+func (k Keeper) PlaceBidDutch(ctx sdk.Context, auction *types.DutchAuction, bidder sdk.AccAddress, lot sdk.Coin) (*types.DutchAuction, error) {
+	// Validate lot
+	if lot.Denom != auction.Lot.Denom {
+		return auction, sdkerrors.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", lot.Denom, auction.Lot.Denom)
+	}
+	if !lot.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrLotTooSmall, "%s ≤ %s%s", lot, sdk.ZeroInt(), auction.Lot.Denom)
+	}
+	if lot.Amount.GT(auction.Lot.Amount) {
+		return auction, sdkerrors.Wrapf(types.ErrLotTooLarge, "%s > %s", lot, auction.Lot)
+	}
+	price := auction.GetCurrentPrice(ctx.BlockTime(), k.GetParams(ctx).DutchDecayDuration)
+	if !price.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrAuctionHasExpired, "%d has decayed to a zero price", auction.ID)
+	}
+
+	// Cost is rounded up in favor of the auction. Once the max bid is reached the lot is reduced to what the remaining bid buys.
+	remainingBid := auction.MaxBid.Sub(auction.Bid)
+	cost := sdk.NewCoin(auction.Bid.Denom, lot.Amount.ToDec().Mul(price).Ceil().TruncateInt())
+	if remainingBid.IsLT(cost) {
+		cost = remainingBid
+		lot.Amount = sdk.MaxInt(sdk.OneInt(), remainingBid.Amount.ToDec().Quo(price).TruncateInt())
+	}
+
+	// Payment is sent to auction initiator
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(cost))
+	if err != nil {
+		return auction, err
+	}
+	// Debt coins are sent to liquidator (until there is no CorrespondingDebt left). Amount sent is equal to cost (or whatever is left if < cost).
+	if auction.CorrespondingDebt.IsPositive() {
+		debtAmountToReturn := sdk.MinInt(cost.Amount, auction.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(auction.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return auction, err
+		}
+		auction.CorrespondingDebt = auction.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ auction.CorrespondingDebt from the MinInt above
+	}
+	// Lot bought is sent to the bidder
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(lot))
+	if err != nil {
+		return auction, err
+	}
+
+	// Update Auction
+	auction.Bidder = bidder
+	auction.Bid = auction.Bid.Add(cost)
+	auction.Lot = auction.Lot.Sub(lot)
+	auction.HasReceivedBids = true
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, auction.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, cost.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, lot.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+
+	return auction, nil
+}
+
+// ResetDutchAuction restarts the price of an unsold dutch auction from the current oracle price.
+// If the lot cannot be priced the auction restarts from its previous start price, so it is never stuck at a low price.
+func (k Keeper) ResetDutchAuction(ctx sdk.Context, auction *types.DutchAuction) error {
+	startPrice, err := k.getDutchStartPrice(ctx, auction.LotMarketID, auction.BidMarketID, auction.PriceConversion)
+	if err != nil {
+		startPrice = auction.StartPrice
+	}
+	auction.StartPrice = startPrice
+	auction.StartTime = ctx.BlockTime()
+	auction.EndTime = k.getDutchResetTime(ctx, ctx.BlockTime())
+	k.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionReset,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.StartPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+	return nil
+}
+
 // CloseAuction closes an auction and distributes funds to the highest bidder.
+// Unsold dutch auctions are reset instead of closed.
 func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 	auction, found := k.GetAuction(ctx, auctionID)
 	if !found {
@@ -473,15 +732,31 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		return sdkerrors.Wrapf(types.ErrAuctionHasNotExpired, "block time %s, auction end time %s", ctx.BlockTime().UTC(), auction.GetEndTime().UTC())
 	}
 
+	if dutchAuction, ok := auction.(*types.DutchAuction); ok && !dutchAuction.IsSold() {
+		return k.ResetDutchAuction(ctx, dutchAuction)
+	}
+
+	return k.closeAuction(ctx, auction)
+}
+
+// closeAuction pays out an auction, removes it from the store, and emits a close event.
+func (k Keeper) closeAuction(ctx sdk.Context, auction types.Auction) error {
 	// payout to the last bidder
+	// proceeds are what bidders paid for the auction, which for debt auctions is only paid once a bid is placed
+	proceeds := auction.GetBid()
 	var err error
 	switch auc := auction.(type) {
 	case *types.SurplusAuction:
 		err = k.PayoutSurplusAuction(ctx, auc)
 	case *types.DebtAuction:
 		err = k.PayoutDebtAuction(ctx, auc)
+		if !auc.HasReceivedBids {
+			proceeds = sdk.NewCoin(auc.Bid.Denom, sdk.ZeroInt())
+		}
 	case *types.CollateralAuction:
 		err = k.PayoutCollateralAuction(ctx, auc)
+	case *types.DutchAuction:
+		err = k.PayoutDutchAuction(ctx, auc)
 	default:
 		err = sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auc.GetType())
 	}
@@ -490,15 +765,17 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		return err
 	}
 
-	k.DeleteAuction(ctx, auctionID)
+	k.DeleteAuction(ctx, auction.GetID())
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeAuctionClose,
-			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.GetID())),
 			sdk.NewAttribute(types.AttributeKeyCloseBlock, fmt.Sprintf("%d", ctx.BlockHeight())),
 		),
 	)
+
+	k.AfterAuctionClosed(ctx, auction, proceeds)
 	return nil
 }
 
@@ -544,6 +821,36 @@ func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, auction *types.Collater
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
 }
 
+// PayoutDutchAuction returns the unsold lot and any remaining debt of a dutch auction.
+// Bidders are paid their lot as they bid, so only the remainder is paid out.
+func (k Keeper) PayoutDutchAuction(ctx sdk.Context, auction *types.DutchAuction) error {
+	// Unsold lot is sent to weighted addresses (normally the CDP depositors)
+	// Note: splitting an integer amount across weighted buckets results in small errors.
+	if auction.Lot.IsPositive() {
+		lotPayouts, err := splitCoinIntoWeightedBuckets(auction.Lot, auction.LotReturns.Weights)
+		if err != nil {
+			return err
+		}
+		for i, payout := range lotPayouts {
+			// if the payout amount is 0, don't send 0 coins
+			if !payout.IsPositive() {
+				continue
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.LotReturns.Addresses[i], sdk.NewCoins(payout))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// if there is remaining debt after the auction, send it back to the initiating module for management
+	if !auction.CorrespondingDebt.IsPositive() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+}
+
 // CloseExpiredAuctions iterates over all the auctions stored by until the current
 // block timestamp and that are past (or at) their ending times and closes them,
 // paying out to the highest bidder.
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/mokitanetwork/aether/x/auction/testutil"
 	"github.com/mokitanetwork/aether/x/auction/types"
+	pricefeedtypes "github.com/mokitanetwork/aether/x/pricefeed/types"
 )
 
 type auctionTestSuite struct {
@@ -156,6 +158,87 @@ func (suite *auctionTestSuite) TestCollateralAuctionBasic() {
 	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 115), c("token2", 50)))
 }
 
+func (suite *auctionTestSuite) TestCollateralAuctionOutbid() {
+	// Setup
+	returnAddrs := suite.Addrs[2:]
+	returnWeights := is(30, 20)
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	suite.NoError(err)
+
+	// Place forward bids, outbidding each other
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 10)))
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[1], c("token2", 20)))
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 50)))
+	// Place a reverse bid
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[1], c("token1", 15)))
+
+	// Check outbid bidders were refunded
+	suite.CheckAccountBalanceEqual(suite.Addrs[0], cs(c("token1", 100), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 100), c("token2", 50)))
+
+	// Check the outbid events
+	var outbidEvents []sdk.Event
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAuctionOutbid {
+			outbidEvents = append(outbidEvents, event)
+		}
+	}
+	expectedEvents := []sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeAuctionOutbid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, "1"),
+			sdk.NewAttribute(types.AttributeKeyPreviousBidder, suite.Addrs[0].String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, "10token2"),
+			sdk.NewAttribute(types.AttributeKeyBidder, suite.Addrs[1].String()),
+			sdk.NewAttribute(types.AttributeKeyBid, "20token2"),
+			sdk.NewAttribute(types.AttributeKeyLot, "20token1"),
+			sdk.NewAttribute(types.AttributeKeyPhase, types.ForwardAuctionPhase),
+		),
+		sdk.NewEvent(
+			types.EventTypeAuctionOutbid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, "1"),
+			sdk.NewAttribute(types.AttributeKeyPreviousBidder, suite.Addrs[1].String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, "20token2"),
+			sdk.NewAttribute(types.AttributeKeyBidder, suite.Addrs[0].String()),
+			sdk.NewAttribute(types.AttributeKeyBid, "50token2"),
+			sdk.NewAttribute(types.AttributeKeyLot, "20token1"),
+			sdk.NewAttribute(types.AttributeKeyPhase, types.ReverseAuctionPhase), // the max bid ends the forward phase
+		),
+		sdk.NewEvent(
+			types.EventTypeAuctionOutbid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, "1"),
+			sdk.NewAttribute(types.AttributeKeyPreviousBidder, suite.Addrs[0].String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, "50token2"),
+			sdk.NewAttribute(types.AttributeKeyBidder, suite.Addrs[1].String()),
+			sdk.NewAttribute(types.AttributeKeyBid, "50token2"),
+			sdk.NewAttribute(types.AttributeKeyLot, "15token1"),
+			sdk.NewAttribute(types.AttributeKeyPhase, types.ReverseAuctionPhase),
+		),
+	}
+	suite.Equal(expectedEvents, outbidEvents)
+
+	// Check the bid log
+	blockTime := suite.Ctx.BlockTime()
+	height := suite.Ctx.BlockHeight()
+	suite.Equal(
+		[]types.AuctionBid{
+			types.NewAuctionBid(auctionID, suite.Addrs[0], c("token2", 10), c("token1", 20), types.ForwardAuctionPhase, height, blockTime),
+			types.NewAuctionBid(auctionID, suite.Addrs[1], c("token2", 20), c("token1", 20), types.ForwardAuctionPhase, height, blockTime),
+			types.NewAuctionBid(auctionID, suite.Addrs[0], c("token2", 50), c("token1", 20), types.ForwardAuctionPhase, height, blockTime),
+			types.NewAuctionBid(auctionID, suite.Addrs[1], c("token2", 50), c("token1", 15), types.ReverseAuctionPhase, height, blockTime),
+		},
+		suite.Keeper.GetAuctionBids(suite.Ctx, auctionID),
+	)
+
+	// Check the bid log is removed with the auction
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultReverseBidDuration))
+	suite.NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+	suite.Empty(suite.Keeper.GetAuctionBids(ctx, auctionID))
+}
+
 func (suite *auctionTestSuite) TestCollateralAuctionDebtRemaining() {
 	// Setup
 	buyer := suite.Addrs[0]
@@ -194,6 +277,120 @@ func (suite *auctionTestSuite) TestCollateralAuctionDebtRemaining() {
 	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
 }
 
+func (suite *auctionTestSuite) setPrice(marketID string, price sdk.Dec) {
+	pfKeeper := suite.App.GetPriceFeedKeeper()
+	pfKeeper.SetParams(suite.Ctx, pricefeedtypes.NewParams([]pricefeedtypes.Market{
+		pricefeedtypes.NewMarket(marketID, "token1", "usd", nil, true),
+	}))
+	_, err := pfKeeper.SetPrice(suite.Ctx, sdk.AccAddress{}, marketID, price, suite.Ctx.BlockTime().Add(48*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.Ctx, marketID))
+}
+
+func (suite *auctionTestSuite) TestDutchAuctionBasic() {
+	// Setup
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:2]
+	returnWeights := is(1)
+	sellerModName := suite.ModAcc.Name
+	sellerAddr := suite.ModAcc.GetAddress()
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+	suite.setPrice("token1:usd", d("2.0"))
+
+	// Start auction, with the price starting at 2.0 * 1.2 = 2.4 token2 per token1
+	auctionID, err := suite.Keeper.StartDutchAuction(
+		suite.Ctx, sellerModName, c("token1", 20), c("token2", 30), returnAddrs, returnWeights, c("debt", 30),
+		"token1:usd", "", d("1"),
+	)
+	suite.NoError(err)
+	// Check seller's coins have decreased
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 70)))
+
+	// Buy part of the lot after an hour, at a price of 2.4 * 5/6 = 2.0
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 5)))
+	// Check bidder has paid and received the lot immediately
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 105), c("token2", 90)))
+	// Check seller's coins have increased
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 80)))
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	suite.Equal(c("token1", 15), auction.GetLot())
+	suite.Equal(c("token2", 10), auction.GetBid())
+
+	// Buy more than the remaining max bid at a price of 1.6, which is reduced to the 20 / 1.6 = 12 token1 the max bid buys
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token1", 15)))
+	suite.CheckAccountBalanceEqual(buyer, cs(c("token1", 117), c("token2", 70)))
+	suite.CheckAccountBalanceEqual(sellerAddr, cs(c("token1", 80), c("token2", 130), c("debt", 100)))
+
+	// Check the auction closed once the max bid was raised, returning the unsold lot
+	suite.CheckAccountBalanceEqual(suite.Addrs[1], cs(c("token1", 103), c("token2", 100)))
+	_, found = suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.False(found)
+}
+
+func (suite *auctionTestSuite) TestDutchAuctionReset() {
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+	suite.setPrice("token1:usd", d("2.0"))
+	startTime := suite.Ctx.BlockTime()
+
+	auctionID, err := suite.Keeper.StartDutchAuction(
+		suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 30), suite.Addrs[1:2], is(1), c("debt", 30),
+		"token1:usd", "", d("1"),
+	)
+	suite.NoError(err)
+
+	// The auction resets once the price decays to half its start price
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	suite.Equal(startTime.Add(3*time.Hour), auction.GetEndTime())
+	suite.True(errors.Is(suite.Keeper.CloseAuction(suite.Ctx, auctionID), types.ErrAuctionHasNotExpired))
+
+	// Reset the auction from the new oracle price
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(3 * time.Hour))
+	suite.setPrice("token1:usd", d("1.0"))
+	suite.NoError(suite.Keeper.CloseExpiredAuctions(suite.Ctx))
+
+	auction, found = suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	dutchAuction, ok := auction.(*types.DutchAuction)
+	suite.Require().True(ok)
+	suite.Equal(d("1.2"), dutchAuction.StartPrice)
+	suite.Equal(suite.Ctx.BlockTime(), dutchAuction.StartTime)
+	suite.Equal(suite.Ctx.BlockTime().Add(3*time.Hour), dutchAuction.EndTime)
+	suite.Equal(c("token1", 20), dutchAuction.Lot)
+
+	// Bids are placed at the reset price
+	suite.NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 10)))
+	suite.CheckAccountBalanceEqual(suite.Addrs[0], cs(c("token1", 110), c("token2", 88)))
+}
+
+func (suite *auctionTestSuite) TestDutchAuctionInvalid() {
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// Auctions cannot start without a price
+	_, err := suite.Keeper.StartDutchAuction(
+		suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 30), suite.Addrs[1:2], is(1), c("debt", 30),
+		"token1:usd", "", d("1"),
+	)
+	suite.True(errors.Is(err, types.ErrNoValidPrice))
+
+	suite.setPrice("token1:usd", d("2.0"))
+	auctionID, err := suite.Keeper.StartDutchAuction(
+		suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 30), suite.Addrs[1:2], is(1), c("debt", 30),
+		"token1:usd", "", d("1"),
+	)
+	suite.NoError(err)
+
+	err = suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token2", 5))
+	suite.True(errors.Is(err, types.ErrInvalidLotDenom))
+	err = suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 0))
+	suite.True(errors.Is(err, types.ErrLotTooSmall))
+	err = suite.Keeper.PlaceBid(suite.Ctx, auctionID, suite.Addrs[0], c("token1", 21))
+	suite.True(errors.Is(err, types.ErrLotTooLarge))
+}
+
 func (suite *auctionTestSuite) TestStartSurplusAuction() {
 	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
@@ -461,6 +461,9 @@ func TestAuctionBidding(t *testing.T) {
 				types.DefaultIncrement,
 				types.DefaultIncrement,
 				types.DefaultIncrement,
+				types.DefaultDutchPriceBuffer,
+				types.DefaultDutchDecayDuration,
+				types.DefaultDutchResetRatio,
 			)
 
 			auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{})
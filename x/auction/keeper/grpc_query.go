@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	"google.golang.org/grpc/codes"
@@ -74,33 +75,60 @@ func (s queryServer) Auctions(c context.Context, req *types.QueryAuctionsRequest
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	var auctions []*codectypes.Any
-	auctionStore := prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.AuctionKeyPrefix)
-
-	pageRes, err := query.FilteredPaginate(auctionStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		result, err := s.keeper.UnmarshalAuction(value)
+	params := types.QueryAllAuctionParams{
+		Type:         req.Type,
+		Denom:        req.Denom,
+		Phase:        req.Phase,
+		LotDenom:     req.LotDenom,
+		BidDenom:     req.BidDenom,
+		Initiator:    req.Initiator,
+		EndingBefore: req.EndingBefore,
+	}
+	if req.Owner != "" {
+		owner, err := sdk.AccAddressFromBech32(req.Owner)
 		if err != nil {
-			return false, err
+			return nil, status.Errorf(codes.InvalidArgument, "invalid owner: %s", err)
 		}
+		params.Owner = owner
+	}
+	if req.Bidder != "" {
+		bidder, err := sdk.AccAddressFromBech32(req.Bidder)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid bidder: %s", err)
+		}
+		params.Bidder = bidder
+	}
 
-		// True if empty owner, otherwise check if auction contains owner
-		ownerIsMatch := req.Owner == ""
-		if req.Owner != "" {
-			if cAuc, ok := result.(*types.CollateralAuction); ok {
-				for _, addr := range cAuc.GetLotReturns().Addresses {
-					if addr.String() == req.Owner {
-						ownerIsMatch = true
-						break
-					}
-				}
+	// scan a secondary index of auction IDs if one of the filters is indexed, otherwise scan all auctions
+	var store sdk.KVStore
+	index, endKey, indexed := s.keeper.auctionQueryIndex(ctx, params)
+	switch {
+	case !indexed:
+		store = prefix.NewStore(ctx.KVStore(s.keeper.storeKey), types.AuctionKeyPrefix)
+	case endKey != nil:
+		store = boundedStore{KVStore: index, end: endKey}
+	default:
+		store = index
+	}
+
+	var auctions []*codectypes.Any
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var result types.Auction
+		if indexed {
+			auction, found := s.keeper.GetAuction(ctx, types.Uint64FromBytes(value))
+			if !found {
+				return false, nil
+			}
+			result = auction
+		} else {
+			auction, err := s.keeper.UnmarshalAuction(value)
+			if err != nil {
+				return false, err
 			}
+			result = auction
 		}
 
-		phaseIsMatch := req.Phase == "" || req.Phase == result.GetPhase()
-		typeIsMatch := req.Type == "" || req.Type == result.GetType()
-		denomIsMatch := req.Denom == "" || req.Denom == result.GetBid().Denom || req.Denom == result.GetLot().Denom
-
-		if ownerIsMatch && phaseIsMatch && typeIsMatch && denomIsMatch {
+		if auctionIsMatch(result, params) {
 			if accumulate {
 				msg, ok := result.(proto.Message)
 				if !ok {
@@ -129,6 +157,55 @@ func (s queryServer) Auctions(c context.Context, req *types.QueryAuctionsRequest
 	}, nil
 }
 
+// boundedStore is a KVStore whose iterators stop before an end key, so paginating it never reads past the end key
+type boundedStore struct {
+	sdk.KVStore
+	end []byte
+}
+
+// Iterator iterates from start up to end or the store's end key, whichever is first
+func (s boundedStore) Iterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.Iterator(start, s.boundEnd(end))
+}
+
+// ReverseIterator iterates back from end or the store's end key, whichever is first, down to start
+func (s boundedStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.ReverseIterator(start, s.boundEnd(end))
+}
+
+func (s boundedStore) boundEnd(end []byte) []byte {
+	if end == nil || bytes.Compare(end, s.end) > 0 {
+		return s.end
+	}
+	return end
+}
+
+// AuctionBids implements the Query/AuctionBids gRPC method
+func (s queryServer) AuctionBids(c context.Context, req *types.QueryAuctionBidsRequest) (*types.QueryAuctionBidsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var bids []types.AuctionBid
+	pageRes, err := query.Paginate(s.keeper.auctionBidStore(ctx, req.AuctionId), req.Pagination, func(key []byte, value []byte) error {
+		var bid types.AuctionBid
+		if err := s.keeper.cdc.Unmarshal(value, &bid); err != nil {
+			return err
+		}
+		bids = append(bids, bid)
+		return nil
+	})
+	if err != nil {
+		return &types.QueryAuctionBidsResponse{}, err
+	}
+
+	return &types.QueryAuctionBidsResponse{
+		Bids:       bids,
+		Pagination: pageRes,
+	}, nil
+}
+
 // NextAuctionID implements the gRPC service handler for querying x/auction next auction ID.
 func (s queryServer) NextAuctionID(ctx context.Context, req *types.QueryNextAuctionIDRequest) (*types.QueryNextAuctionIDResponse, error) {
 	if req == nil {
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/mokitanetwork/aether/app"
 	"github.com/mokitanetwork/aether/x/auction/keeper"
@@ -57,6 +58,18 @@ func TestGrpcAuctionsFilter(t *testing.T) {
 			},
 			c("debt", 12345678),
 		).WithID(3),
+		&types.SurplusAuction{
+			BaseAuction: types.BaseAuction{
+				ID:              4,
+				Initiator:       "otherMod",
+				Lot:             c("usdx", 12345678),
+				Bidder:          addrs[1],
+				Bid:             c("hard", 10),
+				HasReceivedBids: true,
+				EndTime:         time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC),
+				MaxEndTime:      time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
 	}
 	for _, a := range auctions {
 		auctionsKeeper.SetAuction(ctx, a)
@@ -113,6 +126,71 @@ func TestGrpcAuctionsFilter(t *testing.T) {
 			},
 			auctions[3:4],
 		},
+		{
+			"lot denom",
+			types.QueryAuctionsRequest{
+				LotDenom: "hard",
+			},
+			auctions[3:4],
+		},
+		{
+			"bid denom",
+			types.QueryAuctionsRequest{
+				BidDenom: "hard",
+			},
+			[]types.Auction{auctions[1], auctions[4]},
+		},
+		{
+			"bidder",
+			types.QueryAuctionsRequest{
+				Bidder: addrs[1].String(),
+			},
+			auctions[4:5],
+		},
+		{
+			"initiator",
+			types.QueryAuctionsRequest{
+				Initiator: "sellerMod",
+			},
+			[]types.Auction{auctions[0], auctions[2], auctions[3]},
+		},
+		{
+			"initiator and bid denom",
+			types.QueryAuctionsRequest{
+				Initiator: "sellerMod",
+				BidDenom:  "hard",
+			},
+			nil,
+		},
+		{
+			"ending before",
+			types.QueryAuctionsRequest{
+				EndingBefore: time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+			auctions[0:4],
+		},
+		{
+			"ending before excludes auctions ending at the time",
+			types.QueryAuctionsRequest{
+				EndingBefore: time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+			nil,
+		},
+		{
+			"type and phase",
+			types.QueryAuctionsRequest{
+				Type:  types.CollateralAuctionType,
+				Phase: types.ForwardAuctionPhase,
+			},
+			auctions[2:4],
+		},
+		{
+			"phase",
+			types.QueryAuctionsRequest{
+				Phase: types.ReverseAuctionPhase,
+			},
+			auctions[1:2],
+		},
 	}
 
 	for _, tc := range tests {
@@ -133,4 +211,79 @@ func TestGrpcAuctionsFilter(t *testing.T) {
 			require.Equal(t, tc.wantResponse, unpackedAuctions)
 		})
 	}
+
+	_, err := qs.Auctions(sdk.WrapSDKContext(ctx), &types.QueryAuctionsRequest{Bidder: "invalid"})
+	require.Error(t, err)
+
+	// check auctions ending before a time are paged through up to that time
+	endingBefore := time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC)
+	res, err := qs.Auctions(sdk.WrapSDKContext(ctx), &types.QueryAuctionsRequest{
+		EndingBefore: endingBefore,
+		Pagination:   &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Auctions, 3)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+	res, err = qs.Auctions(sdk.WrapSDKContext(ctx), &types.QueryAuctionsRequest{
+		EndingBefore: endingBefore,
+		Pagination:   &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Auctions, 1)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// check the indexes follow changes to an auction
+	outbidAuction := *auctions[4].(*types.SurplusAuction)
+	outbidAuction.Bidder = addrs[0]
+	auctionsKeeper.SetAuction(ctx, &outbidAuction)
+
+	res, err = qs.Auctions(sdk.WrapSDKContext(ctx), &types.QueryAuctionsRequest{Bidder: addrs[1].String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Auctions)
+	res, err = qs.Auctions(sdk.WrapSDKContext(ctx), &types.QueryAuctionsRequest{Bidder: addrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Auctions, 1)
+
+	auctionsKeeper.DeleteAuction(ctx, outbidAuction.ID)
+	res, err = qs.Auctions(sdk.WrapSDKContext(ctx), &types.QueryAuctionsRequest{Bidder: addrs[0].String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Auctions)
+}
+
+func TestGrpcAuctionBids(t *testing.T) {
+	// setup
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	auctionsKeeper := tApp.GetAuctionKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	bids := []types.AuctionBid{
+		types.NewAuctionBid(1, addrs[0], c("usdx", 10), c("aeth", 100), types.ForwardAuctionPhase, 1, someTime),
+		types.NewAuctionBid(1, addrs[1], c("usdx", 20), c("aeth", 100), types.ForwardAuctionPhase, 2, someTime),
+		types.NewAuctionBid(1, addrs[0], c("usdx", 20), c("aeth", 90), types.ReverseAuctionPhase, 3, someTime),
+	}
+	for _, b := range bids {
+		auctionsKeeper.AppendAuctionBid(ctx, b)
+	}
+	auctionsKeeper.AppendAuctionBid(ctx, types.NewAuctionBid(2, addrs[0], c("usdx", 10), c("aeth", 100), types.ForwardAuctionPhase, 1, someTime))
+
+	qs := keeper.NewQueryServerImpl(auctionsKeeper)
+
+	res, err := qs.AuctionBids(sdk.WrapSDKContext(ctx), &types.QueryAuctionBidsRequest{AuctionId: 1})
+	require.NoError(t, err)
+	require.Equal(t, bids, res.Bids)
+
+	res, err = qs.AuctionBids(sdk.WrapSDKContext(ctx), &types.QueryAuctionBidsRequest{
+		AuctionId:  1,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, bids[1:2], res.Bids)
+
+	res, err = qs.AuctionBids(sdk.WrapSDKContext(ctx), &types.QueryAuctionBidsRequest{AuctionId: 3})
+	require.NoError(t, err)
+	require.Empty(t, res.Bids)
 }
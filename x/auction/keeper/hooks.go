@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mokitanetwork/aether/x/auction/types"
+)
+
+// Implements AuctionHooks interface
+var _ types.AuctionHooks = Keeper{}
+
+// AfterAuctionStarted - call hook if registered
+func (k Keeper) AfterAuctionStarted(ctx sdk.Context, auction types.Auction) {
+	if k.hooks != nil {
+		k.hooks.AfterAuctionStarted(ctx, auction)
+	}
+}
+
+// AfterBidPlaced - call hook if registered
+func (k Keeper) AfterBidPlaced(ctx sdk.Context, auction types.Auction, bid types.AuctionBid) {
+	if k.hooks != nil {
+		k.hooks.AfterBidPlaced(ctx, auction, bid)
+	}
+}
+
+// AfterAuctionClosed - call hook if registered
+func (k Keeper) AfterAuctionClosed(ctx sdk.Context, auction types.Auction, proceeds sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterAuctionClosed(ctx, auction, proceeds)
+	}
+}
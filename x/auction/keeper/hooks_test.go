@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mokitanetwork/aether/x/auction/types"
+	"github.com/mokitanetwork/aether/x/auction/types/mocks"
+)
+
+func (suite *auctionTestSuite) TestHooks_CollateralAuction() {
+	suite.Keeper.ClearHooks()
+	auctionHooks := mocks.NewAuctionHooks(suite.T())
+	suite.Keeper.SetHooks(auctionHooks)
+
+	buyer := suite.Addrs[0]
+	returnAddrs := suite.Addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := suite.ModAcc.Name
+	suite.AddCoinsToNamedModule(sellerModName, cs(c("token1", 100), c("token2", 100), c("debt", 100)))
+
+	// starting an auction calls AfterAuctionStarted with the new auction
+	expectedAuction := types.NewCollateralAuction(
+		sellerModName,
+		c("token1", 20),
+		types.DistantFuture,
+		c("token2", 50),
+		types.WeightedAddresses{Addresses: returnAddrs, Weights: returnWeights},
+		c("debt", 40),
+	).WithID(types.DefaultNextAuctionID)
+	auctionHooks.On("AfterAuctionStarted", suite.Ctx, expectedAuction).Once()
+	auctionID, err := suite.Keeper.StartCollateralAuction(suite.Ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	suite.Require().NoError(err)
+
+	// placing a bid calls AfterBidPlaced with the updated auction and the bid
+	isAuctionWithBid := func(bid int64) interface{} {
+		return mock.MatchedBy(func(a types.Auction) bool {
+			return a.GetID() == auctionID && a.GetBid().IsEqual(c("token2", bid))
+		})
+	}
+	expectedBid := types.NewAuctionBid(auctionID, buyer, c("token2", 10), c("token1", 20), types.ForwardAuctionPhase, suite.Ctx.BlockHeight(), suite.Ctx.BlockTime())
+	auctionHooks.On("AfterBidPlaced", suite.Ctx, isAuctionWithBid(10), expectedBid).Once()
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, buyer, c("token2", 10)))
+
+	// closing the auction calls AfterAuctionClosed with the bid as the proceeds
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultForwardBidDuration))
+	auctionHooks.On("AfterAuctionClosed", ctx, isAuctionWithBid(10), c("token2", 10)).Once()
+	suite.Require().NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+}
+
+func (suite *auctionTestSuite) TestHooks_DebtAuction() {
+	suite.Keeper.ClearHooks()
+	auctionHooks := mocks.NewAuctionHooks(suite.T())
+	suite.Keeper.SetHooks(auctionHooks)
+
+	bidder := suite.Addrs[0]
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("debt", 100)))
+
+	auctionHooks.On("AfterAuctionStarted", suite.Ctx, mock.Anything).Once()
+	auctionID, err := suite.Keeper.StartDebtAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 99999), c("debt", 20))
+	suite.Require().NoError(err)
+
+	// reverse bids are logged with the lot they bid down to
+	expectedBid := types.NewAuctionBid(auctionID, bidder, c("token1", 20), c("token2", 10), types.ReverseAuctionPhase, suite.Ctx.BlockHeight(), suite.Ctx.BlockTime())
+	auctionHooks.On("AfterBidPlaced", suite.Ctx, mock.Anything, expectedBid).Once()
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, bidder, c("token2", 10)))
+
+	// the proceeds of a debt auction are the fixed amount it raises
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultForwardBidDuration))
+	auctionHooks.On("AfterAuctionClosed", ctx, mock.Anything, c("token1", 20)).Once()
+	suite.Require().NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+}
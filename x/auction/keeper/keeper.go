@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -15,30 +16,48 @@ import (
 )
 
 type Keeper struct {
-	storeKey      sdk.StoreKey
-	cdc           codec.Codec
-	paramSubspace paramtypes.Subspace
-	bankKeeper    types.BankKeeper
-	accountKeeper types.AccountKeeper
+	storeKey        sdk.StoreKey
+	cdc             codec.Codec
+	paramSubspace   paramtypes.Subspace
+	bankKeeper      types.BankKeeper
+	accountKeeper   types.AccountKeeper
+	pricefeedKeeper types.PricefeedKeeper
+	hooks           types.AuctionHooks
 }
 
 // NewKeeper returns a new auction keeper.
 func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, paramstore paramtypes.Subspace,
-	bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, pricefeedKeeper types.PricefeedKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		storeKey:      storeKey,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
+		storeKey:        storeKey,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		pricefeedKeeper: pricefeedKeeper,
+		hooks:           nil,
 	}
 }
 
+// SetHooks adds hooks to the keeper.
+func (k *Keeper) SetHooks(hooks types.AuctionHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set auction hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// ClearHooks clears the hooks on the keeper
+func (k *Keeper) ClearHooks() {
+	k.hooks = nil
+}
+
 // MustUnmarshalAuction attempts to decode and return an Auction object from
 // raw encoded bytes. It panics on error.
 func (k Keeper) MustUnmarshalAuction(bz []byte) types.Auction {
@@ -127,12 +146,14 @@ func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
 	existingAuction, found := k.GetAuction(ctx, auction.GetID())
 	if found {
 		k.removeFromByTimeIndex(ctx, existingAuction.GetEndTime(), existingAuction.GetID())
+		k.removeFromByValueIndexes(ctx, existingAuction)
 	}
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKeyPrefix)
 
 	store.Set(types.GetAuctionKey(auction.GetID()), k.MustMarshalAuction(auction))
 	k.InsertIntoByTimeIndex(ctx, auction.GetEndTime(), auction.GetID())
+	k.insertIntoByValueIndexes(ctx, auction)
 }
 
 // GetAuction gets an auction from the store.
@@ -153,10 +174,14 @@ func (k Keeper) DeleteAuction(ctx sdk.Context, auctionID uint64) {
 	auction, found := k.GetAuction(ctx, auctionID)
 	if found {
 		k.removeFromByTimeIndex(ctx, auction.GetEndTime(), auctionID)
+		k.removeFromByValueIndexes(ctx, auction)
 	}
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKeyPrefix)
 	store.Delete(types.GetAuctionKey(auctionID))
+
+	k.deleteAuctionBids(ctx, auctionID)
+	k.deleteLimitBids(ctx, auctionID)
 }
 
 // InsertIntoByTimeIndex adds an auction ID and end time into the byTime index.
@@ -171,6 +196,47 @@ func (k Keeper) removeFromByTimeIndex(ctx sdk.Context, endTime time.Time, auctio
 	store.Delete(types.GetAuctionByTimeKey(endTime, auctionID))
 }
 
+// byValueIndex is a secondary index of auctions by one of their fields.
+type byValueIndex struct {
+	keyPrefix []byte
+	value     func(auction types.Auction) []byte
+}
+
+// byValueIndexes are the secondary indexes auctions are stored under, in addition to the byTime index.
+var byValueIndexes = []byValueIndex{
+	{types.AuctionByTypeKeyPrefix, func(a types.Auction) []byte { return []byte(a.GetType()) }},
+	{types.AuctionByLotDenomKeyPrefix, func(a types.Auction) []byte { return []byte(a.GetLot().Denom) }},
+	{types.AuctionByBidDenomKeyPrefix, func(a types.Auction) []byte { return []byte(a.GetBid().Denom) }},
+	{types.AuctionByBidderKeyPrefix, func(a types.Auction) []byte { return a.GetBidder() }},
+	{types.AuctionByInitiatorKeyPrefix, func(a types.Auction) []byte { return []byte(a.GetInitiator()) }},
+	{types.AuctionByPhaseKeyPrefix, func(a types.Auction) []byte { return []byte(a.GetPhase()) }},
+}
+
+// insertIntoByValueIndexes adds an auction ID into the secondary indexes under the auction's values.
+// Empty values, such as the bidder of an auction with no bids, are not indexed.
+func (k Keeper) insertIntoByValueIndexes(ctx sdk.Context, auction types.Auction) {
+	for _, index := range byValueIndexes {
+		value := index.value(auction)
+		if len(value) == 0 {
+			continue
+		}
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), index.keyPrefix)
+		store.Set(types.GetAuctionByValueAuctionKey(value, auction.GetID()), types.Uint64ToBytes(auction.GetID()))
+	}
+}
+
+// removeFromByValueIndexes removes an auction ID from the secondary indexes under the auction's values.
+func (k Keeper) removeFromByValueIndexes(ctx sdk.Context, auction types.Auction) {
+	for _, index := range byValueIndexes {
+		value := index.value(auction)
+		if len(value) == 0 {
+			continue
+		}
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), index.keyPrefix)
+		store.Delete(types.GetAuctionByValueAuctionKey(value, auction.GetID()))
+	}
+}
+
 // IterateAuctionByTime provides an iterator over auctions ordered by auction.EndTime.
 // For each auction cb will be callled. If cb returns true the iterator will close and stop.
 func (k Keeper) IterateAuctionsByTime(ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(auctionID uint64) (stop bool)) {
@@ -214,3 +280,144 @@ func (k Keeper) GetAllAuctions(ctx sdk.Context) (auctions []types.Auction) {
 	})
 	return
 }
+
+// AppendAuctionBid adds a bid to the end of its auction's bid log, pruning the oldest bid if the log is full.
+func (k Keeper) AppendAuctionBid(ctx sdk.Context, bid types.AuctionBid) {
+	store := k.auctionBidStore(ctx, bid.AuctionID)
+
+	var (
+		count    int
+		oldest   []byte
+		sequence uint64
+	)
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		if oldest == nil {
+			oldest = append([]byte{}, iterator.Key()...)
+		}
+		sequence = types.Uint64FromBytes(iterator.Key()) + 1
+		count++
+	}
+	iterator.Close()
+
+	if count >= types.MaxAuctionBids {
+		store.Delete(oldest)
+	}
+	store.Set(types.Uint64ToBytes(sequence), k.cdc.MustMarshal(&bid))
+}
+
+// IterateAuctionBids provides an iterator over the bid log of an auction, oldest bid first.
+// For each bid, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateAuctionBids(ctx sdk.Context, auctionID uint64, cb func(bid types.AuctionBid) (stop bool)) {
+	iterator := k.auctionBidStore(ctx, auctionID).Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.AuctionBid
+		k.cdc.MustUnmarshal(iterator.Value(), &bid)
+
+		if cb(bid) {
+			break
+		}
+	}
+}
+
+// GetAuctionBids returns the bid log of an auction, oldest bid first
+func (k Keeper) GetAuctionBids(ctx sdk.Context, auctionID uint64) (bids []types.AuctionBid) {
+	k.IterateAuctionBids(ctx, auctionID, func(bid types.AuctionBid) bool {
+		bids = append(bids, bid)
+		return false
+	})
+	return
+}
+
+// deleteAuctionBids removes the bid log of an auction.
+func (k Keeper) deleteAuctionBids(ctx sdk.Context, auctionID uint64) {
+	store := k.auctionBidStore(ctx, auctionID)
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// auctionBidStore returns the prefix store holding the bid log of an auction.
+func (k Keeper) auctionBidStore(ctx sdk.Context, auctionID uint64) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionBidKeyPrefix)
+	return prefix.NewStore(store, types.GetAuctionBidsKey(auctionID))
+}
+
+// SetLimitBid stores a limit bid, replacing any previous limit bid of the bidder on the auction.
+func (k Keeper) SetLimitBid(ctx sdk.Context, limitBid types.LimitBid) {
+	store := k.limitBidStore(ctx, limitBid.AuctionID)
+	store.Set(address.MustLengthPrefix(limitBid.Bidder), k.cdc.MustMarshal(&limitBid))
+}
+
+// GetLimitBid gets a bidder's limit bid on an auction.
+func (k Keeper) GetLimitBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) (types.LimitBid, bool) {
+	bz := k.limitBidStore(ctx, auctionID).Get(address.MustLengthPrefix(bidder))
+	if bz == nil {
+		return types.LimitBid{}, false
+	}
+	var limitBid types.LimitBid
+	k.cdc.MustUnmarshal(bz, &limitBid)
+	return limitBid, true
+}
+
+// DeleteLimitBid removes a bidder's limit bid on an auction.
+func (k Keeper) DeleteLimitBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) {
+	k.limitBidStore(ctx, auctionID).Delete(address.MustLengthPrefix(bidder))
+}
+
+// IterateLimitBids provides an iterator over the limit bids of an auction.
+// For each limit bid, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateLimitBids(ctx sdk.Context, auctionID uint64, cb func(limitBid types.LimitBid) (stop bool)) {
+	iterator := k.limitBidStore(ctx, auctionID).Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var limitBid types.LimitBid
+		k.cdc.MustUnmarshal(iterator.Value(), &limitBid)
+
+		if cb(limitBid) {
+			break
+		}
+	}
+}
+
+// GetLimitBids returns the limit bids of an auction
+func (k Keeper) GetLimitBids(ctx sdk.Context, auctionID uint64) (limitBids []types.LimitBid) {
+	k.IterateLimitBids(ctx, auctionID, func(limitBid types.LimitBid) bool {
+		limitBids = append(limitBids, limitBid)
+		return false
+	})
+	return
+}
+
+// deleteLimitBids removes the limit bids of an auction.
+func (k Keeper) deleteLimitBids(ctx sdk.Context, auctionID uint64) {
+	store := k.limitBidStore(ctx, auctionID)
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// limitBidStore returns the prefix store holding the limit bids of an auction.
+func (k Keeper) limitBidStore(ctx sdk.Context, auctionID uint64) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LimitBidKeyPrefix)
+	return prefix.NewStore(store, types.GetLimitBidsKey(auctionID))
+}
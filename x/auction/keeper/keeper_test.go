@@ -133,3 +133,30 @@ func TestIterateAuctionsByTime(t *testing.T) {
 
 	require.Equal(t, expectedIndex, readIndex)
 }
+
+func TestAppendAuctionBid(t *testing.T) {
+	// setup keeper
+	tApp := app.NewTestApp()
+	keeper := tApp.GetAuctionKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1})
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+
+	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var bids []types.AuctionBid
+	for i := int64(1); i <= types.MaxAuctionBids+5; i++ {
+		bid := types.NewAuctionBid(1, addrs[0], c("usdx", i), c("aeth", 100), types.ForwardAuctionPhase, i, someTime)
+		keeper.AppendAuctionBid(ctx, bid)
+		bids = append(bids, bid)
+	}
+	otherBid := types.NewAuctionBid(2, addrs[0], c("usdx", 1), c("aeth", 100), types.ForwardAuctionPhase, 1, someTime)
+	keeper.AppendAuctionBid(ctx, otherBid)
+
+	// check only the most recent bids are kept, in order
+	require.Equal(t, bids[5:], keeper.GetAuctionBids(ctx, 1))
+	require.Equal(t, []types.AuctionBid{otherBid}, keeper.GetAuctionBids(ctx, 2))
+
+	// check deleting an auction removes only its bids
+	keeper.DeleteAuction(ctx, 1)
+	require.Empty(t, keeper.GetAuctionBids(ctx, 1))
+	require.Equal(t, []types.AuctionBid{otherBid}, keeper.GetAuctionBids(ctx, 2))
+}
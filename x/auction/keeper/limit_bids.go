@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/auction/types"
+)
+
+// maxLimitBidRounds bounds the number of limit bids a single bid can trigger.
+const maxLimitBidRounds = 10
+
+// PlaceLimitBid places a bid on an auction and stores a limit up to which the bid is automatically raised when the bidder is outbid.
+// The limit is a price in bid units per lot unit.
+func (k Keeper) PlaceLimitBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin, maxPrice sdk.Dec) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	if _, ok := auction.(*types.DutchAuction); ok {
+		return sdkerrors.Wrapf(types.ErrLimitBidNotSupported, "%s auction %d", auction.GetType(), auctionID)
+	}
+
+	limitBid := types.NewLimitBid(auctionID, bidder, maxPrice)
+	if err := limitBid.Validate(); err != nil {
+		return err
+	}
+	// the limit is stored before bidding so that it can answer any limit bids the new bid triggers
+	k.SetLimitBid(ctx, limitBid)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionLimit,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPrice, maxPrice.String()),
+		),
+	)
+
+	return k.PlaceBid(ctx, auctionID, bidder, newAmount)
+}
+
+// applyLimitBids raises the bids of outbid bidders up to their limits, until no limit can beat the highest bid.
+// Limits whose bidders can no longer pay for the raised bid are dropped.
+func (k Keeper) applyLimitBids(ctx sdk.Context, auctionID uint64) {
+	for i := 0; i < maxLimitBidRounds; i++ {
+		auction, found := k.GetAuction(ctx, auctionID)
+		if !found {
+			return
+		}
+		limitBid, amount, found := k.nextLimitBid(ctx, auction)
+		if !found {
+			return
+		}
+		err := k.runCached(ctx, func(cacheCtx sdk.Context) error {
+			return k.placeBid(cacheCtx, auctionID, limitBid.Bidder, amount)
+		})
+		if err != nil {
+			k.DeleteLimitBid(ctx, auctionID, limitBid.Bidder)
+		}
+	}
+}
+
+// nextLimitBid finds the limit bid with the highest max price able to outbid the auction's current bidder, and the amount it bids.
+func (k Keeper) nextLimitBid(ctx sdk.Context, auction types.Auction) (types.LimitBid, sdk.Coin, bool) {
+	if _, ok := auction.(*types.DutchAuction); ok {
+		return types.LimitBid{}, sdk.Coin{}, false
+	}
+
+	// the current bidder's own limit, which the new bid should beat outright rather than one increment at a time
+	rivalPrice := sdk.ZeroDec()
+	if rival, found := k.GetLimitBid(ctx, auction.GetID(), auction.GetBidder()); found {
+		rivalPrice = rival.MaxPrice
+	}
+
+	var (
+		best       types.LimitBid
+		bestAmount sdk.Coin
+		found      bool
+	)
+	k.IterateLimitBids(ctx, auction.GetID(), func(limitBid types.LimitBid) bool {
+		if limitBid.Bidder.Equals(auction.GetBidder()) {
+			return false
+		}
+		if found && !limitBid.MaxPrice.GT(best.MaxPrice) {
+			return false
+		}
+		amount, ok := k.limitBidAmount(ctx, auction, limitBid.MaxPrice, rivalPrice)
+		if ok {
+			best, bestAmount, found = limitBid, amount, true
+		}
+		return false
+	})
+	return best, bestAmount, found
+}
+
+// limitBidAmount returns the bid that outbids an auction without exceeding maxPrice, or false if there is none.
+func (k Keeper) limitBidAmount(ctx sdk.Context, auction types.Auction, maxPrice, rivalPrice sdk.Dec) (sdk.Coin, bool) {
+	params := k.GetParams(ctx)
+	switch a := auction.(type) {
+	case *types.SurplusAuction:
+		return forwardLimitBidAmount(a.Bid, a.Lot, sdk.Coin{}, params.IncrementSurplus, maxPrice, rivalPrice)
+	case *types.DebtAuction:
+		return reverseLimitBidAmount(a.Bid, a.Lot, params.IncrementDebt, maxPrice, rivalPrice)
+	case *types.CollateralAuction:
+		if !a.IsReversePhase() {
+			return forwardLimitBidAmount(a.Bid, a.Lot, a.MaxBid, params.IncrementCollateral, maxPrice, rivalPrice)
+		}
+		return reverseLimitBidAmount(a.Bid, a.Lot, params.IncrementCollateral, maxPrice, rivalPrice)
+	default:
+		return sdk.Coin{}, false
+	}
+}
+
+// forwardLimitBidAmount returns the smallest forward bid that outbids both the current bid and the current bidder's limit,
+// capped at maxPrice and at maxBid if it is set.
+func forwardLimitBidAmount(bid, lot, maxBid sdk.Coin, increment, maxPrice, rivalPrice sdk.Dec) (sdk.Coin, bool) {
+	minNewBidAmt := bid.Amount.Add(
+		sdk.MaxInt(
+			sdk.NewInt(1),
+			sdk.NewDecFromInt(bid.Amount).Mul(increment).RoundInt(),
+		),
+	)
+	maxNewBidAmt := maxPrice.MulInt(lot.Amount).TruncateInt()
+	if maxBid.IsValid() && !maxBid.IsZero() {
+		minNewBidAmt = sdk.MinInt(minNewBidAmt, maxBid.Amount)
+		maxNewBidAmt = sdk.MinInt(maxNewBidAmt, maxBid.Amount)
+	}
+	if maxNewBidAmt.LT(minNewBidAmt) {
+		return sdk.Coin{}, false
+	}
+
+	amount := sdk.MaxInt(minNewBidAmt, rivalPrice.MulInt(lot.Amount).TruncateInt())
+	return sdk.NewCoin(bid.Denom, sdk.MinInt(amount, maxNewBidAmt)), true
+}
+
+// reverseLimitBidAmount returns the largest reverse bid lot that outbids both the current lot and the current bidder's limit,
+// without going below the lot at which the bid costs maxPrice.
+func reverseLimitBidAmount(bid, lot sdk.Coin, increment, maxPrice, rivalPrice sdk.Dec) (sdk.Coin, bool) {
+	maxNewLotAmt := lot.Amount.Sub(
+		sdk.MaxInt(
+			sdk.NewInt(1),
+			sdk.NewDecFromInt(lot.Amount).Mul(increment).RoundInt(),
+		),
+	)
+	minNewLotAmt := sdk.NewDecFromInt(bid.Amount).Quo(maxPrice).Ceil().TruncateInt()
+	if maxNewLotAmt.IsNegative() || maxNewLotAmt.LT(minNewLotAmt) {
+		return sdk.Coin{}, false
+	}
+
+	amount := maxNewLotAmt
+	if rivalPrice.IsPositive() {
+		amount = sdk.MinInt(amount, sdk.NewDecFromInt(bid.Amount).Quo(rivalPrice).Ceil().TruncateInt())
+	}
+	return sdk.NewCoin(lot.Denom, sdk.MaxInt(amount, minNewLotAmt)), true
+}
+
+// runCached runs fn in a cached context, committing its state changes and events only if it succeeds.
+func (k Keeper) runCached(ctx sdk.Context, fn func(cacheCtx sdk.Context) error) error {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := fn(cacheCtx); err != nil {
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
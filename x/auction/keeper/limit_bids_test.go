@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mokitanetwork/aether/x/auction/keeper"
+	"github.com/mokitanetwork/aether/x/auction/types"
+)
+
+func (suite *auctionTestSuite) TestLimitBids_Forward() {
+	alice, bob := suite.Addrs[0], suite.Addrs[1]
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100)))
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), "token2")
+	suite.Require().NoError(err)
+
+	// alice will pay up to 2 token2 per token1, a bid of 40 token2
+	suite.Require().NoError(suite.Keeper.PlaceLimitBid(suite.Ctx, auctionID, alice, c("token2", 10), d("2")))
+
+	// outbidding alice raises her bid by the minimum increment
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, bob, c("token2", 20)))
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	suite.Equal(alice, auction.GetBidder())
+	suite.Equal(c("token2", 21), auction.GetBid())
+
+	// a lower limit is beaten outright, with a bid at the lower limit
+	suite.Require().NoError(suite.Keeper.PlaceLimitBid(suite.Ctx, auctionID, bob, c("token2", 22), d("1.5")))
+	auction, found = suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	suite.Equal(alice, auction.GetBidder())
+	suite.Equal(c("token2", 30), auction.GetBid())
+
+	suite.CheckAccountBalanceEqual(alice, cs(c("token1", 100), c("token2", 70)))
+	suite.CheckAccountBalanceEqual(bob, cs(c("token1", 100), c("token2", 100)))
+
+	// limit bids are removed with their auction
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.DefaultForwardBidDuration))
+	suite.Require().NoError(suite.Keeper.CloseAuction(ctx, auctionID))
+	suite.Empty(suite.Keeper.GetLimitBids(ctx, auctionID))
+}
+
+func (suite *auctionTestSuite) TestLimitBids_Reverse() {
+	alice, bob := suite.Addrs[0], suite.Addrs[1]
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("debt", 100)))
+
+	auctionID, err := suite.Keeper.StartDebtAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), c("token2", 99999), c("debt", 20))
+	suite.Require().NoError(err)
+
+	// alice will pay up to 0.5 token1 per token2, accepting a lot down to 40 token2
+	suite.Require().NoError(suite.Keeper.PlaceLimitBid(suite.Ctx, auctionID, alice, c("token2", 100), d("0.5")))
+
+	// outbidding alice lowers her lot by the minimum increment
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, bob, c("token2", 90)))
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	suite.Equal(alice, auction.GetBidder())
+	suite.Equal(c("token2", 86), auction.GetLot())
+
+	// a lower limit is beaten outright, with a lot at the lower limit
+	suite.Require().NoError(suite.Keeper.PlaceLimitBid(suite.Ctx, auctionID, bob, c("token2", 80), d("0.4")))
+	auction, found = suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	suite.Equal(alice, auction.GetBidder())
+	suite.Equal(c("token2", 50), auction.GetLot())
+
+	suite.CheckAccountBalanceEqual(alice, cs(c("token1", 80), c("token2", 100)))
+	suite.CheckAccountBalanceEqual(bob, cs(c("token1", 100), c("token2", 100)))
+}
+
+func (suite *auctionTestSuite) TestLimitBids_Unaffordable() {
+	alice, bob := suite.Addrs[0], suite.Addrs[1]
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100)))
+
+	auctionID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), "token2")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Keeper.PlaceLimitBid(suite.Ctx, auctionID, alice, c("token2", 10), d("10")))
+
+	// alice cannot pay the raised bid, so her limit is dropped and the outbidding bid stands
+	suite.Require().NoError(suite.Keeper.PlaceBid(suite.Ctx, auctionID, bob, c("token2", 100)))
+	auction, found := suite.Keeper.GetAuction(suite.Ctx, auctionID)
+	suite.Require().True(found)
+	suite.Equal(bob, auction.GetBidder())
+	suite.Equal(c("token2", 100), auction.GetBid())
+
+	_, found = suite.Keeper.GetLimitBid(suite.Ctx, auctionID, alice)
+	suite.False(found)
+}
+
+func (suite *auctionTestSuite) TestMsgPlaceBids() {
+	bidder := suite.Addrs[0]
+	suite.AddCoinsToNamedModule(suite.ModAcc.Name, cs(c("token1", 100)))
+	msgServer := keeper.NewMsgServerImpl(suite.Keeper)
+
+	firstID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), "token2")
+	suite.Require().NoError(err)
+	secondID, err := suite.Keeper.StartSurplusAuction(suite.Ctx, suite.ModAcc.Name, c("token1", 20), "token2")
+	suite.Require().NoError(err)
+
+	bids := []types.BidOrder{
+		types.NewBidOrder(firstID, c("token2", 10), sdk.ZeroDec()),
+		types.NewBidOrder(secondID, c("token2", 10), d("2")),
+		types.NewBidOrder(secondID+1, c("token2", 10), sdk.ZeroDec()),
+	}
+
+	// an atomic message fails as a whole when one of its bids fails
+	ctx, _ := suite.Ctx.CacheContext()
+	msg := types.NewMsgPlaceBids(bidder.String(), bids, true)
+	_, err = msgServer.PlaceBids(sdk.WrapSDKContext(ctx), &msg)
+	suite.ErrorIs(err, types.ErrAuctionNotFound)
+
+	// otherwise failed bids are skipped and reported
+	msg = types.NewMsgPlaceBids(bidder.String(), bids, false)
+	res, err := msgServer.PlaceBids(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+	suite.Equal([]uint64{secondID + 1}, res.FailedAuctionIds)
+
+	for _, id := range []uint64{firstID, secondID} {
+		auction, found := suite.Keeper.GetAuction(suite.Ctx, id)
+		suite.Require().True(found)
+		suite.Equal(bidder, auction.GetBidder())
+		suite.Equal(c("token2", 10), auction.GetBid())
+	}
+	_, found := suite.Keeper.GetLimitBid(suite.Ctx, firstID, bidder)
+	suite.False(found)
+	limitBid, found := suite.Keeper.GetLimitBid(suite.Ctx, secondID, bidder)
+	suite.True(found)
+	suite.Equal(d("2"), limitBid.MaxPrice)
+	suite.CheckAccountBalanceEqual(bidder, cs(c("token1", 100), c("token2", 80)))
+}
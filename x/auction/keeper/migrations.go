@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mokitanetwork/aether/x/auction/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// Auctions stored before version 2 are added to the type, denom, bidder, initiator and phase indexes.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.IterateAuctions(ctx, func(auction types.Auction) bool {
+		m.keeper.insertIntoByValueIndexes(ctx, auction)
+		return false
+	})
+	return nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/auction/keeper"
+	"github.com/mokitanetwork/aether/x/auction/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	tApp := app.NewTestApp()
+	auctionKeeper := tApp.GetAuctionKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1})
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+
+	endTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	auction := types.NewSurplusAuction("liquidator", c("usdx", 100), "aeth", endTime)
+	auction.Bidder = addrs[0]
+	auction.Bid = c("aeth", 10)
+	auctionKeeper.SetAuction(ctx, auction.WithID(1))
+
+	// auctions stored by version 1 are not in the by value indexes
+	store := ctx.KVStore(tApp.GetKeys()[types.StoreKey])
+	for _, keyPrefix := range [][]byte{
+		types.AuctionByTypeKeyPrefix,
+		types.AuctionByLotDenomKeyPrefix,
+		types.AuctionByBidDenomKeyPrefix,
+		types.AuctionByBidderKeyPrefix,
+		types.AuctionByInitiatorKeyPrefix,
+		types.AuctionByPhaseKeyPrefix,
+	} {
+		indexStore := prefix.NewStore(store, keyPrefix)
+		iterator := indexStore.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		require.Len(t, keys, 1)
+		for _, key := range keys {
+			indexStore.Delete(key)
+		}
+	}
+
+	queryServer := keeper.NewQueryServerImpl(auctionKeeper)
+	requests := []types.QueryAuctionsRequest{
+		{Type: types.SurplusAuctionType},
+		{LotDenom: "usdx"},
+		{BidDenom: "aeth"},
+		{Bidder: addrs[0].String()},
+		{Initiator: "liquidator"},
+		{Phase: types.ForwardAuctionPhase},
+	}
+	for _, req := range requests {
+		res, err := queryServer.Auctions(sdk.WrapSDKContext(ctx), &req)
+		require.NoError(t, err)
+		require.Empty(t, res.Auctions)
+	}
+
+	err := keeper.NewMigrator(auctionKeeper).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	for _, req := range requests {
+		res, err := queryServer.Auctions(sdk.WrapSDKContext(ctx), &req)
+		require.NoError(t, err)
+		require.Len(t, res.Auctions, 1)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/mokitanetwork/aether/x/auction/types"
 )
@@ -41,3 +42,41 @@ func (k msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*typ
 	)
 	return &types.MsgPlaceBidResponse{}, nil
 }
+
+func (k msgServer) PlaceBids(goCtx context.Context, msg *types.MsgPlaceBids) (*types.MsgPlaceBidsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	var failedAuctionIDs []uint64
+	for _, bid := range msg.Bids {
+		placeBid := func(ctx sdk.Context) error {
+			if bid.HasLimit() {
+				return k.keeper.PlaceLimitBid(ctx, bid.AuctionId, bidder, bid.Amount, bid.MaxPrice)
+			}
+			return k.keeper.PlaceBid(ctx, bid.AuctionId, bidder, bid.Amount)
+		}
+
+		if msg.Atomic {
+			if err := placeBid(ctx); err != nil {
+				return nil, sdkerrors.Wrapf(err, "auction %d", bid.AuctionId)
+			}
+			continue
+		}
+		if err := k.keeper.runCached(ctx, placeBid); err != nil {
+			failedAuctionIDs = append(failedAuctionIDs, bid.AuctionId)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder),
+		),
+	)
+	return &types.MsgPlaceBidsResponse{FailedAuctionIds: failedAuctionIDs}, nil
+}
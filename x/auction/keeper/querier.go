@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -71,11 +72,7 @@ func queryAuctions(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQueri
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	unfilteredAuctions := k.GetAllAuctions(ctx)
-	auctions := filterAuctions(ctx, unfilteredAuctions, params, legacyQuerierCdc)
-	if auctions == nil {
-		auctions = []types.Auction{}
-	}
+	auctions := filterAuctions(ctx, k, params)
 
 	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, auctions)
 	if err != nil {
@@ -96,13 +93,25 @@ func queryNextAuctionID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, l
 
 // filterAuctions retrieves auctions filtered by a given set of params.
 // If no filters are provided, all auctions will be returned in paginated form.
-func filterAuctions(ctx sdk.Context, auctions []types.Auction, params types.QueryAllAuctionParams, legacyQuerierCdc *codec.LegacyAmino) []types.Auction {
-	filteredAuctions := make([]types.Auction, 0, len(auctions))
-	for _, auc := range auctions {
-		isMatch := auctionIsMatch(auc, params)
-		if isMatch {
-			filteredAuctions = append(filteredAuctions, auc)
+func filterAuctions(ctx sdk.Context, k Keeper, params types.QueryAllAuctionParams) []types.Auction {
+	filteredAuctions := []types.Auction{}
+
+	if index, endKey, found := k.auctionQueryIndex(ctx, params); found {
+		iterator := index.Iterator(nil, endKey)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			auc, found := k.GetAuction(ctx, types.Uint64FromBytes(iterator.Value()))
+			if found && auctionIsMatch(auc, params) {
+				filteredAuctions = append(filteredAuctions, auc)
+			}
 		}
+	} else {
+		k.IterateAuctions(ctx, func(auc types.Auction) bool {
+			if auctionIsMatch(auc, params) {
+				filteredAuctions = append(filteredAuctions, auc)
+			}
+			return false
+		})
 	}
 
 	start, end := client.Paginate(len(filteredAuctions), params.Page, params.Limit, 100)
@@ -115,42 +124,93 @@ func filterAuctions(ctx sdk.Context, auctions []types.Auction, params types.Quer
 	return filteredAuctions
 }
 
-func auctionIsMatch(auc types.Auction, params types.QueryAllAuctionParams) bool {
-	matchType, matchOwner, matchDenom, matchPhase := true, true, true, true
+// auctionQueryIndex returns the secondary index to scan for the auctions matching a set of params, picking the most
+// selective of the filters provided. Values in the index are auction IDs, and only keys before endKey can match.
+// If none of the filters provided are indexed, found is false.
+func (k Keeper) auctionQueryIndex(ctx sdk.Context, params types.QueryAllAuctionParams) (index prefix.Store, endKey []byte, found bool) {
+	byValue := func(keyPrefix []byte, value []byte) prefix.Store {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+		return prefix.NewStore(store, types.GetAuctionByValueKey(value))
+	}
+
+	switch {
+	case len(params.Bidder) > 0:
+		return byValue(types.AuctionByBidderKeyPrefix, params.Bidder), nil, true
+	case len(params.LotDenom) > 0:
+		return byValue(types.AuctionByLotDenomKeyPrefix, []byte(params.LotDenom)), nil, true
+	case len(params.BidDenom) > 0:
+		return byValue(types.AuctionByBidDenomKeyPrefix, []byte(params.BidDenom)), nil, true
+	case !params.EndingBefore.IsZero():
+		// keys in the byTime index start with the end time, so they are before the cutoff time's bytes exactly when the
+		// auction ends before the cutoff time
+		return prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionByTimeKeyPrefix), sdk.FormatTimeBytes(params.EndingBefore), true
+	case len(params.Initiator) > 0:
+		return byValue(types.AuctionByInitiatorKeyPrefix, []byte(params.Initiator)), nil, true
+	case len(params.Type) > 0:
+		return byValue(types.AuctionByTypeKeyPrefix, []byte(params.Type)), nil, true
+	case len(params.Phase) > 0:
+		return byValue(types.AuctionByPhaseKeyPrefix, []byte(params.Phase)), nil, true
+	default:
+		return prefix.Store{}, nil, false
+	}
+}
 
+func auctionIsMatch(auc types.Auction, params types.QueryAllAuctionParams) bool {
 	// match auction type (if supplied)
-	if len(params.Type) > 0 {
-		matchType = auc.GetType() == params.Type
+	if len(params.Type) > 0 && auc.GetType() != params.Type {
+		return false
 	}
 
 	// match auction owner (if supplied)
 	if len(params.Owner) > 0 {
-		if cAuc, ok := auc.(*types.CollateralAuction); ok {
-			foundOwnerAddr := false
-			for _, addr := range cAuc.GetLotReturns().Addresses {
-				if addr.Equals(params.Owner) {
-					foundOwnerAddr = true
-					break
-				}
-			}
-			if !foundOwnerAddr {
-				matchOwner = false
+		cAuc, ok := auc.(types.LotReturnsAuction)
+		if !ok {
+			return false
+		}
+		foundOwnerAddr := false
+		for _, addr := range cAuc.GetLotReturns().Addresses {
+			if addr.Equals(params.Owner) {
+				foundOwnerAddr = true
+				break
 			}
 		}
+		if !foundOwnerAddr {
+			return false
+		}
 	}
 
 	// match auction denom (if supplied)
-	if len(params.Denom) > 0 {
-		matchDenom = auc.GetBid().Denom == params.Denom || auc.GetLot().Denom == params.Denom
+	if len(params.Denom) > 0 && auc.GetBid().Denom != params.Denom && auc.GetLot().Denom != params.Denom {
+		return false
+	}
+
+	// match auction lot and bid denoms (if supplied)
+	if len(params.LotDenom) > 0 && auc.GetLot().Denom != params.LotDenom {
+		return false
+	}
+	if len(params.BidDenom) > 0 && auc.GetBid().Denom != params.BidDenom {
+		return false
 	}
 
 	// match auction phase (if supplied)
-	if len(params.Phase) > 0 {
-		matchPhase = auc.GetPhase() == params.Phase
+	if len(params.Phase) > 0 && auc.GetPhase() != params.Phase {
+		return false
 	}
 
-	if matchType && matchOwner && matchDenom && matchPhase {
-		return true
+	// match auction bidder (if supplied)
+	if len(params.Bidder) > 0 && !auc.GetBidder().Equals(params.Bidder) {
+		return false
 	}
-	return false
+
+	// match auction initiator (if supplied)
+	if len(params.Initiator) > 0 && auc.GetInitiator() != params.Initiator {
+		return false
+	}
+
+	// match auctions ending before a time (if supplied)
+	if !params.EndingBefore.IsZero() && !auc.GetEndTime().Before(params.EndingBefore) {
+		return false
+	}
+
+	return true
 }
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -103,7 +104,7 @@ func (suite *querierTestSuite) TestQueryAuctions() {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctions}, "/"),
 		Data: suite.legacyAmino.MustMarshalJSON(
-			types.NewQueryAllAuctionParams(1, TestAuctionCount, "", "", "", nil),
+			types.NewQueryAllAuctionParams(1, TestAuctionCount, "", "", "", nil, "", "", nil, "", time.Time{}),
 		),
 	}
 
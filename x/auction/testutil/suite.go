@@ -61,6 +61,9 @@ func (suite *Suite) SetupTest(numAddrs int) {
 		types.DefaultIncrement,
 		types.DefaultIncrement,
 		types.DefaultIncrement,
+		types.DefaultDutchPriceBuffer,
+		types.DefaultDutchDecayDuration,
+		types.DefaultDutchResetRatio,
 	)
 
 	auctionGs, err := types.NewGenesisState(types.DefaultNextAuctionID, params, []types.GenesisAuction{})
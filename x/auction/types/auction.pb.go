@@ -46,7 +46,7 @@ func (m *BaseAuction) Reset()         { *m = BaseAuction{} }
 func (m *BaseAuction) String() string { return proto.CompactTextString(m) }
 func (*BaseAuction) ProtoMessage()    {}
 func (*BaseAuction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b9b5dac2c776ef9e, []int{0}
+	return fileDescriptor_3c0b9e3627ede2c3, []int{0}
 }
 func (m *BaseAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -85,7 +85,7 @@ func (m *SurplusAuction) Reset()         { *m = SurplusAuction{} }
 func (m *SurplusAuction) String() string { return proto.CompactTextString(m) }
 func (*SurplusAuction) ProtoMessage()    {}
 func (*SurplusAuction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b9b5dac2c776ef9e, []int{1}
+	return fileDescriptor_3c0b9e3627ede2c3, []int{1}
 }
 func (m *SurplusAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +126,7 @@ func (m *DebtAuction) Reset()         { *m = DebtAuction{} }
 func (m *DebtAuction) String() string { return proto.CompactTextString(m) }
 func (*DebtAuction) ProtoMessage()    {}
 func (*DebtAuction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b9b5dac2c776ef9e, []int{2}
+	return fileDescriptor_3c0b9e3627ede2c3, []int{2}
 }
 func (m *DebtAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,7 +171,7 @@ func (m *CollateralAuction) Reset()         { *m = CollateralAuction{} }
 func (m *CollateralAuction) String() string { return proto.CompactTextString(m) }
 func (*CollateralAuction) ProtoMessage()    {}
 func (*CollateralAuction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b9b5dac2c776ef9e, []int{3}
+	return fileDescriptor_3c0b9e3627ede2c3, []int{3}
 }
 func (m *CollateralAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,6 +200,61 @@ func (m *CollateralAuction) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CollateralAuction proto.InternalMessageInfo
 
+// DutchAuction is a descending price auction.
+// The price of the lot starts above the oracle price and decays linearly over time. Anyone can buy any part of the lot
+// at the current price until the max bid has been raised or the lot is sold. If the price decays too far before then,
+// the auction resets its price from the latest oracle price. Unsold Lot is sent to LotReturns, being divided among the
+// addresses by weight. Dutch auctions are an alternative to collateral auctions for selling off seized collateral.
+type DutchAuction struct {
+	BaseAuction       `protobuf:"bytes,1,opt,name=base_auction,json=baseAuction,proto3,embedded=base_auction" json:"base_auction"`
+	CorrespondingDebt types.Coin        `protobuf:"bytes,2,opt,name=corresponding_debt,json=correspondingDebt,proto3" json:"corresponding_debt"`
+	MaxBid            types.Coin        `protobuf:"bytes,3,opt,name=max_bid,json=maxBid,proto3" json:"max_bid"`
+	LotReturns        WeightedAddresses `protobuf:"bytes,4,opt,name=lot_returns,json=lotReturns,proto3" json:"lot_returns"`
+	// lot_market_id is the pricefeed market used to price the lot
+	LotMarketID string `protobuf:"bytes,5,opt,name=lot_market_id,json=lotMarketId,proto3" json:"lot_market_id,omitempty"`
+	// bid_market_id is the pricefeed market used to price the bid, empty if the bid is valued at the quote asset of the lot market
+	BidMarketID string `protobuf:"bytes,6,opt,name=bid_market_id,json=bidMarketId,proto3" json:"bid_market_id,omitempty"`
+	// price_conversion converts the ratio of the market prices into bid base units per lot base unit
+	PriceConversion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=price_conversion,json=priceConversion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_conversion"`
+	// start_price is the price of the lot, in bid base units per lot base unit, when the auction started or last reset
+	StartPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=start_price,json=startPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"start_price"`
+	// start_time is the time the auction started or last reset
+	StartTime time.Time `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
+func (m *DutchAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchAuction) ProtoMessage()    {}
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0b9e3627ede2c3, []int{4}
+}
+func (m *DutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuction.Merge(m, src)
+}
+func (m *DutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuction proto.InternalMessageInfo
+
 // WeightedAddresses is a type for storing some addresses and associated weights.
 type WeightedAddresses struct {
 	Addresses []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,rep,name=addresses,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"addresses,omitempty"`
@@ -210,7 +265,7 @@ func (m *WeightedAddresses) Reset()         { *m = WeightedAddresses{} }
 func (m *WeightedAddresses) String() string { return proto.CompactTextString(m) }
 func (*WeightedAddresses) ProtoMessage()    {}
 func (*WeightedAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b9b5dac2c776ef9e, []int{4}
+	return fileDescriptor_3c0b9e3627ede2c3, []int{5}
 }
 func (m *WeightedAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,62 +294,168 @@ func (m *WeightedAddresses) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WeightedAddresses proto.InternalMessageInfo
 
+// AuctionBid is an entry in the bounded log of bids kept for each auction.
+type AuctionBid struct {
+	AuctionID uint64                                        `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=bidder,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"bidder,omitempty"`
+	// bid is the auction's bid after the bid was placed, or the amount paid for a dutch auction bid
+	Bid types.Coin `protobuf:"bytes,3,opt,name=bid,proto3" json:"bid"`
+	// lot is the auction's lot after the bid was placed, or the amount bought for a dutch auction bid
+	Lot types.Coin `protobuf:"bytes,4,opt,name=lot,proto3" json:"lot"`
+	// phase is the phase of the auction the bid was placed in
+	Phase  string    `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	Height int64     `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *AuctionBid) Reset()         { *m = AuctionBid{} }
+func (m *AuctionBid) String() string { return proto.CompactTextString(m) }
+func (*AuctionBid) ProtoMessage()    {}
+func (*AuctionBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0b9e3627ede2c3, []int{6}
+}
+func (m *AuctionBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionBid.Merge(m, src)
+}
+func (m *AuctionBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionBid proto.InternalMessageInfo
+
+// LimitBid is a standing order to automatically raise a bidder's bid on an auction, up to a maximum price, when they are outbid
+type LimitBid struct {
+	AuctionID uint64                                        `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Bidder    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=bidder,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"bidder,omitempty"`
+	// max_price is the highest price, in bid units per lot unit, the bidder is willing to pay
+	MaxPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price"`
+}
+
+func (m *LimitBid) Reset()         { *m = LimitBid{} }
+func (m *LimitBid) String() string { return proto.CompactTextString(m) }
+func (*LimitBid) ProtoMessage()    {}
+func (*LimitBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0b9e3627ede2c3, []int{7}
+}
+func (m *LimitBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitBid.Merge(m, src)
+}
+func (m *LimitBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitBid proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseAuction)(nil), "aeth.auction.v1beta1.BaseAuction")
 	proto.RegisterType((*SurplusAuction)(nil), "aeth.auction.v1beta1.SurplusAuction")
 	proto.RegisterType((*DebtAuction)(nil), "aeth.auction.v1beta1.DebtAuction")
 	proto.RegisterType((*CollateralAuction)(nil), "aeth.auction.v1beta1.CollateralAuction")
+	proto.RegisterType((*DutchAuction)(nil), "aeth.auction.v1beta1.DutchAuction")
 	proto.RegisterType((*WeightedAddresses)(nil), "aeth.auction.v1beta1.WeightedAddresses")
+	proto.RegisterType((*AuctionBid)(nil), "aeth.auction.v1beta1.AuctionBid")
+	proto.RegisterType((*LimitBid)(nil), "aeth.auction.v1beta1.LimitBid")
 }
 
 func init() {
-	proto.RegisterFile("aeth/auction/v1beta1/auction.proto", fileDescriptor_b9b5dac2c776ef9e)
-}
-
-var fileDescriptor_b9b5dac2c776ef9e = []byte{
-	// 657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xce, 0x24, 0xbd, 0x49, 0x3a, 0xae, 0xee, 0x55, 0xe6, 0x56, 0xc8, 0xad, 0x90, 0x1d, 0xba,
-	0x80, 0x80, 0x14, 0x5b, 0x2d, 0x1b, 0xc4, 0x06, 0xd5, 0x2d, 0xd0, 0x6e, 0xba, 0x30, 0x48, 0x48,
-	0x6c, 0xcc, 0xd8, 0x33, 0x75, 0x46, 0xb5, 0x3d, 0x91, 0x67, 0x52, 0xd2, 0xb7, 0xe8, 0xc3, 0x74,
-	0xc5, 0x1e, 0xa9, 0xaa, 0x84, 0x54, 0xb1, 0x42, 0x2c, 0x02, 0xa4, 0x6f, 0xc1, 0x0a, 0x8d, 0x3d,
-	0x6e, 0x1b, 0xd1, 0x45, 0x90, 0x60, 0xc1, 0x2a, 0x3e, 0xdf, 0x9c, 0xf3, 0x7d, 0xe7, 0x37, 0x70,
-	0xed, 0x00, 0x1f, 0x62, 0x17, 0x8f, 0x22, 0xc9, 0x78, 0xe6, 0x1e, 0xae, 0x87, 0x54, 0xe2, 0xf5,
-	0xca, 0x76, 0x86, 0x39, 0x97, 0x1c, 0x2d, 0x2b, 0x1f, 0xa7, 0xc2, 0xb4, 0xcf, 0xaa, 0x15, 0x71,
-	0x91, 0x72, 0xe1, 0x86, 0x58, 0xd0, 0xcb, 0xc0, 0x88, 0x33, 0x1d, 0xb5, 0xba, 0x52, 0xbe, 0x07,
-	0x85, 0xe5, 0x96, 0x86, 0x7e, 0x5a, 0x8e, 0x79, 0xcc, 0x4b, 0x5c, 0x7d, 0x69, 0xd4, 0x8e, 0x39,
-	0x8f, 0x13, 0xea, 0x16, 0x56, 0x38, 0xda, 0x77, 0x25, 0x4b, 0xa9, 0x90, 0x38, 0x1d, 0x96, 0x0e,
-	0x6b, 0x1f, 0x1a, 0xd0, 0xf0, 0xb0, 0xa0, 0x9b, 0x65, 0x26, 0xe8, 0x16, 0xac, 0x33, 0x62, 0x82,
-	0x2e, 0xe8, 0x2d, 0x78, 0xcd, 0xe9, 0xc4, 0xae, 0xef, 0x6e, 0xfb, 0x75, 0x46, 0xd0, 0x6d, 0xb8,
-	0xc8, 0x32, 0x26, 0x19, 0x96, 0x3c, 0x37, 0xeb, 0x5d, 0xd0, 0x5b, 0xf4, 0xaf, 0x00, 0xb4, 0x0e,
-	0x1b, 0x09, 0x97, 0x66, 0xa3, 0x0b, 0x7a, 0xc6, 0xc6, 0x8a, 0xa3, 0x13, 0x53, 0x55, 0x54, 0xa5,
-	0x39, 0x5b, 0x9c, 0x65, 0xde, 0xc2, 0xe9, 0xc4, 0xae, 0xf9, 0xca, 0x17, 0xbd, 0x81, 0xcd, 0x90,
-	0x11, 0x42, 0x73, 0x73, 0xa1, 0x0b, 0x7a, 0x4b, 0xde, 0xce, 0xf7, 0x89, 0xdd, 0x8f, 0x99, 0x1c,
-	0x8c, 0x42, 0x27, 0xe2, 0xa9, 0x2e, 0x4e, 0xff, 0xf4, 0x05, 0x39, 0x70, 0xe5, 0xd1, 0x90, 0x0a,
-	0x67, 0x33, 0x8a, 0x36, 0x09, 0xc9, 0xa9, 0x10, 0x1f, 0x4f, 0xfa, 0xff, 0x6b, 0x25, 0x8d, 0x78,
-	0x47, 0x92, 0x0a, 0x5f, 0xf3, 0xaa, 0xa4, 0x42, 0x46, 0xcc, 0x7f, 0xe6, 0x4c, 0x2a, 0x64, 0x04,
-	0x3d, 0x80, 0x9d, 0x01, 0x16, 0x41, 0x4e, 0x23, 0xca, 0x0e, 0x29, 0x09, 0x42, 0x46, 0x84, 0xd9,
-	0xec, 0x82, 0x5e, 0xdb, 0xff, 0x6f, 0x80, 0x85, 0xaf, 0x71, 0x8f, 0x11, 0x81, 0x9e, 0xc0, 0x36,
-	0xcd, 0x48, 0xa0, 0x1a, 0x6a, 0xb6, 0x0a, 0x8d, 0x55, 0xa7, 0xec, 0xb6, 0x53, 0x75, 0xdb, 0x79,
-	0x59, 0x75, 0xdb, 0x6b, 0x2b, 0x91, 0xe3, 0x2f, 0x36, 0xf0, 0x5b, 0x34, 0x23, 0x0a, 0x47, 0xcf,
-	0xe0, 0x52, 0x8a, 0xc7, 0xc1, 0x25, 0x49, 0xfb, 0x17, 0x48, 0x60, 0x8a, 0xc7, 0x4f, 0x4b, 0x9e,
-	0xc7, 0xc6, 0xd9, 0x49, 0xbf, 0xa5, 0xe7, 0xb7, 0x96, 0xc2, 0x7f, 0x5f, 0x8c, 0xf2, 0x61, 0x32,
-	0x12, 0xd5, 0x44, 0xf7, 0xe0, 0x92, 0xaa, 0x39, 0xd0, 0xbb, 0x56, 0xcc, 0xd6, 0xd8, 0xb8, 0xe3,
-	0xdc, 0xb4, 0x80, 0xce, 0xb5, 0x55, 0x28, 0xd5, 0xce, 0x27, 0x36, 0xf0, 0x8d, 0xf0, 0x0a, 0x9e,
-	0x95, 0x7b, 0x07, 0xa0, 0xb1, 0x4d, 0x43, 0xf9, 0x87, 0xc4, 0xd0, 0x1e, 0x44, 0x11, 0xcf, 0x73,
-	0x2a, 0x86, 0x3c, 0x23, 0x2c, 0x8b, 0x03, 0x42, 0x43, 0x59, 0xec, 0xdf, 0x1c, 0x23, 0xed, 0xcc,
-	0x84, 0xaa, 0x34, 0x67, 0x93, 0x3f, 0xab, 0xc3, 0xce, 0x16, 0x4f, 0x12, 0x2c, 0x69, 0x8e, 0x93,
-	0xbf, 0xa4, 0x04, 0xf4, 0x08, 0xb6, 0xd4, 0xda, 0xa8, 0xd5, 0x9e, 0xf3, 0xde, 0x9a, 0x29, 0x1e,
-	0x7b, 0x8c, 0xa0, 0x3d, 0x68, 0x24, 0x5c, 0x06, 0x39, 0x95, 0xa3, 0x3c, 0x13, 0xc5, 0xdd, 0x19,
-	0x1b, 0xf7, 0x6e, 0x2e, 0xec, 0x15, 0x65, 0xf1, 0x40, 0x52, 0xa2, 0x2f, 0x8b, 0x0a, 0xcd, 0x05,
-	0x13, 0x2e, 0xfd, 0x92, 0x60, 0xb6, 0x99, 0xef, 0x01, 0xec, 0xfc, 0x14, 0x84, 0xf6, 0xe1, 0x22,
-	0xae, 0x0c, 0x13, 0x74, 0x1b, 0xbf, 0xf5, 0xd0, 0xaf, 0xa8, 0xd1, 0x0e, 0x6c, 0xbd, 0x2d, 0xc4,
-	0x85, 0x59, 0x2f, 0x54, 0x1c, 0x95, 0xed, 0xe7, 0x89, 0x7d, 0x77, 0x0e, 0xa5, 0xdd, 0x4c, 0xfa,
-	0x55, 0xb8, 0xf7, 0xfc, 0xf4, 0x9b, 0x55, 0x3b, 0x9d, 0x5a, 0xe0, 0x7c, 0x6a, 0x81, 0xaf, 0x53,
-	0x0b, 0x1c, 0x5f, 0x58, 0xb5, 0xf3, 0x0b, 0xab, 0xf6, 0xe9, 0xc2, 0xaa, 0xbd, 0xbe, 0x7f, 0x8d,
-	0x4e, 0xf5, 0xad, 0x9f, 0xe0, 0x50, 0x14, 0x5f, 0xee, 0xf8, 0xf2, 0x1f, 0xbf, 0x60, 0x0d, 0x9b,
-	0xc5, 0x01, 0x3f, 0xfc, 0x11, 0x00, 0x00, 0xff, 0xff, 0x87, 0x6d, 0x9c, 0x17, 0x0e, 0x06, 0x00,
-	0x00,
+	proto.RegisterFile("aeth/auction/v1beta1/auction.proto", fileDescriptor_3c0b9e3627ede2c3)
+}
+
+var fileDescriptor_3c0b9e3627ede2c3 = []byte{
+	// 900 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x8e, 0xff, 0xbc, 0x4d, 0x09, 0x19, 0xa2, 0x6a, 0x1b, 0x21, 0xdb, 0xf8, 0x00,
+	0x16, 0x22, 0xbb, 0x4a, 0x7a, 0xa9, 0xb8, 0xa0, 0x6c, 0x0c, 0xaa, 0x45, 0x1b, 0xd0, 0x82, 0x84,
+	0xe0, 0xb2, 0xcc, 0xee, 0x4c, 0xed, 0x51, 0x76, 0x77, 0xac, 0x9d, 0x71, 0xea, 0x7e, 0x8b, 0x7e,
+	0x04, 0x3e, 0x44, 0x4f, 0xdc, 0x91, 0xa2, 0x4a, 0x48, 0x11, 0x27, 0xc4, 0xc1, 0x80, 0x73, 0x40,
+	0xe2, 0x23, 0x70, 0x42, 0x33, 0x3b, 0x6b, 0x27, 0x6a, 0x0f, 0x71, 0x45, 0x25, 0x90, 0x38, 0xd9,
+	0xef, 0xed, 0x7b, 0xbf, 0xf7, 0xff, 0xbd, 0x81, 0x1e, 0xa6, 0x72, 0xec, 0xe1, 0x69, 0x2c, 0x19,
+	0xcf, 0xbc, 0xb3, 0x83, 0x88, 0x4a, 0x7c, 0x50, 0xd2, 0xee, 0x24, 0xe7, 0x92, 0xa3, 0x5d, 0x25,
+	0xe3, 0x96, 0x3c, 0x23, 0xb3, 0xd7, 0x8e, 0xb9, 0x48, 0xb9, 0xf0, 0x22, 0x2c, 0xe8, 0x52, 0x31,
+	0xe6, 0xcc, 0x68, 0xed, 0xdd, 0x29, 0xbe, 0x87, 0x9a, 0xf2, 0x0a, 0xc2, 0x7c, 0xda, 0x1d, 0xf1,
+	0x11, 0x2f, 0xf8, 0xea, 0x9f, 0xe1, 0x76, 0x46, 0x9c, 0x8f, 0x12, 0xea, 0x69, 0x2a, 0x9a, 0x3e,
+	0xf2, 0x24, 0x4b, 0xa9, 0x90, 0x38, 0x9d, 0x14, 0x02, 0xbd, 0x1f, 0xab, 0x60, 0xfb, 0x58, 0xd0,
+	0xa3, 0xc2, 0x13, 0x74, 0x1b, 0x2a, 0x8c, 0x38, 0x56, 0xd7, 0xea, 0xd7, 0xfc, 0xfa, 0x62, 0xde,
+	0xa9, 0x0c, 0x07, 0x41, 0x85, 0x11, 0xf4, 0x36, 0xb4, 0x58, 0xc6, 0x24, 0xc3, 0x92, 0xe7, 0x4e,
+	0xa5, 0x6b, 0xf5, 0x5b, 0xc1, 0x8a, 0x81, 0x0e, 0xa0, 0x9a, 0x70, 0xe9, 0x54, 0xbb, 0x56, 0xdf,
+	0x3e, 0xbc, 0xe3, 0x1a, 0xc7, 0x54, 0x14, 0x65, 0x68, 0xee, 0x31, 0x67, 0x99, 0x5f, 0x3b, 0x9f,
+	0x77, 0x36, 0x02, 0x25, 0x8b, 0xbe, 0x85, 0x7a, 0xc4, 0x08, 0xa1, 0xb9, 0x53, 0xeb, 0x5a, 0xfd,
+	0x2d, 0xff, 0xfe, 0x5f, 0xf3, 0xce, 0xfe, 0x88, 0xc9, 0xf1, 0x34, 0x72, 0x63, 0x9e, 0x9a, 0xe0,
+	0xcc, 0xcf, 0xbe, 0x20, 0xa7, 0x9e, 0x7c, 0x32, 0xa1, 0xc2, 0x3d, 0x8a, 0xe3, 0x23, 0x42, 0x72,
+	0x2a, 0xc4, 0x4f, 0xcf, 0xf6, 0xdf, 0x32, 0x96, 0x0c, 0xc7, 0x7f, 0x22, 0xa9, 0x08, 0x0c, 0xae,
+	0x72, 0x2a, 0x62, 0xc4, 0xd9, 0xbc, 0xa1, 0x53, 0x11, 0x23, 0xe8, 0x7d, 0xd8, 0x19, 0x63, 0x11,
+	0xe6, 0x34, 0xa6, 0xec, 0x8c, 0x92, 0x30, 0x62, 0x44, 0x38, 0xf5, 0xae, 0xd5, 0x6f, 0x06, 0xdb,
+	0x63, 0x2c, 0x02, 0xc3, 0xf7, 0x19, 0x11, 0xe8, 0x23, 0x68, 0xd2, 0x8c, 0x84, 0x2a, 0xa1, 0x4e,
+	0x43, 0xdb, 0xd8, 0x73, 0x8b, 0x6c, 0xbb, 0x65, 0xb6, 0xdd, 0x2f, 0xcb, 0x6c, 0xfb, 0x4d, 0x65,
+	0xe4, 0xe9, 0xaf, 0x1d, 0x2b, 0x68, 0xd0, 0x8c, 0x28, 0x3e, 0xfa, 0x04, 0xb6, 0x52, 0x3c, 0x0b,
+	0x97, 0x20, 0xcd, 0x35, 0x40, 0x20, 0xc5, 0xb3, 0x8f, 0x0b, 0x9c, 0x0f, 0xed, 0xe7, 0xcf, 0xf6,
+	0x1b, 0xa6, 0x7e, 0xbd, 0x14, 0xde, 0xf8, 0x62, 0x9a, 0x4f, 0x92, 0xa9, 0x28, 0x2b, 0x7a, 0x02,
+	0x5b, 0x2a, 0xe6, 0xd0, 0xf4, 0x9a, 0xae, 0xad, 0x7d, 0xf8, 0x8e, 0xfb, 0xb2, 0x06, 0x74, 0xaf,
+	0xb4, 0x42, 0x61, 0xed, 0x62, 0xde, 0xb1, 0x02, 0x3b, 0x5a, 0xb1, 0xaf, 0x9b, 0xfb, 0xde, 0x02,
+	0x7b, 0x40, 0x23, 0xf9, 0x9a, 0x8c, 0xa1, 0x13, 0x40, 0x31, 0xcf, 0x73, 0x2a, 0x26, 0x3c, 0x23,
+	0x2c, 0x1b, 0x85, 0x84, 0x46, 0xd2, 0xa9, 0xdc, 0xac, 0xa4, 0x3b, 0xd7, 0x54, 0x95, 0x9b, 0xd7,
+	0x9d, 0x7f, 0x5e, 0x81, 0x9d, 0x63, 0x9e, 0x24, 0x58, 0xd2, 0x1c, 0x27, 0xff, 0x91, 0x10, 0xd0,
+	0x3d, 0x68, 0xa8, 0xb6, 0x51, 0xad, 0x7d, 0xc3, 0x79, 0xab, 0xa7, 0x78, 0xe6, 0x33, 0x82, 0x4e,
+	0xc0, 0x4e, 0xb8, 0x0c, 0x73, 0x2a, 0xa7, 0x79, 0x26, 0xf4, 0xdc, 0xd9, 0x87, 0xef, 0xbd, 0x3c,
+	0xb0, 0xaf, 0x28, 0x1b, 0x8d, 0x25, 0x25, 0x66, 0xb2, 0xa8, 0x30, 0x58, 0x90, 0x70, 0x19, 0x14,
+	0x00, 0xd7, 0x93, 0xf9, 0xdd, 0x26, 0x6c, 0x0d, 0xa6, 0x32, 0x1e, 0xff, 0x9f, 0xc7, 0x35, 0xf3,
+	0x88, 0xee, 0xc2, 0x2d, 0x85, 0x97, 0xe2, 0xfc, 0x94, 0xca, 0xd0, 0xac, 0xac, 0x96, 0xbf, 0xbd,
+	0x98, 0x77, 0xec, 0x07, 0x5c, 0x3e, 0xd4, 0xfc, 0xe1, 0x20, 0xb0, 0x93, 0x25, 0x41, 0x94, 0x52,
+	0xc4, 0xc8, 0x15, 0xa5, 0xfa, 0x4a, 0xc9, 0x67, 0x64, 0xa5, 0x14, 0x2d, 0x09, 0x82, 0xbe, 0x86,
+	0x37, 0x27, 0x39, 0x8b, 0x69, 0x18, 0xf3, 0xec, 0x8c, 0xe6, 0x42, 0xd5, 0xa5, 0xa1, 0xd7, 0xaf,
+	0xab, 0xbc, 0xfa, 0x65, 0xde, 0x79, 0xf7, 0x06, 0x2b, 0x78, 0x40, 0xe3, 0x60, 0x5b, 0xe3, 0x1c,
+	0x2f, 0x61, 0xd0, 0x67, 0x60, 0x0b, 0x89, 0x73, 0x19, 0xea, 0x0f, 0x4e, 0xf3, 0x95, 0x50, 0x41,
+	0x43, 0x7c, 0xae, 0x10, 0xd0, 0x31, 0x14, 0x54, 0xb1, 0x1c, 0x5b, 0x6b, 0x2c, 0xc7, 0x96, 0xd6,
+	0x7b, 0x71, 0x37, 0xfe, 0x60, 0xc1, 0xce, 0x0b, 0xf5, 0x40, 0x8f, 0xa0, 0x85, 0x4b, 0xc2, 0xb1,
+	0xba, 0xd5, 0x7f, 0xf4, 0x16, 0xad, 0xa0, 0xd1, 0x7d, 0x68, 0x3c, 0xd6, 0xc6, 0x85, 0x53, 0xe9,
+	0x56, 0xd7, 0x4c, 0xce, 0x30, 0x93, 0x41, 0xa9, 0xde, 0xfb, 0xb3, 0x02, 0x50, 0x0e, 0x0b, 0x23,
+	0xe8, 0x03, 0x00, 0xd3, 0x75, 0xe1, 0xf2, 0x74, 0xdf, 0x5a, 0xcc, 0x3b, 0x2d, 0x23, 0x33, 0x1c,
+	0x04, 0x2d, 0x23, 0x30, 0x24, 0x57, 0xee, 0x6e, 0xe5, 0xf5, 0xde, 0xdd, 0xea, 0x1a, 0x77, 0xd7,
+	0xbc, 0x1f, 0x6a, 0x6b, 0xbc, 0x1f, 0x76, 0x61, 0x73, 0x32, 0xc6, 0x82, 0x16, 0xc3, 0x12, 0x14,
+	0x04, 0xba, 0x0d, 0xf5, 0xb1, 0xce, 0x92, 0x1e, 0x87, 0x6a, 0x60, 0x28, 0x74, 0x0f, 0x6a, 0x6b,
+	0x1f, 0x6a, 0xad, 0xd1, 0xfb, 0xc3, 0x82, 0xe6, 0x03, 0x96, 0x32, 0xf9, 0x6f, 0x4c, 0xf5, 0xa7,
+	0xd0, 0x52, 0x3b, 0xac, 0x18, 0xb9, 0xea, 0x2b, 0x8d, 0x5c, 0x33, 0xc5, 0x33, 0x3d, 0x70, 0xfe,
+	0xc3, 0xf3, 0xdf, 0xdb, 0x1b, 0xe7, 0x8b, 0xb6, 0x75, 0xb1, 0x68, 0x5b, 0xbf, 0x2d, 0xda, 0xd6,
+	0xd3, 0xcb, 0xf6, 0xc6, 0xc5, 0x65, 0x7b, 0xe3, 0xe7, 0xcb, 0xf6, 0xc6, 0x37, 0xde, 0x15, 0xbc,
+	0x94, 0x9f, 0x32, 0x89, 0x33, 0x2a, 0x1f, 0xf3, 0xfc, 0xd4, 0x53, 0x7b, 0x8f, 0xe6, 0xde, 0x6c,
+	0xf9, 0xe2, 0xd5, 0xe0, 0x51, 0x5d, 0x27, 0xf7, 0xee, 0xdf, 0x03, 0x00, 0x24, 0xf7, 0x43, 0xc8,
+	0x0e, 0x0b, 0x00, 0x00,
 }
 
 func (m *BaseAuction) Marshal() (dAtA []byte, err error) {
@@ -524,6 +685,111 @@ func (m *CollateralAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintAuction(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.PriceConversion.Size()
+		i -= size
+		if _, err := m.PriceConversion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BidMarketID) > 0 {
+		i -= len(m.BidMarketID)
+		copy(dAtA[i:], m.BidMarketID)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.BidMarketID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LotMarketID) > 0 {
+		i -= len(m.LotMarketID)
+		copy(dAtA[i:], m.LotMarketID)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.LotMarketID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.LotReturns.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MaxBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CorrespondingDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BaseAuction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WeightedAddresses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -570,6 +836,126 @@ func (m *WeightedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuctionBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintAuction(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Lot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Bid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionID != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionID != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuction(v)
 	base := offset
@@ -653,20 +1039,51 @@ func (m *CollateralAuction) Size() (n int) {
 	return n
 }
 
-func (m *WeightedAddresses) Size() (n int) {
+func (m *DutchAuction) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for _, b := range m.Addresses {
-			l = len(b)
-			n += 1 + l + sovAuction(uint64(l))
-		}
-	}
-	if len(m.Weights) > 0 {
-		for _, e := range m.Weights {
+	l = m.BaseAuction.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.CorrespondingDebt.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.MaxBid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.LotReturns.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = len(m.LotMarketID)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = len(m.BidMarketID)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.PriceConversion.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.StartPrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *WeightedAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
 			l = e.Size()
 			n += 1 + l + sovAuction(uint64(l))
 		}
@@ -674,6 +1091,53 @@ func (m *WeightedAddresses) Size() (n int) {
 	return n
 }
 
+func (m *AuctionBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionID != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionID))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Bid.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.Lot.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuction(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *LimitBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionID != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionID))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
 func sovAuction(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -864,7 +1328,688 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field HasReceivedBids", wireType)
 			}
-			var v int
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasReceivedBids = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.MaxEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SurplusAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SurplusAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebtAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebtAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebtAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotReturns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotReturns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotReturns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotReturns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidMarketID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -874,17 +2019,29 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasReceivedBids = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceConversion", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -894,30 +2051,30 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceConversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -927,78 +2084,28 @@ func (m *BaseAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.MaxEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuction(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuction
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SurplusAuction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SurplusAuction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1025,7 +2132,7 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1050,7 +2157,7 @@ func (m *SurplusAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DebtAuction) Unmarshal(dAtA []byte) error {
+func (m *WeightedAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1073,17 +2180,17 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DebtAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedAddresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebtAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1093,30 +2200,29 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1126,22 +2232,24 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Weights = append(m.Weights, v)
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1166,7 +2274,7 @@ func (m *DebtAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
+func (m *AuctionBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1189,17 +2297,36 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollateralAuction: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuctionBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollateralAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuctionBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionID", wireType)
+			}
+			m.AuctionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAuction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1209,28 +2336,29 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Bidder = append(m.Bidder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bidder == nil {
+				m.Bidder = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CorrespondingDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1257,13 +2385,13 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CorrespondingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1290,13 +2418,64 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LotReturns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1323,7 +2502,7 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LotReturns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1348,7 +2527,7 @@ func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WeightedAddresses) Unmarshal(dAtA []byte) error {
+func (m *LimitBid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,15 +2550,34 @@ func (m *WeightedAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: LimitBid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LimitBid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionID", wireType)
+			}
+			m.AuctionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1406,12 +2604,14 @@ func (m *WeightedAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
-			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			m.Bidder = append(m.Bidder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bidder == nil {
+				m.Bidder = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1438,9 +2638,7 @@ func (m *WeightedAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.Weights = append(m.Weights, v)
-			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15,6 +15,7 @@ const (
 	CollateralAuctionType = "collateral"
 	SurplusAuctionType    = "surplus"
 	DebtAuctionType       = "debt"
+	DutchAuctionType      = "dutch"
 	ForwardAuctionPhase   = "forward"
 	ReverseAuctionPhase   = "reverse"
 )
@@ -31,6 +32,8 @@ var (
 	_ GenesisAuction = &DebtAuction{}
 	_ Auction        = &CollateralAuction{}
 	_ GenesisAuction = &CollateralAuction{}
+	_ Auction        = &DutchAuction{}
+	_ GenesisAuction = &DutchAuction{}
 )
 
 // --------------- Shared auction functionality ---------------
@@ -53,6 +56,12 @@ type Auction interface {
 	GetPhase() string
 }
 
+// LotReturnsAuction is an auction that returns its unsold lot to weighted addresses, normally the owners of the lot.
+type LotReturnsAuction interface {
+	Auction
+	GetLotReturns() WeightedAddresses
+}
+
 // --------------- BaseAuction ---------------
 
 func (a BaseAuction) GetID() uint64 { return a.ID }
@@ -249,6 +258,101 @@ func (a CollateralAuction) Validate() error {
 	return ValidateAuction(&a)
 }
 
+// --------------- DutchAuction ---------------
+
+// NewDutchAuction returns a new dutch auction, starting at startTime with a lot price of startPrice.
+func NewDutchAuction(
+	seller string, lot sdk.Coin, resetTime time.Time, maxBid sdk.Coin, lotReturns WeightedAddresses, debt sdk.Coin,
+	lotMarketID, bidMarketID string, priceConversion, startPrice sdk.Dec, startTime time.Time,
+) DutchAuction {
+	auction := DutchAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(maxBid.Denom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         resetTime,
+			MaxEndTime:      DistantFuture, // dutch auctions reset rather than end
+		},
+		CorrespondingDebt: debt,
+		MaxBid:            maxBid,
+		LotReturns:        lotReturns,
+		LotMarketID:       lotMarketID,
+		BidMarketID:       bidMarketID,
+		PriceConversion:   priceConversion,
+		StartPrice:        startPrice,
+		StartTime:         startTime,
+	}
+	return auction
+}
+
+func (a DutchAuction) WithID(id uint64) Auction {
+	a.ID = id
+	return Auction(&a)
+}
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a DutchAuction) GetType() string { return DutchAuctionType }
+
+// GetPhase returns the direction of a dutch auction, which never changes as bids only increase the amount raised.
+func (a DutchAuction) GetPhase() string { return ForwardAuctionPhase }
+
+// GetLotReturns returns the auction's lot returns as weighted addresses
+func (a DutchAuction) GetLotReturns() WeightedAddresses { return a.LotReturns }
+
+// IsSold returns whether the max bid has been raised or the whole lot has been sold.
+func (a DutchAuction) IsSold() bool {
+	return !a.Bid.IsLT(a.MaxBid) || !a.Lot.IsPositive()
+}
+
+// GetCurrentPrice returns the price of the lot at blockTime, in bid base units per lot base unit.
+// The price decays linearly from the start price, reaching zero after decayDuration.
+func (a DutchAuction) GetCurrentPrice(blockTime time.Time, decayDuration time.Duration) sdk.Dec {
+	elapsed := blockTime.Sub(a.StartTime)
+	if elapsed <= 0 {
+		return a.StartPrice
+	}
+	if elapsed >= decayDuration {
+		return sdk.ZeroDec()
+	}
+	return a.StartPrice.MulInt64(int64(decayDuration - elapsed)).QuoInt64(int64(decayDuration))
+}
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a DutchAuction) GetModuleAccountCoins() sdk.Coins {
+	// a.Bid is paid out on bids, so is never stored in the module account
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt)...)
+}
+
+// Validate validates the DutchAuction fields values.
+func (a DutchAuction) Validate() error {
+	if !a.CorrespondingDebt.IsValid() {
+		return fmt.Errorf("invalid corresponding debt: %s", a.CorrespondingDebt)
+	}
+	if !a.MaxBid.IsValid() {
+		return fmt.Errorf("invalid max bid: %s", a.MaxBid)
+	}
+	if err := a.LotReturns.Validate(); err != nil {
+		return fmt.Errorf("invalid lot returns: %w", err)
+	}
+	if strings.TrimSpace(a.LotMarketID) == "" {
+		return errors.New("lot market id cannot be blank")
+	}
+	if a.PriceConversion.IsNil() || !a.PriceConversion.IsPositive() {
+		return fmt.Errorf("price conversion must be positive: %s", a.PriceConversion)
+	}
+	if a.StartPrice.IsNil() || a.StartPrice.IsNegative() {
+		return fmt.Errorf("start price cannot be negative: %s", a.StartPrice)
+	}
+	if a.StartTime.Unix() <= 0 {
+		return errors.New("start time cannot be zero")
+	}
+	return ValidateAuction(&a)
+}
+
 // NewWeightedAddresses returns a new list addresses with weights.
 func NewWeightedAddresses(addrs []sdk.AccAddress, weights []sdk.Int) (WeightedAddresses, error) {
 	wa := WeightedAddresses{
@@ -288,3 +392,67 @@ func (wa WeightedAddresses) Validate() error {
 
 	return nil
 }
+
+// --------------- AuctionBid ---------------
+
+// MaxAuctionBids is the number of bids kept in each auction's bid log. Older bids are pruned once it is reached.
+const MaxAuctionBids = 100
+
+// NewAuctionBid returns a new bid log entry.
+func NewAuctionBid(auctionID uint64, bidder sdk.AccAddress, bid, lot sdk.Coin, phase string, height int64, blockTime time.Time) AuctionBid {
+	return AuctionBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		Bid:       bid,
+		Lot:       lot,
+		Phase:     phase,
+		Height:    height,
+		Time:      blockTime,
+	}
+}
+
+// Validate performs stateless validation of a bid log entry.
+func (b AuctionBid) Validate() error {
+	if b.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if b.Bidder.Empty() {
+		return errors.New("bidder cannot be empty")
+	}
+	if !b.Bid.IsValid() {
+		return fmt.Errorf("invalid bid: %s", b.Bid)
+	}
+	if !b.Lot.IsValid() {
+		return fmt.Errorf("invalid lot: %s", b.Lot)
+	}
+	if b.Phase != ForwardAuctionPhase && b.Phase != ReverseAuctionPhase {
+		return fmt.Errorf("invalid phase: %s", b.Phase)
+	}
+	if b.Height < 0 {
+		return fmt.Errorf("height cannot be negative: %d", b.Height)
+	}
+	return nil
+}
+
+// NewLimitBid returns a new limit bid.
+func NewLimitBid(auctionID uint64, bidder sdk.AccAddress, maxPrice sdk.Dec) LimitBid {
+	return LimitBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		MaxPrice:  maxPrice,
+	}
+}
+
+// Validate performs stateless validation of a limit bid.
+func (b LimitBid) Validate() error {
+	if b.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if b.Bidder.Empty() {
+		return errors.New("bidder cannot be empty")
+	}
+	if b.MaxPrice.IsNil() || !b.MaxPrice.IsPositive() {
+		return fmt.Errorf("max price must be positive: %s", b.MaxPrice)
+	}
+	return nil
+}
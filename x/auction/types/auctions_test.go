@@ -261,6 +261,98 @@ func TestCollateralAuctionValidate(t *testing.T) {
 	}
 }
 
+func TestDutchAuctionValidate(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte(testAccAddress1))
+	now := time.Now()
+	lotReturns := WeightedAddresses{
+		Addresses: []sdk.AccAddress{addr1},
+		Weights:   []sdk.Int{sdk.NewInt(1)},
+	}
+	validAuction := NewDutchAuction(
+		TestInitiatorModuleName, c("aeth", 10), now, c("usdx", 20), lotReturns, c("debt", 20),
+		"aeth:usd", "", d("1"), d("2.4"), now,
+	)
+
+	tests := []struct {
+		msg     string
+		auction func() DutchAuction
+		expPass bool
+	}{
+		{
+			"valid auction",
+			func() DutchAuction { return validAuction },
+			true,
+		},
+		{
+			"invalid corresponding debt",
+			func() DutchAuction {
+				a := validAuction
+				a.CorrespondingDebt = sdk.Coin{Denom: "debt", Amount: sdk.NewInt(-1)}
+				return a
+			},
+			false,
+		},
+		{
+			"invalid lot returns",
+			func() DutchAuction {
+				a := validAuction
+				a.LotReturns = WeightedAddresses{}
+				return a
+			},
+			false,
+		},
+		{
+			"blank lot market",
+			func() DutchAuction {
+				a := validAuction
+				a.LotMarketID = ""
+				return a
+			},
+			false,
+		},
+		{
+			"zero price conversion",
+			func() DutchAuction {
+				a := validAuction
+				a.PriceConversion = sdk.ZeroDec()
+				return a
+			},
+			false,
+		},
+		{
+			"negative start price",
+			func() DutchAuction {
+				a := validAuction
+				a.StartPrice = d("-1")
+				return a
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		err := tc.auction().Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestDutchAuctionGetCurrentPrice(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	auction := DutchAuction{StartPrice: d("2.4"), StartTime: start}
+	decay := 6 * time.Hour
+
+	require.Equal(t, d("2.4"), auction.GetCurrentPrice(start, decay))
+	require.Equal(t, d("2.0"), auction.GetCurrentPrice(start.Add(time.Hour), decay))
+	require.Equal(t, d("1.2"), auction.GetCurrentPrice(start.Add(3*time.Hour), decay))
+	require.Equal(t, sdk.ZeroDec(), auction.GetCurrentPrice(start.Add(decay), decay))
+	require.Equal(t, sdk.ZeroDec(), auction.GetCurrentPrice(start.Add(7*time.Hour), decay))
+}
+
 func TestBaseAuctionGetters(t *testing.T) {
 	endTime := time.Now().Add(TestExtraEndTime)
 
@@ -27,4 +27,8 @@ var (
 	ErrLotTooSmall = sdkerrors.Register(ModuleName, 11, "lot is not greater than auction's min new lot amount")
 	// ErrLotTooLarge error for when lot is not smaller than auction's max new lot amount
 	ErrLotTooLarge = sdkerrors.Register(ModuleName, 12, "lot is greater than auction's max new lot amount")
+	// ErrNoValidPrice error for when a dutch auction's lot cannot be priced
+	ErrNoValidPrice = sdkerrors.Register(ModuleName, 13, "no valid price for dutch auction")
+	// ErrLimitBidNotSupported error for when a limit bid is placed on an auction that does not support them
+	ErrLimitBidNotSupported = sdkerrors.Register(ModuleName, 14, "auction does not support limit bids")
 )
@@ -2,17 +2,25 @@ package types
 
 // Events for the module
 const (
-	EventTypeAuctionStart = "auction_start"
-	EventTypeAuctionBid   = "auction_bid"
-	EventTypeAuctionClose = "auction_close"
+	EventTypeAuctionStart  = "auction_start"
+	EventTypeAuctionBid    = "auction_bid"
+	EventTypeAuctionClose  = "auction_close"
+	EventTypeAuctionReset  = "auction_reset"
+	EventTypeAuctionOutbid = "auction_outbid"
+	EventTypeAuctionLimit  = "auction_limit_bid"
 
-	AttributeValueCategory  = ModuleName
-	AttributeKeyAuctionID   = "auction_id"
-	AttributeKeyAuctionType = "auction_type"
-	AttributeKeyBidder      = "bidder"
-	AttributeKeyLot         = "lot"
-	AttributeKeyMaxBid      = "max_bid"
-	AttributeKeyBid         = "bid"
-	AttributeKeyEndTime     = "end_time"
-	AttributeKeyCloseBlock  = "close_block"
+	AttributeValueCategory     = ModuleName
+	AttributeKeyAuctionID      = "auction_id"
+	AttributeKeyAuctionType    = "auction_type"
+	AttributeKeyBidder         = "bidder"
+	AttributeKeyLot            = "lot"
+	AttributeKeyMaxBid         = "max_bid"
+	AttributeKeyBid            = "bid"
+	AttributeKeyEndTime        = "end_time"
+	AttributeKeyCloseBlock     = "close_block"
+	AttributeKeyPrice          = "price"
+	AttributeKeyPreviousBidder = "previous_bidder"
+	AttributeKeyRefund         = "refund"
+	AttributeKeyPhase          = "phase"
+	AttributeKeyMaxPrice       = "max_price"
 )
@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	pricefeedtypes "github.com/mokitanetwork/aether/x/pricefeed/types"
 )
 
 // AccountKeeper expected interface for the account keeper (noalias)
@@ -20,3 +22,15 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+// PricefeedKeeper defines the expected interface for the pricefeed
+type PricefeedKeeper interface {
+	GetCurrentPrice(sdk.Context, string) (pricefeedtypes.CurrentPrice, error)
+}
+
+// AuctionHooks event hooks for other keepers to run code in response to auctions starting, receiving bids, and closing
+type AuctionHooks interface {
+	AfterAuctionStarted(ctx sdk.Context, auction Auction)
+	AfterBidPlaced(ctx sdk.Context, auction Auction, bid AuctionBid)
+	AfterAuctionClosed(ctx sdk.Context, auction Auction, proceeds sdk.Coin)
+}
@@ -111,6 +111,39 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("found auction ID ≥ the nextAuctionID (%d ≥ %d)", a.GetID(), gs.NextAuctionId)
 		}
 	}
+
+	bidCounts := map[uint64]int{}
+	for _, b := range gs.Bids {
+		if err := b.Validate(); err != nil {
+			return fmt.Errorf("found invalid bid: %w", err)
+		}
+
+		if !ids[b.AuctionID] {
+			return fmt.Errorf("found bid for unknown auction ID (%d)", b.AuctionID)
+		}
+
+		bidCounts[b.AuctionID]++
+		if bidCounts[b.AuctionID] > MaxAuctionBids {
+			return fmt.Errorf("found more than %d bids for auction ID (%d)", MaxAuctionBids, b.AuctionID)
+		}
+	}
+
+	limitBids := map[string]bool{}
+	for _, b := range gs.LimitBids {
+		if err := b.Validate(); err != nil {
+			return fmt.Errorf("found invalid limit bid: %w", err)
+		}
+
+		if !ids[b.AuctionID] {
+			return fmt.Errorf("found limit bid for unknown auction ID (%d)", b.AuctionID)
+		}
+
+		key := string(GetLimitBidKey(b.AuctionID, b.Bidder))
+		if limitBids[key] {
+			return fmt.Errorf("found duplicate limit bid for auction ID (%d) and bidder %s", b.AuctionID, b.Bidder)
+		}
+		limitBids[key] = true
+	}
 	return nil
 }
 
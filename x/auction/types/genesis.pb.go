@@ -36,13 +36,17 @@ type GenesisState struct {
 	Params        Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// Genesis auctions
 	Auctions []*types.Any `protobuf:"bytes,3,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// Bid logs of the genesis auctions, oldest first
+	Bids []AuctionBid `protobuf:"bytes,4,rep,name=bids,proto3" json:"bids"`
+	// Limit bids on the genesis auctions
+	LimitBids []LimitBid `protobuf:"bytes,5,rep,name=limit_bids,json=limitBids,proto3" json:"limit_bids"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0e5cb58293042f7, []int{0}
+	return fileDescriptor_b72ec33ec88101de, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -79,13 +83,19 @@ type Params struct {
 	IncrementSurplus    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=increment_surplus,json=incrementSurplus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_surplus"`
 	IncrementDebt       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=increment_debt,json=incrementDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_debt"`
 	IncrementCollateral github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=increment_collateral,json=incrementCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"increment_collateral"`
+	// dutch_price_buffer multiplies the oracle price to give the price a dutch auction starts or resets at
+	DutchPriceBuffer github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=dutch_price_buffer,json=dutchPriceBuffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dutch_price_buffer"`
+	// dutch_decay_duration is the time it takes the price of a dutch auction to decay from its start price to zero
+	DutchDecayDuration time.Duration `protobuf:"bytes,9,opt,name=dutch_decay_duration,json=dutchDecayDuration,proto3,stdduration" json:"dutch_decay_duration"`
+	// dutch_reset_ratio is the fraction of its start price at which the price of a dutch auction resets
+	DutchResetRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=dutch_reset_ratio,json=dutchResetRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dutch_reset_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0e5cb58293042f7, []int{1}
+	return fileDescriptor_b72ec33ec88101de, []int{1}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,42 +130,50 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("aeth/auction/v1beta1/genesis.proto", fileDescriptor_d0e5cb58293042f7)
+	proto.RegisterFile("aeth/auction/v1beta1/genesis.proto", fileDescriptor_b72ec33ec88101de)
 }
 
-var fileDescriptor_d0e5cb58293042f7 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0x93, 0x35, 0x94, 0xca, 0xed, 0xc6, 0x30, 0x39, 0xa4, 0x13, 0x4a, 0xab, 0x1e, 0xa6,
-	0x72, 0xa8, 0xa3, 0x95, 0x1b, 0xb7, 0x85, 0x4a, 0x13, 0x9c, 0x50, 0xa6, 0x5d, 0xe0, 0x10, 0x39,
-	0x89, 0x17, 0xa2, 0x25, 0x71, 0x65, 0x3b, 0xa5, 0x7d, 0x0b, 0x8e, 0x3c, 0x08, 0x87, 0x3d, 0x42,
-	0xc5, 0x69, 0x47, 0xc4, 0x61, 0x40, 0xfb, 0x22, 0x28, 0x8e, 0x9b, 0x16, 0xd8, 0x65, 0x3b, 0xd5,
-	0xfe, 0xbe, 0xff, 0xf7, 0xfb, 0xff, 0xed, 0x3a, 0x60, 0x70, 0x85, 0x67, 0xd8, 0xc1, 0x45, 0x28,
-	0x12, 0x9a, 0x3b, 0xb3, 0x93, 0x80, 0x08, 0x7c, 0xe2, 0xc4, 0x24, 0x27, 0x3c, 0xe1, 0x68, 0xca,
-	0xa8, 0xa0, 0xd0, 0x2c, 0x35, 0x48, 0x69, 0x90, 0xd2, 0x1c, 0x75, 0x43, 0xca, 0x33, 0xca, 0x7d,
-	0xa9, 0x71, 0xaa, 0x4d, 0x35, 0x70, 0x64, 0xc6, 0x34, 0xa6, 0x55, 0xbd, 0x5c, 0xa9, 0x6a, 0x37,
-	0xa6, 0x34, 0x4e, 0x89, 0x23, 0x77, 0x41, 0x71, 0xe9, 0xe0, 0x7c, 0xa1, 0x5a, 0xf6, 0xbf, 0xad,
-	0xa8, 0x60, 0x58, 0xba, 0xc9, 0xca, 0xe0, 0x5a, 0x07, 0x9d, 0xb3, 0x2a, 0xd3, 0xb9, 0xc0, 0x82,
-	0xc0, 0x63, 0xf0, 0x24, 0x27, 0x73, 0xe1, 0xab, 0x50, 0x7e, 0x12, 0x59, 0x7a, 0x5f, 0x1f, 0x1a,
-	0xde, 0x7e, 0x59, 0x3e, 0xad, 0xaa, 0x6f, 0x22, 0xf8, 0x0a, 0x34, 0xa7, 0x98, 0xe1, 0x8c, 0x5b,
-	0x7b, 0x7d, 0x7d, 0xd8, 0x1e, 0x3f, 0x47, 0x77, 0x9d, 0x05, 0xbd, 0x93, 0x1a, 0xd7, 0x58, 0xde,
-	0xf6, 0x34, 0x4f, 0x4d, 0xc0, 0x09, 0x68, 0x29, 0x1d, 0xb7, 0x1a, 0xfd, 0xc6, 0xb0, 0x3d, 0x36,
-	0x51, 0x95, 0x13, 0x6d, 0x72, 0xa2, 0xd3, 0x7c, 0xe1, 0xc2, 0x6f, 0x5f, 0x47, 0x07, 0x2a, 0x9d,
-	0x72, 0xf6, 0xea, 0xc9, 0xc1, 0xb5, 0x01, 0x9a, 0x15, 0x1e, 0x5e, 0x00, 0x33, 0xc3, 0xf3, 0x3a,
-	0xf3, 0xe6, 0x8c, 0x32, 0x79, 0x7b, 0xdc, 0xfd, 0x0f, 0x3e, 0x51, 0x02, 0xb7, 0x55, 0xe6, 0xfa,
-	0xf2, 0xb3, 0xa7, 0x7b, 0x30, 0xc3, 0x73, 0xe5, 0xb1, 0xe9, 0x96, 0xd8, 0x4b, 0xca, 0x3e, 0x61,
-	0x16, 0xf9, 0x41, 0x12, 0x6d, 0xb1, 0xcd, 0x7b, 0x60, 0x15, 0xc0, 0x4d, 0xa2, 0x5d, 0x2c, 0x23,
-	0x33, 0xc2, 0x38, 0xf9, 0x1b, 0xfb, 0xf8, 0x1e, 0x58, 0x05, 0xd8, 0xc5, 0x7e, 0x00, 0x4f, 0x93,
-	0x3c, 0x64, 0x24, 0x23, 0xb9, 0xf0, 0x79, 0xc1, 0xa6, 0x69, 0x51, 0x5e, 0xaf, 0x3e, 0xec, 0xb8,
-	0xa8, 0x1c, 0xfc, 0x71, 0xdb, 0x3b, 0x8e, 0x13, 0xf1, 0xb1, 0x08, 0x50, 0x48, 0x33, 0xf5, 0xae,
-	0xd4, 0xcf, 0x88, 0x47, 0x57, 0x8e, 0x58, 0x4c, 0x09, 0x47, 0x13, 0x12, 0x7a, 0x87, 0x35, 0xe8,
-	0xbc, 0xe2, 0xc0, 0x0b, 0x70, 0xb0, 0x85, 0x47, 0x24, 0x10, 0x96, 0xf1, 0x20, 0xf2, 0x7e, 0x4d,
-	0x99, 0x90, 0x40, 0x40, 0x0c, 0xcc, 0x2d, 0x36, 0xa4, 0x69, 0x8a, 0x05, 0x61, 0x38, 0xb5, 0x1e,
-	0x3d, 0x08, 0xfe, 0xac, 0x66, 0xbd, 0xae, 0x51, 0x6f, 0x8d, 0xd6, 0xde, 0x61, 0xc3, 0xeb, 0xec,
-	0xde, 0xb4, 0x7b, 0xb6, 0xfc, 0x6d, 0x6b, 0xcb, 0x95, 0xad, 0xdf, 0xac, 0x6c, 0xfd, 0xd7, 0xca,
-	0xd6, 0x3f, 0xaf, 0x6d, 0xed, 0x66, 0x6d, 0x6b, 0xdf, 0xd7, 0xb6, 0xf6, 0xfe, 0xc5, 0x8e, 0x5d,
-	0xf9, 0xa8, 0x47, 0x29, 0x0e, 0xb8, 0x5c, 0x39, 0xf3, 0xfa, 0x83, 0x96, 0xae, 0x41, 0x53, 0xfe,
-	0x49, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x34, 0x3b, 0x9f, 0xed, 0x03, 0x00, 0x00,
+var fileDescriptor_b72ec33ec88101de = []byte{
+	// 617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x4e, 0xdb, 0x40,
+	0x10, 0xc6, 0x63, 0x08, 0x69, 0x58, 0xfe, 0x6f, 0x73, 0x30, 0xa8, 0x32, 0x11, 0x07, 0x94, 0x0b,
+	0xb6, 0xa0, 0x37, 0x6e, 0x98, 0x48, 0x55, 0xab, 0x56, 0x42, 0x46, 0x5c, 0x68, 0x25, 0x6b, 0x6d,
+	0x4f, 0x8c, 0x85, 0xed, 0x8d, 0x76, 0xd7, 0x90, 0xbc, 0x45, 0x8f, 0x7d, 0x90, 0x5e, 0xfa, 0x06,
+	0xb4, 0x27, 0x8e, 0x55, 0x0f, 0xb4, 0x85, 0x17, 0xa9, 0x76, 0xbd, 0x71, 0xd2, 0x36, 0x97, 0xe4,
+	0x94, 0xec, 0xcc, 0x37, 0xbf, 0xf9, 0x66, 0x67, 0x13, 0xb4, 0x47, 0x40, 0x5c, 0x39, 0xa4, 0x08,
+	0x45, 0x42, 0x73, 0xe7, 0xe6, 0x30, 0x00, 0x41, 0x0e, 0x9d, 0x18, 0x72, 0xe0, 0x09, 0xb7, 0xfb,
+	0x8c, 0x0a, 0x8a, 0x5b, 0x52, 0x63, 0x6b, 0x8d, 0xad, 0x35, 0x3b, 0xdb, 0x21, 0xe5, 0x19, 0xe5,
+	0xbe, 0xd2, 0x38, 0xe5, 0xa1, 0x2c, 0xd8, 0x69, 0xc5, 0x34, 0xa6, 0x65, 0x5c, 0x7e, 0xd3, 0xd1,
+	0xed, 0x98, 0xd2, 0x38, 0x05, 0x47, 0x9d, 0x82, 0xa2, 0xe7, 0x90, 0x7c, 0xa8, 0x53, 0xd6, 0xbf,
+	0xa9, 0xa8, 0x60, 0x44, 0x75, 0x2b, 0xf3, 0xd3, 0x5d, 0x8e, 0x1c, 0x29, 0xcd, 0xde, 0x97, 0x05,
+	0xb4, 0xfa, 0xaa, 0xf4, 0x7d, 0x2e, 0x88, 0x00, 0xbc, 0x8f, 0x36, 0x72, 0x18, 0x08, 0x5f, 0xcb,
+	0xfc, 0x24, 0x32, 0x8d, 0xb6, 0xd1, 0xa9, 0x7b, 0x6b, 0x32, 0x7c, 0x52, 0x46, 0x5f, 0x47, 0xf8,
+	0x18, 0x35, 0xfa, 0x84, 0x91, 0x8c, 0x9b, 0x0b, 0x6d, 0xa3, 0xb3, 0x72, 0xf4, 0xc2, 0x9e, 0x36,
+	0xaf, 0x7d, 0xa6, 0x34, 0x6e, 0xfd, 0xee, 0x61, 0xb7, 0xe6, 0xe9, 0x0a, 0xdc, 0x45, 0x4d, 0xad,
+	0xe3, 0xe6, 0x62, 0x7b, 0xb1, 0xb3, 0x72, 0xd4, 0xb2, 0xcb, 0x59, 0xec, 0xd1, 0x2c, 0xf6, 0x49,
+	0x3e, 0x74, 0xf1, 0xb7, 0xcf, 0x07, 0xeb, 0xda, 0x9d, 0xee, 0xec, 0x55, 0x95, 0xf8, 0x18, 0xd5,
+	0x83, 0x24, 0xe2, 0x66, 0x5d, 0x11, 0xda, 0xd3, 0xfb, 0xeb, 0x32, 0x37, 0x89, 0xb4, 0x07, 0x55,
+	0x83, 0x4f, 0x11, 0x4a, 0x93, 0x2c, 0x11, 0xbe, 0x22, 0x2c, 0x29, 0x82, 0x35, 0x9d, 0xf0, 0x56,
+	0xea, 0xc6, 0xf5, 0xcb, 0xa9, 0x3e, 0xf3, 0xbd, 0xaf, 0x0d, 0xd4, 0x28, 0xe7, 0xc3, 0x17, 0xa8,
+	0x95, 0x91, 0x41, 0x75, 0x69, 0xa3, 0x45, 0xa8, 0xab, 0x5b, 0x39, 0xda, 0xfe, 0x6f, 0xba, 0xae,
+	0x16, 0xb8, 0x4d, 0x09, 0xfd, 0xf4, 0x73, 0xd7, 0xf0, 0x70, 0x46, 0x06, 0xda, 0xed, 0x28, 0x2b,
+	0xb1, 0x3d, 0xca, 0x6e, 0x09, 0x8b, 0xa4, 0xd1, 0x31, 0xb6, 0x31, 0x03, 0x56, 0x03, 0xdc, 0x24,
+	0x9a, 0xc4, 0x32, 0xb8, 0x01, 0xc6, 0xe1, 0x6f, 0xec, 0xb3, 0x19, 0xb0, 0x1a, 0x30, 0x89, 0x7d,
+	0x8f, 0xb6, 0x92, 0x3c, 0x64, 0x90, 0x41, 0x2e, 0x7c, 0x5e, 0xb0, 0x7e, 0x5a, 0xc8, 0xfd, 0x1a,
+	0x9d, 0x55, 0xd7, 0x96, 0x85, 0x3f, 0x1e, 0x76, 0xf7, 0xe3, 0x44, 0x5c, 0x15, 0x81, 0x1d, 0xd2,
+	0x4c, 0x3f, 0x7e, 0xfd, 0x71, 0xc0, 0xa3, 0x6b, 0x47, 0x0c, 0xfb, 0xc0, 0xed, 0x2e, 0x84, 0xde,
+	0x66, 0x05, 0x3a, 0x2f, 0x39, 0xf8, 0x02, 0xad, 0x8f, 0xe1, 0x11, 0x04, 0xc2, 0xac, 0xcf, 0x45,
+	0x5e, 0xab, 0x28, 0x5d, 0x08, 0x04, 0x26, 0xa8, 0x35, 0xc6, 0x86, 0x34, 0x4d, 0x89, 0x00, 0x46,
+	0x52, 0x73, 0x69, 0x2e, 0xf8, 0xf3, 0x8a, 0x75, 0x5a, 0xa1, 0xf0, 0x07, 0x84, 0xa3, 0x42, 0x84,
+	0x57, 0x7e, 0x9f, 0x25, 0x21, 0xf8, 0x41, 0xd1, 0xeb, 0x01, 0x33, 0x9b, 0xf3, 0xdd, 0x8b, 0x22,
+	0x9d, 0x49, 0x90, 0xab, 0x38, 0x72, 0x97, 0x25, 0x3d, 0x82, 0x90, 0x0c, 0xc7, 0xbb, 0x5c, 0x9e,
+	0x61, 0x97, 0x0a, 0xd0, 0x95, 0xf5, 0xd5, 0x2e, 0x2f, 0xd1, 0x56, 0x89, 0x65, 0xc0, 0x41, 0xf8,
+	0x2a, 0x6a, 0xa2, 0xb9, 0x3c, 0x6f, 0x28, 0x90, 0x27, 0x39, 0x9e, 0xc4, 0xbc, 0xa9, 0x37, 0x17,
+	0x36, 0x17, 0xbd, 0xd5, 0xc9, 0xa7, 0xe7, 0xbe, 0xbb, 0xfb, 0x6d, 0xd5, 0xee, 0x1e, 0x2d, 0xe3,
+	0xfe, 0xd1, 0x32, 0x7e, 0x3d, 0x5a, 0xc6, 0xc7, 0x27, 0xab, 0x76, 0xff, 0x64, 0xd5, 0xbe, 0x3f,
+	0x59, 0xb5, 0x4b, 0x67, 0xa2, 0x55, 0x46, 0xaf, 0x13, 0x41, 0x72, 0x10, 0xb7, 0x94, 0x5d, 0x3b,
+	0xf2, 0x27, 0x0b, 0xcc, 0x19, 0x54, 0x7f, 0x73, 0xaa, 0x6f, 0xd0, 0x50, 0xf3, 0xbe, 0xfc, 0x33,
+	0x00, 0x2f, 0x05, 0x4c, 0x11, 0xa9, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +196,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LimitBids) > 0 {
+		for iNdEx := len(m.LimitBids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitBids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Auctions) > 0 {
 		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -230,21 +276,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReverseBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReverseBidDuration):])
+	{
+		size := m.DutchResetRatio.Size()
+		i -= size
+		if _, err := m.DutchResetRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DutchDecayDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DutchDecayDuration):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x3a
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ForwardBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ForwardBidDuration):])
+	dAtA[i] = 0x4a
+	{
+		size := m.DutchPriceBuffer.Size()
+		i -= size
+		if _, err := m.DutchPriceBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReverseBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReverseBidDuration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x3a
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ForwardBidDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ForwardBidDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x32
 	{
 		size := m.IncrementCollateral.Size()
@@ -276,12 +350,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAuctionDuration):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAuctionDuration):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -315,6 +389,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitBids) > 0 {
+		for _, e := range m.LimitBids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,6 +422,12 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReverseBidDuration)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DutchPriceBuffer.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DutchDecayDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DutchResetRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -460,6 +552,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, AuctionBid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitBids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitBids = append(m.LimitBids, LimitBid{})
+			if err := m.LimitBids[len(m.LimitBids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -708,6 +868,105 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchPriceBuffer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutchPriceBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchDecayDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DutchDecayDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchResetRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DutchResetRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
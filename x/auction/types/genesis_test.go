@@ -40,6 +40,14 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 	}
 
+	validBid := NewAuctionBid(validAuction.ID, validAuction.Bidder, validAuction.Bid, validAuction.Lot, ForwardAuctionPhase, 1, arbitraryTime)
+	tooManyBids := make([]AuctionBid, MaxAuctionBids+1)
+	for i := range tooManyBids {
+		tooManyBids[i] = validBid
+	}
+
+	validLimitBid := NewLimitBid(validAuction.ID, sdk.AccAddress("test limit bidder"), sdk.MustNewDecFromStr("0.001"))
+
 	testCases := []struct {
 		name       string
 		genesis    *GenesisState
@@ -60,6 +68,8 @@ func TestGenesisState_Validate(t *testing.T) {
 						validAuction,
 					},
 				),
+				nil,
+				nil,
 			},
 			false,
 		},
@@ -74,6 +84,128 @@ func TestGenesisState_Validate(t *testing.T) {
 						validAuction,
 					},
 				),
+				nil,
+				nil,
+			},
+			false,
+		},
+		{
+			"valid bids",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				[]AuctionBid{validBid, validBid},
+				nil,
+			},
+			true,
+		},
+		{
+			"invalid bid for unknown auction",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				[]AuctionBid{NewAuctionBid(validAuction.ID+1, validBid.Bidder, validBid.Bid, validBid.Lot, validBid.Phase, validBid.Height, validBid.Time)},
+				nil,
+			},
+			false,
+		},
+		{
+			"invalid bid with empty bidder",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				[]AuctionBid{NewAuctionBid(validAuction.ID, nil, validBid.Bid, validBid.Lot, validBid.Phase, validBid.Height, validBid.Time)},
+				nil,
+			},
+			false,
+		},
+		{
+			"invalid bid log longer than the maximum",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				tooManyBids,
+				nil,
+			},
+			false,
+		},
+		{
+			"valid limit bid",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				nil,
+				[]LimitBid{validLimitBid},
+			},
+			true,
+		},
+		{
+			"invalid limit bid with zero max price",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				nil,
+				[]LimitBid{NewLimitBid(validAuction.ID, validLimitBid.Bidder, sdk.ZeroDec())},
+			},
+			false,
+		},
+		{
+			"invalid limit bid for unknown auction",
+			&GenesisState{
+				validAuction.ID + 2,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				nil,
+				[]LimitBid{NewLimitBid(validAuction.ID+1, validLimitBid.Bidder, validLimitBid.MaxPrice)},
+			},
+			false,
+		},
+		{
+			"invalid duplicate limit bids",
+			&GenesisState{
+				validAuction.ID + 1,
+				DefaultParams(),
+				mustPackGenesisAuctions(
+					[]GenesisAuction{
+						validAuction,
+					},
+				),
+				nil,
+				[]LimitBid{validLimitBid, validLimitBid},
 			},
 			false,
 		},
@@ -0,0 +1,32 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// MultiAuctionHooks combine multiple auction hooks, all hook functions are run in array sequence
+type MultiAuctionHooks []AuctionHooks
+
+// NewMultiAuctionHooks returns a new MultiAuctionHooks
+func NewMultiAuctionHooks(hooks ...AuctionHooks) MultiAuctionHooks {
+	return hooks
+}
+
+// AfterAuctionStarted runs after an auction is started
+func (h MultiAuctionHooks) AfterAuctionStarted(ctx sdk.Context, auction Auction) {
+	for i := range h {
+		h[i].AfterAuctionStarted(ctx, auction)
+	}
+}
+
+// AfterBidPlaced runs after a bid is placed on an auction
+func (h MultiAuctionHooks) AfterBidPlaced(ctx sdk.Context, auction Auction, bid AuctionBid) {
+	for i := range h {
+		h[i].AfterBidPlaced(ctx, auction, bid)
+	}
+}
+
+// AfterAuctionClosed runs after an auction is paid out and removed from the store
+func (h MultiAuctionHooks) AfterAuctionClosed(ctx sdk.Context, auction Auction, proceeds sdk.Coin) {
+	for i := range h {
+		h[i].AfterAuctionClosed(ctx, auction, proceeds)
+	}
+}
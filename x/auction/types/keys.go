@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -30,6 +31,17 @@ var (
 	AuctionByTimeKeyPrefix = []byte{0x01} // prefix for keys that are part of the auctionsByTime index
 
 	NextAuctionIDKey = []byte{0x02} // key for the next auction id
+
+	AuctionBidKeyPrefix = []byte{0x03} // prefix for keys that store the bid logs of auctions
+
+	AuctionByTypeKeyPrefix      = []byte{0x04} // prefix for keys that are part of the auctionsByType index
+	AuctionByLotDenomKeyPrefix  = []byte{0x05} // prefix for keys that are part of the auctionsByLotDenom index
+	AuctionByBidDenomKeyPrefix  = []byte{0x06} // prefix for keys that are part of the auctionsByBidDenom index
+	AuctionByBidderKeyPrefix    = []byte{0x07} // prefix for keys that are part of the auctionsByBidder index
+	AuctionByInitiatorKeyPrefix = []byte{0x08} // prefix for keys that are part of the auctionsByInitiator index
+	AuctionByPhaseKeyPrefix     = []byte{0x09} // prefix for keys that are part of the auctionsByPhase index
+
+	LimitBidKeyPrefix = []byte{0x0A} // prefix for keys that store the limit bids of auctions
 )
 
 // GetAuctionKey returns the bytes of an auction key
@@ -42,6 +54,36 @@ func GetAuctionByTimeKey(endTime time.Time, auctionID uint64) []byte {
 	return append(sdk.FormatTimeBytes(endTime), Uint64ToBytes(auctionID)...)
 }
 
+// GetAuctionByValueKey returns the key prefix of the auctions stored under a value in one of the secondary indexes
+func GetAuctionByValueKey(value []byte) []byte {
+	return address.MustLengthPrefix(value)
+}
+
+// GetAuctionByValueAuctionKey returns the key of an auction stored under a value in one of the secondary indexes
+func GetAuctionByValueAuctionKey(value []byte, auctionID uint64) []byte {
+	return append(GetAuctionByValueKey(value), Uint64ToBytes(auctionID)...)
+}
+
+// GetAuctionBidsKey returns the key prefix of an auction's bid log
+func GetAuctionBidsKey(auctionID uint64) []byte {
+	return Uint64ToBytes(auctionID)
+}
+
+// GetAuctionBidKey returns the key of a bid in an auction's bid log
+func GetAuctionBidKey(auctionID uint64, sequence uint64) []byte {
+	return append(GetAuctionBidsKey(auctionID), Uint64ToBytes(sequence)...)
+}
+
+// GetLimitBidsKey returns the key prefix of an auction's limit bids
+func GetLimitBidsKey(auctionID uint64) []byte {
+	return Uint64ToBytes(auctionID)
+}
+
+// GetLimitBidKey returns the key of a bidder's limit bid on an auction
+func GetLimitBidKey(auctionID uint64, bidder sdk.AccAddress) []byte {
+	return append(GetLimitBidsKey(auctionID), address.MustLengthPrefix(bidder)...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func Uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -2,13 +2,20 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPlaceBid{}
+var (
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgPlaceBids{}
+)
+
+// MaxBidOrders is the maximum number of bids in a MsgPlaceBids.
+const MaxBidOrders = 100
 
 // NewMsgPlaceBid returns a new MsgPlaceBid.
 func NewMsgPlaceBid(auctionID uint64, bidder string, amt sdk.Coin) MsgPlaceBid {
@@ -54,3 +61,86 @@ func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{bidder}
 }
+
+// NewBidOrder returns a new BidOrder. A zero max price places the bid without a limit.
+func NewBidOrder(auctionID uint64, amt sdk.Coin, maxPrice sdk.Dec) BidOrder {
+	return BidOrder{
+		AuctionId: auctionID,
+		Amount:    amt,
+		MaxPrice:  maxPrice,
+	}
+}
+
+// HasLimit returns whether the order sets a max price to automatically raise the bid to.
+func (o BidOrder) HasLimit() bool {
+	return !o.MaxPrice.IsNil() && o.MaxPrice.IsPositive()
+}
+
+// Validate performs stateless validation of a BidOrder.
+func (o BidOrder) Validate() error {
+	if o.AuctionId == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if !o.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bid amount %s", o.Amount)
+	}
+	if !o.MaxPrice.IsNil() && o.MaxPrice.IsNegative() {
+		return fmt.Errorf("max price cannot be negative: %s", o.MaxPrice)
+	}
+	return nil
+}
+
+// NewMsgPlaceBids returns a new MsgPlaceBids.
+func NewMsgPlaceBids(bidder string, bids []BidOrder, atomic bool) MsgPlaceBids {
+	return MsgPlaceBids{
+		Bidder: bidder,
+		Bids:   bids,
+		Atomic: atomic,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceBids) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceBids) Type() string { return "place_bids" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgPlaceBids) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty or invalid")
+	}
+	if len(msg.Bids) == 0 {
+		return errors.New("bids cannot be empty")
+	}
+	if len(msg.Bids) > MaxBidOrders {
+		return fmt.Errorf("cannot place more than %d bids, got %d", MaxBidOrders, len(msg.Bids))
+	}
+	auctionIDs := make(map[uint64]bool, len(msg.Bids))
+	for _, bid := range msg.Bids {
+		if err := bid.Validate(); err != nil {
+			return err
+		}
+		if auctionIDs[bid.AuctionId] {
+			return fmt.Errorf("found duplicate bid for auction id %d", bid.AuctionId)
+		}
+		auctionIDs[bid.AuctionId] = true
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceBids) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceBids) GetSigners() []sdk.AccAddress {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{bidder}
+}
@@ -49,3 +49,79 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgPlaceBids_ValidateBasic(t *testing.T) {
+	bidder := sdk.AccAddress("test_bidder_address").String()
+	bid := NewBidOrder(1, c("token", 10), sdk.ZeroDec())
+	limitBid := NewBidOrder(2, c("token", 10), sdk.MustNewDecFromStr("1.5"))
+
+	manyBids := make([]BidOrder, MaxBidOrders+1)
+	for i := range manyBids {
+		manyBids[i] = NewBidOrder(uint64(i+1), c("token", 10), sdk.ZeroDec())
+	}
+
+	tests := []struct {
+		name       string
+		msg        MsgPlaceBids
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgPlaceBids(bidder, []BidOrder{bid, limitBid}, true),
+			true,
+		},
+		{
+			"not atomic",
+			NewMsgPlaceBids(bidder, []BidOrder{bid, limitBid}, false),
+			true,
+		},
+		{
+			"unset max price",
+			NewMsgPlaceBids(bidder, []BidOrder{{AuctionId: 1, Amount: c("token", 10)}}, false),
+			true,
+		},
+		{
+			"empty address",
+			NewMsgPlaceBids("", []BidOrder{bid}, true),
+			false,
+		},
+		{
+			"no bids",
+			NewMsgPlaceBids(bidder, nil, true),
+			false,
+		},
+		{
+			"too many bids",
+			NewMsgPlaceBids(bidder, manyBids, true),
+			false,
+		},
+		{
+			"duplicate auction id",
+			NewMsgPlaceBids(bidder, []BidOrder{bid, bid}, true),
+			false,
+		},
+		{
+			"zero id",
+			NewMsgPlaceBids(bidder, []BidOrder{NewBidOrder(0, c("token", 10), sdk.ZeroDec())}, true),
+			false,
+		},
+		{
+			"negative amount",
+			NewMsgPlaceBids(bidder, []BidOrder{NewBidOrder(1, sdk.Coin{Denom: "token", Amount: sdk.NewInt(-10)}, sdk.ZeroDec())}, true),
+			false,
+		},
+		{
+			"negative max price",
+			NewMsgPlaceBids(bidder, []BidOrder{NewBidOrder(1, c("token", 10), sdk.MustNewDecFromStr("-1"))}, true),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
@@ -19,11 +19,17 @@ const (
 	DefaultForwardBidDuration time.Duration = 24 * time.Hour
 	// DefaultReverseBidDuration how long an auction gets extended when someone bids for a reverse auction
 	DefaultReverseBidDuration time.Duration = 1 * time.Hour
+	// DefaultDutchDecayDuration how long the price of a dutch auction takes to decay to zero
+	DefaultDutchDecayDuration time.Duration = 6 * time.Hour
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
+	// DefaultDutchPriceBuffer is the multiple of the oracle price that dutch auctions start at
+	DefaultDutchPriceBuffer sdk.Dec = sdk.MustNewDecFromStr("1.2")
+	// DefaultDutchResetRatio is the fraction of the start price at which dutch auctions reset
+	DefaultDutchResetRatio sdk.Dec = sdk.MustNewDecFromStr("0.5")
 	// ParamStoreKeyParams Param store key for auction params
 	KeyForwardBidDuration  = []byte("ForwardBidDuration")
 	KeyReverseBidDuration  = []byte("ReverseBidDuration")
@@ -31,6 +37,9 @@ var (
 	KeyIncrementSurplus    = []byte("IncrementSurplus")
 	KeyIncrementDebt       = []byte("IncrementDebt")
 	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyDutchPriceBuffer    = []byte("DutchPriceBuffer")
+	KeyDutchDecayDuration  = []byte("DutchDecayDuration")
+	KeyDutchResetRatio     = []byte("DutchResetRatio")
 )
 
 // NewParams returns a new Params object.
@@ -39,6 +48,9 @@ func NewParams(
 	incrementSurplus,
 	incrementDebt,
 	incrementCollateral sdk.Dec,
+	dutchPriceBuffer sdk.Dec,
+	dutchDecayDuration time.Duration,
+	dutchResetRatio sdk.Dec,
 ) Params {
 	return Params{
 		MaxAuctionDuration:  maxAuctionDuration,
@@ -47,6 +59,9 @@ func NewParams(
 		IncrementSurplus:    incrementSurplus,
 		IncrementDebt:       incrementDebt,
 		IncrementCollateral: incrementCollateral,
+		DutchPriceBuffer:    dutchPriceBuffer,
+		DutchDecayDuration:  dutchDecayDuration,
+		DutchResetRatio:     dutchResetRatio,
 	}
 }
 
@@ -59,6 +74,9 @@ func DefaultParams() Params {
 		DefaultIncrement,
 		DefaultIncrement,
 		DefaultIncrement,
+		DefaultDutchPriceBuffer,
+		DefaultDutchDecayDuration,
+		DefaultDutchResetRatio,
 	)
 }
 
@@ -76,6 +94,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIncrementSurplus, &p.IncrementSurplus, validateIncrementSurplusParam),
 		paramtypes.NewParamSetPair(KeyIncrementDebt, &p.IncrementDebt, validateIncrementDebtParam),
 		paramtypes.NewParamSetPair(KeyIncrementCollateral, &p.IncrementCollateral, validateIncrementCollateralParam),
+		paramtypes.NewParamSetPair(KeyDutchPriceBuffer, &p.DutchPriceBuffer, validateDutchPriceBufferParam),
+		paramtypes.NewParamSetPair(KeyDutchDecayDuration, &p.DutchDecayDuration, validateDutchDecayDurationParam),
+		paramtypes.NewParamSetPair(KeyDutchResetRatio, &p.DutchResetRatio, validateDutchResetRatioParam),
 	}
 }
 
@@ -109,7 +130,19 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateIncrementCollateralParam(p.IncrementCollateral)
+	if err := validateIncrementCollateralParam(p.IncrementCollateral); err != nil {
+		return err
+	}
+
+	if err := validateDutchPriceBufferParam(p.DutchPriceBuffer); err != nil {
+		return err
+	}
+
+	if err := validateDutchDecayDurationParam(p.DutchDecayDuration); err != nil {
+		return err
+	}
+
+	return validateDutchResetRatioParam(p.DutchResetRatio)
 }
 
 func validateBidDurationParam(i interface{}) error {
@@ -188,3 +221,50 @@ func validateIncrementCollateralParam(i interface{}) error {
 
 	return nil
 }
+
+func validateDutchPriceBufferParam(i interface{}) error {
+	priceBuffer, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if priceBuffer == emptyDec || priceBuffer.IsNil() {
+		return errors.New("dutch auction price buffer cannot be nil or empty")
+	}
+
+	if !priceBuffer.IsPositive() {
+		return fmt.Errorf("dutch auction price buffer must be positive %s", priceBuffer)
+	}
+
+	return nil
+}
+
+func validateDutchDecayDurationParam(i interface{}) error {
+	decayDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if decayDuration <= 0 {
+		return fmt.Errorf("dutch auction decay duration must be positive %d", decayDuration)
+	}
+
+	return nil
+}
+
+func validateDutchResetRatioParam(i interface{}) error {
+	resetRatio, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if resetRatio == emptyDec || resetRatio.IsNil() {
+		return errors.New("dutch auction reset ratio cannot be nil or empty")
+	}
+
+	if resetRatio.IsNegative() || resetRatio.GTE(sdk.OneDec()) {
+		return fmt.Errorf("dutch auction reset ratio must be at least zero and less than one %s", resetRatio)
+	}
+
+	return nil
+}
@@ -114,6 +114,81 @@ func TestParams_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"dutch auctions",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				ForwardBidDuration:  1 * time.Hour,
+				ReverseBidDuration:  1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchDecayDuration:  6 * time.Hour,
+				DutchResetRatio:     d("0.5"),
+			},
+			false,
+		},
+		{
+			"zero dutch price buffer",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				ForwardBidDuration:  1 * time.Hour,
+				ReverseBidDuration:  1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("0"),
+				DutchDecayDuration:  6 * time.Hour,
+				DutchResetRatio:     d("0.5"),
+			},
+			true,
+		},
+		{
+			"zero dutch decay duration",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				ForwardBidDuration:  1 * time.Hour,
+				ReverseBidDuration:  1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchDecayDuration:  0,
+				DutchResetRatio:     d("0.5"),
+			},
+			true,
+		},
+		{
+			"negative dutch reset ratio",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				ForwardBidDuration:  1 * time.Hour,
+				ReverseBidDuration:  1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchDecayDuration:  6 * time.Hour,
+				DutchResetRatio:     d("-0.5"),
+			},
+			true,
+		},
+		{
+			"dutch reset ratio of one",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				ForwardBidDuration:  1 * time.Hour,
+				ReverseBidDuration:  1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchDecayDuration:  6 * time.Hour,
+				DutchResetRatio:     d("1"),
+			},
+			true,
+		},
 		{
 			"zero value",
 			Params{},
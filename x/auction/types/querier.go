@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -35,17 +37,31 @@ type QueryAllAuctionParams struct {
 	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
 	Denom string         `json:"denom" yaml:"denom"`
 	Phase string         `json:"phase" yaml:"phase"`
+
+	LotDenom     string         `json:"lot_denom" yaml:"lot_denom"`
+	BidDenom     string         `json:"bid_denom" yaml:"bid_denom"`
+	Bidder       sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Initiator    string         `json:"initiator" yaml:"initiator"`
+	EndingBefore time.Time      `json:"ending_before" yaml:"ending_before"`
 }
 
 // NewQueryAllAuctionParams creates a new QueryAllAuctionParams
-func NewQueryAllAuctionParams(page, limit int, aucType, aucDenom, aucPhase string, aucOwner sdk.AccAddress) QueryAllAuctionParams {
+func NewQueryAllAuctionParams(
+	page, limit int, aucType, aucDenom, aucPhase string, aucOwner sdk.AccAddress,
+	lotDenom, bidDenom string, bidder sdk.AccAddress, initiator string, endingBefore time.Time,
+) QueryAllAuctionParams {
 	return QueryAllAuctionParams{
-		Page:  page,
-		Limit: limit,
-		Type:  aucType,
-		Owner: aucOwner,
-		Denom: aucDenom,
-		Phase: aucPhase,
+		Page:         page,
+		Limit:        limit,
+		Type:         aucType,
+		Owner:        aucOwner,
+		Denom:        aucDenom,
+		Phase:        aucPhase,
+		LotDenom:     lotDenom,
+		BidDenom:     bidDenom,
+		Bidder:       bidder,
+		Initiator:    initiator,
+		EndingBefore: endingBefore,
 	}
 }
 
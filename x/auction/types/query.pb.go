@@ -11,19 +11,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,7 +43,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0afd5f8bae92c6bb, []int{0}
+	return fileDescriptor_9a57df8e7bf7471f, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,7 +81,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0afd5f8bae92c6bb, []int{1}
+	return fileDescriptor_9a57df8e7bf7471f, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,7 +126,7 @@ func (m *QueryAuctionRequest) Reset()         { *m = QueryAuctionRequest{} }
 func (m *QueryAuctionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAuctionRequest) ProtoMessage()    {}
 func (*QueryAuctionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0afd5f8bae92c6bb, []int{2}
+	return fileDescriptor_9a57df8e7bf7471f, []int{2}
 }
 func (m *QueryAuctionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -160,7 +164,7 @@ func (m *QueryAuctionResponse) Reset()         { *m = QueryAuctionResponse{} }
 func (m *QueryAuctionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAuctionResponse) ProtoMessage()    {}
 func (*QueryAuctionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0afd5f8bae92c6bb, []int{3}
+	return fileDescriptor_9a57df8e7bf7471f, []int{3}
 }
 func (m *QueryAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,13 +208,20 @@ type QueryAuctionsRequest struct {
 	Phase string `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	LotDenom   string             `protobuf:"bytes,6,opt,name=lot_denom,json=lotDenom,proto3" json:"lot_denom,omitempty"`
+	BidDenom   string             `protobuf:"bytes,7,opt,name=bid_denom,json=bidDenom,proto3" json:"bid_denom,omitempty"`
+	Bidder     string             `protobuf:"bytes,8,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// initiator is the name of the module that started the auction
+	Initiator string `protobuf:"bytes,9,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// ending_before matches auctions that end strictly before the given time
+	EndingBefore time.Time `protobuf:"bytes,10,opt,name=ending_before,json=endingBefore,proto3,stdtime" json:"ending_before"`
 }
 
 func (m *QueryAuctionsRequest) Reset()         { *m = QueryAuctionsRequest{} }
 func (m *QueryAuctionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAuctionsRequest) ProtoMessage()    {}
 func (*QueryAuctionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0afd5f8bae92c6bb, []int{4}
+	return fileDescriptor_9a57df8e7bf7471f, []int{4}
 }
 func (m *QueryAuctionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,7 +261,7 @@ func (m *QueryAuctionsResponse) Reset()         { *m = QueryAuctionsResponse{} }
 func (m *QueryAuctionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAuctionsResponse) ProtoMessage()    {}
 func (*QueryAuctionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0afd5f8bae92c6bb, []int{5}
+	return fileDescriptor_9a57df8e7bf7471f, []int{5}
 }
 func (m *QueryAuctionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,6 +304,100 @@ func (m *QueryAuctionsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAuctionBidsRequest is the request type for the Query/AuctionBids RPC method.
+type QueryAuctionBidsRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionBidsRequest) Reset()         { *m = QueryAuctionBidsRequest{} }
+func (m *QueryAuctionBidsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionBidsRequest) ProtoMessage()    {}
+func (*QueryAuctionBidsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a57df8e7bf7471f, []int{6}
+}
+func (m *QueryAuctionBidsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionBidsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionBidsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionBidsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionBidsRequest.Merge(m, src)
+}
+func (m *QueryAuctionBidsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionBidsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionBidsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionBidsRequest proto.InternalMessageInfo
+
+// QueryAuctionBidsResponse is the response type for the Query/AuctionBids RPC method.
+type QueryAuctionBidsResponse struct {
+	Bids []AuctionBid `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionBidsResponse) Reset()         { *m = QueryAuctionBidsResponse{} }
+func (m *QueryAuctionBidsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionBidsResponse) ProtoMessage()    {}
+func (*QueryAuctionBidsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a57df8e7bf7471f, []int{7}
+}
+func (m *QueryAuctionBidsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionBidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionBidsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionBidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionBidsResponse.Merge(m, src)
+}
+func (m *QueryAuctionBidsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionBidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionBidsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionBidsResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionBidsResponse) GetBids() []AuctionBid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *QueryAuctionBidsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryNextAuctionIDRequest defines the request type for querying x/auction next auction ID.
 type QueryNextAuctionIDRequest struct {
 }
@@ -301,7 +406,7 @@ func (m *QueryNextAuctionIDRequest) Reset()         { *m = QueryNextAuctionIDReq
 func (m *QueryNextAuctionIDRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNextAuctionIDRequest) ProtoMessage()    {}
 func (*QueryNextAuctionIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0afd5f8bae92c6bb, []int{6}
+	return fileDescriptor_9a57df8e7bf7471f, []int{8}
 }
 func (m *QueryNextAuctionIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,7 +444,7 @@ func (m *QueryNextAuctionIDResponse) Reset()         { *m = QueryNextAuctionIDRe
 func (m *QueryNextAuctionIDResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNextAuctionIDResponse) ProtoMessage()    {}
 func (*QueryNextAuctionIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0afd5f8bae92c6bb, []int{7}
+	return fileDescriptor_9a57df8e7bf7471f, []int{9}
 }
 func (m *QueryNextAuctionIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,54 +487,68 @@ func init() {
 	proto.RegisterType((*QueryAuctionResponse)(nil), "aeth.auction.v1beta1.QueryAuctionResponse")
 	proto.RegisterType((*QueryAuctionsRequest)(nil), "aeth.auction.v1beta1.QueryAuctionsRequest")
 	proto.RegisterType((*QueryAuctionsResponse)(nil), "aeth.auction.v1beta1.QueryAuctionsResponse")
+	proto.RegisterType((*QueryAuctionBidsRequest)(nil), "aeth.auction.v1beta1.QueryAuctionBidsRequest")
+	proto.RegisterType((*QueryAuctionBidsResponse)(nil), "aeth.auction.v1beta1.QueryAuctionBidsResponse")
 	proto.RegisterType((*QueryNextAuctionIDRequest)(nil), "aeth.auction.v1beta1.QueryNextAuctionIDRequest")
 	proto.RegisterType((*QueryNextAuctionIDResponse)(nil), "aeth.auction.v1beta1.QueryNextAuctionIDResponse")
 }
 
-func init() { proto.RegisterFile("aeth/auction/v1beta1/query.proto", fileDescriptor_0afd5f8bae92c6bb) }
-
-var fileDescriptor_0afd5f8bae92c6bb = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0xed, 0x34, 0x4d, 0xd3, 0xfb, 0xe9, 0xc7, 0x70, 0x18, 0x29, 0x35, 0xc1, 0x89, 0x2c,
-	0xe8, 0x5f, 0x72, 0xee, 0x9f, 0xad, 0x03, 0x52, 0x0b, 0x2a, 0xea, 0x82, 0xa8, 0x47, 0x16, 0x74,
-	0x4e, 0x0e, 0xd7, 0xa2, 0xf1, 0xb9, 0x39, 0xa7, 0x24, 0x42, 0x2c, 0xb0, 0x20, 0xb1, 0x20, 0x10,
-	0x7b, 0x79, 0x23, 0xcc, 0x1d, 0x2b, 0x31, 0xc0, 0x84, 0x50, 0xc2, 0xc0, 0xcb, 0x40, 0xbe, 0x7b,
-	0x9c, 0xc4, 0x60, 0x42, 0x36, 0xdf, 0x73, 0xdf, 0xe7, 0xb9, 0xcf, 0x3d, 0xcf, 0xf7, 0x8c, 0xea,
-	0x4f, 0xe9, 0x19, 0x75, 0x68, 0xb7, 0x19, 0x07, 0x3c, 0x74, 0xce, 0xb6, 0x3c, 0x16, 0xd3, 0x2d,
-	0xe7, 0xb4, 0xcb, 0x3a, 0x7d, 0x12, 0x75, 0x78, 0xcc, 0xb1, 0x91, 0x28, 0x08, 0x28, 0x08, 0x28,
-	0xcc, 0xf5, 0x26, 0x17, 0x6d, 0x2e, 0x1c, 0x8f, 0x0a, 0xa6, 0xe4, 0xa3, 0xe4, 0x88, 0xfa, 0x41,
-	0x48, 0xa5, 0x5a, 0x56, 0x30, 0x0d, 0x9f, 0xfb, 0x5c, 0x7e, 0x3a, 0xc9, 0x17, 0x44, 0xab, 0x3e,
-	0xe7, 0xfe, 0x09, 0x73, 0x68, 0x14, 0x38, 0x34, 0x0c, 0x79, 0x2c, 0x53, 0x04, 0xec, 0x2e, 0xc1,
-	0xae, 0x5c, 0x79, 0xdd, 0x27, 0x0e, 0x0d, 0x01, 0xc8, 0xb4, 0x73, 0x91, 0x7d, 0x16, 0x32, 0x11,
-	0x40, 0xba, 0x6d, 0x20, 0x7c, 0x94, 0x40, 0x3d, 0xa4, 0x1d, 0xda, 0x16, 0x2e, 0x3b, 0xed, 0x32,
-	0x11, 0xdb, 0x47, 0xe8, 0x6a, 0x26, 0x2a, 0x22, 0x1e, 0x0a, 0x86, 0x77, 0x51, 0x29, 0x92, 0x91,
-	0x8a, 0x5e, 0xd7, 0x57, 0xff, 0xdb, 0xae, 0x92, 0xbc, 0x2b, 0x13, 0x95, 0xb5, 0x5f, 0xbc, 0xf8,
-	0x56, 0xd3, 0x5c, 0xc8, 0xb0, 0xef, 0x40, 0xc9, 0x3d, 0x25, 0x86, 0x93, 0xf0, 0x0d, 0x84, 0x20,
-	0xfd, 0x71, 0xd0, 0x92, 0x65, 0x8b, 0xee, 0x22, 0x44, 0x0e, 0x5b, 0xbb, 0xe5, 0xd7, 0xe7, 0x35,
-	0xed, 0xe7, 0x79, 0x4d, 0xb3, 0x0f, 0x90, 0x91, 0xcd, 0x07, 0x26, 0x82, 0x16, 0x40, 0x0e, 0x50,
-	0x06, 0x51, 0x1d, 0x21, 0x69, 0x47, 0xc8, 0x5e, 0xd8, 0x77, 0x53, 0x91, 0xfd, 0x49, 0xcf, 0x16,
-	0x4a, 0xef, 0x8c, 0x31, 0x2a, 0xc6, 0xfd, 0x88, 0xc9, 0x2a, 0x8b, 0xae, 0xfc, 0xc6, 0x06, 0x9a,
-	0xe7, 0xcf, 0x42, 0xd6, 0xa9, 0x14, 0x64, 0x50, 0x2d, 0x92, 0x68, 0x8b, 0x85, 0xbc, 0x5d, 0x99,
-	0x53, 0x51, 0xb9, 0x48, 0xa2, 0xd1, 0x31, 0x15, 0xac, 0x52, 0x54, 0x51, 0xb9, 0xc0, 0x07, 0x08,
-	0x8d, 0xc7, 0x5c, 0x99, 0x97, 0x84, 0xcb, 0x44, 0x79, 0x82, 0x24, 0x9e, 0x20, 0xca, 0x42, 0xe3,
-	0xde, 0xf9, 0x0c, 0x88, 0xdc, 0x89, 0xcc, 0x89, 0x46, 0xbc, 0xd3, 0xd1, 0xb5, 0xdf, 0x2e, 0x00,
-	0xad, 0xd8, 0x44, 0x65, 0xb8, 0x65, 0x32, 0xa0, 0xb9, 0xbf, 0xf6, 0x62, 0xa4, 0xc2, 0xf7, 0x33,
-	0x74, 0x05, 0x49, 0xb7, 0xf2, 0x4f, 0x3a, 0x75, 0xdc, 0x24, 0x9e, 0x7d, 0x1d, 0x2d, 0x49, 0xa6,
-	0x07, 0xac, 0x17, 0x03, 0xd7, 0xe1, 0xbd, 0xd4, 0x4d, 0xb7, 0x91, 0x99, 0xb7, 0x09, 0xd4, 0x57,
-	0x50, 0x61, 0x34, 0xf9, 0x42, 0xd0, 0xda, 0xfe, 0x52, 0x44, 0xf3, 0x52, 0x8e, 0x5f, 0xe9, 0xa8,
-	0xa4, 0xbc, 0x84, 0x57, 0xf3, 0x9d, 0xf6, 0xa7, 0x75, 0xcd, 0xb5, 0x19, 0x94, 0xea, 0x64, 0xfb,
-	0xe6, 0xcb, 0xcf, 0x3f, 0xde, 0x17, 0x2c, 0x5c, 0x75, 0x72, 0x1f, 0x8a, 0x32, 0x2e, 0xfe, 0xa0,
-	0xa3, 0x05, 0xa0, 0xc6, 0xd3, 0x8a, 0x67, 0x8d, 0x6d, 0xae, 0xcf, 0x22, 0x05, 0x90, 0x1d, 0x09,
-	0xd2, 0xc0, 0x1b, 0xf9, 0x20, 0xe9, 0xb8, 0x9c, 0xe7, 0xe3, 0xa7, 0xf2, 0x02, 0xbf, 0xd1, 0x51,
-	0x39, 0xb5, 0x00, 0x9e, 0xe1, 0xb4, 0x51, 0x87, 0x36, 0x66, 0xd2, 0x02, 0xda, 0xb2, 0x44, 0xab,
-	0x63, 0x6b, 0x3a, 0x1a, 0xfe, 0xa8, 0xa3, 0xff, 0x33, 0xf3, 0xc5, 0xce, 0x94, 0x63, 0xf2, 0x6c,
-	0x62, 0x6e, 0xce, 0x9e, 0x00, 0x70, 0x0d, 0x09, 0xb7, 0x82, 0x6f, 0xe5, 0xc3, 0x85, 0xac, 0x17,
-	0x37, 0x20, 0xd8, 0x08, 0x5a, 0xfb, 0x77, 0x2f, 0x06, 0x96, 0x7e, 0x39, 0xb0, 0xf4, 0xef, 0x03,
-	0x4b, 0x7f, 0x3b, 0xb4, 0xb4, 0xcb, 0xa1, 0xa5, 0x7d, 0x1d, 0x5a, 0xda, 0xa3, 0x35, 0x3f, 0x88,
-	0x8f, 0xbb, 0x1e, 0x69, 0xf2, 0xb6, 0x2c, 0xd5, 0x38, 0xa1, 0x9e, 0x50, 0x45, 0x7b, 0xa3, 0xb2,
-	0xc9, 0x1f, 0x41, 0x78, 0x25, 0xf9, 0x94, 0x76, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x51, 0x53,
-	0x3d, 0x4a, 0x10, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("aeth/auction/v1beta1/query.proto", fileDescriptor_9a57df8e7bf7471f) }
+
+var fileDescriptor_9a57df8e7bf7471f = []byte{
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0x8e, 0x43, 0x08, 0xc9, 0xa3, 0xf4, 0x30, 0x4d, 0x5b, 0x63, 0x52, 0x27, 0xb2, 0x5a, 0xa0,
+	0xd0, 0x78, 0xf8, 0x71, 0xa9, 0x38, 0x54, 0x22, 0x42, 0x54, 0xb9, 0x54, 0x25, 0xea, 0xa9, 0x17,
+	0x64, 0xe3, 0xc1, 0x8c, 0x20, 0x1e, 0x63, 0x4f, 0x0a, 0xa8, 0xaa, 0x54, 0xb5, 0x97, 0xaa, 0xbd,
+	0xa0, 0xae, 0xf6, 0xbc, 0xec, 0x65, 0xff, 0x16, 0x8e, 0x48, 0x7b, 0xd9, 0xd3, 0xee, 0x0a, 0xf6,
+	0xb0, 0xff, 0xc2, 0xde, 0x56, 0x9e, 0x19, 0xe7, 0x07, 0x78, 0x43, 0x76, 0xb5, 0x37, 0xcf, 0x7b,
+	0xdf, 0x7b, 0xef, 0x9b, 0xef, 0xbd, 0x79, 0x86, 0xba, 0x43, 0xf8, 0x01, 0x76, 0xba, 0x7b, 0x9c,
+	0xb2, 0x00, 0xff, 0xb6, 0xea, 0x12, 0xee, 0xac, 0xe2, 0xe3, 0x2e, 0x89, 0xce, 0xec, 0x30, 0x62,
+	0x9c, 0xa1, 0x4a, 0x82, 0xb0, 0x15, 0xc2, 0x56, 0x08, 0x63, 0x69, 0x8f, 0xc5, 0x1d, 0x16, 0x63,
+	0xd7, 0x89, 0x89, 0x84, 0xf7, 0x82, 0x43, 0xc7, 0xa7, 0x81, 0x23, 0xd0, 0x22, 0x83, 0x51, 0xf1,
+	0x99, 0xcf, 0xc4, 0x27, 0x4e, 0xbe, 0x94, 0xb5, 0xea, 0x33, 0xe6, 0x1f, 0x11, 0xec, 0x84, 0x14,
+	0x3b, 0x41, 0xc0, 0xb8, 0x08, 0x89, 0x95, 0x77, 0x56, 0x79, 0xc5, 0xc9, 0xed, 0xee, 0x63, 0x27,
+	0x50, 0x84, 0x8c, 0xda, 0x6d, 0x17, 0xa7, 0x1d, 0x12, 0x73, 0xa7, 0x13, 0x2a, 0x80, 0x95, 0x79,
+	0xa7, 0xf4, 0x06, 0xa3, 0x30, 0x3e, 0x09, 0x48, 0x4c, 0x15, 0x07, 0xab, 0x02, 0x68, 0x27, 0xb9,
+	0xd9, 0xcf, 0x4e, 0xe4, 0x74, 0xe2, 0x36, 0x39, 0xee, 0x92, 0x98, 0x5b, 0x3b, 0xf0, 0xd9, 0x90,
+	0x35, 0x0e, 0x59, 0x10, 0x13, 0xb4, 0x01, 0xc5, 0x50, 0x58, 0x74, 0xad, 0xae, 0x2d, 0x4e, 0xaf,
+	0x55, 0xed, 0x2c, 0xdd, 0x6c, 0x19, 0xd5, 0x2c, 0x5c, 0x3e, 0xaf, 0xe5, 0xda, 0x2a, 0xc2, 0xfa,
+	0x41, 0xa5, 0xdc, 0x94, 0x60, 0x55, 0x09, 0x7d, 0x05, 0xa0, 0xc2, 0x77, 0xa9, 0x27, 0xd2, 0x16,
+	0xda, 0x65, 0x65, 0x69, 0x79, 0x1b, 0xa5, 0x7f, 0x2e, 0x6a, 0xb9, 0xd7, 0x17, 0xb5, 0x9c, 0xb5,
+	0x0d, 0x95, 0xe1, 0x78, 0xc5, 0xc9, 0x86, 0x29, 0x05, 0x57, 0xa4, 0x2a, 0xb6, 0xd4, 0xce, 0x4e,
+	0xb5, 0xb3, 0x37, 0x83, 0xb3, 0x76, 0x0a, 0xb2, 0xde, 0xe4, 0x87, 0x13, 0xa5, 0x77, 0x46, 0x08,
+	0x0a, 0xfc, 0x2c, 0x24, 0x22, 0x4b, 0xb9, 0x2d, 0xbe, 0x51, 0x05, 0x26, 0xd9, 0x49, 0x40, 0x22,
+	0x3d, 0x2f, 0x8c, 0xf2, 0x90, 0x58, 0x3d, 0x12, 0xb0, 0x8e, 0x3e, 0x21, 0xad, 0xe2, 0x90, 0x58,
+	0xc3, 0x03, 0x27, 0x26, 0x7a, 0x41, 0x5a, 0xc5, 0x01, 0x6d, 0x03, 0xf4, 0x67, 0x45, 0x9f, 0x14,
+	0x0c, 0xe7, 0x6d, 0x39, 0x58, 0x76, 0x32, 0x58, 0xb6, 0x9c, 0xc3, 0xbe, 0x76, 0x3e, 0x51, 0x8c,
+	0xda, 0x03, 0x91, 0x68, 0x0e, 0xca, 0x47, 0x8c, 0xef, 0xca, 0xba, 0x45, 0x51, 0xa1, 0x74, 0xc4,
+	0xf8, 0x96, 0x28, 0x3d, 0x07, 0x65, 0x97, 0x7a, 0xca, 0x39, 0x25, 0x9d, 0x2e, 0xf5, 0xa4, 0xf3,
+	0x0b, 0x28, 0xba, 0xd4, 0xf3, 0x48, 0xa4, 0x97, 0x84, 0x47, 0x9d, 0x50, 0x15, 0xca, 0x34, 0xa0,
+	0x9c, 0x3a, 0x9c, 0x45, 0x7a, 0x59, 0xb8, 0xfa, 0x06, 0xd4, 0x82, 0x19, 0x12, 0x78, 0x34, 0xf0,
+	0x77, 0x5d, 0xb2, 0xcf, 0x22, 0xa2, 0x83, 0xa0, 0x6e, 0xdc, 0x11, 0xf7, 0x97, 0x74, 0x30, 0x9b,
+	0xa5, 0xa4, 0xdf, 0xe7, 0x2f, 0x6a, 0x5a, 0xfb, 0x13, 0x19, 0xda, 0x14, 0x91, 0x03, 0x3d, 0xfc,
+	0x5f, 0x83, 0xcf, 0x6f, 0x69, 0xaf, 0xba, 0xb8, 0x02, 0x25, 0xd5, 0xa0, 0x64, 0xb6, 0x26, 0xde,
+	0xd9, 0xc6, 0x1e, 0x0a, 0xfd, 0x38, 0x24, 0x6c, 0x5e, 0xb0, 0x5b, 0xb8, 0x57, 0x58, 0x59, 0x6e,
+	0x50, 0x59, 0xeb, 0x5f, 0x0d, 0xbe, 0x1c, 0x24, 0xd5, 0xa4, 0x5e, 0x3c, 0xde, 0x74, 0xa2, 0xed,
+	0x0c, 0x0e, 0x1f, 0xd0, 0xdc, 0x01, 0x85, 0x1e, 0x69, 0xa0, 0xdf, 0x25, 0xd3, 0x7b, 0x7e, 0x05,
+	0x97, 0x7a, 0xa9, 0x40, 0xf5, 0xec, 0xc7, 0xd7, 0x0f, 0x54, 0x0f, 0x50, 0xc4, 0x7c, 0x3c, 0xb9,
+	0xe6, 0x60, 0x56, 0x10, 0xfc, 0x89, 0x9c, 0x72, 0x55, 0xab, 0xb5, 0x95, 0xee, 0x8d, 0xef, 0xc0,
+	0xc8, 0x72, 0x2a, 0xfe, 0x9f, 0x42, 0xbe, 0xa7, 0x62, 0x9e, 0x7a, 0x6b, 0x7f, 0x16, 0x61, 0x52,
+	0xc0, 0xd1, 0xdf, 0x1a, 0x14, 0xe5, 0xd6, 0x40, 0x8b, 0xd9, 0xd7, 0xba, 0xbb, 0xa4, 0x8c, 0x6f,
+	0xc7, 0x40, 0xca, 0xca, 0xd6, 0xd7, 0x7f, 0x3d, 0x7d, 0xf5, 0x20, 0x6f, 0xa2, 0x2a, 0xce, 0x5c,
+	0x89, 0x72, 0x45, 0xa1, 0x87, 0x1a, 0x4c, 0x29, 0xd6, 0x68, 0x54, 0xf2, 0xe1, 0x15, 0x66, 0x2c,
+	0x8d, 0x03, 0x55, 0x44, 0xd6, 0x05, 0x91, 0x06, 0x5a, 0xc6, 0xa3, 0xf6, 0x77, 0x8c, 0x7f, 0xef,
+	0x8f, 0xdd, 0x1f, 0xe8, 0x3f, 0x0d, 0x4a, 0xe9, 0x8b, 0x41, 0x63, 0x54, 0xeb, 0x29, 0xb4, 0x3c,
+	0x16, 0x56, 0x51, 0x9b, 0x17, 0xd4, 0xea, 0xc8, 0x1c, 0x4d, 0x0d, 0x3d, 0xd1, 0x60, 0x7a, 0x60,
+	0x3a, 0x51, 0xe3, 0xfe, 0x22, 0x03, 0x4f, 0xca, 0xb0, 0xc7, 0x85, 0x2b, 0x5a, 0xdf, 0x0b, 0x5a,
+	0x6b, 0x68, 0xe5, 0x3d, 0x14, 0xc3, 0x62, 0xe4, 0x1f, 0x6b, 0x30, 0x33, 0x34, 0x88, 0x08, 0x8f,
+	0xa8, 0x9d, 0x35, 0xcf, 0xc6, 0xca, 0xf8, 0x01, 0x8a, 0x6e, 0x43, 0xd0, 0x5d, 0x40, 0xdf, 0x64,
+	0xd3, 0x0d, 0xc8, 0x29, 0x6f, 0x28, 0x63, 0x83, 0x7a, 0xcd, 0xd6, 0xe5, 0xb5, 0xa9, 0x5d, 0x5d,
+	0x9b, 0xda, 0xcb, 0x6b, 0x53, 0x3b, 0xbf, 0x31, 0x73, 0x57, 0x37, 0x66, 0xee, 0xd9, 0x8d, 0x99,
+	0xfb, 0x15, 0xfb, 0x94, 0x1f, 0x74, 0x5d, 0x7b, 0x8f, 0x75, 0x70, 0x87, 0x1d, 0x52, 0xee, 0x04,
+	0x84, 0x9f, 0xb0, 0xe8, 0x50, 0x24, 0x26, 0x11, 0x3e, 0xed, 0x25, 0x4f, 0x7e, 0x55, 0xb1, 0x5b,
+	0x14, 0x8b, 0x72, 0xfd, 0xed, 0x00, 0xb1, 0x6a, 0x72, 0xfb, 0xee, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -448,8 +567,11 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Auction queries an individual Auction by auction ID
 	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
-	// Auctions queries auctions filtered by asset denom, owner address, phase, and auction type
+	// Auctions queries auctions filtered by asset denom, owner address, phase, auction type, bidder, initiator, and end
+	// time
 	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
+	// AuctionBids queries the bid log of an auction, oldest bid first
+	AuctionBids(ctx context.Context, in *QueryAuctionBidsRequest, opts ...grpc.CallOption) (*QueryAuctionBidsResponse, error)
 	// NextAuctionID queries the next auction ID
 	NextAuctionID(ctx context.Context, in *QueryNextAuctionIDRequest, opts ...grpc.CallOption) (*QueryNextAuctionIDResponse, error)
 }
@@ -489,6 +611,15 @@ func (c *queryClient) Auctions(ctx context.Context, in *QueryAuctionsRequest, op
 	return out, nil
 }
 
+func (c *queryClient) AuctionBids(ctx context.Context, in *QueryAuctionBidsRequest, opts ...grpc.CallOption) (*QueryAuctionBidsResponse, error) {
+	out := new(QueryAuctionBidsResponse)
+	err := c.cc.Invoke(ctx, "/aeth.auction.v1beta1.Query/AuctionBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) NextAuctionID(ctx context.Context, in *QueryNextAuctionIDRequest, opts ...grpc.CallOption) (*QueryNextAuctionIDResponse, error) {
 	out := new(QueryNextAuctionIDResponse)
 	err := c.cc.Invoke(ctx, "/aeth.auction.v1beta1.Query/NextAuctionID", in, out, opts...)
@@ -504,8 +635,11 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Auction queries an individual Auction by auction ID
 	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
-	// Auctions queries auctions filtered by asset denom, owner address, phase, and auction type
+	// Auctions queries auctions filtered by asset denom, owner address, phase, auction type, bidder, initiator, and end
+	// time
 	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
+	// AuctionBids queries the bid log of an auction, oldest bid first
+	AuctionBids(context.Context, *QueryAuctionBidsRequest) (*QueryAuctionBidsResponse, error)
 	// NextAuctionID queries the next auction ID
 	NextAuctionID(context.Context, *QueryNextAuctionIDRequest) (*QueryNextAuctionIDResponse, error)
 }
@@ -523,6 +657,9 @@ func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryAuctionR
 func (*UnimplementedQueryServer) Auctions(ctx context.Context, req *QueryAuctionsRequest) (*QueryAuctionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Auctions not implemented")
 }
+func (*UnimplementedQueryServer) AuctionBids(ctx context.Context, req *QueryAuctionBidsRequest) (*QueryAuctionBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionBids not implemented")
+}
 func (*UnimplementedQueryServer) NextAuctionID(ctx context.Context, req *QueryNextAuctionIDRequest) (*QueryNextAuctionIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextAuctionID not implemented")
 }
@@ -585,6 +722,24 @@ func _Query_Auctions_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AuctionBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.auction.v1beta1.Query/AuctionBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionBids(ctx, req.(*QueryAuctionBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_NextAuctionID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNextAuctionIDRequest)
 	if err := dec(in); err != nil {
@@ -619,6 +774,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Auctions",
 			Handler:    _Query_Auctions_Handler,
 		},
+		{
+			MethodName: "AuctionBids",
+			Handler:    _Query_AuctionBids_Handler,
+		},
 		{
 			MethodName: "NextAuctionID",
 			Handler:    _Query_NextAuctionID_Handler,
@@ -767,6 +926,42 @@ func (m *QueryAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndingBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndingBefore):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Initiator) > 0 {
+		i -= len(m.Initiator)
+		copy(dAtA[i:], m.Initiator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Initiator)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BidDenom) > 0 {
+		i -= len(m.BidDenom)
+		copy(dAtA[i:], m.BidDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BidDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LotDenom) > 0 {
+		i -= len(m.LotDenom)
+		copy(dAtA[i:], m.LotDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LotDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -859,6 +1054,95 @@ func (m *QueryAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAuctionBidsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionBidsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionBidsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionBidsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryNextAuctionIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -992,6 +1276,24 @@ func (m *QueryAuctionsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.LotDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BidDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Initiator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndingBefore)
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1014,6 +1316,41 @@ func (m *QueryAuctionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAuctionBidsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionBidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryNextAuctionIDRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1522,31 +1859,192 @@ func (m *QueryAuctionsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+			m.LotDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Initiator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndingBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1663,6 +2161,231 @@ func (m *QueryAuctionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAuctionBidsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionBidsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuctionBidsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionBidsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, AuctionBid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryNextAuctionIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
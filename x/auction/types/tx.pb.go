@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -40,7 +42,7 @@ func (m *MsgPlaceBid) Reset()         { *m = MsgPlaceBid{} }
 func (m *MsgPlaceBid) String() string { return proto.CompactTextString(m) }
 func (*MsgPlaceBid) ProtoMessage()    {}
 func (*MsgPlaceBid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_226282be4da73be5, []int{0}
+	return fileDescriptor_1e3b61cd4f30bf64, []int{0}
 }
 func (m *MsgPlaceBid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,7 +79,7 @@ func (m *MsgPlaceBidResponse) Reset()         { *m = MsgPlaceBidResponse{} }
 func (m *MsgPlaceBidResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPlaceBidResponse) ProtoMessage()    {}
 func (*MsgPlaceBidResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_226282be4da73be5, []int{1}
+	return fileDescriptor_1e3b61cd4f30bf64, []int{1}
 }
 func (m *MsgPlaceBidResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -106,35 +108,179 @@ func (m *MsgPlaceBidResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPlaceBidResponse proto.InternalMessageInfo
 
+// MsgPlaceBids represents a message used by bidders to place bids on several auctions at once
+type MsgPlaceBids struct {
+	Bidder string     `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Bids   []BidOrder `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+	// atomic fails the whole message if any bid fails, otherwise failed bids are skipped
+	Atomic bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+}
+
+func (m *MsgPlaceBids) Reset()         { *m = MsgPlaceBids{} }
+func (m *MsgPlaceBids) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBids) ProtoMessage()    {}
+func (*MsgPlaceBids) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3b61cd4f30bf64, []int{2}
+}
+func (m *MsgPlaceBids) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBids) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBids.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceBids) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBids.Merge(m, src)
+}
+func (m *MsgPlaceBids) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBids) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBids.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceBids proto.InternalMessageInfo
+
+// BidOrder defines a single bid of a MsgPlaceBids
+type BidOrder struct {
+	AuctionId uint64     `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// max_price is the highest price, in bid units per lot unit, the bid is automatically raised to when outbid.
+	// A zero max price places the bid without a limit.
+	MaxPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price"`
+}
+
+func (m *BidOrder) Reset()         { *m = BidOrder{} }
+func (m *BidOrder) String() string { return proto.CompactTextString(m) }
+func (*BidOrder) ProtoMessage()    {}
+func (*BidOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3b61cd4f30bf64, []int{3}
+}
+func (m *BidOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BidOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidOrder.Merge(m, src)
+}
+func (m *BidOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BidOrder proto.InternalMessageInfo
+
+// MsgPlaceBidsResponse defines the Msg/PlaceBids response type.
+type MsgPlaceBidsResponse struct {
+	// failed_auction_ids are the auctions of the bids that failed when the bids are not atomic
+	FailedAuctionIds []uint64 `protobuf:"varint,1,rep,packed,name=failed_auction_ids,json=failedAuctionIds,proto3" json:"failed_auction_ids,omitempty"`
+}
+
+func (m *MsgPlaceBidsResponse) Reset()         { *m = MsgPlaceBidsResponse{} }
+func (m *MsgPlaceBidsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceBidsResponse) ProtoMessage()    {}
+func (*MsgPlaceBidsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e3b61cd4f30bf64, []int{4}
+}
+func (m *MsgPlaceBidsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceBidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceBidsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceBidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceBidsResponse.Merge(m, src)
+}
+func (m *MsgPlaceBidsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceBidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceBidsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceBidsResponse proto.InternalMessageInfo
+
+func (m *MsgPlaceBidsResponse) GetFailedAuctionIds() []uint64 {
+	if m != nil {
+		return m.FailedAuctionIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgPlaceBid)(nil), "aeth.auction.v1beta1.MsgPlaceBid")
 	proto.RegisterType((*MsgPlaceBidResponse)(nil), "aeth.auction.v1beta1.MsgPlaceBidResponse")
+	proto.RegisterType((*MsgPlaceBids)(nil), "aeth.auction.v1beta1.MsgPlaceBids")
+	proto.RegisterType((*BidOrder)(nil), "aeth.auction.v1beta1.BidOrder")
+	proto.RegisterType((*MsgPlaceBidsResponse)(nil), "aeth.auction.v1beta1.MsgPlaceBidsResponse")
 }
 
-func init() { proto.RegisterFile("aeth/auction/v1beta1/tx.proto", fileDescriptor_226282be4da73be5) }
-
-var fileDescriptor_226282be4da73be5 = []byte{
-	// 311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x4f, 0x2c, 0x4d, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x01, 0x49, 0xeb, 0x41, 0xa5,
-	0xf5, 0xa0, 0xd2, 0x52, 0x72, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x49, 0x89, 0xc5, 0xa9,
-	0x70, 0x3d, 0xc9, 0xf9, 0x99, 0x79, 0x10, 0x5d, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6,
-	0x3e, 0x88, 0x05, 0x11, 0x55, 0x6a, 0x67, 0xe4, 0xe2, 0xf6, 0x2d, 0x4e, 0x0f, 0xc8, 0x49, 0x4c,
-	0x4e, 0x75, 0xca, 0x4c, 0x11, 0x92, 0xe5, 0xe2, 0x82, 0x1a, 0x1c, 0x9f, 0x99, 0x22, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x12, 0xc4, 0x09, 0x15, 0xf1, 0x4c, 0x11, 0x12, 0xe3, 0x62, 0x4b, 0xca, 0x4c,
-	0x49, 0x49, 0x2d, 0x92, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0xf2, 0x84, 0xcc, 0xb9, 0xd8,
-	0x12, 0x73, 0xf3, 0x4b, 0xf3, 0x4a, 0x24, 0x98, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x24, 0xf5, 0x20,
-	0xae, 0xd1, 0x03, 0xb9, 0x06, 0xe6, 0x44, 0x3d, 0xe7, 0xfc, 0xcc, 0x3c, 0x27, 0x96, 0x13, 0xf7,
-	0xe4, 0x19, 0x82, 0xa0, 0xca, 0xad, 0x38, 0x3a, 0x16, 0xc8, 0x33, 0xbc, 0x58, 0x20, 0xcf, 0xa0,
-	0x24, 0xca, 0x25, 0x8c, 0xe4, 0x90, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa3, 0x78,
-	0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x08, 0x2e, 0x0e, 0xb8, 0x1b, 0x15, 0xf5, 0xb0, 0x05, 0x80,
-	0x1e, 0x92, 0x6e, 0x29, 0x4d, 0x82, 0x4a, 0x60, 0x16, 0x38, 0x39, 0x9f, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x3e, 0xc8, 0x38, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x30, 0x4b, 0xbf, 0x02, 0x1e, 0x3b, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xd0, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xbd,
-	0x2b, 0xa7, 0xac, 0xba, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("aeth/auction/v1beta1/tx.proto", fileDescriptor_1e3b61cd4f30bf64) }
+
+var fileDescriptor_1e3b61cd4f30bf64 = []byte{
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0x7d, 0x89, 0x15, 0xd9, 0x17, 0x06, 0x64, 0x02, 0x72, 0x23, 0xd5, 0x31, 0x19, 0x90,
+	0x41, 0xd4, 0x56, 0xc3, 0x00, 0x42, 0x2c, 0x98, 0x2c, 0x1d, 0x2a, 0x2a, 0x4f, 0xbc, 0x0c, 0xd1,
+	0xf9, 0xee, 0x70, 0x4f, 0xa9, 0x7d, 0x91, 0xef, 0x02, 0x61, 0x65, 0x81, 0x91, 0x8f, 0xd0, 0x0f,
+	0xc1, 0xc8, 0x4e, 0xc7, 0x8a, 0x09, 0x31, 0x54, 0x28, 0x59, 0xf8, 0x18, 0xc8, 0xf6, 0x39, 0x31,
+	0x52, 0xa4, 0xa8, 0x53, 0xf2, 0x3c, 0xff, 0xe7, 0xe5, 0x77, 0xff, 0xf3, 0xc1, 0x7d, 0x44, 0xe5,
+	0x69, 0x80, 0xe6, 0x58, 0x32, 0x9e, 0x05, 0xef, 0x0f, 0x63, 0x2a, 0xd1, 0x61, 0x20, 0x17, 0xfe,
+	0x2c, 0xe7, 0x92, 0x5b, 0xbd, 0x42, 0xf6, 0x95, 0xec, 0x2b, 0xb9, 0xef, 0x60, 0x2e, 0x52, 0x2e,
+	0x82, 0x18, 0x09, 0xba, 0xee, 0xc1, 0x9c, 0x65, 0x55, 0x57, 0x7f, 0xaf, 0xd2, 0x27, 0x65, 0x14,
+	0x54, 0x81, 0x92, 0x7a, 0x09, 0x4f, 0x78, 0x95, 0x2f, 0xfe, 0x55, 0xd9, 0xe1, 0x67, 0x00, 0xbb,
+	0xc7, 0x22, 0x39, 0x39, 0x43, 0x98, 0x86, 0x8c, 0x58, 0xfb, 0x10, 0xaa, 0x9d, 0x13, 0x46, 0x6c,
+	0xe0, 0x02, 0x4f, 0x8f, 0x4c, 0x95, 0x39, 0x22, 0xd6, 0x1d, 0xd8, 0x89, 0x19, 0x21, 0x34, 0xb7,
+	0x5b, 0x2e, 0xf0, 0xcc, 0x48, 0x45, 0xd6, 0x63, 0xd8, 0x41, 0x29, 0x9f, 0x67, 0xd2, 0x6e, 0xbb,
+	0xc0, 0xeb, 0x8e, 0xf6, 0x7c, 0xb5, 0xbb, 0x00, 0xad, 0xe9, 0xfd, 0x17, 0x9c, 0x65, 0xa1, 0x7e,
+	0x71, 0x35, 0xd0, 0x22, 0x55, 0xfe, 0xd4, 0xf8, 0x72, 0x3e, 0xd0, 0xfe, 0x9e, 0x0f, 0xb4, 0xe1,
+	0x6d, 0x78, 0xab, 0x01, 0x12, 0x51, 0x31, 0xe3, 0x99, 0xa0, 0xc3, 0x4f, 0x00, 0xde, 0x68, 0xe4,
+	0x45, 0x03, 0x01, 0xfc, 0x87, 0xf0, 0x04, 0xea, 0x31, 0x23, 0xc2, 0x6e, 0xb9, 0x6d, 0xaf, 0x3b,
+	0x72, 0xfc, 0x6d, 0xfe, 0xf9, 0x21, 0x23, 0x2f, 0x73, 0x42, 0x73, 0x45, 0xa1, 0xc7, 0x6a, 0x22,
+	0x92, 0x3c, 0x65, 0xb8, 0x84, 0x37, 0x22, 0x15, 0x35, 0xd8, 0x7e, 0x00, 0x68, 0xd4, 0xad, 0xbb,
+	0x2c, 0xda, 0x58, 0xd1, 0xba, 0x96, 0x15, 0xd6, 0x6b, 0x68, 0xa6, 0x68, 0x31, 0x99, 0xe5, 0x0c,
+	0xd3, 0x92, 0xc4, 0x0c, 0x9f, 0x15, 0x05, 0xbf, 0xaf, 0x06, 0xf7, 0x12, 0x26, 0x4f, 0xe7, 0xb1,
+	0x8f, 0x79, 0xaa, 0x2e, 0x55, 0xfd, 0x1c, 0x08, 0x32, 0x0d, 0xe4, 0xc7, 0x19, 0x15, 0xfe, 0x98,
+	0xe2, 0x9f, 0xdf, 0x0e, 0xa0, 0x5a, 0x36, 0xa6, 0x38, 0x32, 0x52, 0xb4, 0x38, 0x29, 0xa6, 0x35,
+	0x4e, 0x32, 0x86, 0xbd, 0xa6, 0x9b, 0xb5, 0xcd, 0xd6, 0x43, 0x68, 0xbd, 0x43, 0xec, 0x8c, 0x92,
+	0xc9, 0xe6, 0x6c, 0xc2, 0x06, 0x6e, 0xdb, 0xd3, 0xa3, 0x9b, 0x95, 0xf2, 0xbc, 0x3e, 0xa2, 0x18,
+	0x7d, 0x07, 0xb0, 0x7d, 0x2c, 0x12, 0xeb, 0x15, 0x34, 0xd6, 0x5f, 0xce, 0xdd, 0xed, 0x8e, 0x37,
+	0xb6, 0xf5, 0xef, 0xef, 0x2c, 0x59, 0xf3, 0xbc, 0x85, 0xe6, 0xe6, 0xca, 0x87, 0x3b, 0xfb, 0x44,
+	0xff, 0xc1, 0xee, 0x9a, 0x7a, 0x78, 0x78, 0x74, 0xb1, 0x74, 0xc0, 0xe5, 0xd2, 0x01, 0x7f, 0x96,
+	0x0e, 0xf8, 0xba, 0x72, 0xb4, 0xcb, 0x95, 0xa3, 0xfd, 0x5a, 0x39, 0xda, 0x9b, 0xa0, 0x61, 0x74,
+	0xca, 0xa7, 0x4c, 0xa2, 0x8c, 0xca, 0x0f, 0x3c, 0x9f, 0x06, 0xc5, 0x74, 0x9a, 0x07, 0x8b, 0xf5,
+	0x8b, 0x2d, 0x5d, 0x8f, 0x3b, 0xe5, 0x33, 0x7a, 0xf4, 0x6f, 0x00, 0x46, 0x0a, 0x85, 0x52, 0xce,
+	0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -151,6 +297,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// PlaceBid message type used by bidders to place bids on auctions
 	PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.CallOption) (*MsgPlaceBidResponse, error)
+	// PlaceBids message type used by bidders to place bids on several auctions at once
+	PlaceBids(ctx context.Context, in *MsgPlaceBids, opts ...grpc.CallOption) (*MsgPlaceBidsResponse, error)
 }
 
 type msgClient struct {
@@ -170,10 +318,21 @@ func (c *msgClient) PlaceBid(ctx context.Context, in *MsgPlaceBid, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) PlaceBids(ctx context.Context, in *MsgPlaceBids, opts ...grpc.CallOption) (*MsgPlaceBidsResponse, error) {
+	out := new(MsgPlaceBidsResponse)
+	err := c.cc.Invoke(ctx, "/aeth.auction.v1beta1.Msg/PlaceBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// PlaceBid message type used by bidders to place bids on auctions
 	PlaceBid(context.Context, *MsgPlaceBid) (*MsgPlaceBidResponse, error)
+	// PlaceBids message type used by bidders to place bids on several auctions at once
+	PlaceBids(context.Context, *MsgPlaceBids) (*MsgPlaceBidsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -183,6 +342,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) PlaceBid(ctx context.Context, req *MsgPlaceBid) (*MsgPlaceBidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlaceBid not implemented")
 }
+func (*UnimplementedMsgServer) PlaceBids(ctx context.Context, req *MsgPlaceBids) (*MsgPlaceBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBids not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -206,6 +368,24 @@ func _Msg_PlaceBid_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PlaceBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceBids)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.auction.v1beta1.Msg/PlaceBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceBids(ctx, req.(*MsgPlaceBids))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "aeth.auction.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -214,6 +394,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PlaceBid",
 			Handler:    _Msg_PlaceBid_Handler,
 		},
+		{
+			MethodName: "PlaceBids",
+			Handler:    _Msg_PlaceBids_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aeth/auction/v1beta1/tx.proto",
@@ -287,6 +471,149 @@ func (m *MsgPlaceBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPlaceBids) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBids) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBids) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Atomic {
+		i--
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BidOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BidOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceBidsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceBidsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedAuctionIds) > 0 {
+		dAtA4 := make([]byte, len(m.FailedAuctionIds)*10)
+		var j3 int
+		for _, num := range m.FailedAuctionIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -325,6 +652,60 @@ func (m *MsgPlaceBidResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPlaceBids) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Atomic {
+		n += 2
+	}
+	return n
+}
+
+func (m *BidOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceBidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedAuctionIds) > 0 {
+		l = 0
+		for _, e := range m.FailedAuctionIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -515,6 +896,404 @@ func (m *MsgPlaceBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPlaceBids) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBids: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBids: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, BidOrder{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BidOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BidOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BidOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceBidsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceBidsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailedAuctionIds = append(m.FailedAuctionIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailedAuctionIds) == 0 {
+					m.FailedAuctionIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedAuctionIds = append(m.FailedAuctionIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAuctionIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,6 +13,11 @@ import (
 
 // BeginBlocker compounds the debt in outstanding cdps and liquidates cdps that are below the required collateralization ratio
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
+	// global settlement freezes the cdp system: no interest accrues and no cdps are liquidated
+	if _, found := k.GetGlobalSettlement(ctx); found {
+		return
+	}
+
 	params := k.GetParams(ctx)
 
 	for _, cp := range params.CollateralParams {
@@ -40,6 +45,11 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) {
 			panic(err)
 		}
+
+		err = k.LiquidateMultiCollateralCdps(ctx, cp.Type, cp.LiquidationRatio)
+		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) {
+			panic(err)
+		}
 	}
 
 	err := k.RunSurplusAndDebtAuctions(ctx)
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+// GetCmdSubmitGlobalSettlementProposal implements the command to submit a global settlement proposal
+func GetCmdSubmitGlobalSettlementProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "global-settlement [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to shut down the cdp system with a global settlement",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to shut down the cdp system with a global settlement along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal global-settlement <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Global Settlement",
+  "description": "Shut down the cdp system and let USDX holders redeem collateral",
+  "deposit": [
+    {
+      "denom": "uaeth",
+      "amount": "1000000000"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseGlobalSettlementProposalJSON(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewGlobalSettlementProposal(proposal.Title, proposal.Description)
+			msg, err := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// ParseGlobalSettlementProposalJSON reads and parses a GlobalSettlementProposalJSON from a file.
+func ParseGlobalSettlementProposalJSON(cdc codec.JSONCodec, proposalFile string) (types.GlobalSettlementProposalJSON, error) {
+	proposal := types.GlobalSettlementProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -38,6 +38,11 @@ func GetQueryCmd() *cobra.Command {
 		QueryCdpDepositsCmd(),
 		QueryParamsCmd(),
 		QueryGetAccounts(),
+		QueryStabilityFeeCmd(),
+		QueryGlobalSettlementCmd(),
+		QueryPsmReservesCmd(),
+		QueryCdpRiskCmd(),
+		QueryRiskyCdpsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -51,7 +56,7 @@ func GetQueryCmd() *cobra.Command {
 
 // QueryCdpCmd returns the command handler for querying a particular cdp
 func QueryCdpCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "cdp [owner-addr] [collateral-type]",
 		Short: "get info about a cdp",
 		Long: strings.TrimSpace(
@@ -74,9 +79,15 @@ $ %s query %s cdp aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 				return err
 			}
 
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Cdp(context.Background(), &types.QueryCdpRequest{
 				Owner:          args[0],
 				CollateralType: args[1],
+				CdpID:          cdpID,
 			})
 			if err != nil {
 				return err
@@ -85,6 +96,9 @@ $ %s query %s cdp aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 			return clientCtx.PrintProto(res)
 		},
 	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // QueryGetCdpsCmd queries the cdps in the store
@@ -185,7 +199,7 @@ $ kvcli q cdp cdps --page=2 --limit=100
 
 // QueryCdpDepositsCmd returns the command handler for querying the deposits of a particular cdp
 func QueryCdpDepositsCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposits [owner-addr] [collateral-type]",
 		Short: "get deposits for a cdp",
 		Long: strings.TrimSpace(
@@ -208,9 +222,15 @@ $ %s query %s deposits aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 				return err
 			}
 
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Deposits(context.Background(), &types.QueryDepositsRequest{
 				Owner:          args[0],
 				CollateralType: args[1],
+				CdpID:          cdpID,
 			})
 			if err != nil {
 				return err
@@ -219,6 +239,9 @@ $ %s query %s deposits aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 			return clientCtx.PrintProto(res)
 		},
 	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // QueryParamsCmd returns the command handler for cdp parameter querying
@@ -270,3 +293,191 @@ func QueryGetAccounts() *cobra.Command {
 		},
 	}
 }
+
+// QueryStabilityFeeCmd queries the effective stability fee of collateral types
+func QueryStabilityFeeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stability-fee [collateral-type]",
+		Short: "get the effective stability fee",
+		Long:  "get the per second stability fee currently charged for a collateral type, or for all collateral types if none is given.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryStabilityFeeRequest{}
+			if len(args) > 0 {
+				req.CollateralType = args[0]
+			}
+			res, err := queryClient.StabilityFee(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryGlobalSettlementCmd queries the global settlement of the cdp system
+func QueryGlobalSettlementCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "global-settlement",
+		Short: "get the global settlement",
+		Long:  "get the settlement prices, reserved collateral and redemption progress of the cdp system's global settlement.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GlobalSettlement(context.Background(), &types.QueryGlobalSettlementRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.GlobalSettlement)
+		},
+	}
+}
+
+// QueryPsmReservesCmd queries the reserves of the peg stability module
+func QueryPsmReservesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "psm-reserves [denom]",
+		Short: "get the peg stability module reserves",
+		Long:  "get the stablecoins held by the peg stability module and the stable asset they back, or only those of one stablecoin if a denom is given.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPsmReservesRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+			res, err := queryClient.PsmReserves(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// QueryCdpRiskCmd returns the command handler for querying the liquidation risk of a particular cdp
+func QueryCdpRiskCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cdp-risk [owner-addr] [collateral-type]",
+		Short: "get the liquidation risk of a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the liquidation price of a CDP, its fees accrued since it was last synchronized, and the collateral
+and debt liquidating it would seize and cover at current prices.
+
+Example:
+$ %s query %s cdp-risk aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CdpRisk(context.Background(), &types.QueryCdpRiskRequest{
+				Owner:          args[0],
+				CollateralType: args[1],
+				CdpID:          cdpID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
+}
+
+// QueryRiskyCdpsCmd returns the command handler for querying the cdps of a collateral type below a collateralization ratio
+func QueryRiskyCdpsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "risky-cdps [collateral-type]",
+		Short: "query the riskiest cdps of a collateral type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the liquidation risk of the paginated cdps of a collateral type below a collateralization ratio,
+riskiest first. The ratio defaults to the collateral type's liquidation ratio.
+
+Example:
+$ %s query %s risky-cdps atom-a
+$ %s query %s risky-cdps atom-a --ratio=1.75 --limit=50
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			strRatio, err := cmd.Flags().GetString(flagRatio)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRiskyCdpsRequest{
+				CollateralType: args[0],
+				Pagination:     pageReq,
+			}
+			if len(strRatio) != 0 {
+				ratio, err := sdk.NewDecFromStr(strRatio)
+				if err != nil {
+					return fmt.Errorf("cannot parse cdp ratio %s", strRatio)
+				}
+				req.Ratio = ratio.String()
+			}
+
+			res, err := queryClient.RiskyCdps(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagRatio, "", "(optional) collateralization ratio below which cdps are returned")
+	flags.AddPaginationFlagsToCmd(cmd, "risky-cdps")
+
+	return cmd
+}
@@ -11,10 +11,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/mokitanetwork/aether/x/cdp/types"
 )
 
+const flagCdpID = "cdp-id"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cdpTxCmd := &cobra.Command{
@@ -29,6 +32,15 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDraw(),
 		GetCmdRepay(),
 		GetCmdLiquidate(),
+		GetCmdTransfer(),
+		GetCmdSetManagers(),
+		GetCmdDepositBasket(),
+		GetCmdWithdrawBasket(),
+		GetCmdSettle(),
+		GetCmdRedeemSettlement(),
+		GetCmdPsmMint(),
+		GetCmdPsmRedeem(),
+		GetCmdFlashMint(),
 	}
 
 	for _, cmd := range cmds {
@@ -78,7 +90,7 @@ $ %s tx %s create 10000000uatom 1000usdx atom-a --from myKeyName
 
 // GetCmdDeposit cli command for depositing to a cdp.
 func GetCmdDeposit() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposit [owner-addr] [collateral] [collateral-type]",
 		Short: "deposit collateral to an existing cdp",
 		Long: strings.TrimSpace(
@@ -102,7 +114,11 @@ $ %s tx %s deposit aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom ato
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgDeposit(owner, clientCtx.GetFromAddress(), collateral, args[2])
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDeposit(owner, clientCtx.GetFromAddress(), collateral, args[2], cdpID)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -110,11 +126,14 @@ $ %s tx %s deposit aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom ato
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // GetCmdWithdraw cli command for withdrawing from a cdp.
 func GetCmdWithdraw() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "withdraw [owner-addr] [collateral] [collateral-type]",
 		Short: "withdraw collateral from an existing cdp",
 		Long: strings.TrimSpace(
@@ -138,7 +157,11 @@ $ %s tx %s withdraw aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom at
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgWithdraw(owner, clientCtx.GetFromAddress(), collateral, args[2])
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdraw(owner, clientCtx.GetFromAddress(), collateral, args[2], cdpID)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -146,11 +169,14 @@ $ %s tx %s withdraw aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom at
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // GetCmdDraw cli command for depositing to a cdp.
 func GetCmdDraw() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "draw [collateral-type] [debt]",
 		Short: "draw debt off an existing cdp",
 		Long: strings.TrimSpace(
@@ -170,7 +196,11 @@ $ %s tx %s draw atom-a 1000usdx --from myKeyName
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgDrawDebt(clientCtx.GetFromAddress(), args[0], debt)
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDrawDebt(clientCtx.GetFromAddress(), args[0], cdpID, debt)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -178,11 +208,14 @@ $ %s tx %s draw atom-a 1000usdx --from myKeyName
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
 }
 
 // GetCmdRepay cli command for depositing to a cdp.
 func GetCmdRepay() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "repay [collateral-name] [debt]",
 		Short: "repay debt to an existing cdp",
 		Long: strings.TrimSpace(
@@ -190,7 +223,8 @@ func GetCmdRepay() *cobra.Command {
 
 Example:
 $ %s tx %s repay atom-a 1000usdx --from myKeyName
-`, version.AppName, types.ModuleName)),
+$ %s tx %s repay atom-a 1000usdx --owner aeth1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm --from myManagerKeyName
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName)),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -202,7 +236,15 @@ $ %s tx %s repay atom-a 1000usdx --from myKeyName
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgRepayDebt(clientCtx.GetFromAddress(), args[0], payment)
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRepayDebt(clientCtx.GetFromAddress(), args[0], cdpID, payment)
+			msg.Owner, err = cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -210,11 +252,15 @@ $ %s tx %s repay atom-a 1000usdx --from myKeyName
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+	cmd.Flags().String(flagOwner, "", "(optional) owner of the cdp when repaying as one of its managers")
+
+	return cmd
 }
 
 // GetCmdLiquidate cli command for liquidating a cdp.
 func GetCmdLiquidate() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "liquidate [cdp-owner-address] [collateral-type]",
 		Short: "liquidate a cdp",
 		Long: strings.TrimSpace(
@@ -234,7 +280,358 @@ $ %s tx %s liquidate aeth1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm  btcb-a --from
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgLiquidate(clientCtx.GetFromAddress(), addr, args[1])
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgLiquidate(clientCtx.GetFromAddress(), addr, args[1], cdpID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
+}
+
+// GetCmdTransfer cli command for transferring a cdp to a new owner.
+func GetCmdTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [recipient-address] [collateral-type]",
+		Short: "transfer ownership of a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer ownership of a cdp and the owner's deposit to another address. The cdp's managers are removed.
+
+Example:
+$ %s tx %s transfer aeth1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTransferCDP(clientCtx.GetFromAddress(), recipient, args[1], cdpID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
+}
+
+// GetCmdSetManagers cli command for setting the managers of a cdp.
+func GetCmdSetManagers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-managers [collateral-type] [manager-addresses]",
+		Short: "set the managers of a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the comma separated list of addresses that may deposit, withdraw and repay on behalf of the cdp owner.
+Omit the addresses to remove all managers.
+
+Example:
+$ %s tx %s set-managers atom-a aeth1y70y90wzmnf00e63efk2lycgqwepthdmyzsfzm --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var managers []sdk.AccAddress
+			if len(args) > 1 {
+				for _, manager := range strings.Split(args[1], ",") {
+					addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(manager))
+					if err != nil {
+						return err
+					}
+					managers = append(managers, addr)
+				}
+			}
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetCDPManagers(clientCtx.GetFromAddress(), args[0], cdpID, managers)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
+}
+
+// GetCmdDepositBasket cli command for depositing collateral of another collateral type to a cdp's basket.
+func GetCmdDepositBasket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-basket [owner-addr] [collateral] [collateral-type] [basket-collateral-type]",
+		Short: "deposit collateral of another collateral type to a cdp's basket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add collateral of another collateral type to an existing cdp, making it a multi-collateral cdp.
+Only the owner or a manager of the cdp can deposit basket collateral.
+
+Example:
+$ %s tx %s deposit-basket aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom bnb-a atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDepositBasket(owner, clientCtx.GetFromAddress(), collateral, args[2], cdpID, args[3])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
+}
+
+// GetCmdWithdrawBasket cli command for withdrawing collateral from a cdp's basket.
+func GetCmdWithdrawBasket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-basket [owner-addr] [collateral] [collateral-type] [basket-collateral-type]",
+		Short: "withdraw collateral from a cdp's basket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove collateral of another collateral type from an existing cdp. The collateral is returned to the cdp owner.
+
+Example:
+$ %s tx %s withdraw-basket aeth15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom bnb-a atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdrawBasket(owner, clientCtx.GetFromAddress(), collateral, args[2], cdpID, args[3])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
+}
+
+// GetCmdSettle cli command for settling a cdp during global settlement.
+func GetCmdSettle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle [collateral-type]",
+		Short: "settle a cdp during global settlement",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Close a cdp after global settlement has started. The collateral owed for the cdp's debt at the settlement prices
+is kept to back redemptions, and the remaining collateral is returned to the depositors.
+
+Example:
+$ %s tx %s settle atom-a --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cdpID, err := cmd.Flags().GetUint64(flagCdpID)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSettleCDP(clientCtx.GetFromAddress(), args[0], cdpID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64(flagCdpID, 0, "(optional) id of the cdp, required when the owner has several cdps of the collateral type")
+
+	return cmd
+}
+
+// GetCmdRedeemSettlement cli command for redeeming stable asset for collateral during global settlement.
+func GetCmdRedeemSettlement() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-settlement [amount]",
+		Short: "redeem stable asset for collateral during global settlement",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn stable asset after global settlement has started, receiving a pro-rata share of the collateral reserved for each collateral type.
+
+Example:
+$ %s tx %s redeem-settlement 1000000usdx --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRedeemSettlement(clientCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdPsmMint cli command for minting stable asset with a stablecoin through the peg stability module.
+func GetCmdPsmMint() *cobra.Command {
+	return &cobra.Command{
+		Use:   "psm-mint [amount]",
+		Short: "mint stable asset 1:1 with a stablecoin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit a stablecoin accepted by the peg stability module and mint the same value of stable asset, less the mint fee.
+
+Example:
+$ %s tx %s psm-mint 1000000erc20/usdc --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgPsmMint(clientCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdPsmRedeem cli command for redeeming stable asset for a stablecoin through the peg stability module.
+func GetCmdPsmRedeem() *cobra.Command {
+	return &cobra.Command{
+		Use:   "psm-redeem [amount] [denom]",
+		Short: "redeem stable asset 1:1 for a stablecoin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn stable asset and withdraw the same value of a stablecoin held by the peg stability module, less the redeem fee.
+
+Example:
+$ %s tx %s psm-redeem 1000000usdx erc20/usdc --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgPsmRedeem(clientCtx.GetFromAddress(), amount, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+// GetCmdFlashMint cli command for flash minting stable asset around a list of messages.
+func GetCmdFlashMint() *cobra.Command {
+	return &cobra.Command{
+		Use:   "flash-mint [amount] [msg-tx-json-file]",
+		Short: "flash mint stable asset for the messages in a tx file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint stable asset, execute the messages of a generated tx file, then repay the minted amount plus the flash mint fee.
+The messages must be signed only by the sender, and the flash mint fails if the sender does not hold the repayment once they have executed.
+
+Example:
+$ %s tx %s flash-mint 1000000000usdx tx.json --from myKeyName
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			msg, err := types.NewMsgFlashMint(clientCtx.GetFromAddress(), amount, theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/mokitanetwork/aether/x/cdp/client/cli"
+	"github.com/mokitanetwork/aether/x/cdp/client/rest"
+)
+
+// global settlement proposal handler
+var (
+	GlobalSettlementProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitGlobalSettlementProposal, rest.GlobalSettlementProposalRESTHandler)
+)
@@ -44,7 +44,16 @@ func queryCdpHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		params := types.NewQueryCdpParams(owner, collateralType)
+		var cdpID uint64
+		if x := r.URL.Query().Get(RestID); len(x) != 0 {
+			cdpID, err = strconv.ParseUint(strings.TrimSpace(x), 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryCdpParams(owner, collateralType, cdpID)
 
 		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
 		if err != nil {
@@ -144,7 +153,16 @@ func queryCdpDepositsHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		params := types.NewQueryCdpDeposits(owner, collateralType)
+		var cdpID uint64
+		if x := r.URL.Query().Get(RestID); len(x) != 0 {
+			cdpID, err = strconv.ParseUint(strings.TrimSpace(x), 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryCdpDeposits(owner, collateralType, cdpID)
 
 		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
 		if err != nil {
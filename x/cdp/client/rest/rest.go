@@ -39,6 +39,7 @@ type PostDepositReq struct {
 	Depositor      sdk.AccAddress `json:"depositor" yaml:"depositor"`
 	Collateral     sdk.Coin       `json:"collateral" yaml:"collateral"`
 	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+	CdpID          uint64         `json:"cdp_id" yaml:"cdp_id"`
 }
 
 // PostWithdrawalReq defines the properties of cdp request's body.
@@ -48,6 +49,7 @@ type PostWithdrawalReq struct {
 	Depositor      sdk.AccAddress `json:"depositor" yaml:"depositor"`
 	Collateral     sdk.Coin       `json:"collateral" yaml:"collateral"`
 	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+	CdpID          uint64         `json:"cdp_id" yaml:"cdp_id"`
 }
 
 // PostDrawReq defines the properties of cdp request's body.
@@ -56,6 +58,7 @@ type PostDrawReq struct {
 	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
 	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
 	Principal      sdk.Coin       `json:"principal" yaml:"principal"`
+	CdpID          uint64         `json:"cdp_id" yaml:"cdp_id"`
 }
 
 // PostRepayReq defines the properties of cdp request's body.
@@ -64,6 +67,36 @@ type PostRepayReq struct {
 	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
 	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
 	Payment        sdk.Coin       `json:"payment" yaml:"payment"`
+	CdpID          uint64         `json:"cdp_id" yaml:"cdp_id"`
+}
+
+// PostTransferReq defines the properties of a cdp transfer request's body.
+type PostTransferReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	Recipient      sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+	CdpID          uint64         `json:"cdp_id" yaml:"cdp_id"`
+}
+
+// PostSetManagersReq defines the properties of a cdp set managers request's body.
+type PostSetManagersReq struct {
+	BaseReq        rest.BaseReq     `json:"base_req" yaml:"base_req"`
+	Owner          sdk.AccAddress   `json:"owner" yaml:"owner"`
+	CollateralType string           `json:"collateral_type" yaml:"collateral_type"`
+	CdpID          uint64           `json:"cdp_id" yaml:"cdp_id"`
+	Managers       []sdk.AccAddress `json:"managers" yaml:"managers"`
+}
+
+// PostBasketReq defines the properties of a cdp basket deposit or withdrawal request's body.
+type PostBasketReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	Depositor      sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Collateral     sdk.Coin       `json:"collateral" yaml:"collateral"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+	CdpID          uint64         `json:"cdp_id" yaml:"cdp_id"`
+	BasketType     string         `json:"basket_type" yaml:"basket_type"`
 }
 
 // PostLiquidateReq defines the properties of cdp liquidation request's body.
@@ -71,4 +104,45 @@ type PostLiquidateReq struct {
 	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
 	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
 	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+	CdpID          uint64         `json:"cdp_id" yaml:"cdp_id"`
+}
+
+// PostSettleReq defines the properties of a cdp settlement request's body.
+type PostSettleReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+	CdpID          uint64         `json:"cdp_id" yaml:"cdp_id"`
+}
+
+// PostRedeemSettlementReq defines the properties of a settlement redemption request's body.
+type PostRedeemSettlementReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount  sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// GlobalSettlementProposalReq defines a global settlement proposal request body.
+type GlobalSettlementProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+}
+
+// PostPsmMintReq defines the properties of a peg stability module mint request's body.
+type PostPsmMintReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount  sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// PostPsmRedeemReq defines the properties of a peg stability module redeem request's body.
+type PostPsmRedeemReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount  sdk.Coin       `json:"amount" yaml:"amount"`
+	Denom   string         `json:"denom" yaml:"denom"`
 }
@@ -24,6 +24,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 	if liqModuleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.LiquidatorMacc))
 	}
+	psmModuleAcc := ak.GetModuleAccount(ctx, types.PsmMacc)
+	if psmModuleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.PsmMacc))
+	}
 
 	// validate denoms - check that any collaterals in the params are in the pricefeed,
 	// pricefeed MUST call InitGenesis before cdp
@@ -73,8 +77,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 			panic(fmt.Sprintf("error setting cdp: %v", err))
 		}
 		k.IndexCdpByOwner(ctx, cdp)
-		ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
-		k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, ratio)
+		if cdp.IsMultiCollateral() {
+			k.IndexMultiCollateralCdp(ctx, cdp.Type, cdp.ID)
+		} else {
+			ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+			k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, ratio)
+		}
 	}
 
 	k.SetNextCdpID(ctx, gs.StartingCdpID)
@@ -84,6 +92,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, pk types.PricefeedKeeper, ak
 	for _, d := range gs.Deposits {
 		k.SetDeposit(ctx, d)
 	}
+
+	if gs.GlobalSettlement != nil {
+		k.SetGlobalSettlement(ctx, *gs.GlobalSettlement)
+	}
+
+	for _, reserve := range gs.PsmReserves {
+		k.SetPsmReserve(ctx, reserve)
+	}
 }
 
 // ExportGenesis export genesis state for cdp module
@@ -129,5 +145,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		totalPrincipals = append(totalPrincipals, genTotalPrincipal)
 	}
 
-	return types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	genState := types.NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	if settlement, found := k.GetGlobalSettlement(ctx); found {
+		genState.GlobalSettlement = &settlement
+	}
+	k.IteratePsmReserves(ctx, func(reserve sdk.Coin) bool {
+		genState.PsmReserves = genState.PsmReserves.Add(reserve)
+		return false
+	})
+	return genState
 }
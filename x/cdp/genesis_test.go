@@ -209,6 +209,7 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 			SurplusAuctionLot:       types.DefaultSurplusLot,
 			DebtAuctionThreshold:    types.DefaultDebtThreshold,
 			DebtAuctionLot:          types.DefaultDebtLot,
+			FlashMintParam:          types.DefaultFlashMintParam,
 			CollateralParams: types.CollateralParams{
 				{
 					Denom:                            "xrp",
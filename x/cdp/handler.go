@@ -0,0 +1,22 @@
+package cdp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/mokitanetwork/aether/x/cdp/keeper"
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+// NewGlobalSettlementProposalHandler handles proposals that shut down the cdp system
+func NewGlobalSettlementProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.GlobalSettlementProposal:
+			return keeper.HandleGlobalSettlementProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized cdp proposal content type: %T", c)
+		}
+	}
+}
@@ -68,10 +68,10 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 		penalty := k.ApplyLiquidationPenalty(ctx, collateralType, debtAmount)
 
-		_, err := k.auctionKeeper.StartCollateralAuction(
-			ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, auctionSize),
-			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), []sdk.AccAddress{returnAddr},
-			[]sdk.Int{auctionSize}, sdk.NewCoin(debtDenom, debtAmount),
+		err := k.startCollateralAuction(
+			ctx, collateralType, sdk.NewCoin(collateral.Denom, auctionSize),
+			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), returnAddr,
+			auctionSize, sdk.NewCoin(debtDenom, debtAmount),
 		)
 		if err != nil {
 			return err
@@ -93,12 +93,32 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 	penalty := k.ApplyLiquidationPenalty(ctx, collateralType, lastAuctionDebt)
 
-	_, err := k.auctionKeeper.StartCollateralAuction(
-		ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
-		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), []sdk.AccAddress{returnAddr},
-		[]sdk.Int{lastAuctionCollateral}, sdk.NewCoin(debtDenom, lastAuctionDebt),
+	return k.startCollateralAuction(
+		ctx, collateralType, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
+		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), returnAddr,
+		lastAuctionCollateral, sdk.NewCoin(debtDenom, lastAuctionDebt),
 	)
+}
 
+// startCollateralAuction starts a dutch auction for the lot if the collateral type uses them, otherwise a collateral auction
+func (k Keeper) startCollateralAuction(
+	ctx sdk.Context, collateralType string, lot, maxBid sdk.Coin, returnAddr sdk.AccAddress, weight sdk.Int, debt sdk.Coin,
+) error {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if found && cp.DutchAuction {
+		// the stable asset is valued at the quote asset of the liquidation market, so the price only needs converting to base units
+		dp := k.GetParams(ctx).DebtParam
+		priceConversion := sdk.NewDecFromIntWithPrec(sdk.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64())), cp.ConversionFactor.Int64())
+		_, err := k.auctionKeeper.StartDutchAuction(
+			ctx, types.LiquidatorMacc, lot, maxBid, []sdk.AccAddress{returnAddr}, []sdk.Int{weight}, debt,
+			cp.LiquidationMarketID, "", priceConversion,
+		)
+		return err
+	}
+
+	_, err := k.auctionKeeper.StartCollateralAuction(
+		ctx, types.LiquidatorMacc, lot, maxBid, []sdk.AccAddress{returnAddr}, []sdk.Int{weight}, debt,
+	)
 	return err
 }
 
@@ -70,6 +70,38 @@ func (suite *AuctionTestSuite) TestCollateralAuction() {
 	suite.Require().NoError(err)
 }
 
+func (suite *AuctionTestSuite) TestCollateralDutchAuction() {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == "bnb-a" {
+			params.CollateralParams[i].DutchAuction = true
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	bk := suite.app.GetBankKeeper()
+	err := bk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 21000000000), c("bnb", 190000000000)))
+	suite.Require().NoError(err)
+	testDeposit := types.NewDeposit(1, suite.addrs[0], c("bnb", 190000000000))
+	err = suite.keeper.AuctionCollateral(suite.ctx, types.Deposits{testDeposit}, "bnb-a", i(21000000000), "usdx")
+	suite.Require().NoError(err)
+
+	// the lot is priced from the liquidation market, converted from 8 decimal bnb to 6 decimal usdx, plus the 20% price buffer
+	auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+	suite.Require().NotEmpty(auctions)
+	lot := c("bnb", 0)
+	for _, auction := range auctions {
+		dutchAuction, ok := auction.(*auctiontypes.DutchAuction)
+		suite.Require().True(ok)
+		suite.Equal("bnb:usd:30", dutchAuction.LotMarketID)
+		suite.Equal(d("0.01"), dutchAuction.PriceConversion)
+		suite.Equal(d("0.207"), dutchAuction.StartPrice)
+		suite.Equal([]sdk.AccAddress{suite.addrs[0]}, dutchAuction.LotReturns.Addresses)
+		lot = lot.Add(dutchAuction.Lot)
+	}
+	suite.Equal(c("bnb", 190000000000), lot)
+}
+
 func (suite *AuctionTestSuite) TestSurplusAuction() {
 	bk := suite.app.GetBankKeeper()
 	ak := suite.app.GetAccountKeeper()
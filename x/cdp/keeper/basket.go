@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+// DepositBasketCollateral adds collateral of another collateral type to a cdp's basket, making it a multi-collateral cdp
+// A cdpID of zero selects the owner's only cdp of the collateral type.
+// Basket collateral belongs to the cdp owner, so only the owner or one of the cdp's managers can deposit it.
+func (k Keeper) DepositBasketCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string, cdpID uint64, basketType string) error {
+	err := k.ValidateNoGlobalSettlement(ctx)
+	if err != nil {
+		return err
+	}
+	if basketType == collateralType {
+		return sdkerrors.Wrapf(types.ErrInvalidCollateral, "basket collateral type must differ from the cdp's collateral type %s", collateralType)
+	}
+	err = k.ValidateCollateral(ctx, collateral, basketType)
+	if err != nil {
+		return err
+	}
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
+	}
+	if !depositor.Equals(cdp.Owner) && !cdp.IsManager(depositor) {
+		return sdkerrors.Wrapf(types.ErrNotOwnerOrManager, "%s cannot deposit basket collateral to cdp %d", depositor, cdp.ID)
+	}
+	err = k.ValidateBalance(ctx, collateral, depositor)
+	if err != nil {
+		return err
+	}
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.NewCoins(collateral))
+	if err != nil {
+		return err
+	}
+	cdp.Basket = cdp.Basket.Add(basketType, collateral)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpBasketDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, basketType),
+		),
+	)
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// WithdrawBasketCollateral removes collateral of another collateral type from a cdp's basket and returns it to the owner,
+// if it does not put the cdp below the liquidation ratio. A cdpID of zero selects the owner's only cdp of the collateral type.
+func (k Keeper) WithdrawBasketCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string, cdpID uint64, basketType string) error {
+	err := k.ValidateNoGlobalSettlement(ctx)
+	if err != nil {
+		return err
+	}
+	err = k.ValidateCollateral(ctx, collateral, basketType)
+	if err != nil {
+		return err
+	}
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
+	}
+	if !depositor.Equals(cdp.Owner) && !cdp.IsManager(depositor) {
+		return sdkerrors.Wrapf(types.ErrNotOwnerOrManager, "%s cannot withdraw basket collateral from cdp %d", depositor, cdp.ID)
+	}
+	held, found := cdp.Basket.AmountOf(basketType)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDepositNotFound, "cdp %d has no %s basket collateral", cdp.ID, basketType)
+	}
+	if collateral.Amount.GT(held.Amount) {
+		return sdkerrors.Wrapf(types.ErrInvalidWithdrawAmount, "collateral %s, basket collateral %s", collateral, held)
+	}
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	basket := cdp.Basket.Sub(basketType, collateral)
+	collateralizationRatio, err := k.CalculateBasketCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, basket, cdp.Principal, cdp.AccumulatedFees, spot)
+	if err != nil {
+		return err
+	}
+	liquidationRatio := k.getLiquidationRatio(ctx, cdp.Type)
+	if collateralizationRatio.LT(liquidationRatio) {
+		return sdkerrors.Wrapf(types.ErrInvalidCollateralRatio, "collateral %s, collateral ratio %s, liquidation ratio %s", collateral.Denom, collateralizationRatio, liquidationRatio)
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cdp.Owner, sdk.NewCoins(collateral))
+	if err != nil {
+		panic(err)
+	}
+	cdp.Basket = basket
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpBasketWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, basketType),
+		),
+	)
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// LiquidateMultiCollateralCdps seizes the collateral of multi-collateral cdps of the collateral type whose risk-weighted
+// collateralization ratio, at the liquidation price of each collateral type, is below the liquidation ratio.
+// Cdps holding collateral without a current liquidation price are skipped.
+func (k Keeper) LiquidateMultiCollateralCdps(ctx sdk.Context, collateralType string, liquidationRatio sdk.Dec) error {
+	var cdpsToLiquidate types.CDPs
+	k.IterateMultiCollateralCdps(ctx, collateralType, func(cdp types.CDP) bool {
+		fees := cdp.AccumulatedFees.Add(k.CalculateNewInterest(ctx, cdp))
+		collateralizationRatio, err := k.CalculateBasketCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Basket, cdp.Principal, fees, liquidation)
+		if err == nil && collateralizationRatio.LT(liquidationRatio) {
+			cdpsToLiquidate = append(cdpsToLiquidate, cdp)
+		}
+		return false
+	})
+	for _, cdp := range cdpsToLiquidate {
+		k.hooks.BeforeCDPModified(ctx, cdp)
+		cdp = k.SynchronizeInterest(ctx, cdp)
+		if err := k.SeizeCollateral(ctx, cdp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTotalBasketCollateral returns the amount of collateral of the collateral type held in the baskets of multi-collateral cdps
+func (k Keeper) getTotalBasketCollateral(ctx sdk.Context, collateralType string) sdk.Int {
+	total := sdk.ZeroInt()
+	k.IterateMultiCollateralCdps(ctx, "", func(cdp types.CDP) bool {
+		if amount, found := cdp.Basket.AmountOf(collateralType); found {
+			total = total.Add(amount.Amount)
+		}
+		return false
+	})
+	return total
+}
+
+// seizeBasket sends a multi-collateral cdp's basket to the liquidator module account and auctions each basket collateral
+// for a share of the debt in proportion to its value at the liquidation price. It returns the debt left for the cdp's own collateral,
+// or for its last basket collateral if the cdp has none of its own.
+func (k Keeper) seizeBasket(ctx sdk.Context, cdp types.CDP, debt sdk.Int) (sdk.Int, error) {
+	totalValue := sdk.ZeroDec()
+	if !cdp.Collateral.IsZero() {
+		collateralValue, err := k.calculateCollateralValue(ctx, cdp.Collateral, cdp.Type, liquidation)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		totalValue = collateralValue
+	}
+	basketValues := make([]sdk.Dec, len(cdp.Basket))
+	for i, bc := range cdp.Basket {
+		value, err := k.calculateCollateralValue(ctx, bc.Amount, bc.Type, liquidation)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		basketValues[i] = value
+		totalValue = totalValue.Add(value)
+	}
+	if !totalValue.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidCollateral, "cdp %d collateral has no value", cdp.ID)
+	}
+
+	remainingDebt := debt
+	for i, bc := range cdp.Basket {
+		debtShare := debt.ToDec().Mul(basketValues[i]).Quo(totalValue).TruncateInt()
+		if i == len(cdp.Basket)-1 && cdp.Collateral.IsZero() {
+			debtShare = remainingDebt
+		}
+		remainingDebt = remainingDebt.Sub(debtShare)
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(bc.Amount)); err != nil {
+			return sdk.Int{}, err
+		}
+		seized := types.NewDeposit(cdp.ID, cdp.Owner, bc.Amount)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDeposit, seized.String()),
+				sdk.NewAttribute(types.AttributeKeyCollateralType, bc.Type),
+			),
+		)
+		err := k.CreateAuctionsFromDeposit(ctx, bc.Amount, bc.Type, cdp.Owner, debtShare, k.getAuctionSize(ctx, bc.Type), cdp.Principal.Denom)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+	return remainingDebt, nil
+}
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/mokitanetwork/aether/app"
+	auctiontypes "github.com/mokitanetwork/aether/x/auction/types"
+	"github.com/mokitanetwork/aether/x/cdp/keeper"
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+type BasketTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *BasketTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	authGS := app.NewFundedGenStateWithSameCoins(cdc, cs(c("btc", 100000000), c("xrp", 10000000000)), addrs)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	// $100 of xrp backing $40 of debt, a collateralization ratio of 2.5
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 40000000), "xrp-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *BasketTestSuite) setPrice(price sdk.Dec, market string) {
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	_, err := pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, market, price, suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.Require().NoError(err)
+	err = pfKeeper.SetCurrentPrices(suite.ctx, market)
+	suite.Require().NoError(err)
+}
+
+func (suite *BasketTestSuite) multiCollateralCdpIDs(collateralType string) []uint64 {
+	var ids []uint64
+	suite.keeper.IterateMultiCollateralCdps(suite.ctx, collateralType, func(cdp types.CDP) bool {
+		ids = append(ids, cdp.ID)
+		return false
+	})
+	return ids
+}
+
+func (suite *BasketTestSuite) TestDepositBasketCollateral() {
+	bk := suite.app.GetBankKeeper()
+
+	err := suite.keeper.DepositBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("btc", 1000000), "xrp-a", 0, "btc-a")
+	suite.Require().True(errors.Is(err, types.ErrNotOwnerOrManager))
+	err = suite.keeper.DepositBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 1000000), "xrp-a", 0, "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateral))
+	err = suite.keeper.DepositBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 1000000), "xrp-a", 0, "btc-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateral))
+
+	// $80 of btc
+	err = suite.keeper.DepositBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 1000000), "xrp-a", 0, "btc-a")
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.True(cdp.IsMultiCollateral())
+	suite.Equal(types.BasketCollaterals{types.NewBasketCollateral("btc-a", c("btc", 1000000))}, cdp.Basket)
+	suite.Equal(i(99000000), bk.GetBalance(suite.ctx, suite.addrs[0], "btc").Amount)
+
+	// multi-collateral cdps are indexed by type instead of collateral to debt ratio
+	suite.Equal([]uint64{1}, suite.multiCollateralCdpIDs("xrp-a"))
+	suite.Empty(suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "xrp-a", d("100")))
+
+	// btc counts at its value scaled by the xrp-a liquidation ratio over the btc-a liquidation ratio: 100 + 80 * 2.0 / 1.5
+	ratio, err := suite.keeper.CalculateBasketCollateralizationRatio(suite.ctx, cdp.Collateral, cdp.Type, cdp.Basket, cdp.Principal, cdp.AccumulatedFees, "spot")
+	suite.Require().NoError(err)
+	suite.True(ratio.Sub(d("5.166666666666666667")).Abs().LTE(d("0.000000000000000001")), "ratio %s", ratio)
+
+	// the basket allows more debt to be drawn than the xrp alone
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", 0, c("usdx", 60000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", 0, c("usdx", 5000000))
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+}
+
+func (suite *BasketTestSuite) TestWithdrawBasketCollateral() {
+	err := suite.keeper.DepositBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 1000000), "xrp-a", 0, "btc-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", 0, c("usdx", 60000000))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.WithdrawBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 1000000), "xrp-a", 0, "bnb-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateral))
+	err = suite.keeper.WithdrawBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 2000000), "xrp-a", 0, "btc-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidWithdrawAmount))
+	err = suite.keeper.WithdrawBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 1000000), "xrp-a", 0, "btc-a")
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 100000000), "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
+
+	// withdrawals by a manager are returned to the owner
+	err = suite.keeper.SetCdpManagers(suite.ctx, suite.addrs[0], "xrp-a", 0, []sdk.AccAddress{suite.addrs[1]})
+	suite.Require().NoError(err)
+	err = suite.keeper.WithdrawBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("btc", 10000), "xrp-a", 0, "btc-a")
+	suite.Require().NoError(err)
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(i(99010000), bk.GetBalance(suite.ctx, suite.addrs[0], "btc").Amount)
+	suite.Equal(i(100000000), bk.GetBalance(suite.ctx, suite.addrs[1], "btc").Amount)
+
+	// withdrawing the whole basket returns the cdp to the collateral ratio index
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp-a", 0, c("usdx", 60000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.WithdrawBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 990000), "xrp-a", 0, "btc-a")
+	suite.Require().NoError(err)
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.False(cdp.IsMultiCollateral())
+	suite.Empty(suite.multiCollateralCdpIDs(""))
+	suite.Len(suite.keeper.GetAllCdpsByCollateralTypeAndRatio(suite.ctx, "xrp-a", d("100")), 1)
+}
+
+func (suite *BasketTestSuite) TestRepayReturnsBasketCollateral() {
+	err := suite.keeper.DepositBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 1000000), "xrp-a", 0, "btc-a")
+	suite.Require().NoError(err)
+
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp-a", 0, c("usdx", 40000000))
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.False(found)
+	suite.Empty(suite.multiCollateralCdpIDs(""))
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(i(100000000), bk.GetBalance(suite.ctx, suite.addrs[0], "btc").Amount)
+}
+
+func (suite *BasketTestSuite) TestLiquidateMultiCollateralCdps() {
+	err := suite.keeper.DepositBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 1000000), "xrp-a", 0, "btc-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", 0, c("usdx", 60000000))
+	suite.Require().NoError(err)
+
+	// the xrp alone is below the liquidation ratio, but the basket keeps the cdp safe
+	err = suite.keeper.LiquidateMultiCollateralCdps(suite.ctx, "xrp-a", d("2.0"))
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+
+	// $80 of xrp and $80 of btc weighted to 80 + 80 * 2.0 / 1.5 against $100 of debt is below 2.0
+	suite.setPrice(d("0.2"), "xrp:usd:30")
+	err = suite.keeper.LiquidateMultiCollateralCdps(suite.ctx, "xrp-a", d("2.0"))
+	suite.Require().NoError(err)
+
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.False(found)
+	suite.Empty(suite.multiCollateralCdpIDs(""))
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	auctionMacc := ak.GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(cs(c("btc", 1000000), c("debt", 100000000), c("xrp", 400000000)), bk.GetAllBalances(suite.ctx, auctionMacc.GetAddress()))
+
+	// each collateral type is auctioned for the share of debt matching its value
+	corresponding := make(map[string]sdk.Coin)
+	for _, a := range suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx) {
+		auction, ok := a.(*auctiontypes.CollateralAuction)
+		suite.Require().True(ok)
+		suite.Equal([]sdk.AccAddress{suite.addrs[0]}, auction.LotReturns.Addresses)
+		corresponding[auction.Lot.Denom] = auction.CorrespondingDebt
+	}
+	suite.Equal(c("debt", 50000000), corresponding["btc"])
+	suite.Equal(c("debt", 50000000), corresponding["xrp"])
+}
+
+func (suite *BasketTestSuite) TestKeeperLiquidationPaysBasketReward() {
+	err := suite.keeper.DepositBasketCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 1000000), "xrp-a", 0, "btc-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", 0, c("usdx", 60000000))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[2], suite.addrs[0], "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrNotLiquidatable))
+
+	suite.setPrice(d("0.2"), "xrp:usd:30")
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[2], suite.addrs[0], "xrp-a", 0)
+	suite.Require().NoError(err)
+
+	// the keeper receives 1% of the xrp and 1% of the btc
+	bk := suite.app.GetBankKeeper()
+	suite.Equal(i(100010000), bk.GetBalance(suite.ctx, suite.addrs[2], "btc").Amount)
+	suite.Equal(i(10004000000), bk.GetBalance(suite.ctx, suite.addrs[2], "xrp").Amount)
+	auctionMacc := suite.app.GetAccountKeeper().GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(cs(c("btc", 990000), c("debt", 100000000), c("xrp", 396000000)), bk.GetAllBalances(suite.ctx, auctionMacc.GetAddress()))
+}
+
+func TestBasketTestSuite(t *testing.T) {
+	suite.Run(t, new(BasketTestSuite))
+}
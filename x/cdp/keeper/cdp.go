@@ -14,17 +14,17 @@ import (
 // AddCdp adds a cdp for a specific owner and collateral type
 func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, principal sdk.Coin, collateralType string) error {
 	// validation
-	err := k.ValidateCollateral(ctx, collateral, collateralType)
+	err := k.ValidateNoGlobalSettlement(ctx)
 	if err != nil {
 		return err
 	}
-	err = k.ValidateBalance(ctx, collateral, owner)
+	err = k.ValidateCollateral(ctx, collateral, collateralType)
 	if err != nil {
 		return err
 	}
-	_, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
-	if found {
-		return sdkerrors.Wrapf(types.ErrCdpAlreadyExists, "owner %s, denom %s", owner, collateral.Denom)
+	err = k.ValidateBalance(ctx, collateral, owner)
+	if err != nil {
+		return err
 	}
 	err = k.ValidatePrincipalAdd(ctx, principal)
 	if err != nil {
@@ -35,7 +35,7 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	if err != nil {
 		return err
 	}
-	err = k.ValidateCollateralizationRatio(ctx, collateral, collateralType, principal, sdk.NewCoin(principal.Denom, sdk.ZeroInt()))
+	err = k.ValidateCollateralizationRatio(ctx, collateral, collateralType, nil, principal, sdk.NewCoin(principal.Denom, sdk.ZeroInt()))
 	if err != nil {
 		return err
 	}
@@ -122,7 +122,7 @@ func (k Keeper) UpdateCdpAndCollateralRatioIndex(ctx sdk.Context, cdp types.CDP,
 	if err != nil {
 		return err
 	}
-	k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, ratio)
+	k.indexCdpForLiquidation(ctx, cdp, ratio)
 	return nil
 }
 
@@ -142,7 +142,7 @@ func (k Keeper) SetCdpAndCollateralRatioIndex(ctx sdk.Context, cdp types.CDP, ra
 	if err != nil {
 		return err
 	}
-	k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, ratio)
+	k.indexCdpForLiquidation(ctx, cdp, ratio)
 	return nil
 }
 
@@ -151,6 +151,10 @@ func (k Keeper) removeOldCollateralRatioIndex(ctx sdk.Context, ctype string, id
 	if !found {
 		return sdkerrors.Wrapf(types.ErrCdpNotFound, "%d", storedCDP.ID)
 	}
+	if storedCDP.IsMultiCollateral() {
+		k.RemoveMultiCollateralIndex(ctx, storedCDP.Type, storedCDP.ID)
+		return nil
+	}
 	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, storedCDP.Collateral, storedCDP.Type, storedCDP.GetTotalPrincipal())
 	k.RemoveCdpCollateralRatioIndex(ctx, storedCDP.Type, storedCDP.ID, oldCollateralToDebtRatio)
 	return nil
@@ -172,6 +176,7 @@ func (k Keeper) BurnDebtCoins(ctx sdk.Context, moduleAccount string, denom strin
 }
 
 // GetCdpID returns the id of the cdp corresponding to a specific owner and collateral denom
+// If the owner has several cdps of the collateral type, the lowest id is returned.
 func (k Keeper) GetCdpID(ctx sdk.Context, owner sdk.AccAddress, collateralType string) (uint64, bool) {
 	cdpIDs, found := k.GetCdpIdsByOwner(ctx, owner)
 	if !found {
@@ -200,6 +205,7 @@ func (k Keeper) GetCdpIdsByOwner(ctx sdk.Context, owner sdk.AccAddress) ([]uint6
 }
 
 // GetCdpByOwnerAndCollateralType queries cdps owned by owner and returns the cdp with matching denom
+// If the owner has several cdps of the collateral type, the one with the lowest id is returned.
 func (k Keeper) GetCdpByOwnerAndCollateralType(ctx sdk.Context, owner sdk.AccAddress, collateralType string) (types.CDP, bool) {
 	cdpIDs, found := k.GetCdpIdsByOwner(ctx, owner)
 	if !found {
@@ -214,6 +220,43 @@ func (k Keeper) GetCdpByOwnerAndCollateralType(ctx sdk.Context, owner sdk.AccAdd
 	return types.CDP{}, false
 }
 
+// GetCdpsByOwnerAndCollateralType returns all cdps owned by owner with the input collateral type, sorted by id
+func (k Keeper) GetCdpsByOwnerAndCollateralType(ctx sdk.Context, owner sdk.AccAddress, collateralType string) types.CDPs {
+	cdps := types.CDPs{}
+	cdpIDs, found := k.GetCdpIdsByOwner(ctx, owner)
+	if !found {
+		return cdps
+	}
+	for _, id := range cdpIDs {
+		cdp, found := k.GetCDP(ctx, collateralType, id)
+		if found {
+			cdps = append(cdps, cdp)
+		}
+	}
+	return cdps
+}
+
+// GetOwnerCdp returns the cdp with the input id belonging to owner and collateral type.
+// An id of zero selects the owner's only cdp of that collateral type, and fails if the owner has more than one.
+func (k Keeper) GetOwnerCdp(ctx sdk.Context, owner sdk.AccAddress, collateralType string, cdpID uint64) (types.CDP, error) {
+	if cdpID == 0 {
+		cdps := k.GetCdpsByOwnerAndCollateralType(ctx, owner, collateralType)
+		switch len(cdps) {
+		case 0:
+			return types.CDP{}, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, collateral type %s", owner, collateralType)
+		case 1:
+			return cdps[0], nil
+		default:
+			return types.CDP{}, sdkerrors.Wrapf(types.ErrCdpIDRequired, "owner %s has %d cdps of collateral type %s", owner, len(cdps), collateralType)
+		}
+	}
+	cdp, found := k.GetCDP(ctx, collateralType, cdpID)
+	if !found || !cdp.Owner.Equals(owner) {
+		return types.CDP{}, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, collateral type %s, id %d", owner, collateralType, cdpID)
+	}
+	return cdp, nil
+}
+
 // GetCDP returns the cdp associated with a particular collateral denom and id
 func (k Keeper) GetCDP(ctx sdk.Context, collateralType string, cdpID uint64) (types.CDP, bool) {
 	// get store
@@ -351,6 +394,28 @@ func (k Keeper) IndexCdpByCollateralRatio(ctx sdk.Context, collateralType string
 	store.Set(types.CollateralRatioKey(collateralType, id, collateralRatio), types.GetCdpIDBytes(id))
 }
 
+// IndexMultiCollateralCdp sets the id of a multi-collateral cdp in the store, indexed by its collateral type.
+// Multi-collateral cdps are not indexed by collateral to debt ratio, as their ratio depends on the price of every collateral type in their basket.
+func (k Keeper) IndexMultiCollateralCdp(ctx sdk.Context, collateralType string, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCollateralIndexPrefix)
+	store.Set(types.CdpKey(collateralType, id), types.GetCdpIDBytes(id))
+}
+
+// RemoveMultiCollateralIndex deletes the cdp id from the store's index of multi-collateral cdps
+func (k Keeper) RemoveMultiCollateralIndex(ctx sdk.Context, collateralType string, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCollateralIndexPrefix)
+	store.Delete(types.CdpKey(collateralType, id))
+}
+
+// indexCdpForLiquidation indexes a multi-collateral cdp by collateral type and any other cdp by collateral to debt ratio
+func (k Keeper) indexCdpForLiquidation(ctx sdk.Context, cdp types.CDP, collateralRatio sdk.Dec) {
+	if cdp.IsMultiCollateral() {
+		k.IndexMultiCollateralCdp(ctx, cdp.Type, cdp.ID)
+		return
+	}
+	k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, collateralRatio)
+}
+
 // RemoveCdpCollateralRatioIndex deletes the cdp id from the store's index of cdps by collateral type and collateral to debt ratio
 func (k Keeper) RemoveCdpCollateralRatioIndex(ctx sdk.Context, collateralType string, id uint64, collateralRatio sdk.Dec) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CollateralRatioIndexPrefix)
@@ -456,8 +521,8 @@ func (k Keeper) ValidateDebtLimit(ctx sdk.Context, collateralType string, princi
 }
 
 // ValidateCollateralizationRatio validate that adding the input principal doesn't put the cdp below the liquidation ratio
-func (k Keeper) ValidateCollateralizationRatio(ctx sdk.Context, collateral sdk.Coin, collateralType string, principal sdk.Coin, fees sdk.Coin) error {
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, collateral, collateralType, principal, fees, spot)
+func (k Keeper) ValidateCollateralizationRatio(ctx sdk.Context, collateral sdk.Coin, collateralType string, basket types.BasketCollaterals, principal sdk.Coin, fees sdk.Coin) error {
+	collateralizationRatio, err := k.CalculateBasketCollateralizationRatio(ctx, collateral, collateralType, basket, principal, fees, spot)
 	if err != nil {
 		return err
 	}
@@ -509,7 +574,7 @@ func (k Keeper) LoadAugmentedCDP(ctx sdk.Context, cdp types.CDP) types.Augmented
 		cdp.InterestFactor = globalInterestFactor
 	}
 	// calculate collateralization ratio
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
+	collateralizationRatio, err := k.CalculateBasketCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Basket, cdp.Principal, cdp.AccumulatedFees, liquidation)
 	if err != nil {
 		return types.AugmentedCDP{CDP: cdp}
 	}
@@ -537,7 +602,7 @@ func (k Keeper) LoadCDPResponse(ctx sdk.Context, cdp types.CDP) types.CDPRespons
 		cdp.InterestFactor = globalInterestFactor
 	}
 	// calculate collateralization ratio
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
+	collateralizationRatio, err := k.CalculateBasketCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Basket, cdp.Principal, cdp.AccumulatedFees, liquidation)
 	if err != nil {
 		return types.CDPResponse{
 			ID:              cdp.ID,
@@ -548,6 +613,8 @@ func (k Keeper) LoadCDPResponse(ctx sdk.Context, cdp types.CDP) types.CDPRespons
 			AccumulatedFees: cdp.AccumulatedFees,
 			FeesUpdated:     cdp.FeesUpdated,
 			InterestFactor:  cdp.InterestFactor.String(),
+			Managers:        cdp.ManagerStrings(),
+			Basket:          cdp.Basket,
 		}
 	}
 	// convert collateral value to debt coin
@@ -563,6 +630,64 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 	if collateral.IsZero() {
 		return sdk.ZeroDec(), nil
 	}
+	collateralValue, err := k.calculateCollateralValue(ctx, collateral, collateralType, pfType)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	prinicpalBaseUnits := k.convertDebtToBaseUnits(ctx, principal)
+	principalTotal := prinicpalBaseUnits
+	feeBaseUnits := k.convertDebtToBaseUnits(ctx, fees)
+	principalTotal = principalTotal.Add(feeBaseUnits)
+
+	collateralRatio := collateralValue.Quo(principalTotal)
+	return collateralRatio, nil
+}
+
+// CalculateBasketCollateralizationRatio returns the risk-weighted collateralization ratio of the input collateral and basket to the input debt plus fees.
+// Each basket collateral's value is scaled by the liquidation ratio of the collateral type over that of the basket collateral's type,
+// so the ratio can be compared to the collateral type's liquidation ratio as for a cdp without a basket.
+func (k Keeper) CalculateBasketCollateralizationRatio(ctx sdk.Context, collateral sdk.Coin, collateralType string, basket types.BasketCollaterals, principal sdk.Coin, fees sdk.Coin, pfType pricefeedType) (sdk.Dec, error) {
+	if len(basket) == 0 {
+		return k.CalculateCollateralizationRatio(ctx, collateral, collateralType, principal, fees, pfType)
+	}
+	weightedValue, err := k.calculateWeightedBasketValue(ctx, collateralType, basket, pfType)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !collateral.IsZero() {
+		collateralValue, err := k.calculateCollateralValue(ctx, collateral, collateralType, pfType)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		weightedValue = weightedValue.Add(collateralValue)
+	}
+
+	principalTotal := k.convertDebtToBaseUnits(ctx, principal).Add(k.convertDebtToBaseUnits(ctx, fees))
+	return weightedValue.Quo(principalTotal), nil
+}
+
+// calculateWeightedBasketValue returns the value of the basket in debt base units, with each basket collateral's value
+// scaled by the liquidation ratio of the collateral type over that of the basket collateral's type.
+func (k Keeper) calculateWeightedBasketValue(ctx sdk.Context, collateralType string, basket types.BasketCollaterals, pfType pricefeedType) (sdk.Dec, error) {
+	weightedValue := sdk.ZeroDec()
+	liquidationRatio := k.getLiquidationRatio(ctx, collateralType)
+	for _, bc := range basket {
+		cp, found := k.GetCollateral(ctx, bc.Type)
+		if !found {
+			return sdk.Dec{}, sdkerrors.Wrap(types.ErrCollateralNotSupported, bc.Type)
+		}
+		basketValue, err := k.calculateCollateralValue(ctx, bc.Amount, bc.Type, pfType)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		weightedValue = weightedValue.Add(basketValue.Mul(liquidationRatio).Quo(cp.LiquidationRatio))
+	}
+	return weightedValue, nil
+}
+
+// calculateCollateralValue returns the value of the input collateral in debt base units, using the collateral type's spot or liquidation price
+func (k Keeper) calculateCollateralValue(ctx sdk.Context, collateral sdk.Coin, collateralType string, pfType pricefeedType) (sdk.Dec, error) {
 	var marketID string
 	switch pfType {
 	case spot:
@@ -578,15 +703,7 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 		return sdk.Dec{}, err
 	}
 	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, collateral, collateralType)
-	collateralValue := collateralBaseUnits.Mul(price.Price)
-
-	prinicpalBaseUnits := k.convertDebtToBaseUnits(ctx, principal)
-	principalTotal := prinicpalBaseUnits
-	feeBaseUnits := k.convertDebtToBaseUnits(ctx, fees)
-	principalTotal = principalTotal.Add(feeBaseUnits)
-
-	collateralRatio := collateralValue.Quo(principalTotal)
-	return collateralRatio, nil
+	return collateralBaseUnits.Mul(price.Price), nil
 }
 
 // CalculateCollateralizationRatioFromAbsoluteRatio takes a coin's denom and an absolute ratio and returns the respective collateralization ratio
@@ -628,10 +745,11 @@ func (k Keeper) GetMarketStatus(ctx sdk.Context, marketID string) bool {
 	return bz != nil
 }
 
-// UpdatePricefeedStatus determines if the price of an asset is available and updates the global status of the market
+// UpdatePricefeedStatus determines if the price of an asset is available and not flagged as stale by the pricefeed
+// circuit breaker, and updates the global status of the market
 func (k Keeper) UpdatePricefeedStatus(ctx sdk.Context, marketID string) (ok bool) {
 	_, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
-	if err != nil {
+	if err != nil || k.pricefeedKeeper.IsMarketStale(ctx, marketID) {
 		k.SetMarketStatus(ctx, marketID, false)
 		return false
 	}
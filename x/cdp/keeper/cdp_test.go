@@ -15,6 +15,7 @@ import (
 	"github.com/mokitanetwork/aether/app"
 	"github.com/mokitanetwork/aether/x/cdp/keeper"
 	"github.com/mokitanetwork/aether/x/cdp/types"
+	pricefeedtypes "github.com/mokitanetwork/aether/x/pricefeed/types"
 )
 
 type CdpTestSuite struct {
@@ -106,8 +107,15 @@ func (suite *CdpTestSuite) TestAddCdp() {
 
 	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("lol", 100), c("usdx", 10), "lol-a")
 	suite.Require().True(errors.Is(err, types.ErrCollateralNotSupported))
-	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 100), c("usdx", 10), "xrp-a")
-	suite.Require().True(errors.Is(err, types.ErrCdpAlreadyExists))
+
+	// owners can open several cdps of the same collateral type
+	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 100000000), c("usdx", 10000000), "xrp-a")
+	suite.NoError(err)
+	id = suite.keeper.GetNextCdpID(suite.ctx)
+	suite.Equal(uint64(4), id)
+	suite.Len(suite.keeper.GetCdpsByOwnerAndCollateralType(suite.ctx, addrs[0], "xrp-a"), 2)
+	tp = suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+	suite.Equal(i(20000000), tp)
 }
 
 func (suite *CdpTestSuite) TestGetCollateral() {
@@ -178,6 +186,34 @@ func (suite *CdpTestSuite) TestGetSetCdpByOwnerAndCollateralType() {
 	suite.NotPanics(func() { suite.keeper.IndexCdpByOwner(suite.ctx, cdp) })
 }
 
+func (suite *CdpTestSuite) TestGetOwnerCdp() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	cdp1 := types.NewCDP(1, addrs[0], c("xrp", 1), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()), sdk.OneDec())
+	cdp2 := types.NewCDP(2, addrs[0], c("xrp", 2), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()), sdk.OneDec())
+	suite.NoError(suite.keeper.SetCDP(suite.ctx, cdp1))
+	suite.keeper.IndexCdpByOwner(suite.ctx, cdp1)
+
+	t, err := suite.keeper.GetOwnerCdp(suite.ctx, addrs[0], "xrp-a", 0)
+	suite.NoError(err)
+	suite.Equal(cdp1, t)
+
+	suite.NoError(suite.keeper.SetCDP(suite.ctx, cdp2))
+	suite.keeper.IndexCdpByOwner(suite.ctx, cdp2)
+	suite.Equal(types.CDPs{cdp1, cdp2}, suite.keeper.GetCdpsByOwnerAndCollateralType(suite.ctx, addrs[0], "xrp-a"))
+
+	_, err = suite.keeper.GetOwnerCdp(suite.ctx, addrs[0], "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrCdpIDRequired))
+	t, err = suite.keeper.GetOwnerCdp(suite.ctx, addrs[0], "xrp-a", 2)
+	suite.NoError(err)
+	suite.Equal(cdp2, t)
+	_, err = suite.keeper.GetOwnerCdp(suite.ctx, addrs[0], "btc-a", 2)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	_, err = suite.keeper.GetOwnerCdp(suite.ctx, addrs[1], "xrp-a", 2)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	_, err = suite.keeper.GetOwnerCdp(suite.ctx, addrs[1], "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+}
+
 func (suite *CdpTestSuite) TestCalculateCollateralToDebtRatio() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
 	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], c("xrp", 3), "xrp-a", c("usdx", 1), tmtime.Canonical(time.Now()), sdk.OneDec())
@@ -390,6 +426,30 @@ func (suite *CdpTestSuite) TestMarketStatus() {
 	suite.Require().False(status)
 }
 
+func (suite *CdpTestSuite) TestAddCdp_StaleMarket() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, addrs[0])
+	err := suite.app.FundAccount(suite.ctx, acc.GetAddress(), cs(c("xrp", 200000000)))
+	suite.Require().NoError(err)
+	ak.SetAccount(suite.ctx, acc)
+
+	pk := suite.app.GetPriceFeedKeeper()
+	pk.SetMarketStale(suite.ctx, "xrp:usd", pricefeedtypes.StaleReasonMaxPriceChange)
+
+	// the last accepted price is still stored, but a stale market is treated as down
+	ok := suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd")
+	suite.False(ok)
+	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 100000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrPricefeedDown))
+
+	pk.ClearMarketStale(suite.ctx, "xrp:usd", pricefeedtypes.AttributeValueProposal)
+	ok = suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd")
+	suite.True(ok)
+	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 100000000), c("usdx", 10000000), "xrp-a")
+	suite.NoError(err)
+}
+
 func TestCdpTestSuite(t *testing.T) {
 	suite.Run(t, new(CdpTestSuite))
 }
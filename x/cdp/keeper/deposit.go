@@ -11,15 +11,21 @@ import (
 )
 
 // DepositCollateral adds collateral to a cdp
-func (k Keeper) DepositCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+// A cdpID of zero selects the owner's only cdp of the collateral type.
+// Collateral deposited by one of the cdp's managers is credited to the owner's deposit.
+func (k Keeper) DepositCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string, cdpID uint64) error {
+	err := k.ValidateNoGlobalSettlement(ctx)
+	if err != nil {
+		return err
+	}
 	// check that collateral exists and has a functioning pricefeed
-	err := k.ValidateCollateral(ctx, collateral, collateralType)
+	err = k.ValidateCollateral(ctx, collateral, collateralType)
 	if err != nil {
 		return err
 	}
-	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
-	if !found {
-		return sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
 	}
 	err = k.ValidateBalance(ctx, collateral, depositor)
 	if err != nil {
@@ -28,11 +34,15 @@ func (k Keeper) DepositCollateral(ctx sdk.Context, owner, depositor sdk.AccAddre
 	k.hooks.BeforeCDPModified(ctx, cdp)
 	cdp = k.SynchronizeInterest(ctx, cdp)
 
-	deposit, found := k.GetDeposit(ctx, cdp.ID, depositor)
+	creditor := depositor
+	if cdp.IsManager(depositor) {
+		creditor = cdp.Owner
+	}
+	deposit, found := k.GetDeposit(ctx, cdp.ID, creditor)
 	if found {
 		deposit.Amount = deposit.Amount.Add(collateral)
 	} else {
-		deposit = types.NewDeposit(cdp.ID, depositor, collateral)
+		deposit = types.NewDeposit(cdp.ID, creditor, collateral)
 	}
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.NewCoins(collateral))
 	if err != nil {
@@ -56,14 +66,23 @@ func (k Keeper) DepositCollateral(ctx sdk.Context, owner, depositor sdk.AccAddre
 }
 
 // WithdrawCollateral removes collateral from a cdp if it does not put the cdp below the liquidation ratio
-func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
-	err := k.ValidateCollateral(ctx, collateral, collateralType)
+// A cdpID of zero selects the owner's only cdp of the collateral type.
+// A manager of the cdp withdraws from the owner's deposit and the collateral is returned to the owner.
+func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string, cdpID uint64) error {
+	err := k.ValidateNoGlobalSettlement(ctx)
 	if err != nil {
 		return err
 	}
-	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
-	if !found {
-		return sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateral.Denom)
+	err = k.ValidateCollateral(ctx, collateral, collateralType)
+	if err != nil {
+		return err
+	}
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
+	}
+	if cdp.IsManager(depositor) {
+		depositor = cdp.Owner
 	}
 	deposit, found := k.GetDeposit(ctx, cdp.ID, depositor)
 	if !found {
@@ -75,7 +94,7 @@ func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner, depositor sdk.AccAddr
 	k.hooks.BeforeCDPModified(ctx, cdp)
 	cdp = k.SynchronizeInterest(ctx, cdp)
 
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral.Sub(collateral), cdp.Type, cdp.Principal, cdp.AccumulatedFees, spot)
+	collateralizationRatio, err := k.CalculateBasketCollateralizationRatio(ctx, cdp.Collateral.Sub(collateral), cdp.Type, cdp.Basket, cdp.Principal, cdp.AccumulatedFees, spot)
 	if err != nil {
 		return err
 	}
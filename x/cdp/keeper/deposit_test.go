@@ -69,7 +69,7 @@ func (suite *DepositTestSuite) TestGetSetDeposit() {
 }
 
 func (suite *DepositTestSuite) TestDepositCollateral() {
-	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a")
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a", 0)
 	suite.NoError(err)
 	d, found := suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[0])
 	suite.True(found)
@@ -86,13 +86,13 @@ func (suite *DepositTestSuite) TestDepositCollateral() {
 	acc := ak.GetAccount(suite.ctx, suite.addrs[0])
 	suite.Equal(i(90000000), bk.GetBalance(suite.ctx, acc.GetAddress(), "xrp").Amount)
 
-	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 1), "btc-a")
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 1), "btc-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
 
-	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], c("xrp", 1), "xrp-a")
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], c("xrp", 1), "xrp-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
 
-	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a")
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a", 0)
 	suite.NoError(err)
 	d, found = suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[1])
 	suite.True(found)
@@ -103,22 +103,48 @@ func (suite *DepositTestSuite) TestDepositCollateral() {
 	suite.True(ds[1].Equals(td))
 }
 
+func (suite *DepositTestSuite) TestDepositCollateralMultipleCdps() {
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 100000000), c("usdx", 10000000), "xrp-a")
+	suite.NoError(err)
+
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrCdpIDRequired))
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("btc", 1), "btc-a", 2)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10000000), "xrp-a", 2)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a", 2)
+	suite.NoError(err)
+	cd, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
+	suite.Equal(c("xrp", 110000000), cd.Collateral)
+	cd, _ = suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Equal(c("xrp", 400000000), cd.Collateral)
+
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a", 1)
+	suite.Require().True(errors.Is(err, types.ErrDepositNotFound))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a", 2)
+	suite.NoError(err)
+	cd, _ = suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
+	suite.Equal(c("xrp", 100000000), cd.Collateral)
+}
+
 func (suite *DepositTestSuite) TestWithdrawCollateral() {
-	err := suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 400000000), "xrp-a")
+	err := suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 400000000), "xrp-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 321000000), "xrp-a")
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 321000000), "xrp-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], c("xrp", 10000000), "xrp-a")
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], c("xrp", 10000000), "xrp-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
 
 	cd, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
 	cd.AccumulatedFees = c("usdx", 1)
 	err = suite.keeper.SetCDP(suite.ctx, cd)
 	suite.NoError(err)
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 320000000), "xrp-a")
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 320000000), "xrp-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
 
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a")
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a", 0)
 	suite.NoError(err)
 	dep, _ := suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[0])
 	td := types.NewDeposit(uint64(1), suite.addrs[0], c("xrp", 390000000))
@@ -130,7 +156,7 @@ func (suite *DepositTestSuite) TestWithdrawCollateral() {
 	acc := ak.GetAccount(suite.ctx, suite.addrs[0])
 	suite.Equal(i(110000000), bk.GetBalance(suite.ctx, acc.GetAddress(), "xrp").Amount)
 
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a")
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrDepositNotFound))
 }
 
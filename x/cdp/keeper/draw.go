@@ -10,13 +10,18 @@ import (
 )
 
 // AddPrincipal adds debt to a cdp if the additional debt does not put the cdp below the liquidation ratio
-func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralType string, principal sdk.Coin) error {
+// A cdpID of zero selects the owner's only cdp of the collateral type.
+func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralType string, cdpID uint64, principal sdk.Coin) error {
 	// validation
-	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
-	if !found {
-		return sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", owner, collateralType)
+	err := k.ValidateNoGlobalSettlement(ctx)
+	if err != nil {
+		return err
+	}
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
 	}
-	err := k.ValidatePrincipalDraw(ctx, principal, cdp.Principal.Denom)
+	err = k.ValidatePrincipalDraw(ctx, principal, cdp.Principal.Denom)
 	if err != nil {
 		return err
 	}
@@ -28,7 +33,7 @@ func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralTy
 	k.hooks.BeforeCDPModified(ctx, cdp)
 	cdp = k.SynchronizeInterest(ctx, cdp)
 
-	err = k.ValidateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal.Add(principal), cdp.AccumulatedFees)
+	err = k.ValidateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Basket, cdp.Principal.Add(principal), cdp.AccumulatedFees)
 	if err != nil {
 		return err
 	}
@@ -71,19 +76,28 @@ func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralTy
 
 // RepayPrincipal removes debt from the cdp
 // If all debt is repaid, the collateral is returned to depositors and the cdp is removed from the store
-func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralType string, payment sdk.Coin) error {
+// A cdpID of zero selects the owner's only cdp of the collateral type.
+// The payment is taken from the payer, who must be the owner or one of the cdp's managers.
+func (k Keeper) RepayPrincipal(ctx sdk.Context, owner, payer sdk.AccAddress, collateralType string, cdpID uint64, payment sdk.Coin) error {
 	// validation
-	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
-	if !found {
-		return sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", owner, collateralType)
+	err := k.ValidateNoGlobalSettlement(ctx)
+	if err != nil {
+		return err
+	}
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
+	}
+	if !payer.Equals(owner) && !cdp.IsManager(payer) {
+		return sdkerrors.Wrapf(types.ErrNotOwnerOrManager, "payer %s, cdp %d", payer, cdp.ID)
 	}
 
-	err := k.ValidatePaymentCoins(ctx, cdp, payment)
+	err = k.ValidatePaymentCoins(ctx, cdp, payment)
 	if err != nil {
 		return err
 	}
 
-	err = k.ValidateBalance(ctx, payment, owner)
+	err = k.ValidateBalance(ctx, payment, payer)
 	if err != nil {
 		return err
 	}
@@ -101,7 +115,7 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateral
 		return err
 	}
 	// send the payment from the sender to the cpd module
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(feePayment.Add(principalPayment)))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.NewCoins(feePayment.Add(principalPayment)))
 	if err != nil {
 		return err
 	}
@@ -188,6 +202,7 @@ func (k Keeper) ValidatePaymentCoins(ctx sdk.Context, cdp types.CDP, payment sdk
 }
 
 // ReturnCollateral returns collateral to depositors on a cdp and removes deposits from the store
+// Basket collateral is returned to the cdp owner.
 func (k Keeper) ReturnCollateral(ctx sdk.Context, cdp types.CDP) {
 	deposits := k.GetDeposits(ctx, cdp.ID)
 	for _, deposit := range deposits {
@@ -196,6 +211,11 @@ func (k Keeper) ReturnCollateral(ctx sdk.Context, cdp types.CDP) {
 		}
 		k.DeleteDeposit(ctx, cdp.ID, deposit.Depositor)
 	}
+	for _, bc := range cdp.Basket {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cdp.Owner, sdk.NewCoins(bc.Amount)); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // calculatePayment divides the input payment into the portions that will be used to repay fees and principal
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+// FlashMint mints stable asset to the sender, executes msgs, then takes the minted amount plus the flash mint fee back from the sender.
+// The minted amount is burned and the fee is sent to the liquidator as surplus. If any msg fails, or the sender cannot repay, the
+// flash mint fails and none of its state changes are committed.
+func (k Keeper) FlashMint(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, msgs []sdk.Msg) error {
+	err := k.ValidateNoGlobalSettlement(ctx)
+	if err != nil {
+		return err
+	}
+	params := k.GetParams(ctx)
+	if !params.FlashMintParam.IsEnabled() {
+		return types.ErrFlashMintDisabled
+	}
+	if amount.Denom != params.DebtParam.Denom {
+		return sdkerrors.Wrapf(types.ErrDebtNotSupported, "cannot flash mint %s, only %s", amount.Denom, params.DebtParam.Denom)
+	}
+	if amount.Amount.GT(params.FlashMintParam.MaxAmount) {
+		return sdkerrors.Wrapf(types.ErrExceedsFlashMintLimit, "%s > %s%s", amount, params.FlashMintParam.MaxAmount, amount.Denom)
+	}
+	fee := sdk.NewCoin(amount.Denom, amount.Amount.ToDec().Mul(params.FlashMintParam.Fee).Ceil().TruncateInt())
+
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	err = k.executeFlashMintMsgs(ctx, sender, msgs)
+	if err != nil {
+		return err
+	}
+
+	repayment := amount.Add(fee)
+	if k.bankKeeper.SpendableCoins(ctx, sender).AmountOf(repayment.Denom).LT(repayment.Amount) {
+		return sdkerrors.Wrapf(types.ErrFlashMintNotRepaid, "%s must hold %s", sender, repayment)
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(repayment))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+	if fee.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(fee))
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFlashMint,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+	return nil
+}
+
+// executeFlashMintMsgs routes each msg to its handler in order, emitting the events of each msg.
+func (k Keeper) executeFlashMintMsgs(ctx sdk.Context, sender sdk.AccAddress, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		err := types.ValidateFlashMintMsg(sender, msg)
+		if err != nil {
+			return err
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return sdkerrors.Wrapf(types.ErrInvalidFlashMintMsg, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute flash mint message %s", sdk.MsgTypeURL(msg))
+		}
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/cdp/keeper"
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+type FlashMintTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *FlashMintTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(10)
+	authGS := app.NewFundedGenStateWithCoins(
+		cdc,
+		[]sdk.Coins{
+			cs(c("xrp", 500000000)),
+		},
+		addrs[0:1],
+	)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 20000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.FlashMintParam = types.NewFlashMintParam(i(1000000000), d("0.001"))
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *FlashMintTestSuite) TestFlashMint() {
+	bk := suite.app.GetBankKeeper()
+	ak := suite.app.GetAccountKeeper()
+
+	repay := types.NewMsgRepayDebt(suite.addrs[0], "xrp-a", 0, c("usdx", 10000000))
+	err := suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 10000000), []sdk.Msg{&repay})
+	suite.Require().NoError(err)
+
+	// the flash minted usdx repaid half of the cdp's debt, and was then repaid from the sender's own usdx with a 0.01 usdx fee
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 10000000), cdp.Principal)
+	suite.Equal(c("usdx", 9990000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdx"))
+	suite.Equal(c("usdx", 10000), bk.GetBalance(suite.ctx, ak.GetModuleAddress(types.LiquidatorMacc), "usdx"))
+	suite.Equal(c("usdx", 10000000), bk.GetSupply(suite.ctx, "usdx"))
+}
+
+func (suite *FlashMintTestSuite) TestFlashMintNotRepaid() {
+	// the sender holds 30 usdx while the messages execute, leaving 5 usdx to repay 10.01 usdx
+	send := banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], cs(c("usdx", 25000000)))
+	err := suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 10000000), []sdk.Msg{send})
+	suite.True(errors.Is(err, types.ErrFlashMintNotRepaid))
+}
+
+func (suite *FlashMintTestSuite) TestFlashMintInvalid() {
+	send := banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], cs(c("usdx", 1000000)))
+
+	err := suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000000001), []sdk.Msg{send})
+	suite.True(errors.Is(err, types.ErrExceedsFlashMintLimit))
+	err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("xrp", 1000000), []sdk.Msg{send})
+	suite.True(errors.Is(err, types.ErrDebtNotSupported))
+
+	// messages signed by another address cannot be executed
+	other := banktypes.NewMsgSend(suite.addrs[1], suite.addrs[0], cs(c("usdx", 1000000)))
+	err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000000), []sdk.Msg{other})
+	suite.True(errors.Is(err, types.ErrInvalidFlashMintMsg))
+
+	// failed messages fail the flash mint
+	repay := types.NewMsgRepayDebt(suite.addrs[0], "btc-a", 0, c("usdx", 1000000))
+	err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000000), []sdk.Msg{&repay})
+	suite.True(errors.Is(err, types.ErrCdpNotFound))
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.FlashMintParam = types.DefaultFlashMintParam
+	suite.keeper.SetParams(suite.ctx, params)
+	err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000000), []sdk.Msg{send})
+	suite.True(errors.Is(err, types.ErrFlashMintDisabled))
+}
+
+func (suite *FlashMintTestSuite) TestFlashMintGlobalSettlement() {
+	err := suite.keeper.StartGlobalSettlement(suite.ctx)
+	suite.Require().NoError(err)
+
+	send := banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], cs(c("usdx", 1000000)))
+	err = suite.keeper.FlashMint(suite.ctx, suite.addrs[0], c("usdx", 1000000), []sdk.Msg{send})
+	suite.True(errors.Is(err, types.ErrGlobalSettlementActive))
+}
+
+func TestFlashMintTestSuite(t *testing.T) {
+	suite.Run(t, new(FlashMintTestSuite))
+}
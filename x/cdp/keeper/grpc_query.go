@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"sort"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -88,6 +90,76 @@ func (s QueryServer) TotalPrincipal(c context.Context, req *types.QueryTotalPrin
 	}, nil
 }
 
+// StabilityFee queries the effective per second stability fee of a given collateral type.
+func (s QueryServer) StabilityFee(c context.Context, req *types.QueryStabilityFeeRequest) (*types.QueryStabilityFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var collateralParams types.CollateralParams
+	if req.CollateralType != "" {
+		cp, found := s.keeper.GetCollateral(ctx, req.CollateralType)
+		if !found {
+			return nil, sdkerrors.Wrap(types.ErrCollateralNotSupported, req.CollateralType)
+		}
+		collateralParams = append(collateralParams, cp)
+	} else {
+		collateralParams = s.keeper.GetParams(ctx).CollateralParams
+	}
+
+	var stabilityFees []types.StabilityFeeResponse
+	for _, cp := range collateralParams {
+		stabilityFees = append(stabilityFees, types.StabilityFeeResponse{
+			CollateralType: cp.Type,
+			StabilityFee:   s.keeper.GetStabilityFee(ctx, cp),
+			Utilization:    s.keeper.GetDebtUtilization(ctx, cp),
+			ModelActive:    cp.StabilityFeeModel != nil,
+		})
+	}
+
+	return &types.QueryStabilityFeeResponse{
+		StabilityFees: stabilityFees,
+	}, nil
+}
+
+// GlobalSettlement queries the settlement prices and redemption progress of the cdp system's global settlement.
+func (s QueryServer) GlobalSettlement(c context.Context, req *types.QueryGlobalSettlementRequest) (*types.QueryGlobalSettlementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	settlement, found := s.keeper.GetGlobalSettlement(ctx)
+	if !found {
+		return nil, types.ErrGlobalSettlementNotActive
+	}
+
+	return &types.QueryGlobalSettlementResponse{
+		GlobalSettlement: settlement,
+	}, nil
+}
+
+// PsmReserves queries the stablecoins held by the peg stability module, or the reserve of one stablecoin if a denom is given.
+func (s QueryServer) PsmReserves(c context.Context, req *types.QueryPsmReservesRequest) (*types.QueryPsmReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	debtDenom := s.keeper.GetParams(ctx).DebtParam.Denom
+	var reserves []types.PsmReserveResponse
+	for _, pp := range s.keeper.GetParams(ctx).PsmParams {
+		if req.Denom != "" && req.Denom != pp.Denom {
+			continue
+		}
+		reserve := s.keeper.GetPsmReserve(ctx, pp.Denom)
+		reserves = append(reserves, types.PsmReserveResponse{
+			Reserve:   reserve,
+			Minted:    sdk.NewCoin(debtDenom, s.keeper.convertPsmToDebt(ctx, pp, reserve.Amount)),
+			DebtLimit: pp.DebtLimit,
+		})
+	}
+	if req.Denom != "" && len(reserves) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrPsmAssetNotSupported, req.Denom)
+	}
+
+	return &types.QueryPsmReservesResponse{
+		Reserves: reserves,
+	}, nil
+}
+
 // TotalCollateral queries the total collateral of a given collateral type.
 func (s QueryServer) TotalCollateral(c context.Context, req *types.QueryTotalCollateralRequest) (*types.QueryTotalCollateralResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -139,6 +211,7 @@ func (s QueryServer) TotalCollateral(c context.Context, req *types.QueryTotalCol
 			for _, cdp := range cdps {
 				collateral = collateral.Add(cdp.Collateral.Amount)
 			}
+			collateral = collateral.Add(s.keeper.getTotalBasketCollateral(ctx, collateralTypes[i]))
 
 			totalCollateral = totalCollateral.Sub(collateral)
 
@@ -186,7 +259,7 @@ func (s QueryServer) Cdps(c context.Context, req *types.QueryCdpsRequest) (*type
 	}, nil
 }
 
-// Cdp queries a CDP with the input owner address and collateral type.
+// Cdp queries a CDP with the input owner address, collateral type and optional id.
 func (s QueryServer) Cdp(c context.Context, req *types.QueryCdpRequest) (*types.QueryCdpResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -200,9 +273,9 @@ func (s QueryServer) Cdp(c context.Context, req *types.QueryCdpRequest) (*types.
 		return nil, sdkerrors.Wrap(types.ErrInvalidCollateral, req.CollateralType)
 	}
 
-	cdp, found := s.keeper.GetCdpByOwnerAndCollateralType(ctx, owner, req.CollateralType)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", req.Owner, req.CollateralType)
+	cdp, err := s.keeper.GetOwnerCdp(ctx, owner, req.CollateralType, req.CdpID)
+	if err != nil {
+		return nil, err
 	}
 
 	cdpResponse := s.keeper.LoadCDPResponse(ctx, cdp)
@@ -212,7 +285,7 @@ func (s QueryServer) Cdp(c context.Context, req *types.QueryCdpRequest) (*types.
 	}, nil
 }
 
-// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
+// Deposits queries deposits associated with the CDP owned by an address for a collateral type and optional id.
 func (s QueryServer) Deposits(c context.Context, req *types.QueryDepositsRequest) (*types.QueryDepositsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -226,9 +299,9 @@ func (s QueryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 		return nil, sdkerrors.Wrap(types.ErrInvalidCollateral, req.CollateralType)
 	}
 
-	cdp, found := s.keeper.GetCdpByOwnerAndCollateralType(ctx, owner, req.CollateralType)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", req.Owner, req.CollateralType)
+	cdp, err := s.keeper.GetOwnerCdp(ctx, owner, req.CollateralType, req.CdpID)
+	if err != nil {
+		return nil, err
 	}
 
 	deposits := s.keeper.GetDeposits(ctx, cdp.ID)
@@ -238,6 +311,120 @@ func (s QueryServer) Deposits(c context.Context, req *types.QueryDepositsRequest
 	}, nil
 }
 
+// CdpRisk queries the liquidation risk of a CDP with the input owner address, collateral type and optional id.
+func (s QueryServer) CdpRisk(c context.Context, req *types.QueryCdpRiskRequest) (*types.QueryCdpRiskResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address")
+	}
+
+	_, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !valid {
+		return nil, sdkerrors.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+
+	cdp, err := s.keeper.GetOwnerCdp(ctx, owner, req.CollateralType, req.CdpID)
+	if err != nil {
+		return nil, err
+	}
+
+	risk, err := s.keeper.GetCdpRisk(ctx, cdp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCdpRiskResponse{
+		CdpRisk: risk,
+	}, nil
+}
+
+// RiskyCdps pages through the collateral ratio index of a collateral type, returning the risk of each CDP below the
+// requested collateralization ratio, riskiest first. CDPs are selected by the ratio they were last indexed at, so fees
+// accrued since then are not taken into account, and multi-collateral CDPs, which are not indexed by ratio, are not returned.
+func (s QueryServer) RiskyCdps(c context.Context, req *types.QueryRiskyCdpsRequest) (*types.QueryRiskyCdpsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	cp, valid := s.keeper.GetCollateral(ctx, req.CollateralType)
+	if !valid {
+		return nil, sdkerrors.Wrap(types.ErrInvalidCollateral, req.CollateralType)
+	}
+	ratio := cp.LiquidationRatio
+	if req.Ratio != "" {
+		var err error
+		ratio, err = sdk.NewDecFromStr(req.Ratio)
+		if err != nil || !ratio.IsPositive() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid ratio %s", req.Ratio)
+		}
+	}
+
+	price, err := s.keeper.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil {
+		return nil, err
+	}
+	priceDivRatio := price.Price.Quo(ratio)
+	if priceDivRatio.IsZero() {
+		priceDivRatio = sdk.SmallestDec()
+	}
+	// the index holds collateral:debt ratios, which are below 1 / (price / ratio) for cdps below the collateralization ratio
+	normalizedRatio := sdk.OneDec().Quo(priceDivRatio)
+
+	var risks types.CdpRiskResponses
+	indexPrefix := types.DenomIterKey(req.CollateralType)
+	indexStore := prefix.NewStore(prefix.NewStore(ctx.KVStore(s.keeper.key), types.CollateralRatioIndexPrefix), indexPrefix)
+	// the index is sorted by ratio, so only the cdps below the collateralization ratio are read
+	riskyStore := boundedStore{KVStore: indexStore, end: types.CollateralRatioBytes(normalizedRatio)}
+
+	pageRes, err := query.Paginate(riskyStore, req.Pagination, func(key []byte, value []byte) error {
+		_, cdpID, _ := types.SplitCollateralRatioKey(append(append([]byte{}, indexPrefix...), key...))
+		cdp, found := s.keeper.GetCDP(ctx, req.CollateralType, cdpID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrCdpNotFound, "%d", cdpID)
+		}
+		risk, err := s.keeper.GetCdpRisk(ctx, cdp)
+		if err != nil {
+			return err
+		}
+		risks = append(risks, risk)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRiskyCdpsResponse{
+		CdpRisks:   risks,
+		Pagination: pageRes,
+	}, nil
+}
+
+// boundedStore is a KVStore whose iterators stop before an end key, so paginating it never reads past the end key
+type boundedStore struct {
+	sdk.KVStore
+	end []byte
+}
+
+// Iterator iterates from start up to end or the store's end key, whichever is first
+func (s boundedStore) Iterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.Iterator(start, s.boundEnd(end))
+}
+
+// ReverseIterator iterates back from end or the store's end key, whichever is first, down to start
+func (s boundedStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.ReverseIterator(start, s.boundEnd(end))
+}
+
+func (s boundedStore) boundEnd(end []byte) []byte {
+	if end == nil || bytes.Compare(end, s.end) > 0 {
+		return s.end
+	}
+	return end
+}
+
 // FilterCDPs queries the store for all CDPs that match query req
 func GrpcFilterCDPs(ctx sdk.Context, k Keeper, req types.QueryCdpsRequest) (types.CDPResponses, error) {
 	// TODO: Ideally use query.Paginate() here over existing FilterCDPs. However
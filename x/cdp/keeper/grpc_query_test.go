@@ -117,6 +117,28 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryTotalPrincipal() {
 	}, "total busd principal should be 0")
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryStabilityFee() {
+	suite.addCdp()
+
+	res, err := suite.queryServer.StabilityFee(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Len(res.StabilityFees, 4, "stability fees should include all collateral params")
+
+	cp, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+	res, err = suite.queryServer.StabilityFee(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeeRequest{CollateralType: "xrp-a"})
+	suite.Require().NoError(err)
+	suite.Equal([]types.StabilityFeeResponse{{
+		CollateralType: "xrp-a",
+		StabilityFee:   cp.StabilityFee,
+		Utilization:    sdk.NewDec(10000000).QuoInt(cp.DebtLimit.Amount),
+		ModelActive:    false,
+	}}, res.StabilityFees)
+
+	_, err = suite.queryServer.StabilityFee(sdk.WrapSDKContext(suite.ctx), &types.QueryStabilityFeeRequest{CollateralType: "unknown"})
+	suite.Require().ErrorIs(err, types.ErrCollateralNotSupported)
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryTotalCollateral() {
 	suite.addCdp()
 
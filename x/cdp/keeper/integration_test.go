@@ -223,6 +223,9 @@ func NewCDPGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 				ConversionFactor: i(6),
 				DebtFloor:        i(10000000),
 			},
+			PsmParams: types.PsmParams{
+				types.NewPsmParam("erc20/usdc", i(6), c("usdx", 1000000000), d("0.001"), d("0.002")),
+			},
 		},
 		StartingCdpID: types.DefaultCdpStartingID,
 		DebtDenom:     types.DefaultDebtDenom,
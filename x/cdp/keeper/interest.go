@@ -82,6 +82,50 @@ func (k Keeper) AccumulateInterest(ctx sdk.Context, ctype string) error {
 	return nil
 }
 
+// GetStabilityFee returns the per second fee rate currently charged for a collateral type.
+// Collateral types without a stability fee model are charged their fixed stability fee.
+func (k Keeper) GetStabilityFee(ctx sdk.Context, cp types.CollateralParam) sdk.Dec {
+	if cp.StabilityFeeModel == nil {
+		return cp.StabilityFee
+	}
+	pegDeviation := sdk.ZeroDec()
+	if cp.StabilityFeeModel.PegMarketID != "" {
+		// an unavailable peg price leaves the fee on the utilization curve
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.StabilityFeeModel.PegMarketID)
+		if err == nil {
+			pegDeviation = sdk.OneDec().Sub(price.Price)
+		}
+	}
+	return CalculateStabilityFee(*cp.StabilityFeeModel, k.GetDebtUtilization(ctx, cp), pegDeviation)
+}
+
+// GetDebtUtilization returns the total principal of a collateral type divided by its debt limit, capped at 1
+func (k Keeper) GetDebtUtilization(ctx sdk.Context, cp types.CollateralParam) sdk.Dec {
+	if !cp.DebtLimit.Amount.IsPositive() {
+		return sdk.OneDec()
+	}
+	totalPrincipal := k.GetTotalPrincipal(ctx, cp.Type, cp.DebtLimit.Denom)
+	return sdk.MinDec(sdk.OneDec(), totalPrincipal.ToDec().QuoInt(cp.DebtLimit.Amount))
+}
+
+// CalculateStabilityFee calculates the per second fee rate of a stability fee model from the debt utilization
+// and the amount the peg market price is below 1.0. The rate is kept between 1.0 and the model's max rate.
+func CalculateStabilityFee(model types.StabilityFeeModel, utilization, pegDeviation sdk.Dec) sdk.Dec {
+	rate := model.BaseRate
+	if utilization.LTE(model.Kink) {
+		rate = rate.Add(utilization.Mul(model.BaseMultiplier))
+	} else {
+		rate = rate.Add(model.Kink.Mul(model.BaseMultiplier))
+		rate = rate.Add(utilization.Sub(model.Kink).Mul(model.JumpMultiplier))
+	}
+	rate = rate.Add(pegDeviation.Mul(model.PegMultiplier))
+
+	if rate.LT(sdk.OneDec()) {
+		return sdk.OneDec()
+	}
+	return sdk.MinDec(rate, model.MaxRate)
+}
+
 // CalculateInterestFactor calculates the simple interest scaling factor,
 // which is equal to: (per-second interest rate ** number of seconds elapsed)
 // Will return 1.000x, multiply by principal to get new principal with added interest
@@ -260,6 +260,75 @@ func (suite *InterestTestSuite) TestAccumulateInterest() {
 	}
 }
 
+func (suite *InterestTestSuite) TestCalculateStabilityFee() {
+	d := sdk.MustNewDecFromStr
+	model := types.NewStabilityFeeModel(d("1.000000001"), d("0.000000002"), d("0.5"), d("0.00000004"), "usdx:usd", d("0.0000001"), d("1.00000005"))
+
+	testCases := []struct {
+		name         string
+		utilization  sdk.Dec
+		pegDeviation sdk.Dec
+		expectedRate sdk.Dec
+	}{
+		{"zero utilization", sdk.ZeroDec(), sdk.ZeroDec(), d("1.000000001")},
+		{"below kink", d("0.25"), sdk.ZeroDec(), d("1.0000000015")},
+		{"at kink", d("0.5"), sdk.ZeroDec(), d("1.000000002")},
+		{"above kink", d("0.75"), sdk.ZeroDec(), d("1.000000012")},
+		{"full utilization", sdk.OneDec(), sdk.ZeroDec(), d("1.000000022")},
+		{"price below peg", sdk.ZeroDec(), d("0.01"), d("1.000000002")},
+		{"price above peg floors at one", sdk.ZeroDec(), d("-0.5"), sdk.OneDec()},
+		{"capped at max rate", sdk.OneDec(), sdk.OneDec(), d("1.00000005")},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			rate := keeper.CalculateStabilityFee(model, tc.utilization, tc.pegDeviation)
+			suite.Require().Equal(tc.expectedRate, rate)
+		})
+	}
+}
+
+func (suite *InterestTestSuite) TestAccumulateInterestWithStabilityFeeModel() {
+	d := sdk.MustNewDecFromStr
+	model := types.NewStabilityFeeModel(d("1.000000001"), d("0.000000002"), d("0.5"), d("0.00000004"), "", sdk.ZeroDec(), d("1.00000005"))
+	params := suite.keeper.GetParams(suite.ctx)
+	for i := range params.CollateralParams {
+		if params.CollateralParams[i].Type == "bnb-a" {
+			params.CollateralParams[i].StabilityFeeModel = &model
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	cp, _ := suite.keeper.GetCollateral(suite.ctx, "bnb-a")
+
+	// half of the 500,000 usdx debt limit is used
+	totalPrincipal := sdk.NewInt(250000000000)
+	suite.keeper.SetTotalPrincipal(suite.ctx, "bnb-a", types.DefaultStableDenom, totalPrincipal)
+	suite.keeper.SetPreviousAccrualTime(suite.ctx, "bnb-a", suite.ctx.BlockTime())
+	suite.keeper.SetInterestFactor(suite.ctx, "bnb-a", sdk.OneDec())
+	suite.Require().Equal(d("0.5"), suite.keeper.GetDebtUtilization(suite.ctx, cp))
+	suite.Require().Equal(d("1.000000002"), suite.keeper.GetStabilityFee(suite.ctx, cp))
+
+	// a peg market trading below 1.0 raises the fee
+	pegModel := model
+	pegModel.PegMarketID = "xrp:usd"
+	pegModel.PegMultiplier = d("0.00000001")
+	pegCp := cp
+	pegCp.StabilityFeeModel = &pegModel
+	err := suite.app.GetPriceFeedKeeper().SetCurrentPrices(suite.ctx, "xrp:usd")
+	suite.Require().NoError(err)
+	utilization := suite.keeper.GetDebtUtilization(suite.ctx, pegCp)
+	expectedRate := keeper.CalculateStabilityFee(pegModel, utilization, d("0.75"))
+	suite.Require().Equal(expectedRate, suite.keeper.GetStabilityFee(suite.ctx, pegCp))
+	suite.Require().True(expectedRate.GT(keeper.CalculateStabilityFee(pegModel, utilization, sdk.ZeroDec())))
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 24))
+	err = suite.keeper.AccumulateInterest(suite.ctx, "bnb-a")
+	suite.Require().NoError(err)
+
+	interestFactor := keeper.CalculateInterestFactor(d("1.000000002"), sdk.NewInt(86400))
+	expectedPrincipal := interestFactor.MulInt(totalPrincipal).RoundInt()
+	suite.Require().Equal(expectedPrincipal, suite.keeper.GetTotalPrincipal(suite.ctx, "bnb-a", types.DefaultStableDenom))
+}
+
 // TestSynchronizeInterest tests the functionality of synchronizing the accumulated interest for CDPs
 func (suite *InterestTestSuite) TestSynchronizeInterest() {
 	type args struct {
@@ -21,13 +21,14 @@ type Keeper struct {
 	auctionKeeper   types.AuctionKeeper
 	bankKeeper      types.BankKeeper
 	accountKeeper   types.AccountKeeper
+	router          types.MsgRouter
 	hooks           types.CDPHooks
 	maccPerms       map[string][]string
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc codec.Codec, key sdk.StoreKey, paramstore paramtypes.Subspace, pfk types.PricefeedKeeper,
-	ak types.AuctionKeeper, bk types.BankKeeper, ack types.AccountKeeper, maccs map[string][]string,
+	ak types.AuctionKeeper, bk types.BankKeeper, ack types.AccountKeeper, router types.MsgRouter, maccs map[string][]string,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -41,6 +42,7 @@ func NewKeeper(cdc codec.Codec, key sdk.StoreKey, paramstore paramtypes.Subspace
 		auctionKeeper:   ak,
 		bankKeeper:      bk,
 		accountKeeper:   ack,
+		router:          router,
 		hooks:           nil,
 		maccPerms:       maccs,
 	}
@@ -83,6 +85,28 @@ func (k Keeper) IterateAllCdps(ctx sdk.Context, cb func(cdp types.CDP) (stop boo
 	}
 }
 
+// IterateMultiCollateralCdps iterates over multi-collateral cdps of the collateral type and performs a callback function.
+// An empty collateral type iterates over the multi-collateral cdps of every collateral type.
+func (k Keeper) IterateMultiCollateralCdps(ctx sdk.Context, collateralType string, cb func(cdp types.CDP) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MultiCollateralIndexPrefix)
+	iterPrefix := []byte{}
+	if collateralType != "" {
+		iterPrefix = types.DenomIterKey(collateralType)
+	}
+	iterator := sdk.KVStorePrefixIterator(store, iterPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		cdpType, id := types.SplitCdpKey(iterator.Key())
+		cdp, found := k.GetCDP(ctx, cdpType, id)
+		if !found {
+			panic(fmt.Sprintf("cdp %d does not exist", id))
+		}
+		if cb(cdp) {
+			break
+		}
+	}
+}
+
 // IterateCdpsByCollateralType iterates over cdps with matching denom and performs a callback function
 func (k Keeper) IterateCdpsByCollateralType(ctx sdk.Context, collateralType string, cb func(cdp types.CDP) (stop bool)) {
 	iterator := k.CdpDenomIndexIterator(ctx, collateralType)
@@ -27,6 +27,7 @@ func (k msgServer) CreateCDP(goCtx context.Context, msg *types.MsgCreateCDP) (*t
 		return nil, err
 	}
 
+	id := k.keeper.GetNextCdpID(ctx)
 	err = k.keeper.AddCdp(ctx, sender, msg.Collateral, msg.Principal, msg.CollateralType)
 	if err != nil {
 		return nil, err
@@ -39,8 +40,6 @@ func (k msgServer) CreateCDP(goCtx context.Context, msg *types.MsgCreateCDP) (*t
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
 		),
 	)
-
-	id, _ := k.keeper.GetCdpID(ctx, sender, msg.CollateralType)
 	return &types.MsgCreateCDPResponse{CdpID: id}, nil
 }
 
@@ -57,7 +56,7 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		return nil, err
 	}
 
-	err = k.keeper.DepositCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType)
+	err = k.keeper.DepositCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType, msg.CdpID)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +84,7 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 		return nil, err
 	}
 
-	err = k.keeper.WithdrawCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType)
+	err = k.keeper.WithdrawCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType, msg.CdpID)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +107,7 @@ func (k msgServer) DrawDebt(goCtx context.Context, msg *types.MsgDrawDebt) (*typ
 		return nil, err
 	}
 
-	err = k.keeper.AddPrincipal(ctx, sender, msg.CollateralType, msg.Principal)
+	err = k.keeper.AddPrincipal(ctx, sender, msg.CollateralType, msg.CdpID, msg.Principal)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +130,15 @@ func (k msgServer) RepayDebt(goCtx context.Context, msg *types.MsgRepayDebt) (*t
 		return nil, err
 	}
 
-	err = k.keeper.RepayPrincipal(ctx, sender, msg.CollateralType, msg.Payment)
+	owner := sender
+	if msg.Owner != "" {
+		owner, err = sdk.AccAddressFromBech32(msg.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = k.keeper.RepayPrincipal(ctx, owner, sender, msg.CollateralType, msg.CdpID, msg.Payment)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +166,7 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 		return nil, err
 	}
 
-	err = k.keeper.AttemptKeeperLiquidation(ctx, keeper, borrower, msg.CollateralType)
+	err = k.keeper.AttemptKeeperLiquidation(ctx, keeper, borrower, msg.CollateralType, msg.CdpID)
 	if err != nil {
 		return nil, err
 	}
@@ -173,3 +180,234 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) TransferCDP(goCtx context.Context, msg *types.MsgTransferCDP) (*types.MsgTransferCDPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.TransferCDP(ctx, sender, recipient, msg.CollateralType, msg.CdpID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgTransferCDPResponse{}, nil
+}
+
+func (k msgServer) SetCDPManagers(goCtx context.Context, msg *types.MsgSetCDPManagers) (*types.MsgSetCDPManagersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	managers, err := msg.ManagerAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.SetCdpManagers(ctx, sender, msg.CollateralType, msg.CdpID, managers)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgSetCDPManagersResponse{}, nil
+}
+
+func (k msgServer) DepositBasket(goCtx context.Context, msg *types.MsgDepositBasket) (*types.MsgDepositBasketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.DepositBasketCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType, msg.CdpID, msg.BasketType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+	return &types.MsgDepositBasketResponse{}, nil
+}
+
+func (k msgServer) WithdrawBasket(goCtx context.Context, msg *types.MsgWithdrawBasket) (*types.MsgWithdrawBasketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.WithdrawBasketCollateral(ctx, owner, depositor, msg.Collateral, msg.CollateralType, msg.CdpID, msg.BasketType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+	return &types.MsgWithdrawBasketResponse{}, nil
+}
+
+func (k msgServer) SettleCDP(goCtx context.Context, msg *types.MsgSettleCDP) (*types.MsgSettleCDPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.SettleCDP(ctx, sender, msg.CollateralType, msg.CdpID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgSettleCDPResponse{}, nil
+}
+
+func (k msgServer) RedeemSettlement(goCtx context.Context, msg *types.MsgRedeemSettlement) (*types.MsgRedeemSettlementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RedeemSettlement(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgRedeemSettlementResponse{}, nil
+}
+
+func (k msgServer) PsmMint(goCtx context.Context, msg *types.MsgPsmMint) (*types.MsgPsmMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.PsmMint(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgPsmMintResponse{}, nil
+}
+
+func (k msgServer) PsmRedeem(goCtx context.Context, msg *types.MsgPsmRedeem) (*types.MsgPsmRedeemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.PsmRedeem(ctx, sender, msg.Amount, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgPsmRedeemResponse{}, nil
+}
+
+func (k msgServer) FlashMint(goCtx context.Context, msg *types.MsgFlashMint) (*types.MsgFlashMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.FlashMint(ctx, sender, msg.Amount, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgFlashMintResponse{}, nil
+}
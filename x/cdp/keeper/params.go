@@ -50,6 +50,17 @@ func (k Keeper) GetDebtParam(ctx sdk.Context, denom string) (types.DebtParam, bo
 	return types.DebtParam{}, false
 }
 
+// GetPsmParam returns the peg stability module param of a stablecoin
+func (k Keeper) GetPsmParam(ctx sdk.Context, denom string) (types.PsmParam, bool) {
+	params := k.GetParams(ctx)
+	for _, pp := range params.PsmParams {
+		if pp.Denom == denom {
+			return pp, true
+		}
+	}
+	return types.PsmParam{}, false
+}
+
 func (k Keeper) getSpotMarketID(ctx sdk.Context, collateralType string) string {
 	cp, found := k.GetCollateral(ctx, collateralType)
 	if !found {
@@ -96,5 +107,5 @@ func (k Keeper) getFeeRate(ctx sdk.Context, collateralType string) (fee sdk.Dec)
 	if !found {
 		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", collateralType))
 	}
-	return collalateralParam.StabilityFee
+	return k.GetStabilityFee(ctx, collalateralParam)
 }
@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+// HandleGlobalSettlementProposal shuts down the cdp system, see StartGlobalSettlement.
+func HandleGlobalSettlementProposal(ctx sdk.Context, k Keeper, p *types.GlobalSettlementProposal) error {
+	return k.StartGlobalSettlement(ctx)
+}
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+// PsmMint deposits a stablecoin in the peg stability module and mints the same value of stable asset.
+// The mint fee is taken from the minted stable asset and sent to the liquidator as surplus, the rest is sent to the sender.
+func (k Keeper) PsmMint(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) error {
+	err := k.ValidateNoGlobalSettlement(ctx)
+	if err != nil {
+		return err
+	}
+	pp, found := k.GetPsmParam(ctx, amount.Denom)
+	if !found {
+		return sdkerrors.Wrap(types.ErrPsmAssetNotSupported, amount.Denom)
+	}
+	err = k.ValidateBalance(ctx, amount, sender)
+	if err != nil {
+		return err
+	}
+
+	reserve := k.GetPsmReserve(ctx, amount.Denom).Add(amount)
+	debtDenom := k.GetParams(ctx).DebtParam.Denom
+	totalMinted := k.convertPsmToDebt(ctx, pp, reserve.Amount)
+	if totalMinted.GT(pp.DebtLimit.Amount) {
+		return sdkerrors.Wrapf(types.ErrExceedsDebtLimit, "psm debt %s exceeds debt limit %s for %s", sdk.NewCoin(debtDenom, totalMinted), pp.DebtLimit, pp.Denom)
+	}
+
+	minted := sdk.NewCoin(debtDenom, k.convertPsmToDebt(ctx, pp, amount.Amount))
+	fee := sdk.NewCoin(debtDenom, minted.Amount.ToDec().Mul(pp.MintFee).Ceil().TruncateInt())
+	out := minted.Sub(fee)
+	if !out.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidPsmSwap, "%s is too small to mint any %s", amount, debtDenom)
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.PsmMacc, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(minted))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(out))
+	if err != nil {
+		return err
+	}
+	if fee.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(fee))
+		if err != nil {
+			return err
+		}
+	}
+	k.SetPsmReserve(ctx, reserve)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePsmMint,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyMinted, out.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+	return nil
+}
+
+// PsmRedeem burns stable asset and withdraws the same value of a stablecoin from the peg stability module.
+// The redeem fee is taken from the redeemed stable asset and sent to the liquidator as surplus, the rest is burned.
+// Redemptions stay open during global settlement, as the reserves back the stable asset minted by the peg stability module.
+func (k Keeper) PsmRedeem(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, denom string) error {
+	pp, found := k.GetPsmParam(ctx, denom)
+	if !found {
+		return sdkerrors.Wrap(types.ErrPsmAssetNotSupported, denom)
+	}
+	debtDenom := k.GetParams(ctx).DebtParam.Denom
+	if amount.Denom != debtDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidPsmSwap, "expected %s, got %s", debtDenom, amount.Denom)
+	}
+	err := k.ValidateBalance(ctx, amount, sender)
+	if err != nil {
+		return err
+	}
+
+	fee := sdk.NewCoin(debtDenom, amount.Amount.ToDec().Mul(pp.RedeemFee).Ceil().TruncateInt())
+	burned := amount.Sub(fee)
+	out := sdk.NewCoin(denom, k.convertDebtToPsm(ctx, pp, burned.Amount))
+	if !out.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidPsmSwap, "%s is too small to redeem any %s", amount, denom)
+	}
+	reserve := k.GetPsmReserve(ctx, denom)
+	if out.Amount.GT(reserve.Amount) {
+		return sdkerrors.Wrapf(types.ErrInsufficientPsmReserve, "%s < %s", reserve, out)
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burned))
+	if err != nil {
+		return err
+	}
+	if fee.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(fee))
+		if err != nil {
+			return err
+		}
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.PsmMacc, sender, sdk.NewCoins(out))
+	if err != nil {
+		return err
+	}
+	k.SetPsmReserve(ctx, reserve.Sub(out))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePsmRedeem,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateral, out.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+	return nil
+}
+
+// GetPsmMinted returns the stable asset backed by the peg stability module's reserves
+func (k Keeper) GetPsmMinted(ctx sdk.Context) sdk.Int {
+	minted := sdk.ZeroInt()
+	k.IteratePsmReserves(ctx, func(reserve sdk.Coin) bool {
+		pp, found := k.GetPsmParam(ctx, reserve.Denom)
+		if found {
+			minted = minted.Add(k.convertPsmToDebt(ctx, pp, reserve.Amount))
+		}
+		return false
+	})
+	return minted
+}
+
+// GetPsmReserve returns the peg stability module's reserve of a stablecoin
+func (k Keeper) GetPsmReserve(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PsmReserveKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+// SetPsmReserve sets the peg stability module's reserve of a stablecoin
+func (k Keeper) SetPsmReserve(ctx sdk.Context, reserve sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PsmReserveKeyPrefix)
+	if reserve.IsZero() {
+		store.Delete([]byte(reserve.Denom))
+		return
+	}
+	bz, err := reserve.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(reserve.Denom), bz)
+}
+
+// IteratePsmReserves iterates over the peg stability module's non-zero reserves and performs a callback function
+func (k Keeper) IteratePsmReserves(ctx sdk.Context, cb func(reserve sdk.Coin) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PsmReserveKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(sdk.NewCoin(string(iterator.Key()), amount)) {
+			break
+		}
+	}
+}
+
+// convertPsmToDebt converts an amount of a stablecoin to the stable asset of the same value, rounding down
+func (k Keeper) convertPsmToDebt(ctx sdk.Context, pp types.PsmParam, amount sdk.Int) sdk.Int {
+	dp := k.GetParams(ctx).DebtParam
+	baseUnits := amount.ToDec().Mul(sdk.NewDecFromIntWithPrec(sdk.OneInt(), pp.ConversionFactor.Int64()))
+	return baseUnits.MulInt(sdk.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64()))).TruncateInt()
+}
+
+// convertDebtToPsm converts an amount of stable asset to the stablecoin of the same value, rounding down
+func (k Keeper) convertDebtToPsm(ctx sdk.Context, pp types.PsmParam, amount sdk.Int) sdk.Int {
+	baseUnits := k.convertDebtToBaseUnits(ctx, sdk.NewCoin(k.GetParams(ctx).DebtParam.Denom, amount))
+	return baseUnits.MulInt(sdk.NewIntWithDecimal(1, int(pp.ConversionFactor.Int64()))).TruncateInt()
+}
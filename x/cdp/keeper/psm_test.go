@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/cdp/keeper"
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+type PsmTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *PsmTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(10)
+	authGS := app.NewFundedGenStateWithCoins(
+		cdc,
+		[]sdk.Coins{
+			cs(c("erc20/usdc", 2000000000), c("erc20/dai", 1000000000000000000)),
+			cs(c("erc20/usdc", 2000000000)),
+		},
+		addrs[0:2],
+	)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.PsmParams = types.PsmParams{
+		types.NewPsmParam("erc20/usdc", i(6), c("usdx", 1000000000), d("0.001"), d("0.002")),
+		types.NewPsmParam("erc20/dai", i(18), c("usdx", 1000000000), sdk.ZeroDec(), sdk.ZeroDec()),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *PsmTestSuite) TestPsmMint() {
+	bk := suite.app.GetBankKeeper()
+	ak := suite.app.GetAccountKeeper()
+
+	err := suite.keeper.PsmMint(suite.ctx, suite.addrs[0], c("erc20/usdc", 100000000))
+	suite.Require().NoError(err)
+
+	// 100 usdc mints 100 usdx, of which 0.1 usdx is the mint fee
+	suite.Equal(c("usdx", 99900000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdx"))
+	suite.Equal(c("usdx", 100000), bk.GetBalance(suite.ctx, ak.GetModuleAddress(types.LiquidatorMacc), "usdx"))
+	suite.Equal(c("erc20/usdc", 100000000), bk.GetBalance(suite.ctx, ak.GetModuleAddress(types.PsmMacc), "erc20/usdc"))
+	suite.Equal(c("erc20/usdc", 100000000), suite.keeper.GetPsmReserve(suite.ctx, "erc20/usdc"))
+	suite.Equal(i(100000000), suite.keeper.GetPsmMinted(suite.ctx))
+
+	// the debt limit applies to the stable asset backed by the reserve
+	err = suite.keeper.PsmMint(suite.ctx, suite.addrs[1], c("erc20/usdc", 900000001))
+	suite.True(errors.Is(err, types.ErrExceedsDebtLimit))
+	err = suite.keeper.PsmMint(suite.ctx, suite.addrs[1], c("erc20/usdc", 900000000))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.PsmMint(suite.ctx, suite.addrs[0], c("xrp", 100000000))
+	suite.True(errors.Is(err, types.ErrPsmAssetNotSupported))
+	err = suite.keeper.PsmMint(suite.ctx, suite.addrs[1], c("erc20/dai", 1000000000000000000))
+	suite.True(errors.Is(err, types.ErrInsufficientBalance))
+}
+
+func (suite *PsmTestSuite) TestPsmMintConversionFactor() {
+	bk := suite.app.GetBankKeeper()
+
+	// 18 decimal stablecoins mint the 6 decimal stable asset of the same value
+	err := suite.keeper.PsmMint(suite.ctx, suite.addrs[0], c("erc20/dai", 1000000000000000000))
+	suite.Require().NoError(err)
+	suite.Equal(c("usdx", 1000000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdx"))
+
+	err = suite.keeper.PsmMint(suite.ctx, suite.addrs[0], c("erc20/usdc", 1))
+	suite.True(errors.Is(err, types.ErrInvalidPsmSwap))
+}
+
+func (suite *PsmTestSuite) TestPsmRedeem() {
+	bk := suite.app.GetBankKeeper()
+	ak := suite.app.GetAccountKeeper()
+
+	err := suite.keeper.PsmMint(suite.ctx, suite.addrs[0], c("erc20/usdc", 100000000))
+	suite.Require().NoError(err)
+	usdc := bk.GetBalance(suite.ctx, suite.addrs[0], "erc20/usdc")
+
+	err = suite.keeper.PsmRedeem(suite.ctx, suite.addrs[0], c("usdx", 50000000), "erc20/usdc")
+	suite.Require().NoError(err)
+
+	// 50 usdx redeems 49.9 usdc after the 0.1 usdx redeem fee
+	suite.Equal(usdc.AddAmount(i(49900000)), bk.GetBalance(suite.ctx, suite.addrs[0], "erc20/usdc"))
+	suite.Equal(c("usdx", 49900000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdx"))
+	suite.Equal(c("usdx", 200000), bk.GetBalance(suite.ctx, ak.GetModuleAddress(types.LiquidatorMacc), "usdx"))
+	suite.Equal(c("erc20/usdc", 50100000), suite.keeper.GetPsmReserve(suite.ctx, "erc20/usdc"))
+	suite.Equal(c("usdx", 50100000), bk.GetSupply(suite.ctx, "usdx"))
+
+	err = suite.keeper.PsmRedeem(suite.ctx, suite.addrs[0], c("usdx", 49900000), "erc20/dai")
+	suite.True(errors.Is(err, types.ErrInsufficientPsmReserve))
+	err = suite.keeper.PsmRedeem(suite.ctx, suite.addrs[0], c("erc20/usdc", 1000000), "erc20/usdc")
+	suite.True(errors.Is(err, types.ErrInvalidPsmSwap))
+	err = suite.keeper.PsmRedeem(suite.ctx, suite.addrs[0], c("usdx", 1000000), "xrp")
+	suite.True(errors.Is(err, types.ErrPsmAssetNotSupported))
+}
+
+func (suite *PsmTestSuite) TestPsmGlobalSettlement() {
+	err := suite.keeper.PsmMint(suite.ctx, suite.addrs[0], c("erc20/usdc", 100000000))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.StartGlobalSettlement(suite.ctx)
+	suite.Require().NoError(err)
+
+	// stable asset minted by the psm is redeemed from its reserves, not from cdp collateral
+	settlement, found := suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 0), settlement.RedeemableSupply)
+
+	err = suite.keeper.PsmMint(suite.ctx, suite.addrs[0], c("erc20/usdc", 100000000))
+	suite.True(errors.Is(err, types.ErrGlobalSettlementActive))
+	err = suite.keeper.PsmRedeem(suite.ctx, suite.addrs[0], c("usdx", 99900000), "erc20/usdc")
+	suite.Require().NoError(err)
+}
+
+func (suite *PsmTestSuite) TestQueryPsmReserves() {
+	err := suite.keeper.PsmMint(suite.ctx, suite.addrs[0], c("erc20/usdc", 100000000))
+	suite.Require().NoError(err)
+	queryServer := keeper.NewQueryServerImpl(suite.keeper)
+
+	res, err := queryServer.PsmReserves(sdk.WrapSDKContext(suite.ctx), &types.QueryPsmReservesRequest{})
+	suite.Require().NoError(err)
+	suite.Equal([]types.PsmReserveResponse{
+		{Reserve: c("erc20/usdc", 100000000), Minted: c("usdx", 100000000), DebtLimit: c("usdx", 1000000000)},
+		{Reserve: c("erc20/dai", 0), Minted: c("usdx", 0), DebtLimit: c("usdx", 1000000000)},
+	}, res.Reserves)
+
+	res, err = queryServer.PsmReserves(sdk.WrapSDKContext(suite.ctx), &types.QueryPsmReservesRequest{Denom: "erc20/dai"})
+	suite.Require().NoError(err)
+	suite.Len(res.Reserves, 1)
+	suite.Equal(c("erc20/dai", 0), res.Reserves[0].Reserve)
+
+	_, err = queryServer.PsmReserves(sdk.WrapSDKContext(suite.ctx), &types.QueryPsmReservesRequest{Denom: "xrp"})
+	suite.True(errors.Is(err, types.ErrPsmAssetNotSupported))
+}
+
+func TestPsmTestSuite(t *testing.T) {
+	suite.Run(t, new(PsmTestSuite))
+}
@@ -56,9 +56,9 @@ func queryGetCdp(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacyQu
 		return nil, sdkerrors.Wrap(types.ErrInvalidCollateral, requestParams.CollateralType)
 	}
 
-	cdp, found := keeper.GetCdpByOwnerAndCollateralType(ctx, requestParams.Owner, requestParams.CollateralType)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", requestParams.Owner, requestParams.CollateralType)
+	cdp, err := keeper.GetOwnerCdp(ctx, requestParams.Owner, requestParams.CollateralType, requestParams.CdpID)
+	if err != nil {
+		return nil, err
 	}
 
 	augmentedCDP := keeper.LoadAugmentedCDP(ctx, cdp)
@@ -83,9 +83,9 @@ func queryGetDeposits(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, leg
 		return nil, sdkerrors.Wrap(types.ErrInvalidCollateral, requestParams.CollateralType)
 	}
 
-	cdp, found := keeper.GetCdpByOwnerAndCollateralType(ctx, requestParams.Owner, requestParams.CollateralType)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", requestParams.Owner, requestParams.CollateralType)
+	cdp, err := keeper.GetOwnerCdp(ctx, requestParams.Owner, requestParams.CollateralType, requestParams.CdpID)
+	if err != nil {
+		return nil, err
 	}
 
 	deposits := keeper.GetDeposits(ctx, cdp.ID)
@@ -346,10 +346,7 @@ func FilterCDPs(ctx sdk.Context, k Keeper, params types.QueryCdpsParams) (types.
 	if len(params.Owner) > 0 {
 		denoms := k.GetCollateralTypes(ctx)
 		for _, denom := range denoms {
-			cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, params.Owner, denom)
-			if found {
-				matchOwner = append(matchOwner, cdp)
-			}
+			matchOwner = append(matchOwner, k.GetCdpsByOwnerAndCollateralType(ctx, params.Owner, denom)...)
 		}
 	}
 
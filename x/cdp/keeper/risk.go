@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+// GetCdpRisk returns the liquidation risk of a cdp at current liquidation prices, including fees accrued since the cdp was last synchronized.
+// The collateral seized and debt covered are those SeizeCollateral would seize and cover if the cdp were liquidated now, not counting any keeper reward.
+func (k Keeper) GetCdpRisk(ctx sdk.Context, cdp types.CDP) (types.CdpRiskResponse, error) {
+	accruedFees := k.CalculateNewInterest(ctx, cdp)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(accruedFees)
+	totalDebt := cdp.GetTotalPrincipal()
+
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, k.getliquidationMarketID(ctx, cdp.Type))
+	if err != nil {
+		return types.CdpRiskResponse{}, err
+	}
+	collateralizationRatio, err := k.CalculateBasketCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Basket, cdp.Principal, cdp.AccumulatedFees, liquidation)
+	if err != nil {
+		return types.CdpRiskResponse{}, err
+	}
+	basketValue, err := k.calculateWeightedBasketValue(ctx, cdp.Type, cdp.Basket, liquidation)
+	if err != nil {
+		return types.CdpRiskResponse{}, err
+	}
+	liquidationRatio := k.getLiquidationRatio(ctx, cdp.Type)
+
+	// the cdp can be liquidated once collateral * price + basket value <= liquidation ratio * debt
+	liquidationPrice := sdk.ZeroDec()
+	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, cdp.Collateral, cdp.Type)
+	if collateralBaseUnits.IsPositive() {
+		debtBaseUnits := k.convertDebtToBaseUnits(ctx, totalDebt)
+		liquidationPrice = sdk.MaxDec(liquidationRatio.Mul(debtBaseUnits).Sub(basketValue).Quo(collateralBaseUnits), sdk.ZeroDec())
+	}
+	distanceToLiquidation := sdk.ZeroDec()
+	if price.Price.IsPositive() {
+		distanceToLiquidation = sdk.OneDec().Sub(liquidationPrice.Quo(price.Price))
+	}
+
+	risk := types.CdpRiskResponse{
+		CdpID:                  cdp.ID,
+		Owner:                  cdp.Owner.String(),
+		Type:                   cdp.Type,
+		AccruedFees:            accruedFees,
+		TotalDebt:              totalDebt,
+		CollateralizationRatio: collateralizationRatio,
+		LiquidationRatio:       liquidationRatio,
+		Price:                  price.Price,
+		LiquidationPrice:       liquidationPrice,
+		DistanceToLiquidation:  distanceToLiquidation,
+		Liquidatable:           collateralizationRatio.LTE(liquidationRatio),
+		CollateralSeized:       sdk.NewCoin(cdp.Collateral.Denom, sdk.ZeroInt()),
+		DebtCovered:            sdk.NewCoin(totalDebt.Denom, sdk.ZeroInt()),
+	}
+	if !risk.Liquidatable {
+		return risk, nil
+	}
+
+	if collateralSeized, debtCovered, ok := k.getPartialLiquidation(ctx, cdp); ok {
+		risk.PartialLiquidation = true
+		risk.CollateralSeized = sdk.NewCoin(cdp.Collateral.Denom, collateralSeized)
+		risk.DebtCovered = sdk.NewCoin(totalDebt.Denom, debtCovered)
+		return risk, nil
+	}
+	risk.CollateralSeized = cdp.Collateral
+	risk.BasketSeized = cdp.Basket
+	risk.DebtCovered = totalDebt
+	return risk, nil
+}
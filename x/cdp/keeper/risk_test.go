@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/cdp/keeper"
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+type RiskTestSuite struct {
+	suite.Suite
+
+	keeper      keeper.Keeper
+	queryServer types.QueryServer
+	app         app.TestApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+}
+
+func (suite *RiskTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	authGS := app.NewFundedGenStateWithCoins(
+		cdc,
+		[]sdk.Coins{
+			cs(c("xrp", 500000000)),
+			cs(c("xrp", 500000000)),
+			cs(c("xrp", 500000000)),
+		},
+		addrs,
+	)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.queryServer = keeper.NewQueryServerImpl(suite.keeper)
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 40000000), "xrp-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *RiskTestSuite) setPrice(price sdk.Dec, market string) {
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+
+	_, err := pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, market, price, suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.Require().NoError(err)
+	err = pfKeeper.SetCurrentPrices(suite.ctx, market)
+	suite.Require().NoError(err)
+}
+
+func (suite *RiskTestSuite) getRisk() types.CdpRiskResponse {
+	res, err := suite.queryServer.CdpRisk(sdk.WrapSDKContext(suite.ctx), &types.QueryCdpRiskRequest{
+		CollateralType: "xrp-a",
+		Owner:          suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	return res.CdpRisk
+}
+
+func (suite *RiskTestSuite) TestCdpRisk() {
+	risk := suite.getRisk()
+
+	// collateral value 400 * 0.25 = 100 against 40 debt, liquidated at a price of 2.0 * 40 / 400 = 0.2
+	suite.Equal(uint64(1), risk.CdpID)
+	suite.Equal(d("2.5"), risk.CollateralizationRatio)
+	suite.Equal(d("2.0"), risk.LiquidationRatio)
+	suite.Equal(d("0.25"), risk.Price)
+	suite.Equal(d("0.2"), risk.LiquidationPrice)
+	suite.Equal(d("0.2"), risk.DistanceToLiquidation)
+	suite.False(risk.Liquidatable)
+	suite.Equal(c("usdx", 0), risk.AccruedFees)
+	suite.Equal(c("usdx", 40000000), risk.TotalDebt)
+	suite.Equal(c("xrp", 0), risk.CollateralSeized)
+	suite.Equal(c("usdx", 0), risk.DebtCovered)
+}
+
+func (suite *RiskTestSuite) TestCdpRiskAccruedFees() {
+	err := suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 24 * 365))
+	err = suite.keeper.AccumulateInterest(suite.ctx, "xrp-a")
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	accrued := suite.keeper.CalculateNewInterest(suite.ctx, cdp)
+	suite.True(accrued.IsPositive())
+
+	// accrued fees are counted as debt without synchronizing the cdp
+	risk := suite.getRisk()
+	suite.Equal(accrued, risk.AccruedFees)
+	suite.Equal(c("usdx", 40000000).Add(accrued), risk.TotalDebt)
+	suite.True(risk.LiquidationPrice.GT(d("0.2")))
+	suite.True(risk.CollateralizationRatio.LT(d("2.5")))
+
+	cdp, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 0), cdp.AccumulatedFees)
+}
+
+func (suite *RiskTestSuite) TestCdpRiskLiquidatable() {
+	// collateral value 400 * 0.11 = 44, ratio 1.1
+	suite.setPrice(d("0.11"), "xrp:usd:30")
+
+	risk := suite.getRisk()
+	suite.True(risk.Liquidatable)
+	suite.Equal(d("-0.818181818181818182"), risk.DistanceToLiquidation)
+	suite.False(risk.PartialLiquidation)
+	suite.Equal(c("xrp", 400000000), risk.CollateralSeized)
+	suite.Equal(c("usdx", 40000000), risk.DebtCovered)
+}
+
+func (suite *RiskTestSuite) TestCdpRiskPartialLiquidation() {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == "xrp-a" {
+			params.CollateralParams[i].PartialLiquidation = types.NewPartialLiquidation(d("0.2"))
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// collateral value 400 * 0.19 = 76, ratio 1.9 is restored to 2.2 by covering (2.2 * 40 - 76) / (2.2 - 1.05) debt
+	suite.setPrice(d("0.19"), "xrp:usd:30")
+
+	risk := suite.getRisk()
+	suite.True(risk.Liquidatable)
+	suite.True(risk.PartialLiquidation)
+	suite.Equal(c("xrp", 57665906), risk.CollateralSeized)
+	suite.Equal(c("usdx", 10434783), risk.DebtCovered)
+
+	// seizing the cdp matches the preview
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	err := suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+	cdp, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 400000000).Sub(risk.CollateralSeized), cdp.Collateral)
+	suite.Equal(c("usdx", 40000000).Sub(risk.DebtCovered), cdp.GetTotalPrincipal())
+}
+
+func (suite *RiskTestSuite) TestRiskyCdps() {
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("xrp", 400000000), c("usdx", 45000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[2], c("xrp", 400000000), c("usdx", 30000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	getRiskyCdps := func(ratio string, pagination *query.PageRequest) ([]uint64, *query.PageResponse) {
+		res, err := suite.queryServer.RiskyCdps(sdk.WrapSDKContext(suite.ctx), &types.QueryRiskyCdpsRequest{
+			CollateralType: "xrp-a",
+			Ratio:          ratio,
+			Pagination:     pagination,
+		})
+		suite.Require().NoError(err)
+		var ids []uint64
+		for _, risk := range res.CdpRisks {
+			ids = append(ids, risk.CdpID)
+		}
+		return ids, res.Pagination
+	}
+
+	// ratios are 2.5, 2.22 and 3.33, none below the liquidation ratio
+	ids, _ := getRiskyCdps("", nil)
+	suite.Empty(ids)
+	ids, _ = getRiskyCdps("2.4", nil)
+	suite.Equal([]uint64{2}, ids)
+	ids, _ = getRiskyCdps("3", nil)
+	suite.Equal([]uint64{2, 1}, ids)
+
+	// pages through the cdps riskiest first
+	ids, page := getRiskyCdps("4", &query.PageRequest{Limit: 2})
+	suite.Equal([]uint64{2, 1}, ids)
+	suite.Require().NotNil(page.NextKey)
+	ids, _ = getRiskyCdps("4", &query.PageRequest{Key: page.NextKey, Limit: 2})
+	suite.Equal([]uint64{3}, ids)
+
+	// pages end at the ratio, and only risky cdps are counted
+	ids, page = getRiskyCdps("3", &query.PageRequest{Limit: 1, CountTotal: true})
+	suite.Equal([]uint64{2}, ids)
+	suite.Equal(uint64(2), page.Total)
+	ids, page = getRiskyCdps("3", &query.PageRequest{Key: page.NextKey, Limit: 1})
+	suite.Equal([]uint64{1}, ids)
+	suite.Nil(page.NextKey)
+
+	// ratios are 1.9, 1.69 and 2.53 at a price of 0.19
+	suite.setPrice(d("0.19"), "xrp:usd:30")
+	ids, _ = getRiskyCdps("", nil)
+	suite.Equal([]uint64{2, 1}, ids)
+}
+
+func (suite *RiskTestSuite) TestRiskyCdpsInvalid() {
+	_, err := suite.queryServer.RiskyCdps(sdk.WrapSDKContext(suite.ctx), &types.QueryRiskyCdpsRequest{CollateralType: "lol-a"})
+	suite.True(errors.Is(err, types.ErrInvalidCollateral))
+	_, err = suite.queryServer.RiskyCdps(sdk.WrapSDKContext(suite.ctx), &types.QueryRiskyCdpsRequest{CollateralType: "xrp-a", Ratio: "-1"})
+	suite.Error(err)
+}
+
+func TestRiskTestSuite(t *testing.T) {
+	suite.Run(t, new(RiskTestSuite))
+}
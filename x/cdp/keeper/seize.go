@@ -11,16 +11,20 @@ import (
 
 // AttemptKeeperLiquidation liquidates the cdp with the input collateral type and owner if it is below the required collateralization ratio
 // if the cdp is liquidated, the keeper that sent the transaction is rewarded a percentage of the collateral according to that collateral types'
-// keeper reward percentage.
-func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccAddress, collateralType string) error {
-	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
-	if !found {
-		return sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, denom %s", owner, collateralType)
+// keeper reward percentage. A cdpID of zero selects the owner's only cdp of the collateral type.
+func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccAddress, collateralType string, cdpID uint64) error {
+	err := k.ValidateNoGlobalSettlement(ctx)
+	if err != nil {
+		return err
+	}
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
 	}
 	k.hooks.BeforeCDPModified(ctx, cdp)
 	cdp = k.SynchronizeInterest(ctx, cdp)
 
-	err := k.ValidateLiquidation(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees)
+	err = k.ValidateLiquidation(ctx, cdp.Collateral, cdp.Type, cdp.Basket, cdp.Principal, cdp.AccumulatedFees)
 	if err != nil {
 		return err
 	}
@@ -32,13 +36,19 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccA
 }
 
 // SeizeCollateral liquidates the collateral in the input cdp.
+// If the collateral type enables partial liquidation and the cdp can be restored to its target ratio,
+// only part of the collateral is seized, see PartiallySeizeCollateral. Otherwise
 // the following operations are performed:
-// 1. Collateral for all deposits is sent from the cdp module to the liquidator module account
+// 1. Collateral for all deposits is sent from the cdp module to the liquidator module account, as is any basket collateral
 // 2. The liquidation penalty is applied
 // 3. Debt coins are sent from the cdp module to the liquidator module account
 // 4. The total amount of principal outstanding for that collateral type is decremented
 // (this is the equivalent of saying that fees are no longer accumulated by a cdp once it gets liquidated)
 func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
+	if collateralSeized, debtCovered, ok := k.getPartialLiquidation(ctx, cdp); ok {
+		return k.PartiallySeizeCollateral(ctx, cdp, collateralSeized, debtCovered)
+	}
+
 	// Calculate the previous collateral ratio
 	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
 
@@ -71,6 +81,14 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 		)
 	}
 
+	// a multi-collateral cdp's basket is auctioned for its share of the debt
+	if cdp.IsMultiCollateral() {
+		debt, err = k.seizeBasket(ctx, cdp, debt)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = k.AuctionCollateral(ctx, deposits, cdp.Type, debt, cdp.Principal.Denom)
 	if err != nil {
 		return err
@@ -82,10 +100,159 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 
 	// Delete CDP from state
 	k.RemoveCdpOwnerIndex(ctx, cdp)
-	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
+	if cdp.IsMultiCollateral() {
+		k.RemoveMultiCollateralIndex(ctx, cdp.Type, cdp.ID)
+	} else {
+		k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
+	}
 	return k.DeleteCDP(ctx, cdp)
 }
 
+// PartiallySeizeCollateral seizes collateral from the deposits of a cdp, in proportion to their size, and auctions it to cover part of the cdp's debt.
+// Debt covered is taken from accumulated fees first, then principal. The cdp remains open with the rest of its collateral and debt.
+func (k Keeper) PartiallySeizeCollateral(ctx sdk.Context, cdp types.CDP, collateralSeized, debtCovered sdk.Int) error {
+	// Move debt coins for the covered debt from cdp to liquidator account
+	debt := sdk.MinInt(debtCovered, k.getModAccountDebt(ctx, types.ModuleName))
+	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), debt)
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
+	if err != nil {
+		return err
+	}
+
+	// take collateral from each deposit in proportion to its size
+	deposits := k.GetDeposits(ctx, cdp.ID)
+	shares := depositShares(deposits, cdp.Collateral.Amount, collateralSeized)
+
+	var auctionedDeposits types.Deposits
+	for i, dep := range deposits {
+		if !shares[i].IsPositive() {
+			continue
+		}
+		seized := types.NewDeposit(dep.CdpID, dep.Depositor, sdk.NewCoin(dep.Amount.Denom, shares[i]))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(seized.Amount)); err != nil {
+			return err
+		}
+		dep.Amount = dep.Amount.Sub(seized.Amount)
+		if dep.Amount.IsZero() {
+			k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
+		} else {
+			k.SetDeposit(ctx, dep)
+		}
+		auctionedDeposits = append(auctionedDeposits, seized)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDeposit, seized.String()),
+			),
+		)
+	}
+
+	err = k.AuctionCollateral(ctx, auctionedDeposits, cdp.Type, debt, cdp.Principal.Denom)
+	if err != nil {
+		return err
+	}
+
+	// reduce fees before principal, as repayments do
+	feesCovered := sdk.MinInt(debtCovered, cdp.AccumulatedFees.Amount)
+	principalCovered := debtCovered.Sub(feesCovered)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.SubAmount(feesCovered)
+	cdp.Principal = cdp.Principal.SubAmount(principalCovered)
+	cdp.Collateral = cdp.Collateral.SubAmount(collateralSeized)
+	k.DecrementTotalPrincipal(ctx, cdp.Type, sdk.NewCoin(cdp.Principal.Denom, debtCovered))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpPartialLiquidation,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyCollateralSeized, sdk.NewCoin(cdp.Collateral.Denom, collateralSeized).String()),
+			sdk.NewAttribute(types.AttributeKeyDebtCovered, sdk.NewCoin(cdp.Principal.Denom, debtCovered).String()),
+		),
+	)
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// depositShares divides an amount of a cdp's collateral between its deposits in proportion to their size.
+// The first deposits with room absorb any rounding remainder, so the shares sum to the amount.
+func depositShares(deposits types.Deposits, collateral, amount sdk.Int) []sdk.Int {
+	shares := make([]sdk.Int, len(deposits))
+	remaining := amount
+	for i, dep := range deposits {
+		shares[i] = dep.Amount.Amount.Mul(amount).Quo(collateral)
+		remaining = remaining.Sub(shares[i])
+	}
+	for i, dep := range deposits {
+		if !remaining.IsPositive() {
+			break
+		}
+		extra := sdk.MinInt(remaining, dep.Amount.Amount.Sub(shares[i]))
+		shares[i] = shares[i].Add(extra)
+		remaining = remaining.Sub(extra)
+	}
+	return shares
+}
+
+// getPartialLiquidation returns the collateral to seize and debt to cover when partially liquidating a cdp.
+// ok is false if the collateral type does not enable partial liquidation, the cdp is multi-collateral, or the cdp has to be
+// fully liquidated because it cannot be restored to its target ratio or the debt left would be below the debt floor.
+func (k Keeper) getPartialLiquidation(ctx sdk.Context, cdp types.CDP) (collateralSeized, debtCovered sdk.Int, ok bool) {
+	cp, found := k.GetCollateral(ctx, cdp.Type)
+	if !found || cp.PartialLiquidation == nil || cdp.IsMultiCollateral() {
+		return sdk.Int{}, sdk.Int{}, false
+	}
+	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, liquidation)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, false
+	}
+	targetRatio := cp.LiquidationRatio.Add(cp.PartialLiquidation.Buffer)
+	collateralSeized, debtCovered, ok = CalculatePartialLiquidation(
+		cdp.Collateral.Amount, cdp.GetTotalPrincipal().Amount, collateralizationRatio, targetRatio, cp.LiquidationPenalty,
+	)
+	if !ok {
+		return sdk.Int{}, sdk.Int{}, false
+	}
+	dp, found := k.GetDebtParam(ctx, cdp.Principal.Denom)
+	if !found || cdp.GetTotalPrincipal().Amount.Sub(debtCovered).LT(dp.DebtFloor) {
+		return sdk.Int{}, sdk.Int{}, false
+	}
+	return collateralSeized, debtCovered, true
+}
+
+// CalculatePartialLiquidation calculates the smallest amount of debt to cover, and the collateral to seize for it, that restores
+// a cdp's collateralization ratio to the target ratio. Seized collateral is valued at the debt covered plus the liquidation penalty.
+//
+// With collateral value V = ratio * debt, covering d debt seizes collateral worth d * (1 + penalty), so the cdp is restored when
+// V - d * (1 + penalty) >= target * (debt - d), which gives d >= (target * debt - V) / (target - 1 - penalty).
+// Debt covered is rounded up and collateral seized is rounded down, so the cdp ends at or above the target ratio.
+// ok is false if the cdp cannot be restored without seizing all of its collateral or covering all of its debt, or if no collateral would be seized.
+func CalculatePartialLiquidation(collateral, debt sdk.Int, collateralizationRatio, targetRatio, penalty sdk.Dec) (collateralSeized, debtCovered sdk.Int, ok bool) {
+	if !collateral.IsPositive() || !debt.IsPositive() || !collateralizationRatio.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, false
+	}
+	seizedValuePerDebt := sdk.OneDec().Add(penalty)
+	if targetRatio.LTE(seizedValuePerDebt) {
+		return sdk.Int{}, sdk.Int{}, false
+	}
+	if collateralizationRatio.GTE(targetRatio) {
+		return sdk.ZeroInt(), sdk.ZeroInt(), true
+	}
+
+	debtDec := debt.ToDec()
+	collateralValue := collateralizationRatio.Mul(debtDec)
+	debtCovered = targetRatio.Mul(debtDec).Sub(collateralValue).Quo(targetRatio.Sub(seizedValuePerDebt)).Ceil().TruncateInt()
+	collateralSeized = collateral.ToDec().Mul(debtCovered.ToDec().Mul(seizedValuePerDebt)).Quo(collateralValue).TruncateInt()
+
+	if debtCovered.GTE(debt) || collateralSeized.GTE(collateral) || !collateralSeized.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, false
+	}
+	return collateralSeized, debtCovered, true
+}
+
 // LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType string, liquidationRatio sdk.Dec, count sdk.Int) error {
 	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
@@ -118,8 +285,8 @@ func (k Keeper) ApplyLiquidationPenalty(ctx sdk.Context, collateralType string,
 }
 
 // ValidateLiquidation validate that adding the input principal puts the cdp below the liquidation ratio
-func (k Keeper) ValidateLiquidation(ctx sdk.Context, collateral sdk.Coin, collateralType string, principal sdk.Coin, fees sdk.Coin) error {
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, collateral, collateralType, principal, fees, liquidation)
+func (k Keeper) ValidateLiquidation(ctx sdk.Context, collateral sdk.Coin, collateralType string, basket types.BasketCollaterals, principal sdk.Coin, fees sdk.Coin) error {
+	collateralizationRatio, err := k.CalculateBasketCollateralizationRatio(ctx, collateral, collateralType, basket, principal, fees, liquidation)
 	if err != nil {
 		return err
 	}
@@ -152,16 +319,35 @@ func (k Keeper) payoutKeeperLiquidationReward(ctx sdk.Context, keeper sdk.AccAdd
 			break
 		}
 	}
+	if paidReward {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, keeper, sdk.NewCoins(rewardCoin))
+		if err != nil {
+			return types.CDP{}, err
+		}
+		cdp.Collateral = cdp.Collateral.Sub(rewardCoin)
+	}
+	// basket collateral pays the reward percentage of its own collateral type
+	for _, bc := range cdp.Basket {
+		basketParam, found := k.GetCollateral(ctx, bc.Type)
+		if !found {
+			continue
+		}
+		basketReward := sdk.NewCoin(bc.Amount.Denom, bc.Amount.Amount.ToDec().Mul(basketParam.KeeperRewardPercentage).RoundInt())
+		if !basketReward.IsPositive() || basketReward.IsGTE(bc.Amount) {
+			continue
+		}
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, keeper, sdk.NewCoins(basketReward))
+		if err != nil {
+			return types.CDP{}, err
+		}
+		cdp.Basket = cdp.Basket.Sub(bc.Type, basketReward)
+		paidReward = true
+	}
 	if !paidReward {
 		return cdp, nil
 	}
-	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, keeper, sdk.NewCoins(rewardCoin))
-	if err != nil {
-		return types.CDP{}, err
-	}
-	cdp.Collateral = cdp.Collateral.Sub(rewardCoin)
 	ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
-	err = k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, ratio)
+	err := k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, ratio)
 	if err != nil {
 		return types.CDP{}, err
 	}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -149,7 +150,7 @@ func (suite *SeizeTestSuite) TestSeizeCollateral() {
 
 	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
 	suite.Equal(p.Int64(), bk.GetBalance(suite.ctx, acc.GetAddress(), "usdx").Amount.Int64())
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10), "xrp-a")
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10), "xrp-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
 }
 
@@ -161,7 +162,7 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
 	suite.True(found)
 
-	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], c("xrp", 6999000000), "xrp-a")
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], c("xrp", 6999000000), "xrp-a", 0)
 	suite.NoError(err)
 
 	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
@@ -184,7 +185,7 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 
 	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
 	suite.Equal(p.Int64(), bk.GetBalance(suite.ctx, acc.GetAddress(), "usdx").Amount.Int64())
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10), "xrp-a")
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10), "xrp-a", 0)
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
 }
 
@@ -209,6 +210,258 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(10, xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) TestLiquidateCdps_TWAPMarket() {
+	suite.createCdps()
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+	acc := ak.GetModuleAccount(suite.ctx, types.ModuleName)
+
+	// track a TWAP for xrp:usd and use it as the liquidation market of xrp-a
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	for i, market := range pfParams.Markets {
+		if market.MarketID == "xrp:usd" {
+			pfParams.Markets[i].TwapWindow = time.Hour
+		}
+	}
+	pfKeeper.SetParams(suite.ctx, pfParams)
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == "xrp-a" {
+			params.CollateralParams[i].LiquidationMarketID = "xrp:usd:twap"
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	p, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+	suite.True(found)
+
+	originalXrpCollateral := bk.GetBalance(suite.ctx, acc.GetAddress(), "xrp").Amount
+	startTime := suite.ctx.BlockTime()
+	suite.setPrice(d("0.25"), "xrp:usd")
+
+	// a sudden drop in the spot price does not move the TWAP, so no cdps are liquidated
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Minute))
+	suite.setPrice(d("0.2"), "xrp:usd")
+	err := suite.keeper.LiquidateCdps(suite.ctx, p.LiquidationMarketID, "xrp-a", p.LiquidationRatio, p.CheckCollateralizationIndexCount)
+	suite.NoError(err)
+	acc = ak.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(originalXrpCollateral, bk.GetBalance(suite.ctx, acc.GetAddress(), "xrp").Amount)
+
+	// once the lower price has been held for the whole window the TWAP reflects it
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	suite.setPrice(d("0.2"), "xrp:usd")
+	twap, err := pfKeeper.GetCurrentPrice(suite.ctx, "xrp:usd:twap")
+	suite.NoError(err)
+	suite.Equal(d("0.2"), twap.Price)
+	err = suite.keeper.LiquidateCdps(suite.ctx, p.LiquidationMarketID, "xrp-a", p.LiquidationRatio, p.CheckCollateralizationIndexCount)
+	suite.NoError(err)
+
+	acc = ak.GetModuleAccount(suite.ctx, types.ModuleName)
+	finalXrpCollateral := bk.GetBalance(suite.ctx, acc.GetAddress(), "xrp").Amount
+	seizedXrpCollateral := originalXrpCollateral.Sub(finalXrpCollateral)
+	xrpLiquidations := int(seizedXrpCollateral.Quo(i(10000000000)).Int64())
+	suite.Equal(10, xrpLiquidations)
+}
+
+func (suite *SeizeTestSuite) enablePartialLiquidation(collateralType string, buffer sdk.Dec) {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Type == collateralType {
+			params.CollateralParams[i].PartialLiquidation = types.NewPartialLiquidation(buffer)
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *SeizeTestSuite) TestPartialLiquidation() {
+	suite.enablePartialLiquidation("xrp-a", d("0.2"))
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 400000000), c("usdx", 40000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 100000000), "xrp-a", 0)
+	suite.Require().NoError(err)
+
+	// collateral value 500,000,000 * 0.152 = 76,000,000, ratio 1.9 is below the liquidation ratio of 2.0
+	suite.setPrice(d("0.152"), "xrp:usd:30")
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	tpb := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx")
+
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	// covered debt (2.2 * 40,000,000 - 76,000,000) / (2.2 - 1.05) rounded up, collateral seized is worth it plus the penalty rounded down
+	debtCovered := i(10434783)
+	collateralSeized := i(72082382)
+	cdp, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 500000000).SubAmount(collateralSeized), cdp.Collateral)
+	suite.Equal(c("usdx", 40000000).SubAmount(debtCovered), cdp.GetTotalPrincipal())
+	suite.Equal(tpb.Sub(debtCovered), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+
+	ratio, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, cdp.Collateral, cdp.Type, cdp.Principal, cdp.AccumulatedFees, "liquidation")
+	suite.Require().NoError(err)
+	suite.True(ratio.GTE(d("2.2")), "ratio %s below target", ratio)
+
+	// deposits are seized in proportion to their size
+	deposit0, found := suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[0])
+	suite.Require().True(found)
+	deposit1, found := suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Equal(c("xrp", 342334094), deposit0.Amount)
+	suite.Equal(c("xrp", 85583524), deposit1.Amount)
+
+	auctionMacc := ak.GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(cs(c("debt", debtCovered.Int64()), c("xrp", collateralSeized.Int64())), bk.GetAllBalances(suite.ctx, auctionMacc.GetAddress()))
+
+	var partialEvents int
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeCdpPartialLiquidation {
+			continue
+		}
+		partialEvents++
+		suite.Contains(event.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyCollateralSeized), Value: []byte("72082382xrp")})
+		suite.Contains(event.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyDebtCovered), Value: []byte("10434783usdx")})
+	}
+	suite.Equal(1, partialEvents)
+
+	// the restored cdp is no longer liquidatable
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[2], suite.addrs[0], "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrNotLiquidatable))
+}
+
+func (suite *SeizeTestSuite) TestPartialLiquidationFallsBackToFullSeizure() {
+	suite.enablePartialLiquidation("xrp-a", d("0.2"))
+	ak := suite.app.GetAccountKeeper()
+	bk := suite.app.GetBankKeeper()
+
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 400000000), c("usdx", 40000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	// ratio 1.1 would need 38,260,870 of 40,000,000 debt covered, leaving less than the debt floor
+	suite.setPrice(d("0.11"), "xrp:usd:30")
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.Require().NoError(err)
+
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.False(found)
+	auctionMacc := ak.GetModuleAccount(suite.ctx, auctiontypes.ModuleName)
+	suite.Equal(cs(c("debt", 40000000), c("xrp", 400000000)), bk.GetAllBalances(suite.ctx, auctionMacc.GetAddress()))
+}
+
+func TestCalculatePartialLiquidation(t *testing.T) {
+	type expected struct {
+		collateralSeized sdk.Int
+		debtCovered      sdk.Int
+		ok               bool
+	}
+	testCases := []struct {
+		name                   string
+		collateral             sdk.Int
+		debt                   sdk.Int
+		collateralizationRatio sdk.Dec
+		targetRatio            sdk.Dec
+		penalty                sdk.Dec
+		expected               expected
+	}{
+		{
+			name:                   "restores target ratio",
+			collateral:             i(500000000),
+			debt:                   i(40000000),
+			collateralizationRatio: d("1.9"),
+			targetRatio:            d("2.2"),
+			penalty:                d("0.05"),
+			expected:               expected{i(72082382), i(10434783), true},
+		},
+		{
+			name:                   "exact amounts are not rounded",
+			collateral:             i(1500),
+			debt:                   i(1000),
+			collateralizationRatio: d("1.5"),
+			targetRatio:            d("2"),
+			penalty:                d("0"),
+			expected:               expected{i(500), i(500), true},
+		},
+		{
+			name:                   "at target ratio",
+			collateral:             i(1000),
+			debt:                   i(1000),
+			collateralizationRatio: d("2"),
+			targetRatio:            d("2"),
+			penalty:                d("0.05"),
+			expected:               expected{i(0), i(0), true},
+		},
+		{
+			name:                   "just below target ratio",
+			collateral:             i(1000000),
+			debt:                   i(1000000),
+			collateralizationRatio: d("1.999999"),
+			targetRatio:            d("2"),
+			penalty:                d("0.05"),
+			expected:               expected{i(1), i(2), true},
+		},
+		{
+			name:                   "all debt covered",
+			collateral:             i(1000),
+			debt:                   i(1000),
+			collateralizationRatio: d("1.05"),
+			targetRatio:            d("2"),
+			penalty:                d("0.05"),
+			expected:               expected{ok: false},
+		},
+		{
+			name:                   "undercollateralized",
+			collateral:             i(1000),
+			debt:                   i(1000),
+			collateralizationRatio: d("0.9"),
+			targetRatio:            d("2"),
+			penalty:                d("0.05"),
+			expected:               expected{ok: false},
+		},
+		{
+			name:                   "target ratio does not exceed penalty",
+			collateral:             i(1000),
+			debt:                   i(1000),
+			collateralizationRatio: d("1"),
+			targetRatio:            d("1.05"),
+			penalty:                d("0.05"),
+			expected:               expected{ok: false},
+		},
+		{
+			name:                   "zero debt",
+			collateral:             i(1000),
+			debt:                   i(0),
+			collateralizationRatio: d("1.5"),
+			targetRatio:            d("2"),
+			penalty:                d("0.05"),
+			expected:               expected{ok: false},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			collateralSeized, debtCovered, ok := keeper.CalculatePartialLiquidation(tc.collateral, tc.debt, tc.collateralizationRatio, tc.targetRatio, tc.penalty)
+			require.Equal(t, tc.expected.ok, ok)
+			if !tc.expected.ok {
+				return
+			}
+			require.Equal(t, tc.expected.collateralSeized, collateralSeized)
+			require.Equal(t, tc.expected.debtCovered, debtCovered)
+
+			// the remaining collateral is worth at least the target ratio of the remaining debt
+			if debtCovered.IsPositive() {
+				collateralValue := tc.collateralizationRatio.Mul(tc.debt.ToDec())
+				remainingValue := collateralValue.Mul(tc.collateral.Sub(collateralSeized).ToDec()).Quo(tc.collateral.ToDec())
+				require.True(t, remainingValue.GTE(tc.targetRatio.Mul(tc.debt.Sub(debtCovered).ToDec())))
+			}
+		})
+	}
+}
+
 func (suite *SeizeTestSuite) TestApplyLiquidationPenalty() {
 	penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "xrp-a", i(1000))
 	suite.Equal(i(50), penalty)
@@ -398,7 +651,7 @@ func (suite *SeizeTestSuite) TestKeeperLiquidation() {
 			_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], tc.args.ctype)
 			suite.Require().True(found)
 
-			err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], tc.args.ctype)
+			err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[1], suite.addrs[0], tc.args.ctype, 0)
 
 			if tc.errArgs.expectLiquidate {
 				suite.Require().NoError(err)
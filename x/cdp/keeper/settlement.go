@@ -0,0 +1,268 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+// StartGlobalSettlement shuts down the cdp system.
+// Interest is accumulated up to the current block and then stops, the spot price of every collateral type is frozen,
+// and the collateral each cdp owes for its debt at those prices is reserved to back redemptions of the stable asset.
+// Settlement must not be blocked by a market that has stopped reporting, so a collateral type without a spot price
+// falls back to its liquidation price, and a type with neither is frozen at a price of zero and backs no redemptions.
+func (k Keeper) StartGlobalSettlement(ctx sdk.Context) error {
+	if err := k.ValidateNoGlobalSettlement(ctx); err != nil {
+		return err
+	}
+	params := k.GetParams(ctx)
+
+	var collateral types.SettlementCollaterals
+	for _, cp := range params.CollateralParams {
+		if err := k.AccumulateInterest(ctx, cp.Type); err != nil {
+			return err
+		}
+		price := k.getSettlementPrice(ctx, cp)
+		collateral = append(collateral, types.NewSettlementCollateral(cp.Type, price, sdk.NewCoin(cp.Denom, sdk.ZeroInt())))
+	}
+	settlement := types.NewGlobalSettlement(ctx.BlockTime(), collateral, sdk.NewCoin(params.DebtParam.Denom, sdk.ZeroInt()))
+
+	var err error
+	k.IterateAllCdps(ctx, func(cdp types.CDP) bool {
+		var claims types.BasketCollaterals
+		claims, err = k.calculateSettlementClaims(ctx, settlement, cdp)
+		if err != nil {
+			return true
+		}
+		for _, claim := range claims {
+			for i := range settlement.Collateral {
+				if settlement.Collateral[i].CollateralType == claim.Type {
+					settlement.Collateral[i].Reserved = settlement.Collateral[i].Reserved.Add(claim.Amount)
+				}
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// surplus held by the liquidator is not redeemable, so it does not dilute the share of other holders,
+	// and stable asset minted by the peg stability module is redeemed from its reserves instead
+	supply := k.bankKeeper.GetSupply(ctx, params.DebtParam.Denom)
+	surplus := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.LiquidatorMacc), params.DebtParam.Denom)
+	redeemable := sdk.MaxInt(supply.Amount.Sub(surplus.Amount).Sub(k.GetPsmMinted(ctx)), sdk.ZeroInt())
+	settlement.RedeemableSupply = sdk.NewCoin(supply.Denom, redeemable)
+	settlement.Redeemed = sdk.NewCoin(supply.Denom, sdk.ZeroInt())
+	k.SetGlobalSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGlobalSettlement,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, settlement.RedeemableSupply.String()),
+		),
+	)
+	return nil
+}
+
+// SettleCDP closes a cdp during global settlement.
+// The collateral owed for the cdp's debt at the settlement prices stays reserved for redemptions, the rest of the
+// collateral is returned to the depositors in proportion to their deposits, and any basket collateral not owed is returned to the owner.
+// A cdpID of zero selects the owner's only cdp of the collateral type.
+func (k Keeper) SettleCDP(ctx sdk.Context, owner sdk.AccAddress, collateralType string, cdpID uint64) error {
+	settlement, found := k.GetGlobalSettlement(ctx)
+	if !found {
+		return types.ErrGlobalSettlementNotActive
+	}
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
+	}
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	claims, err := k.calculateSettlementClaims(ctx, settlement, cdp)
+	if err != nil {
+		return err
+	}
+
+	returned := sdk.NewCoins()
+	excess := cdp.Collateral.Amount.Sub(claims[0].Amount.Amount)
+	deposits := k.GetDeposits(ctx, cdp.ID)
+	shares := depositShares(deposits, cdp.Collateral.Amount, excess)
+	for i, dep := range deposits {
+		if shares[i].IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(dep.Amount.Denom, shares[i]))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dep.Depositor, coins); err != nil {
+				return err
+			}
+			returned = returned.Add(coins...)
+		}
+		k.DeleteDeposit(ctx, cdp.ID, dep.Depositor)
+	}
+	for i, bc := range cdp.Basket {
+		basketExcess := bc.Amount.Sub(claims[i+1].Amount)
+		if !basketExcess.IsPositive() {
+			continue
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cdp.Owner, sdk.NewCoins(basketExcess)); err != nil {
+			return err
+		}
+		returned = returned.Add(basketExcess)
+	}
+
+	// the cdp's debt is backed by its reserved collateral, so its debt coins are no longer needed
+	debt := cdp.GetTotalPrincipal()
+	debtDenom := k.GetDebtDenom(ctx)
+	debtCoins := sdk.NewCoin(debtDenom, sdk.MinInt(debt.Amount, k.getModAccountDebt(ctx, types.ModuleName)))
+	if debtCoins.IsPositive() {
+		if err := k.BurnDebtCoins(ctx, types.ModuleName, debtDenom, debtCoins); err != nil {
+			return err
+		}
+	}
+	k.DecrementTotalPrincipal(ctx, cdp.Type, debt)
+
+	k.RemoveCdpOwnerIndex(ctx, cdp)
+	if err := k.DeleteCdpAndCollateralRatioIndex(ctx, cdp); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpSettle,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returned.String()),
+		),
+	)
+	return nil
+}
+
+// RedeemSettlement burns stable asset during global settlement and pays the redeemer its pro-rata share of each collateral type's reserved collateral.
+// The share of a collateral type is the amount redeemed divided by the redeemable supply, times the collateral reserved.
+func (k Keeper) RedeemSettlement(ctx sdk.Context, redeemer sdk.AccAddress, amount sdk.Coin) error {
+	settlement, found := k.GetGlobalSettlement(ctx)
+	if !found {
+		return types.ErrGlobalSettlementNotActive
+	}
+	if amount.Denom != settlement.RedeemableSupply.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidRedemption, "expected %s, got %s", settlement.RedeemableSupply.Denom, amount.Denom)
+	}
+	remaining := settlement.RedeemableSupply.Sub(settlement.Redeemed)
+	if amount.Amount.GT(remaining.Amount) {
+		return sdkerrors.Wrapf(types.ErrInvalidRedemption, "%s exceeds remaining redeemable supply %s", amount, remaining)
+	}
+	err := k.ValidateBalance(ctx, amount, redeemer)
+	if err != nil {
+		return err
+	}
+
+	payout := sdk.NewCoins()
+	for i, sc := range settlement.Collateral {
+		share := sc.Reserved.Amount.Mul(amount.Amount).Quo(settlement.RedeemableSupply.Amount)
+		share = sdk.MinInt(share, sc.Reserved.Amount.Sub(sc.Redeemed.Amount))
+		if !share.IsPositive() {
+			continue
+		}
+		settlement.Collateral[i].Redeemed = sc.Redeemed.AddAmount(share)
+		payout = payout.Add(sdk.NewCoin(sc.Reserved.Denom, share))
+	}
+	if payout.IsZero() {
+		return sdkerrors.Wrapf(types.ErrInvalidRedemption, "%s is too small to redeem any collateral", amount)
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, payout)
+	if err != nil {
+		return err
+	}
+
+	settlement.Redeemed = settlement.Redeemed.Add(amount)
+	k.SetGlobalSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettlementRedemption,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateral, payout.String()),
+		),
+	)
+	return nil
+}
+
+// calculateSettlementClaims returns the collateral a cdp owes for its debt at the settlement prices.
+// The first claim is against the cdp's collateral, followed by one claim for each basket entry in order.
+// Debt is covered by the cdp's collateral first, then by its basket collateral, and a claim never exceeds the collateral held.
+// Collateral settled at a price of zero covers no debt, so nothing is claimed against it.
+func (k Keeper) calculateSettlementClaims(ctx sdk.Context, settlement types.GlobalSettlement, cdp types.CDP) (types.BasketCollaterals, error) {
+	debt := cdp.GetTotalPrincipal().Add(k.CalculateNewInterest(ctx, cdp))
+	remaining := k.convertDebtToBaseUnits(ctx, debt)
+
+	holdings := append(types.BasketCollaterals{types.NewBasketCollateral(cdp.Type, cdp.Collateral)}, cdp.Basket...)
+	claims := make(types.BasketCollaterals, len(holdings))
+	for i, holding := range holdings {
+		sc, found := settlement.GetCollateral(holding.Type)
+		if !found {
+			return nil, sdkerrors.Wrap(types.ErrCollateralNotSupported, holding.Type)
+		}
+		cp, _ := k.GetCollateral(ctx, holding.Type)
+
+		owed := sdk.ZeroInt()
+		if remaining.IsPositive() && sc.Price.IsPositive() {
+			owed = remaining.Quo(sc.Price).MulInt(sdk.NewIntWithDecimal(1, int(cp.ConversionFactor.Int64()))).Ceil().TruncateInt()
+			owed = sdk.MinInt(owed, holding.Amount.Amount)
+			owedValue := k.convertCollateralToBaseUnits(ctx, sdk.NewCoin(holding.Amount.Denom, owed), holding.Type).Mul(sc.Price)
+			remaining = remaining.Sub(owedValue)
+		}
+		claims[i] = types.NewBasketCollateral(holding.Type, sdk.NewCoin(holding.Amount.Denom, owed))
+	}
+	return claims, nil
+}
+
+// getSettlementPrice returns the price a collateral type is frozen at by global settlement.
+// It is the spot price, or the liquidation price if the spot market has no price, or zero if neither market has a price.
+func (k Keeper) getSettlementPrice(ctx sdk.Context, cp types.CollateralParam) sdk.Dec {
+	for _, marketID := range []string{cp.SpotMarketID, cp.LiquidationMarketID} {
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+		if err == nil {
+			return price.Price
+		}
+	}
+	return sdk.ZeroDec()
+}
+
+// ValidateNoGlobalSettlement returns an error if the cdp system has been shut down by global settlement
+func (k Keeper) ValidateNoGlobalSettlement(ctx sdk.Context) error {
+	if _, found := k.GetGlobalSettlement(ctx); found {
+		return types.ErrGlobalSettlementActive
+	}
+	return nil
+}
+
+// GetGlobalSettlement returns the global settlement of the cdp system, if it has been shut down
+func (k Keeper) GetGlobalSettlement(ctx sdk.Context) (types.GlobalSettlement, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.GlobalSettlementKey)
+	if bz == nil {
+		return types.GlobalSettlement{}, false
+	}
+	var settlement types.GlobalSettlement
+	k.cdc.MustUnmarshal(bz, &settlement)
+	return settlement, true
+}
+
+// SetGlobalSettlement sets the global settlement of the cdp system
+func (k Keeper) SetGlobalSettlement(ctx sdk.Context, settlement types.GlobalSettlement) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.GlobalSettlementKey, k.cdc.MustMarshal(&settlement))
+}
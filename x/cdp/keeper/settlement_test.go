@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/cdp"
+	"github.com/mokitanetwork/aether/x/cdp/keeper"
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+type SettlementTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *SettlementTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(10)
+	authGS := app.NewFundedGenStateWithCoins(
+		cdc,
+		[]sdk.Coins{
+			cs(c("xrp", 500000000), c("btc", 100000000)),
+			cs(c("xrp", 200000000)),
+			cs(c("xrp", 200000000)),
+		},
+		addrs[0:3],
+	)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	// 20 usdx owes 80 xrp at 0.25
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 20000000), "xrp-a")
+	suite.Require().NoError(err)
+	// 10 usdx owes 40 xrp at 0.25
+	err = suite.keeper.AddCdp(suite.ctx, addrs[1], c("xrp", 200000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+}
+
+func (suite *SettlementTestSuite) TestStartGlobalSettlement() {
+	_, found := suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.False(found)
+
+	err := suite.keeper.StartGlobalSettlement(suite.ctx)
+	suite.Require().NoError(err)
+
+	settlement, found := suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Require().True(found)
+	suite.Equal(suite.ctx.BlockTime().UTC(), settlement.StartTime.UTC())
+	suite.Equal(c("usdx", 30000000), settlement.RedeemableSupply)
+	suite.Equal(c("usdx", 0), settlement.Redeemed)
+
+	xrp, found := settlement.GetCollateral("xrp-a")
+	suite.Require().True(found)
+	suite.Equal(d("0.25"), xrp.Price)
+	suite.Equal(c("xrp", 120000000), xrp.Reserved)
+	suite.Equal(c("xrp", 0), xrp.Redeemed)
+
+	btc, found := settlement.GetCollateral("btc-a")
+	suite.Require().True(found)
+	suite.Equal(d("8000"), btc.Price)
+	suite.Equal(c("btc", 0), btc.Reserved)
+
+	err = suite.keeper.StartGlobalSettlement(suite.ctx)
+	suite.True(errors.Is(err, types.ErrGlobalSettlementActive))
+}
+
+func (suite *SettlementTestSuite) TestStartGlobalSettlementWithoutPrice() {
+	// the xrp prices expire, so neither the xrp spot nor liquidation market has a price
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 2))
+	pk := suite.app.GetPriceFeedKeeper()
+	for _, marketID := range []string{"xrp:usd", "xrp:usd:30"} {
+		err := pk.SetCurrentPrices(suite.ctx, marketID)
+		suite.Require().Error(err)
+	}
+
+	err := suite.keeper.StartGlobalSettlement(suite.ctx)
+	suite.Require().NoError(err)
+
+	settlement, found := suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().NoError(settlement.Validate())
+	suite.Equal(c("usdx", 30000000), settlement.RedeemableSupply)
+
+	// xrp without a price backs no redemptions
+	xrp, found := settlement.GetCollateral("xrp-a")
+	suite.Require().True(found)
+	suite.Equal(sdk.ZeroDec(), xrp.Price)
+	suite.Equal(c("xrp", 0), xrp.Reserved)
+
+	// so settling returns all of the cdp's collateral
+	balance := suite.app.GetBankKeeper().GetBalance(suite.ctx, suite.addrs[0], "xrp")
+	err = suite.keeper.SettleCDP(suite.ctx, suite.addrs[0], "xrp-a", 0)
+	suite.Require().NoError(err)
+	suite.Equal(balance.AddAmount(i(400000000)), suite.app.GetBankKeeper().GetBalance(suite.ctx, suite.addrs[0], "xrp"))
+
+	err = suite.keeper.RedeemSettlement(suite.ctx, suite.addrs[1], c("usdx", 10000000))
+	suite.True(errors.Is(err, types.ErrInvalidRedemption))
+}
+
+func (suite *SettlementTestSuite) TestGlobalSettlementBlocksCdpActions() {
+	err := suite.keeper.StartGlobalSettlement(suite.ctx)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[2], c("xrp", 200000000), c("usdx", 10000000), "xrp-a")
+	suite.True(errors.Is(err, types.ErrGlobalSettlementActive))
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a", 0)
+	suite.True(errors.Is(err, types.ErrGlobalSettlementActive))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 10000000), "xrp-a", 0)
+	suite.True(errors.Is(err, types.ErrGlobalSettlementActive))
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp-a", 0, c("usdx", 1000000))
+	suite.True(errors.Is(err, types.ErrGlobalSettlementActive))
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp-a", 0, c("usdx", 1000000))
+	suite.True(errors.Is(err, types.ErrGlobalSettlementActive))
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[2], suite.addrs[0], "xrp-a", 0)
+	suite.True(errors.Is(err, types.ErrGlobalSettlementActive))
+}
+
+func (suite *SettlementTestSuite) TestSettleCDP() {
+	err := suite.keeper.SettleCDP(suite.ctx, suite.addrs[0], "xrp-a", 0)
+	suite.True(errors.Is(err, types.ErrGlobalSettlementNotActive))
+
+	err = suite.keeper.StartGlobalSettlement(suite.ctx)
+	suite.Require().NoError(err)
+
+	balance := suite.app.GetBankKeeper().GetBalance(suite.ctx, suite.addrs[0], "xrp")
+	err = suite.keeper.SettleCDP(suite.ctx, suite.addrs[0], "xrp-a", 0)
+	suite.Require().NoError(err)
+
+	// the 80 xrp owed for the debt stays reserved, the remaining 320 xrp are returned
+	suite.Equal(balance.AddAmount(i(320000000)), suite.app.GetBankKeeper().GetBalance(suite.ctx, suite.addrs[0], "xrp"))
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.False(found)
+	_, found = suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[0])
+	suite.False(found)
+	suite.Equal(i(10000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+
+	err = suite.keeper.SettleCDP(suite.ctx, suite.addrs[0], "xrp-a", 0)
+	suite.True(errors.Is(err, types.ErrCdpNotFound))
+}
+
+func (suite *SettlementTestSuite) TestRedeemSettlement() {
+	err := suite.keeper.RedeemSettlement(suite.ctx, suite.addrs[0], c("usdx", 15000000))
+	suite.True(errors.Is(err, types.ErrGlobalSettlementNotActive))
+
+	err = suite.keeper.StartGlobalSettlement(suite.ctx)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.RedeemSettlement(suite.ctx, suite.addrs[0], c("xrp", 15000000))
+	suite.True(errors.Is(err, types.ErrInvalidRedemption))
+	err = suite.keeper.RedeemSettlement(suite.ctx, suite.addrs[0], c("usdx", 30000001))
+	suite.True(errors.Is(err, types.ErrInvalidRedemption))
+
+	bk := suite.app.GetBankKeeper()
+	balance := bk.GetBalance(suite.ctx, suite.addrs[0], "xrp")
+	err = suite.keeper.RedeemSettlement(suite.ctx, suite.addrs[0], c("usdx", 15000000))
+	suite.Require().NoError(err)
+
+	// half of the redeemable supply is paid half of the reserved collateral
+	suite.Equal(balance.AddAmount(i(60000000)), bk.GetBalance(suite.ctx, suite.addrs[0], "xrp"))
+	suite.Equal(c("usdx", 5000000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdx"))
+	suite.Equal(c("usdx", 15000000), bk.GetSupply(suite.ctx, "usdx"))
+
+	settlement, found := suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 15000000), settlement.Redeemed)
+	xrp, _ := settlement.GetCollateral("xrp-a")
+	suite.Equal(c("xrp", 60000000), xrp.Redeemed)
+
+	// redemptions and settlements draw on separate collateral, so both can complete
+	err = suite.keeper.SettleCDP(suite.ctx, suite.addrs[1], "xrp-a", 0)
+	suite.Require().NoError(err)
+	err = suite.keeper.RedeemSettlement(suite.ctx, suite.addrs[1], c("usdx", 10000000))
+	suite.Require().NoError(err)
+	err = suite.keeper.RedeemSettlement(suite.ctx, suite.addrs[0], c("usdx", 5000000))
+	suite.Require().NoError(err)
+
+	settlement, _ = suite.keeper.GetGlobalSettlement(suite.ctx)
+	suite.Equal(settlement.RedeemableSupply, settlement.Redeemed)
+	xrp, _ = settlement.GetCollateral("xrp-a")
+	suite.Equal(xrp.Reserved, xrp.Redeemed)
+}
+
+func (suite *SettlementTestSuite) TestBeginBlockerSkippedDuringSettlement() {
+	err := suite.keeper.StartGlobalSettlement(suite.ctx)
+	suite.Require().NoError(err)
+	accrualTime, found := suite.keeper.GetPreviousAccrualTime(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 24 * 365))
+	cdp.BeginBlocker(suite.ctx, abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()}, suite.keeper)
+
+	after, found := suite.keeper.GetPreviousAccrualTime(suite.ctx, "xrp-a")
+	suite.Require().True(found)
+	suite.Equal(accrualTime, after)
+	settled, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.Equal(c("usdx", 0), suite.keeper.CalculateNewInterest(suite.ctx, settled))
+}
+
+func TestSettlementTestSuite(t *testing.T) {
+	suite.Run(t, new(SettlementTestSuite))
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+// TransferCDP reassigns ownership of a cdp to the recipient
+// The owner's deposit moves to the recipient and the cdp's managers are cleared.
+// A cdpID of zero selects the owner's only cdp of the collateral type.
+func (k Keeper) TransferCDP(ctx sdk.Context, owner, recipient sdk.AccAddress, collateralType string, cdpID uint64) error {
+	if owner.Equals(recipient) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "recipient %s is already the owner", recipient)
+	}
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
+	}
+	// accumulate the outgoing owner's rewards up to now
+	k.hooks.BeforeCDPModified(ctx, cdp)
+	cdp = k.SynchronizeInterest(ctx, cdp)
+
+	ownerDeposit, found := k.GetDeposit(ctx, cdp.ID, owner)
+	if found {
+		k.DeleteDeposit(ctx, cdp.ID, owner)
+		recipientDeposit, found := k.GetDeposit(ctx, cdp.ID, recipient)
+		if found {
+			recipientDeposit.Amount = recipientDeposit.Amount.Add(ownerDeposit.Amount)
+		} else {
+			recipientDeposit = types.NewDeposit(cdp.ID, recipient, ownerDeposit.Amount)
+		}
+		k.SetDeposit(ctx, recipientDeposit)
+	}
+
+	k.RemoveCdpOwnerIndex(ctx, cdp)
+	cdp.Owner = recipient
+	cdp.Managers = nil
+	if err := k.SetCDP(ctx, cdp); err != nil {
+		return err
+	}
+	k.IndexCdpByOwner(ctx, cdp)
+
+	// start the recipient's rewards from the current index
+	k.hooks.AfterCDPCreated(ctx, cdp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpTransfer,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		),
+	)
+	return nil
+}
+
+// SetCdpManagers replaces the list of addresses that may deposit, withdraw and repay on behalf of the cdp owner
+// An empty list removes all managers.
+func (k Keeper) SetCdpManagers(ctx sdk.Context, owner sdk.AccAddress, collateralType string, cdpID uint64, managers []sdk.AccAddress) error {
+	cdp, err := k.GetOwnerCdp(ctx, owner, collateralType, cdpID)
+	if err != nil {
+		return err
+	}
+	if err := types.ValidateManagers(owner, managers); err != nil {
+		return err
+	}
+	// a manager's deposits are credited to the owner, so it cannot hold a deposit of its own
+	for _, manager := range managers {
+		if _, found := k.GetDeposit(ctx, cdp.ID, manager); found {
+			return sdkerrors.Wrapf(types.ErrInvalidManager, "manager %s has a deposit in cdp %d", manager, cdp.ID)
+		}
+	}
+
+	cdp.Managers = managers
+	if err := k.SetCDP(ctx, cdp); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpSetManagers,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyManagers, strings.Join(cdp.ManagerStrings(), ",")),
+		),
+	)
+	return nil
+}
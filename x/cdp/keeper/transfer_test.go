@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/cdp/keeper"
+	"github.com/mokitanetwork/aether/x/cdp/types"
+)
+
+type TransferTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *TransferTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.AppCodec()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(10)
+	authGS := app.NewFundedGenStateWithCoins(
+		cdc,
+		[]sdk.Coins{
+			cs(c("xrp", 500000000)),
+			cs(c("xrp", 200000000)),
+			cs(c("xrp", 200000000), c("usdx", 10000000)),
+		},
+		addrs[0:3],
+	)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(cdc),
+		NewCDPGenStateMulti(cdc),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 400000000), c("usdx", 20000000), "xrp-a")
+	suite.NoError(err)
+}
+
+func (suite *TransferTestSuite) TestTransferCDP() {
+	err := suite.keeper.SetCdpManagers(suite.ctx, suite.addrs[0], "xrp-a", 0, []sdk.AccAddress{suite.addrs[2]})
+	suite.Require().NoError(err)
+
+	err = suite.keeper.TransferCDP(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp-a", 0)
+	suite.Require().Error(err)
+	err = suite.keeper.TransferCDP(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+
+	err = suite.keeper.TransferCDP(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", 0)
+	suite.Require().NoError(err)
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Require().True(found)
+	suite.Equal(suite.addrs[1], cdp.Owner)
+	suite.Empty(cdp.Managers)
+
+	_, found = suite.keeper.GetCdpIdsByOwner(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	ids, found := suite.keeper.GetCdpIdsByOwner(suite.ctx, suite.addrs[1])
+	suite.True(found)
+	suite.Equal([]uint64{1}, ids)
+
+	_, found = suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[0])
+	suite.False(found)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[1])
+	suite.True(found)
+	suite.Equal(c("xrp", 400000000), deposit.Amount)
+
+	_, found = suite.app.GetIncentiveKeeper().GetUSDXMintingClaim(suite.ctx, suite.addrs[1])
+	suite.True(found)
+
+	err = suite.keeper.TransferCDP(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[2], c("xrp", 10000000), "xrp-a", 0)
+	suite.Require().True(errors.Is(err, types.ErrDepositNotFound))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], c("xrp", 10000000), "xrp-a", 0)
+	suite.Require().NoError(err)
+}
+
+func (suite *TransferTestSuite) TestTransferCDPMergesRecipientDeposit() {
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a", 0)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.TransferCDP(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", 0)
+	suite.Require().NoError(err)
+
+	deposits := suite.keeper.GetDeposits(suite.ctx, 1)
+	suite.Require().Len(deposits, 1)
+	suite.Equal(suite.addrs[1], deposits[0].Depositor)
+	suite.Equal(c("xrp", 410000000), deposits[0].Amount)
+}
+
+func (suite *TransferTestSuite) TestSetCdpManagers() {
+	err := suite.keeper.SetCdpManagers(suite.ctx, suite.addrs[1], "xrp-a", 0, []sdk.AccAddress{suite.addrs[2]})
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+	err = suite.keeper.SetCdpManagers(suite.ctx, suite.addrs[0], "xrp-a", 0, []sdk.AccAddress{suite.addrs[0]})
+	suite.Require().True(errors.Is(err, types.ErrInvalidManager))
+
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a", 0)
+	suite.Require().NoError(err)
+	err = suite.keeper.SetCdpManagers(suite.ctx, suite.addrs[0], "xrp-a", 0, []sdk.AccAddress{suite.addrs[1]})
+	suite.Require().True(errors.Is(err, types.ErrInvalidManager))
+
+	err = suite.keeper.SetCdpManagers(suite.ctx, suite.addrs[0], "xrp-a", 0, []sdk.AccAddress{suite.addrs[2]})
+	suite.Require().NoError(err)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.True(cdp.IsManager(suite.addrs[2]))
+
+	err = suite.keeper.SetCdpManagers(suite.ctx, suite.addrs[0], "xrp-a", 0, nil)
+	suite.Require().NoError(err)
+	cdp, _ = suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.False(cdp.IsManager(suite.addrs[2]))
+}
+
+func (suite *TransferTestSuite) TestManagerActions() {
+	err := suite.keeper.SetCdpManagers(suite.ctx, suite.addrs[0], "xrp-a", 0, []sdk.AccAddress{suite.addrs[2]})
+	suite.Require().NoError(err)
+	bk := suite.app.GetBankKeeper()
+
+	// manager deposits are credited to the owner
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[2], c("xrp", 10000000), "xrp-a", 0)
+	suite.Require().NoError(err)
+	_, found := suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[2])
+	suite.False(found)
+	deposit, _ := suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[0])
+	suite.Equal(c("xrp", 410000000), deposit.Amount)
+
+	// manager withdrawals are returned to the owner
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[2], c("xrp", 20000000), "xrp-a", 0)
+	suite.Require().NoError(err)
+	suite.Equal(i(120000000), bk.GetBalance(suite.ctx, suite.addrs[0], "xrp").Amount)
+	suite.Equal(i(190000000), bk.GetBalance(suite.ctx, suite.addrs[2], "xrp").Amount)
+
+	// manager repayments come from the manager
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp-a", 0, c("usdx", 1000000))
+	suite.Require().True(errors.Is(err, types.ErrNotOwnerOrManager))
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], suite.addrs[2], "xrp-a", 0, c("usdx", 1000000))
+	suite.Require().NoError(err)
+	suite.Equal(i(9000000), bk.GetBalance(suite.ctx, suite.addrs[2], "usdx").Amount)
+	suite.Equal(i(20000000), bk.GetBalance(suite.ctx, suite.addrs[0], "usdx").Amount)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", 1)
+	suite.Equal(c("usdx", 19000000), cdp.Principal)
+}
+
+func TestTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferTestSuite))
+}
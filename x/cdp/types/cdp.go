@@ -10,6 +10,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxCdpManagers is the maximum number of managers a cdp can have
+const MaxCdpManagers = 10
+
 // NewCDP creates a new CDP object
 func NewCDP(id uint64, owner sdk.AccAddress, collateral sdk.Coin, collateralType string, principal sdk.Coin, time time.Time, interestFactor sdk.Dec) CDP {
 	fees := sdk.NewCoin(principal.Denom, sdk.ZeroInt())
@@ -62,9 +65,48 @@ func (cdp CDP) Validate() error {
 	if strings.TrimSpace(cdp.Type) == "" {
 		return fmt.Errorf("cdp type cannot be empty")
 	}
+	if err := cdp.Basket.Validate(cdp.Type); err != nil {
+		return err
+	}
+	return ValidateManagers(cdp.Owner, cdp.Managers)
+}
+
+// ValidateManagers checks a cdp manager list is within the size limit, has no duplicates and does not include the owner
+func ValidateManagers(owner sdk.AccAddress, managers []sdk.AccAddress) error {
+	if len(managers) > MaxCdpManagers {
+		return sdkerrors.Wrapf(ErrInvalidManager, "%d managers exceeds the maximum of %d", len(managers), MaxCdpManagers)
+	}
+	seen := make(map[string]bool)
+	for _, manager := range managers {
+		if manager.Empty() {
+			return sdkerrors.Wrap(ErrInvalidManager, "manager cannot be empty")
+		}
+		if manager.Equals(owner) {
+			return sdkerrors.Wrapf(ErrInvalidManager, "owner %s cannot be a manager", owner)
+		}
+		if seen[manager.String()] {
+			return sdkerrors.Wrapf(ErrInvalidManager, "duplicate manager %s", manager)
+		}
+		seen[manager.String()] = true
+	}
 	return nil
 }
 
+// IsManager returns true if the address is one of the cdp's managers
+func (cdp CDP) IsManager(addr sdk.AccAddress) bool {
+	for _, manager := range cdp.Managers {
+		if manager.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMultiCollateral returns true if the cdp holds collateral of other collateral types in its basket
+func (cdp CDP) IsMultiCollateral() bool {
+	return len(cdp.Basket) > 0
+}
+
 // GetTotalPrincipal returns the total principle for the cdp
 func (cdp CDP) GetTotalPrincipal() sdk.Coin {
 	return cdp.Principal.Add(cdp.AccumulatedFees)
@@ -97,6 +139,87 @@ func (cdps CDPs) Validate() error {
 	return nil
 }
 
+// NewBasketCollateral returns a new BasketCollateral
+func NewBasketCollateral(collateralType string, amount sdk.Coin) BasketCollateral {
+	return BasketCollateral{
+		Type:   collateralType,
+		Amount: amount,
+	}
+}
+
+// BasketCollaterals a collection of BasketCollateral objects, with at most one per collateral type
+type BasketCollaterals []BasketCollateral
+
+// Validate checks each basket collateral is a positive amount of a distinct collateral type other than the cdp's own type
+func (bcs BasketCollaterals) Validate(cdpType string) error {
+	seen := make(map[string]bool)
+	for _, bc := range bcs {
+		if strings.TrimSpace(bc.Type) == "" {
+			return errors.New("basket collateral type cannot be empty")
+		}
+		if bc.Type == cdpType {
+			return fmt.Errorf("basket cannot hold the cdp's collateral type %s", cdpType)
+		}
+		if seen[bc.Type] {
+			return fmt.Errorf("duplicate basket collateral type %s", bc.Type)
+		}
+		seen[bc.Type] = true
+		if !bc.Amount.IsValid() || !bc.Amount.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "basket collateral %s %s", bc.Amount, bc.Type)
+		}
+	}
+	return nil
+}
+
+// AmountOf returns the basket's collateral of the collateral type, found is false if it has none
+func (bcs BasketCollaterals) AmountOf(collateralType string) (amount sdk.Coin, found bool) {
+	for _, bc := range bcs {
+		if bc.Type == collateralType {
+			return bc.Amount, true
+		}
+	}
+	return sdk.Coin{}, false
+}
+
+// Add returns a copy of the basket with the amount added to the collateral type
+func (bcs BasketCollaterals) Add(collateralType string, amount sdk.Coin) BasketCollaterals {
+	updated := make(BasketCollaterals, 0, len(bcs)+1)
+	added := false
+	for _, bc := range bcs {
+		if bc.Type == collateralType {
+			bc.Amount = bc.Amount.Add(amount)
+			added = true
+		}
+		updated = append(updated, bc)
+	}
+	if !added {
+		updated = append(updated, NewBasketCollateral(collateralType, amount))
+	}
+	return updated
+}
+
+// Sub returns a copy of the basket with the amount removed from the collateral type, dropping the collateral type if none is left.
+// It panics if the basket holds less than the amount of the collateral type.
+func (bcs BasketCollaterals) Sub(collateralType string, amount sdk.Coin) BasketCollaterals {
+	current, found := bcs.AmountOf(collateralType)
+	if !found {
+		current = sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	}
+	remaining := current.Sub(amount)
+	updated := make(BasketCollaterals, 0, len(bcs))
+	for _, bc := range bcs {
+		if bc.Type != collateralType {
+			updated = append(updated, bc)
+		} else if remaining.IsPositive() {
+			updated = append(updated, NewBasketCollateral(collateralType, remaining))
+		}
+	}
+	if len(updated) == 0 {
+		return nil
+	}
+	return updated
+}
+
 // AugmentedCDP provides additional information about an active CDP.
 // This is only used for the legacy querier and legacy rest endpoints.
 type AugmentedCDP struct {
@@ -117,6 +240,8 @@ func NewAugmentedCDP(cdp CDP, collateralValue sdk.Coin, collateralizationRatio s
 			AccumulatedFees: cdp.AccumulatedFees,
 			FeesUpdated:     cdp.FeesUpdated,
 			InterestFactor:  cdp.InterestFactor,
+			Managers:        cdp.Managers,
+			Basket:          cdp.Basket,
 		},
 		CollateralValue:        collateralValue,
 		CollateralizationRatio: collateralizationRatio,
@@ -175,12 +300,29 @@ func NewCDPResponse(cdp CDP, collateralValue sdk.Coin, collateralizationRatio sd
 		InterestFactor:         cdp.InterestFactor.String(),
 		CollateralValue:        collateralValue,
 		CollateralizationRatio: collateralizationRatio.String(),
+		Managers:               cdp.ManagerStrings(),
+		Basket:                 cdp.Basket,
 	}
 }
 
+// ManagerStrings returns the cdp's manager addresses as bech32 strings
+func (cdp CDP) ManagerStrings() []string {
+	if len(cdp.Managers) == 0 {
+		return nil
+	}
+	managers := make([]string, len(cdp.Managers))
+	for i, manager := range cdp.Managers {
+		managers[i] = manager.String()
+	}
+	return managers
+}
+
 // CDPResponses a collection of CDPResponse objects
 type CDPResponses []CDPResponse
 
+// CdpRiskResponses a collection of CdpRiskResponse objects
+type CdpRiskResponses []CdpRiskResponse
+
 // TotalPrincipals a collection of TotalPrincipal objects
 type TotalPrincipals []TotalPrincipal
 
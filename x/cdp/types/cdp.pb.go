@@ -40,13 +40,17 @@ type CDP struct {
 	AccumulatedFees types.Coin                                    `protobuf:"bytes,6,opt,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees"`
 	FeesUpdated     time.Time                                     `protobuf:"bytes,7,opt,name=fees_updated,json=feesUpdated,proto3,stdtime" json:"fees_updated"`
 	InterestFactor  github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,8,opt,name=interest_factor,json=interestFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_factor"`
+	// managers may deposit, withdraw and repay on behalf of the owner
+	Managers []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,9,rep,name=managers,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"managers,omitempty"`
+	// basket holds the owner's collateral of other collateral types, which backs the cdp's debt alongside its collateral
+	Basket BasketCollaterals `protobuf:"bytes,10,rep,name=basket,proto3,castrepeated=BasketCollaterals" json:"basket"`
 }
 
 func (m *CDP) Reset()         { *m = CDP{} }
 func (m *CDP) String() string { return proto.CompactTextString(m) }
 func (*CDP) ProtoMessage()    {}
 func (*CDP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a9ab097fb7be40, []int{0}
+	return fileDescriptor_e36c31b6abab7aa5, []int{0}
 }
 func (m *CDP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -75,6 +79,45 @@ func (m *CDP) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CDP proto.InternalMessageInfo
 
+// BasketCollateral defines an amount of collateral of one collateral type held by a multi-collateral cdp
+type BasketCollateral struct {
+	Type   string     `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *BasketCollateral) Reset()         { *m = BasketCollateral{} }
+func (m *BasketCollateral) String() string { return proto.CompactTextString(m) }
+func (*BasketCollateral) ProtoMessage()    {}
+func (*BasketCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e36c31b6abab7aa5, []int{1}
+}
+func (m *BasketCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasketCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasketCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BasketCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasketCollateral.Merge(m, src)
+}
+func (m *BasketCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasketCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasketCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasketCollateral proto.InternalMessageInfo
+
 // Deposit defines an amount of coins deposited by an account to a cdp
 type Deposit struct {
 	CdpID     uint64                                        `protobuf:"varint,1,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
@@ -86,7 +129,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a9ab097fb7be40, []int{1}
+	return fileDescriptor_e36c31b6abab7aa5, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,7 +168,7 @@ func (m *TotalPrincipal) Reset()         { *m = TotalPrincipal{} }
 func (m *TotalPrincipal) String() string { return proto.CompactTextString(m) }
 func (*TotalPrincipal) ProtoMessage()    {}
 func (*TotalPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a9ab097fb7be40, []int{2}
+	return fileDescriptor_e36c31b6abab7aa5, []int{3}
 }
 func (m *TotalPrincipal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +207,7 @@ func (m *TotalCollateral) Reset()         { *m = TotalCollateral{} }
 func (m *TotalCollateral) String() string { return proto.CompactTextString(m) }
 func (*TotalCollateral) ProtoMessage()    {}
 func (*TotalCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a9ab097fb7be40, []int{3}
+	return fileDescriptor_e36c31b6abab7aa5, []int{4}
 }
 func (m *TotalCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,6 +236,93 @@ func (m *TotalCollateral) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TotalCollateral proto.InternalMessageInfo
 
+// GlobalSettlement records the state of the cdp system after an emergency shutdown.
+// Prices are frozen when settlement starts, and stable asset holders redeem their stable asset for a share of the reserved collateral.
+type GlobalSettlement struct {
+	StartTime  time.Time             `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	Collateral SettlementCollaterals `protobuf:"bytes,2,rep,name=collateral,proto3,castrepeated=SettlementCollaterals" json:"collateral"`
+	// redeemable_supply is the stable asset supply when settlement started, less the surplus held by the liquidator
+	RedeemableSupply types.Coin `protobuf:"bytes,3,opt,name=redeemable_supply,json=redeemableSupply,proto3" json:"redeemable_supply"`
+	// redeemed is the stable asset redeemed so far
+	Redeemed types.Coin `protobuf:"bytes,4,opt,name=redeemed,proto3" json:"redeemed"`
+}
+
+func (m *GlobalSettlement) Reset()         { *m = GlobalSettlement{} }
+func (m *GlobalSettlement) String() string { return proto.CompactTextString(m) }
+func (*GlobalSettlement) ProtoMessage()    {}
+func (*GlobalSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e36c31b6abab7aa5, []int{5}
+}
+func (m *GlobalSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobalSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GlobalSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalSettlement.Merge(m, src)
+}
+func (m *GlobalSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalSettlement proto.InternalMessageInfo
+
+// SettlementCollateral defines the settlement price of a collateral type and the collateral reserved to back redemptions
+type SettlementCollateral struct {
+	CollateralType string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Price          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// reserved is the collateral owed by cdps for their debt at the settlement price
+	Reserved types.Coin `protobuf:"bytes,3,opt,name=reserved,proto3" json:"reserved"`
+	// redeemed is the reserved collateral paid out to stable asset holders so far
+	Redeemed types.Coin `protobuf:"bytes,4,opt,name=redeemed,proto3" json:"redeemed"`
+}
+
+func (m *SettlementCollateral) Reset()         { *m = SettlementCollateral{} }
+func (m *SettlementCollateral) String() string { return proto.CompactTextString(m) }
+func (*SettlementCollateral) ProtoMessage()    {}
+func (*SettlementCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e36c31b6abab7aa5, []int{6}
+}
+func (m *SettlementCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettlementCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementCollateral.Merge(m, src)
+}
+func (m *SettlementCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementCollateral proto.InternalMessageInfo
+
 // OwnerCDPIndex defines the cdp ids for a single cdp owner
 type OwnerCDPIndex struct {
 	CdpIDs []uint64 `protobuf:"varint,1,rep,packed,name=cdp_ids,json=cdpIds,proto3" json:"cdp_ids,omitempty"`
@@ -202,7 +332,7 @@ func (m *OwnerCDPIndex) Reset()         { *m = OwnerCDPIndex{} }
 func (m *OwnerCDPIndex) String() string { return proto.CompactTextString(m) }
 func (*OwnerCDPIndex) ProtoMessage()    {}
 func (*OwnerCDPIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68a9ab097fb7be40, []int{4}
+	return fileDescriptor_e36c31b6abab7aa5, []int{7}
 }
 func (m *OwnerCDPIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,55 +363,71 @@ var xxx_messageInfo_OwnerCDPIndex proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*CDP)(nil), "aeth.cdp.v1beta1.CDP")
+	proto.RegisterType((*BasketCollateral)(nil), "aeth.cdp.v1beta1.BasketCollateral")
 	proto.RegisterType((*Deposit)(nil), "aeth.cdp.v1beta1.Deposit")
 	proto.RegisterType((*TotalPrincipal)(nil), "aeth.cdp.v1beta1.TotalPrincipal")
 	proto.RegisterType((*TotalCollateral)(nil), "aeth.cdp.v1beta1.TotalCollateral")
+	proto.RegisterType((*GlobalSettlement)(nil), "aeth.cdp.v1beta1.GlobalSettlement")
+	proto.RegisterType((*SettlementCollateral)(nil), "aeth.cdp.v1beta1.SettlementCollateral")
 	proto.RegisterType((*OwnerCDPIndex)(nil), "aeth.cdp.v1beta1.OwnerCDPIndex")
 }
 
-func init() { proto.RegisterFile("aeth/cdp/v1beta1/cdp.proto", fileDescriptor_68a9ab097fb7be40) }
-
-var fileDescriptor_68a9ab097fb7be40 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xae, 0xbb, 0x36, 0x5b, 0xbd, 0xb1, 0x4e, 0x06, 0xa1, 0xac, 0x87, 0xa4, 0x1a, 0x02, 0x7a,
-	0x69, 0xa2, 0x01, 0x12, 0x17, 0x10, 0x5a, 0x1a, 0x0d, 0xca, 0x85, 0x29, 0x1a, 0x17, 0x0e, 0x54,
-	0xae, 0xed, 0x96, 0x68, 0x69, 0x1c, 0xc5, 0xee, 0xd8, 0x1e, 0x02, 0x69, 0x0f, 0xb3, 0x87, 0xd8,
-	0x81, 0xc3, 0xb4, 0x13, 0xe2, 0x10, 0x20, 0x7b, 0x0b, 0x4e, 0xc8, 0x4e, 0xba, 0xec, 0x58, 0x24,
-	0x38, 0xf5, 0xf7, 0xc7, 0xdf, 0xf7, 0x73, 0x7f, 0xdf, 0x17, 0xc3, 0xce, 0x11, 0x3e, 0xc6, 0x2e,
-	0xa1, 0x89, 0x7b, 0xbc, 0x3b, 0x66, 0x12, 0xef, 0xaa, 0xd8, 0x49, 0x52, 0x2e, 0x39, 0xda, 0x52,
-	0x3d, 0x47, 0xe5, 0x65, 0xaf, 0x63, 0x11, 0x2e, 0x66, 0x5c, 0xb8, 0x63, 0x2c, 0x58, 0x05, 0xe0,
-	0x61, 0x5c, 0x20, 0x3a, 0xdb, 0x45, 0x7f, 0xa4, 0x33, 0xb7, 0x48, 0xca, 0xd6, 0xbd, 0x29, 0x9f,
-	0xf2, 0xa2, 0xae, 0xa2, 0xb2, 0x6a, 0x4f, 0x39, 0x9f, 0x46, 0xcc, 0xd5, 0xd9, 0x78, 0x3e, 0x71,
-	0x65, 0x38, 0x63, 0x42, 0xe2, 0x59, 0x79, 0x87, 0x9d, 0x2f, 0x0d, 0xb8, 0x32, 0xf0, 0x0f, 0xd0,
-	0x7d, 0x58, 0x0f, 0xa9, 0x09, 0xba, 0xa0, 0xd7, 0xf0, 0x8c, 0x3c, 0xb3, 0xeb, 0x43, 0x3f, 0xa8,
-	0x87, 0x14, 0x7d, 0x84, 0x4d, 0xfe, 0x39, 0x66, 0xa9, 0x59, 0xef, 0x82, 0xde, 0x86, 0xf7, 0xe6,
-	0x77, 0x66, 0xf7, 0xa7, 0xa1, 0xfc, 0x34, 0x1f, 0x3b, 0x84, 0xcf, 0xca, 0x2b, 0x94, 0x3f, 0x7d,
-	0x41, 0x8f, 0x5c, 0x79, 0x9a, 0x30, 0xe1, 0xec, 0x11, 0xb2, 0x47, 0x69, 0xca, 0x84, 0xb8, 0x3a,
-	0xef, 0xdf, 0x2d, 0x2f, 0x5a, 0x56, 0xbc, 0x53, 0xc9, 0x44, 0x50, 0xd0, 0x22, 0x04, 0x1b, 0x0a,
-	0x61, 0xae, 0x74, 0x41, 0xaf, 0x15, 0xe8, 0x18, 0xbd, 0x82, 0x90, 0xf0, 0x28, 0xc2, 0x92, 0xa5,
-	0x38, 0x32, 0x1b, 0x5d, 0xd0, 0x5b, 0x7f, 0xb2, 0xed, 0x94, 0x24, 0x6a, 0x35, 0x8b, 0x7d, 0x39,
-	0x03, 0x1e, 0xc6, 0x5e, 0xe3, 0x22, 0xb3, 0x6b, 0xc1, 0x2d, 0x08, 0x7a, 0x09, 0x5b, 0x49, 0x1a,
-	0xc6, 0x24, 0x4c, 0x70, 0x64, 0x36, 0x97, 0xc3, 0x57, 0x08, 0xf4, 0x16, 0x6e, 0x61, 0x42, 0xe6,
-	0xb3, 0xb9, 0xe2, 0xa3, 0xa3, 0x09, 0x63, 0xc2, 0x34, 0x96, 0x63, 0x69, 0xdf, 0x02, 0xee, 0x33,
-	0x26, 0xd0, 0x6b, 0xb8, 0xa1, 0xf0, 0xa3, 0x79, 0x42, 0x55, 0xcd, 0x5c, 0xd5, 0x3c, 0x1d, 0xa7,
-	0xd0, 0xc5, 0x59, 0xe8, 0xe2, 0x1c, 0x2e, 0x74, 0xf1, 0xd6, 0x14, 0xd1, 0xd9, 0x0f, 0x1b, 0x04,
-	0xeb, 0x0a, 0xf9, 0xbe, 0x00, 0x22, 0x06, 0xdb, 0x61, 0x2c, 0x59, 0xca, 0x84, 0x1c, 0x4d, 0x30,
-	0x91, 0x3c, 0x35, 0xd7, 0xd4, 0xce, 0xbc, 0x17, 0xea, 0xfc, 0xf7, 0xcc, 0x7e, 0xb4, 0x84, 0x2c,
-	0x3e, 0x23, 0x57, 0xe7, 0x7d, 0x58, 0xfe, 0x09, 0x9f, 0x91, 0x60, 0x73, 0x41, 0xba, 0xaf, 0x39,
-	0x77, 0xbe, 0x02, 0xb8, 0xea, 0xb3, 0x84, 0x8b, 0x50, 0xa2, 0x2e, 0x34, 0x08, 0x4d, 0x46, 0x37,
-	0xbe, 0x68, 0xe5, 0x99, 0xdd, 0x1c, 0xd0, 0x64, 0xe8, 0x07, 0x4d, 0x42, 0x93, 0x21, 0x45, 0x13,
-	0xd8, 0xa2, 0xc5, 0x61, 0x5e, 0x38, 0xa4, 0xf5, 0x0f, 0x1d, 0x52, 0x51, 0xa3, 0xe7, 0xd0, 0xc0,
-	0x33, 0x3e, 0x8f, 0xa5, 0xf6, 0xc9, 0x12, 0x3a, 0x94, 0xc7, 0x77, 0x52, 0xb8, 0x79, 0xc8, 0x25,
-	0x8e, 0x0e, 0x6e, 0xc4, 0x7d, 0x0c, 0xdb, 0x95, 0x53, 0x46, 0xda, 0x7b, 0x40, 0x7b, 0x6f, 0xb3,
-	0x2a, 0x1f, 0x2a, 0x17, 0x56, 0x33, 0xeb, 0x7f, 0x37, 0x53, 0xc0, 0xb6, 0x9e, 0x39, 0xa8, 0x0c,
-	0xf9, 0xff, 0x87, 0x3e, 0x83, 0x77, 0xde, 0xa9, 0x0f, 0x6a, 0xe0, 0x1f, 0x0c, 0x63, 0xca, 0x4e,
-	0xd0, 0x03, 0xb8, 0x5a, 0x88, 0x27, 0x4c, 0xd0, 0x5d, 0xe9, 0x35, 0x3c, 0x98, 0x67, 0xb6, 0xa1,
-	0xd5, 0x13, 0x81, 0xa1, 0xe5, 0x13, 0xde, 0xe0, 0xe2, 0x97, 0x55, 0xbb, 0xc8, 0x2d, 0x70, 0x99,
-	0x5b, 0xe0, 0x67, 0x6e, 0x81, 0xb3, 0x6b, 0xab, 0x76, 0x79, 0x6d, 0xd5, 0xbe, 0x5d, 0x5b, 0xb5,
-	0x0f, 0x0f, 0x6f, 0xc9, 0xa8, 0x9e, 0xaa, 0x7e, 0x84, 0xc7, 0x42, 0x47, 0xee, 0x89, 0x7e, 0xd2,
-	0xb4, 0x92, 0x63, 0x43, 0x9b, 0xf8, 0xe9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0xe3, 0x65,
-	0xc4, 0xeb, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("aeth/cdp/v1beta1/cdp.proto", fileDescriptor_e36c31b6abab7aa5) }
+
+var fileDescriptor_e36c31b6abab7aa5 = []byte{
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0x9d, 0x3f, 0xbb, 0x79, 0x5b, 0x76, 0xd3, 0xa1, 0x20, 0xef, 0x4a, 0xc4, 0x51, 0x90,
+	0x4a, 0x24, 0xb4, 0xb6, 0x5a, 0x90, 0x38, 0x00, 0x42, 0x75, 0xa2, 0x96, 0x20, 0x24, 0x56, 0xee,
+	0xf6, 0xc2, 0x01, 0x6b, 0xec, 0x79, 0x9b, 0x5a, 0x6b, 0x7b, 0x2c, 0xcf, 0xa4, 0xed, 0x7e, 0x08,
+	0xa4, 0x7e, 0x0e, 0xce, 0xfd, 0x10, 0x0b, 0xe2, 0x50, 0xf5, 0x84, 0x38, 0xa4, 0x90, 0xfd, 0x16,
+	0x9c, 0xd0, 0xd8, 0x4e, 0xe2, 0x2e, 0x3d, 0xb8, 0xda, 0xe5, 0x94, 0x99, 0xf7, 0xe6, 0xf7, 0x9b,
+	0x99, 0xdf, 0xef, 0xcd, 0x8b, 0xe1, 0x80, 0xa2, 0x7c, 0x6c, 0x07, 0x2c, 0xb5, 0x9f, 0xdc, 0xf1,
+	0x51, 0xd2, 0x3b, 0x6a, 0x6c, 0xa5, 0x19, 0x97, 0x9c, 0xf4, 0x54, 0xce, 0x52, 0xf3, 0x32, 0x77,
+	0xd0, 0x0f, 0xb8, 0x88, 0xb9, 0xb0, 0x7d, 0x2a, 0x70, 0x03, 0xe0, 0x61, 0x52, 0x20, 0x0e, 0xf6,
+	0x8b, 0xbc, 0x97, 0xcf, 0xec, 0x62, 0x52, 0xa6, 0x6e, 0xcd, 0xf8, 0x8c, 0x17, 0x71, 0x35, 0x2a,
+	0xa3, 0xe6, 0x8c, 0xf3, 0x59, 0x84, 0x76, 0x3e, 0xf3, 0xe7, 0x27, 0xb6, 0x0c, 0x63, 0x14, 0x92,
+	0xc6, 0xe5, 0x19, 0x86, 0xbf, 0xb5, 0xa1, 0x39, 0x9e, 0x1c, 0x91, 0x0f, 0x41, 0x0f, 0x99, 0xa1,
+	0x0d, 0xb4, 0x51, 0xcb, 0xe9, 0x2c, 0x17, 0xa6, 0x3e, 0x9d, 0xb8, 0x7a, 0xc8, 0xc8, 0x4f, 0xd0,
+	0xe6, 0x4f, 0x13, 0xcc, 0x0c, 0x7d, 0xa0, 0x8d, 0x6e, 0x38, 0xdf, 0xfe, 0xb3, 0x30, 0x0f, 0x67,
+	0xa1, 0x7c, 0x3c, 0xf7, 0xad, 0x80, 0xc7, 0xe5, 0x11, 0xca, 0x9f, 0x43, 0xc1, 0x4e, 0x6d, 0x79,
+	0x96, 0xa2, 0xb0, 0xee, 0x05, 0xc1, 0x3d, 0xc6, 0x32, 0x14, 0xe2, 0xd5, 0x8b, 0xc3, 0xf7, 0xcb,
+	0x83, 0x96, 0x11, 0xe7, 0x4c, 0xa2, 0x70, 0x0b, 0x5a, 0x42, 0xa0, 0xa5, 0x10, 0x46, 0x73, 0xa0,
+	0x8d, 0xba, 0x6e, 0x3e, 0x26, 0xdf, 0x00, 0x04, 0x3c, 0x8a, 0xa8, 0xc4, 0x8c, 0x46, 0x46, 0x6b,
+	0xa0, 0x8d, 0x76, 0xee, 0xee, 0x5b, 0x25, 0x89, 0x92, 0x66, 0xa5, 0x97, 0x35, 0xe6, 0x61, 0xe2,
+	0xb4, 0xce, 0x17, 0x66, 0xc3, 0xad, 0x40, 0xc8, 0xd7, 0xd0, 0x4d, 0xb3, 0x30, 0x09, 0xc2, 0x94,
+	0x46, 0x46, 0xbb, 0x1e, 0x7e, 0x83, 0x20, 0xdf, 0x41, 0x8f, 0x06, 0xc1, 0x3c, 0x9e, 0x2b, 0x3e,
+	0xe6, 0x9d, 0x20, 0x0a, 0xa3, 0x53, 0x8f, 0x65, 0xaf, 0x02, 0xbc, 0x8f, 0x28, 0xc8, 0x03, 0xb8,
+	0xa1, 0xf0, 0xde, 0x3c, 0x65, 0x2a, 0x66, 0x6c, 0xe5, 0x3c, 0x07, 0x56, 0xe1, 0x8b, 0xb5, 0xf2,
+	0xc5, 0x3a, 0x5e, 0xf9, 0xe2, 0x6c, 0x2b, 0xa2, 0xe7, 0xaf, 0x4d, 0xcd, 0xdd, 0x51, 0xc8, 0x47,
+	0x05, 0x90, 0x20, 0xec, 0x85, 0x89, 0xc4, 0x0c, 0x85, 0xf4, 0x4e, 0x68, 0x20, 0x79, 0x66, 0x6c,
+	0x2b, 0xcd, 0x9c, 0xaf, 0xd4, 0xfa, 0x3f, 0x17, 0xe6, 0xed, 0x1a, 0xb6, 0x4c, 0x30, 0x78, 0xf5,
+	0xe2, 0x10, 0xca, 0x4b, 0x4c, 0x30, 0x70, 0x77, 0x57, 0xa4, 0xf7, 0x73, 0x4e, 0xc2, 0x60, 0x3b,
+	0xa6, 0x09, 0x9d, 0x61, 0x26, 0x8c, 0xee, 0xa0, 0x79, 0xad, 0x96, 0xaf, 0x99, 0xc9, 0x23, 0xe8,
+	0xf8, 0x54, 0x9c, 0xa2, 0x34, 0x60, 0xd0, 0x1c, 0xed, 0xdc, 0x1d, 0x5a, 0x97, 0x9f, 0x82, 0xe5,
+	0xe4, 0xf9, 0xf1, 0xda, 0x54, 0x67, 0x5f, 0xdd, 0xf3, 0x97, 0xd7, 0xe6, 0xcd, 0xcb, 0x19, 0xe1,
+	0x96, 0x64, 0x43, 0x0f, 0x7a, 0x97, 0x93, 0xeb, 0x02, 0xd3, 0x2a, 0x05, 0xf6, 0x05, 0x74, 0x68,
+	0xcc, 0xe7, 0x89, 0x34, 0xf4, 0x7a, 0xb6, 0x96, 0xcb, 0x87, 0xbf, 0x6b, 0xb0, 0x35, 0xc1, 0x94,
+	0x8b, 0x50, 0x92, 0x01, 0x74, 0x02, 0x96, 0x7a, 0xeb, 0x57, 0xd3, 0x5d, 0x2e, 0xcc, 0xf6, 0x98,
+	0xa5, 0xd3, 0x89, 0xdb, 0x0e, 0x58, 0x3a, 0x65, 0xe4, 0x04, 0xba, 0xac, 0x58, 0xcc, 0x8b, 0xf7,
+	0xd3, 0xbd, 0x46, 0x31, 0x37, 0xd4, 0x95, 0xeb, 0x34, 0xdf, 0xed, 0x3a, 0x19, 0xec, 0x1e, 0x73,
+	0x49, 0xa3, 0xa3, 0x75, 0xe9, 0x7f, 0x02, 0x7b, 0x9b, 0x77, 0xe4, 0x55, 0x84, 0xdb, 0xdd, 0x84,
+	0x8f, 0xaf, 0x24, 0xa1, 0x80, 0xbd, 0x7c, 0xcf, 0x8a, 0x45, 0xff, 0xff, 0xa6, 0xbf, 0xea, 0xd0,
+	0x7b, 0x10, 0x71, 0x9f, 0x46, 0x0f, 0x51, 0xca, 0x08, 0x63, 0x4c, 0x24, 0x19, 0x03, 0x08, 0x49,
+	0x33, 0xe9, 0xa9, 0x9e, 0x68, 0x68, 0xef, 0xf0, 0x30, 0xbb, 0x39, 0x4e, 0x65, 0x08, 0xbe, 0xd1,
+	0xab, 0xf4, 0xbc, 0x9a, 0x6f, 0xff, 0xb7, 0x9a, 0x37, 0xdb, 0x56, 0x2a, 0xfa, 0xa3, 0xb2, 0xa2,
+	0x3f, 0x78, 0x5b, 0x56, 0xbc, 0xd1, 0xd1, 0xbe, 0x87, 0x9b, 0x19, 0x32, 0xc4, 0x98, 0xfa, 0x11,
+	0x7a, 0x62, 0x9e, 0xa6, 0xd1, 0x59, 0x5d, 0xb7, 0x7b, 0x1b, 0xe4, 0xc3, 0x1c, 0x48, 0xbe, 0x84,
+	0xed, 0x22, 0x86, 0xac, 0x6e, 0x7b, 0x5d, 0x03, 0x86, 0x3f, 0xeb, 0x70, 0xeb, 0x6d, 0x07, 0xae,
+	0x6f, 0xa3, 0x0b, 0xed, 0x34, 0x0b, 0x03, 0x34, 0xf4, 0x6b, 0x68, 0x60, 0x05, 0x55, 0x71, 0x25,
+	0x81, 0xd9, 0x13, 0x64, 0x75, 0x75, 0x59, 0x03, 0xae, 0xa6, 0xc7, 0xe7, 0xf0, 0xde, 0x0f, 0xea,
+	0xaf, 0x6c, 0x3c, 0x39, 0x9a, 0x26, 0x0c, 0x9f, 0x91, 0x8f, 0x61, 0xab, 0x68, 0x0c, 0xc2, 0xd0,
+	0x06, 0xcd, 0x51, 0xcb, 0x81, 0xe5, 0xc2, 0xec, 0xe4, 0x9d, 0x41, 0xb8, 0x9d, 0xbc, 0x35, 0x08,
+	0x67, 0x7a, 0xfe, 0x77, 0xbf, 0x71, 0xbe, 0xec, 0x6b, 0x2f, 0x97, 0x7d, 0xed, 0xaf, 0x65, 0x5f,
+	0x7b, 0x7e, 0xd1, 0x6f, 0xbc, 0xbc, 0xe8, 0x37, 0xfe, 0xb8, 0xe8, 0x37, 0x7e, 0xfc, 0xb4, 0x22,
+	0x45, 0xcc, 0x4f, 0x43, 0x49, 0x13, 0x94, 0x4f, 0x79, 0x76, 0x6a, 0xab, 0xca, 0xc2, 0xcc, 0x7e,
+	0x96, 0x7f, 0x52, 0xe4, 0x9a, 0xf8, 0x9d, 0xbc, 0x56, 0x3f, 0xfb, 0x77, 0x00, 0x4e, 0x73, 0xd0,
+	0x62, 0x6b, 0x08, 0x00, 0x00,
 }
 
 func (m *CDP) Marshal() (dAtA []byte, err error) {
@@ -304,6 +450,29 @@ func (m *CDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Basket) > 0 {
+		for iNdEx := len(m.Basket) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Basket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCdp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Managers) > 0 {
+		for iNdEx := len(m.Managers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Managers[iNdEx])
+			copy(dAtA[i:], m.Managers[iNdEx])
+			i = encodeVarintCdp(dAtA, i, uint64(len(m.Managers[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.InterestFactor.Size()
 		i -= size
@@ -374,6 +543,46 @@ func (m *CDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BasketCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasketCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasketCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -499,6 +708,131 @@ func (m *TotalCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GlobalSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Redeemed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RedeemableSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCdp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintCdp(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Redeemed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Reserved.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCdp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintCdp(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OwnerCDPIndex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -520,20 +854,20 @@ func (m *OwnerCDPIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.CdpIDs) > 0 {
-		dAtA9 := make([]byte, len(m.CdpIDs)*10)
-		var j8 int
+		dAtA15 := make([]byte, len(m.CdpIDs)*10)
+		var j14 int
 		for _, num := range m.CdpIDs {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j14++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintCdp(dAtA, i, uint64(j8))
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintCdp(dAtA, i, uint64(j14))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -578,6 +912,33 @@ func (m *CDP) Size() (n int) {
 	n += 1 + l + sovCdp(uint64(l))
 	l = m.InterestFactor.Size()
 	n += 1 + l + sovCdp(uint64(l))
+	if len(m.Managers) > 0 {
+		for _, b := range m.Managers {
+			l = len(b)
+			n += 1 + l + sovCdp(uint64(l))
+		}
+	}
+	if len(m.Basket) > 0 {
+		for _, e := range m.Basket {
+			l = e.Size()
+			n += 1 + l + sovCdp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BasketCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCdp(uint64(l))
 	return n
 }
 
@@ -629,6 +990,46 @@ func (m *TotalCollateral) Size() (n int) {
 	return n
 }
 
+func (m *GlobalSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovCdp(uint64(l))
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovCdp(uint64(l))
+		}
+	}
+	l = m.RedeemableSupply.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.Redeemed.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
+func (m *SettlementCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovCdp(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.Reserved.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	l = m.Redeemed.Size()
+	n += 1 + l + sovCdp(uint64(l))
+	return n
+}
+
 func (m *OwnerCDPIndex) Size() (n int) {
 	if m == nil {
 		return 0
@@ -931,11 +1332,192 @@ func (m *CDP) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCdp(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Managers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Managers = append(m.Managers, make([]byte, postIndex-iNdEx))
+			copy(m.Managers[len(m.Managers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Basket = append(m.Basket, BasketCollateral{})
+			if err := m.Basket[len(m.Basket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasketCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasketCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasketCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCdp
@@ -1316,6 +1898,371 @@ func (m *TotalCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GlobalSettlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalSettlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, SettlementCollateral{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemableSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemableSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redeemed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettlementCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCdp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCdp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCdp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redeemed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCdp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCdp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OwnerCDPIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
@@ -57,7 +58,7 @@ func (suite *CdpValidationSuite) TestCdpValidation() {
 		},
 		{
 			name: "invalid collateral",
-			cdp:  types.CDP{1, suite.addrs[0], "bnb-a", sdk.Coin{"", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(0)}, tmtime.Now(), sdk.OneDec()},
+			cdp:  types.CDP{1, suite.addrs[0], "bnb-a", sdk.Coin{"", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(0)}, tmtime.Now(), sdk.OneDec(), nil, nil},
 			errArgs: errArgs{
 				expectPass: false,
 				msg:        "collateral 100: invalid coins",
@@ -65,7 +66,7 @@ func (suite *CdpValidationSuite) TestCdpValidation() {
 		},
 		{
 			name: "invalid principal",
-			cdp:  types.CDP{1, suite.addrs[0], "xrp-a", sdk.Coin{"xrp", sdk.NewInt(100)}, sdk.Coin{"", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(0)}, tmtime.Now(), sdk.OneDec()},
+			cdp:  types.CDP{1, suite.addrs[0], "xrp-a", sdk.Coin{"xrp", sdk.NewInt(100)}, sdk.Coin{"", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(0)}, tmtime.Now(), sdk.OneDec(), nil, nil},
 			errArgs: errArgs{
 				expectPass: false,
 				msg:        "principal 100: invalid coins",
@@ -73,7 +74,7 @@ func (suite *CdpValidationSuite) TestCdpValidation() {
 		},
 		{
 			name: "invalid fees",
-			cdp:  types.CDP{1, suite.addrs[0], "xrp-a", sdk.Coin{"xrp", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(100)}, sdk.Coin{"", sdk.NewInt(0)}, tmtime.Now(), sdk.OneDec()},
+			cdp:  types.CDP{1, suite.addrs[0], "xrp-a", sdk.Coin{"xrp", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(100)}, sdk.Coin{"", sdk.NewInt(0)}, tmtime.Now(), sdk.OneDec(), nil, nil},
 			errArgs: errArgs{
 				expectPass: false,
 				msg:        "accumulated fees 0: invalid coins",
@@ -81,7 +82,7 @@ func (suite *CdpValidationSuite) TestCdpValidation() {
 		},
 		{
 			name: "invalid fees updated",
-			cdp:  types.CDP{1, suite.addrs[0], "xrp-a", sdk.Coin{"xrp", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(0)}, time.Time{}, sdk.OneDec()},
+			cdp:  types.CDP{1, suite.addrs[0], "xrp-a", sdk.Coin{"xrp", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(0)}, time.Time{}, sdk.OneDec(), nil, nil},
 			errArgs: errArgs{
 				expectPass: false,
 				msg:        "cdp updated fee time cannot be zero",
@@ -89,12 +90,28 @@ func (suite *CdpValidationSuite) TestCdpValidation() {
 		},
 		{
 			name: "invalid type",
-			cdp:  types.CDP{1, suite.addrs[0], "", sdk.Coin{"xrp", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(0)}, tmtime.Now(), sdk.OneDec()},
+			cdp:  types.CDP{1, suite.addrs[0], "", sdk.Coin{"xrp", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(0)}, tmtime.Now(), sdk.OneDec(), nil, nil},
 			errArgs: errArgs{
 				expectPass: false,
 				msg:        "cdp type cannot be empty",
 			},
 		},
+		{
+			name: "owner as manager",
+			cdp:  types.CDP{1, suite.addrs[0], "xrp-a", sdk.Coin{"xrp", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(0)}, tmtime.Now(), sdk.OneDec(), []sdk.AccAddress{suite.addrs[0]}, nil},
+			errArgs: errArgs{
+				expectPass: false,
+				msg:        fmt.Sprintf("owner %s cannot be a manager: invalid cdp manager", suite.addrs[0]),
+			},
+		},
+		{
+			name: "empty manager",
+			cdp:  types.CDP{1, suite.addrs[0], "xrp-a", sdk.Coin{"xrp", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(100)}, sdk.Coin{"usdx", sdk.NewInt(0)}, tmtime.Now(), sdk.OneDec(), []sdk.AccAddress{{}}, nil},
+			errArgs: errArgs{
+				expectPass: false,
+				msg:        "manager cannot be empty: invalid cdp manager",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -237,6 +254,34 @@ func (suite *CdpValidationSuite) TestCDPGetNormalizedPrincipal() {
 	}
 }
 
+func (suite *CdpValidationSuite) TestBasketCollaterals() {
+	basket := types.BasketCollaterals{types.NewBasketCollateral("btc-a", sdk.NewInt64Coin("btc", 100))}
+	suite.Require().NoError(basket.Validate("xrp-a"))
+	suite.Require().Error(basket.Validate("btc-a"))
+
+	duplicate := append(basket, types.NewBasketCollateral("btc-a", sdk.NewInt64Coin("btc", 1)))
+	suite.Require().Error(duplicate.Validate("xrp-a"))
+	zero := types.BasketCollaterals{types.NewBasketCollateral("btc-a", sdk.NewInt64Coin("btc", 0))}
+	suite.Require().Error(zero.Validate("xrp-a"))
+
+	added := basket.Add("btc-a", sdk.NewInt64Coin("btc", 50))
+	amount, found := added.AmountOf("btc-a")
+	suite.True(found)
+	suite.Equal(sdk.NewInt64Coin("btc", 150), amount)
+	amount, _ = basket.AmountOf("btc-a")
+	suite.Equal(sdk.NewInt64Coin("btc", 100), amount, "add should not modify the original basket")
+
+	added = added.Add("bnb-a", sdk.NewInt64Coin("bnb", 10))
+	suite.Len(added, 2)
+
+	subtracted := added.Sub("btc-a", sdk.NewInt64Coin("btc", 150))
+	suite.Len(subtracted, 1)
+	_, found = subtracted.AmountOf("btc-a")
+	suite.False(found)
+	suite.Nil(subtracted.Sub("bnb-a", sdk.NewInt64Coin("bnb", 10)))
+	suite.Panics(func() { subtracted.Sub("bnb-a", sdk.NewInt64Coin("bnb", 11)) })
+}
+
 func TestCdpValidationSuite(t *testing.T) {
 	suite.Run(t, new(CdpValidationSuite))
 }
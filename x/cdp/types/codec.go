@@ -7,6 +7,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers all the necessary types and interfaces for the
@@ -18,6 +19,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDrawDebt{}, "cdp/MsgDrawDebt", nil)
 	cdc.RegisterConcrete(&MsgRepayDebt{}, "cdp/MsgRepayDebt", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "cdp/MsgLiquidate", nil)
+	cdc.RegisterConcrete(&MsgTransferCDP{}, "cdp/MsgTransferCDP", nil)
+	cdc.RegisterConcrete(&MsgSetCDPManagers{}, "cdp/MsgSetCDPManagers", nil)
+	cdc.RegisterConcrete(&MsgDepositBasket{}, "cdp/MsgDepositBasket", nil)
+	cdc.RegisterConcrete(&MsgWithdrawBasket{}, "cdp/MsgWithdrawBasket", nil)
+	cdc.RegisterConcrete(&MsgSettleCDP{}, "cdp/MsgSettleCDP", nil)
+	cdc.RegisterConcrete(&MsgRedeemSettlement{}, "cdp/MsgRedeemSettlement", nil)
+	cdc.RegisterConcrete(&MsgPsmMint{}, "cdp/MsgPsmMint", nil)
+	cdc.RegisterConcrete(&MsgPsmRedeem{}, "cdp/MsgPsmRedeem", nil)
+	cdc.RegisterConcrete(&MsgFlashMint{}, "cdp/MsgFlashMint", nil)
+	cdc.RegisterConcrete(&GlobalSettlementProposal{}, "cdp/GlobalSettlementProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,6 +39,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDrawDebt{},
 		&MsgRepayDebt{},
 		&MsgLiquidate{},
+		&MsgTransferCDP{},
+		&MsgSetCDPManagers{},
+		&MsgDepositBasket{},
+		&MsgWithdrawBasket{},
+		&MsgSettleCDP{},
+		&MsgRedeemSettlement{},
+		&MsgPsmMint{},
+		&MsgPsmRedeem{},
+		&MsgFlashMint{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&GlobalSettlementProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -51,4 +51,30 @@ var (
 	ErrInsufficientBalance = sdkerrors.Register(ModuleName, 22, "insufficient balance")
 	// ErrNotLiquidatable error for when an cdp is not liquidatable
 	ErrNotLiquidatable = sdkerrors.Register(ModuleName, 23, "cdp collateral ratio not below liquidation ratio")
+	// ErrCdpIDRequired error for when an owner has several cdps of a collateral type and no cdp id is given
+	ErrCdpIDRequired = sdkerrors.Register(ModuleName, 24, "cdp id required when owner has multiple cdps of a collateral type")
+	// ErrInvalidManager error for an invalid cdp manager list
+	ErrInvalidManager = sdkerrors.Register(ModuleName, 25, "invalid cdp manager")
+	// ErrNotOwnerOrManager error for when the sender is neither the owner nor a manager of a cdp
+	ErrNotOwnerOrManager = sdkerrors.Register(ModuleName, 26, "sender is not the cdp owner or a manager")
+	// ErrGlobalSettlementActive error for modifying cdps after the cdp system has been shut down
+	ErrGlobalSettlementActive = sdkerrors.Register(ModuleName, 27, "cdp system is in global settlement")
+	// ErrGlobalSettlementNotActive error for settling cdps or redeeming stable asset before global settlement
+	ErrGlobalSettlementNotActive = sdkerrors.Register(ModuleName, 28, "cdp system is not in global settlement")
+	// ErrInvalidRedemption error for a stable asset redemption that cannot be paid
+	ErrInvalidRedemption = sdkerrors.Register(ModuleName, 29, "invalid settlement redemption")
+	// ErrPsmAssetNotSupported error for swapping a stablecoin that is not accepted by the peg stability module
+	ErrPsmAssetNotSupported = sdkerrors.Register(ModuleName, 30, "stablecoin not supported by the peg stability module")
+	// ErrInsufficientPsmReserve error for redemptions that exceed the peg stability module's reserve of a stablecoin
+	ErrInsufficientPsmReserve = sdkerrors.Register(ModuleName, 31, "insufficient peg stability module reserve")
+	// ErrInvalidPsmSwap error for peg stability module swaps that are too small to pay out after fees
+	ErrInvalidPsmSwap = sdkerrors.Register(ModuleName, 32, "invalid peg stability module swap")
+	// ErrFlashMintDisabled error for flash minting when the flash mint param is not set
+	ErrFlashMintDisabled = sdkerrors.Register(ModuleName, 33, "flash minting is disabled")
+	// ErrExceedsFlashMintLimit error for flash minting more than the flash mint max amount
+	ErrExceedsFlashMintLimit = sdkerrors.Register(ModuleName, 34, "flash mint exceeds max amount")
+	// ErrFlashMintNotRepaid error for flash mints that are not repaid with their fee once their messages have executed
+	ErrFlashMintNotRepaid = sdkerrors.Register(ModuleName, 35, "flash mint not repaid")
+	// ErrInvalidFlashMintMsg error for flash mint messages that cannot be executed
+	ErrInvalidFlashMintMsg = sdkerrors.Register(ModuleName, 36, "invalid flash mint message")
 )
@@ -2,17 +2,36 @@ package types
 
 // Event types for cdp module
 const (
-	EventTypeCreateCdp         = "create_cdp"
-	EventTypeCdpDeposit        = "cdp_deposit"
-	EventTypeCdpDraw           = "cdp_draw"
-	EventTypeCdpRepay          = "cdp_repayment"
-	EventTypeCdpClose          = "cdp_close"
-	EventTypeCdpWithdrawal     = "cdp_withdrawal"
-	EventTypeCdpLiquidation    = "cdp_liquidation"
-	EventTypeBeginBlockerFatal = "cdp_begin_block_error"
+	EventTypeCreateCdp             = "create_cdp"
+	EventTypeCdpDeposit            = "cdp_deposit"
+	EventTypeCdpDraw               = "cdp_draw"
+	EventTypeCdpRepay              = "cdp_repayment"
+	EventTypeCdpClose              = "cdp_close"
+	EventTypeCdpWithdrawal         = "cdp_withdrawal"
+	EventTypeCdpLiquidation        = "cdp_liquidation"
+	EventTypeCdpPartialLiquidation = "cdp_partial_liquidation"
+	EventTypeCdpTransfer           = "cdp_transfer"
+	EventTypeCdpSetManagers        = "cdp_set_managers"
+	EventTypeCdpBasketDeposit      = "cdp_basket_deposit"
+	EventTypeCdpBasketWithdrawal   = "cdp_basket_withdrawal"
+	EventTypeGlobalSettlement      = "cdp_global_settlement"
+	EventTypeCdpSettle             = "cdp_settle"
+	EventTypeSettlementRedemption  = "cdp_settlement_redemption"
+	EventTypePsmMint               = "cdp_psm_mint"
+	EventTypePsmRedeem             = "cdp_psm_redeem"
+	EventTypeFlashMint             = "cdp_flash_mint"
+	EventTypeBeginBlockerFatal     = "cdp_begin_block_error"
 
-	AttributeKeyCdpID      = "cdp_id"
-	AttributeKeyDeposit    = "deposit"
-	AttributeValueCategory = "cdp"
-	AttributeKeyError      = "error_message"
+	AttributeKeyCdpID            = "cdp_id"
+	AttributeKeyDeposit          = "deposit"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyManagers         = "managers"
+	AttributeKeyCollateralSeized = "collateral_seized"
+	AttributeKeyDebtCovered      = "debt_covered"
+	AttributeKeyCollateralType   = "collateral_type"
+	AttributeKeyCollateral       = "collateral"
+	AttributeKeyFee              = "fee"
+	AttributeKeyMinted           = "minted"
+	AttributeValueCategory       = "cdp"
+	AttributeKeyError            = "error_message"
 )
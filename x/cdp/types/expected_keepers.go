@@ -3,6 +3,7 @@ package types
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -29,6 +30,7 @@ var _ BankKeeper = (bankkeeper.Keeper)(nil)
 // PricefeedKeeper defines the expected interface for the pricefeed
 type PricefeedKeeper interface {
 	GetCurrentPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
+	IsMarketStale(sdk.Context, string) bool
 	GetParams(sdk.Context) pftypes.Params
 	// These are used for testing TODO replace mockApp with keeper in tests to remove these
 	SetParams(sdk.Context, pftypes.Params)
@@ -41,6 +43,10 @@ type AuctionKeeper interface {
 	StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string) (uint64, error)
 	StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, initialLot sdk.Coin, debt sdk.Coin) (uint64, error)
 	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, error)
+	StartDutchAuction(
+		ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin,
+		lotMarketID, bidMarketID string, priceConversion sdk.Dec,
+	) (uint64, error)
 }
 
 // AccountKeeper expected interface for the account keeper
@@ -54,6 +60,11 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
 
+// MsgRouter expected interface for routing the messages executed by a flash mint
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
 // CDPHooks event hooks for other keepers to run code in response to CDP modifications
 type CDPHooks interface {
 	AfterCDPCreated(ctx sdk.Context, cdp CDP)
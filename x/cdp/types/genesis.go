@@ -62,6 +62,16 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if gs.GlobalSettlement != nil {
+		if err := gs.GlobalSettlement.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := gs.PsmReserves.Validate(); err != nil {
+		return fmt.Errorf("invalid psm reserves: %w", err)
+	}
+
 	if err := sdk.ValidateDenom(gs.DebtDenom); err != nil {
 		return fmt.Errorf(fmt.Sprintf("debt denom invalid: %v", err))
 	}
@@ -41,13 +41,17 @@ type GenesisState struct {
 	GovDenom                  string                   `protobuf:"bytes,6,opt,name=gov_denom,json=govDenom,proto3" json:"gov_denom,omitempty"`
 	PreviousAccumulationTimes GenesisAccumulationTimes `protobuf:"bytes,7,rep,name=previous_accumulation_times,json=previousAccumulationTimes,proto3,castrepeated=GenesisAccumulationTimes" json:"previous_accumulation_times"`
 	TotalPrincipals           GenesisTotalPrincipals   `protobuf:"bytes,8,rep,name=total_principals,json=totalPrincipals,proto3,castrepeated=GenesisTotalPrincipals" json:"total_principals"`
+	// global_settlement is set once the cdp system has been shut down
+	GlobalSettlement *GlobalSettlement `protobuf:"bytes,9,opt,name=global_settlement,json=globalSettlement,proto3" json:"global_settlement,omitempty"`
+	// psm_reserves are the stablecoins held by the peg stability module
+	PsmReserves github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=psm_reserves,json=psmReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"psm_reserves"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{0}
+	return fileDescriptor_86d54eab0f830602, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,6 +136,20 @@ func (m *GenesisState) GetTotalPrincipals() GenesisTotalPrincipals {
 	return nil
 }
 
+func (m *GenesisState) GetGlobalSettlement() *GlobalSettlement {
+	if m != nil {
+		return m.GlobalSettlement
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPsmReserves() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PsmReserves
+	}
+	return nil
+}
+
 // Params defines the parameters for the cdp module.
 type Params struct {
 	CollateralParams        CollateralParams                       `protobuf:"bytes,1,rep,name=collateral_params,json=collateralParams,proto3,castrepeated=CollateralParams" json:"collateral_params"`
@@ -142,13 +160,16 @@ type Params struct {
 	DebtAuctionThreshold    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=debt_auction_threshold,json=debtAuctionThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_threshold"`
 	DebtAuctionLot          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=debt_auction_lot,json=debtAuctionLot,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"debt_auction_lot"`
 	CircuitBreaker          bool                                   `protobuf:"varint,8,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	PsmParams               PsmParams                              `protobuf:"bytes,9,rep,name=psm_params,json=psmParams,proto3,castrepeated=PsmParams" json:"psm_params"`
+	// flash_mint_param configures flash minting of the stable asset
+	FlashMintParam FlashMintParam `protobuf:"bytes,10,opt,name=flash_mint_param,json=flashMintParam,proto3" json:"flash_mint_param"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{1}
+	return fileDescriptor_86d54eab0f830602, []int{1}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,6 +226,20 @@ func (m *Params) GetCircuitBreaker() bool {
 	return false
 }
 
+func (m *Params) GetPsmParams() PsmParams {
+	if m != nil {
+		return m.PsmParams
+	}
+	return nil
+}
+
+func (m *Params) GetFlashMintParam() FlashMintParam {
+	if m != nil {
+		return m.FlashMintParam
+	}
+	return FlashMintParam{}
+}
+
 // DebtParam defines governance params for debt assets
 type DebtParam struct {
 	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -217,7 +252,7 @@ func (m *DebtParam) Reset()         { *m = DebtParam{} }
 func (m *DebtParam) String() string { return proto.CompactTextString(m) }
 func (*DebtParam) ProtoMessage()    {}
 func (*DebtParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{2}
+	return fileDescriptor_86d54eab0f830602, []int{2}
 }
 func (m *DebtParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,13 +309,19 @@ type CollateralParam struct {
 	KeeperRewardPercentage           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
 	CheckCollateralizationIndexCount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=check_collateralization_index_count,json=checkCollateralizationIndexCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"check_collateralization_index_count"`
 	ConversionFactor                 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+	// stability_fee_model optionally replaces the fixed stability_fee with a rate evaluated each block
+	StabilityFeeModel *StabilityFeeModel `protobuf:"bytes,13,opt,name=stability_fee_model,json=stabilityFeeModel,proto3" json:"stability_fee_model,omitempty"`
+	// partial_liquidation optionally limits liquidations to the collateral needed to restore the cdp, instead of seizing all of it
+	PartialLiquidation *PartialLiquidation `protobuf:"bytes,14,opt,name=partial_liquidation,json=partialLiquidation,proto3" json:"partial_liquidation,omitempty"`
+	// dutch_auction sells seized collateral in descending price dutch auctions instead of collateral auctions
+	DutchAuction bool `protobuf:"varint,15,opt,name=dutch_auction,json=dutchAuction,proto3" json:"dutch_auction,omitempty"`
 }
 
 func (m *CollateralParam) Reset()         { *m = CollateralParam{} }
 func (m *CollateralParam) String() string { return proto.CompactTextString(m) }
 func (*CollateralParam) ProtoMessage()    {}
 func (*CollateralParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{3}
+	return fileDescriptor_86d54eab0f830602, []int{3}
 }
 func (m *CollateralParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,6 +385,226 @@ func (m *CollateralParam) GetLiquidationMarketID() string {
 	return ""
 }
 
+func (m *CollateralParam) GetStabilityFeeModel() *StabilityFeeModel {
+	if m != nil {
+		return m.StabilityFeeModel
+	}
+	return nil
+}
+
+func (m *CollateralParam) GetPartialLiquidation() *PartialLiquidation {
+	if m != nil {
+		return m.PartialLiquidation
+	}
+	return nil
+}
+
+func (m *CollateralParam) GetDutchAuction() bool {
+	if m != nil {
+		return m.DutchAuction
+	}
+	return false
+}
+
+// PartialLiquidation configures partial liquidation of a collateral type's cdps.
+type PartialLiquidation struct {
+	// buffer is added to the liquidation ratio to give the collateralization ratio a partially liquidated cdp is restored to
+	Buffer github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=buffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"buffer"`
+}
+
+func (m *PartialLiquidation) Reset()         { *m = PartialLiquidation{} }
+func (m *PartialLiquidation) String() string { return proto.CompactTextString(m) }
+func (*PartialLiquidation) ProtoMessage()    {}
+func (*PartialLiquidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d54eab0f830602, []int{4}
+}
+func (m *PartialLiquidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartialLiquidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartialLiquidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartialLiquidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialLiquidation.Merge(m, src)
+}
+func (m *PartialLiquidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartialLiquidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialLiquidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialLiquidation proto.InternalMessageInfo
+
+// StabilityFeeModel derives a per second stability fee from debt utilization and, optionally, the stable asset peg.
+// Utilization is the collateral type's total principal divided by its debt limit.
+type StabilityFeeModel struct {
+	// base_rate is the per second fee rate at zero utilization
+	BaseRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_rate,json=baseRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_rate"`
+	// base_multiplier is added to the rate per unit of utilization up to the kink
+	BaseMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_multiplier,json=baseMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_multiplier"`
+	// kink is the utilization above which the jump multiplier applies
+	Kink github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=kink,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"kink"`
+	// jump_multiplier is added to the rate per unit of utilization above the kink
+	JumpMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=jump_multiplier,json=jumpMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jump_multiplier"`
+	// peg_market_id is an optional pricefeed market, such as usdx:usd, used to steer the stable asset to its peg
+	PegMarketID string `protobuf:"bytes,5,opt,name=peg_market_id,json=pegMarketId,proto3" json:"peg_market_id,omitempty"`
+	// peg_multiplier is added to the rate per unit the peg market price is below 1.0, and removed when above
+	PegMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=peg_multiplier,json=pegMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"peg_multiplier"`
+	// max_rate caps the per second fee rate produced by the model
+	MaxRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate"`
+}
+
+func (m *StabilityFeeModel) Reset()         { *m = StabilityFeeModel{} }
+func (m *StabilityFeeModel) String() string { return proto.CompactTextString(m) }
+func (*StabilityFeeModel) ProtoMessage()    {}
+func (*StabilityFeeModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d54eab0f830602, []int{5}
+}
+func (m *StabilityFeeModel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StabilityFeeModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StabilityFeeModel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StabilityFeeModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StabilityFeeModel.Merge(m, src)
+}
+func (m *StabilityFeeModel) XXX_Size() int {
+	return m.Size()
+}
+func (m *StabilityFeeModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_StabilityFeeModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StabilityFeeModel proto.InternalMessageInfo
+
+func (m *StabilityFeeModel) GetPegMarketID() string {
+	if m != nil {
+		return m.PegMarketID
+	}
+	return ""
+}
+
+// PsmParam defines governance parameters for a stablecoin accepted by the peg stability module
+type PsmParam struct {
+	// denom of the stablecoin, such as the denom of an x/evmutil conversion pair
+	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ConversionFactor github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+	// debt_limit is the most stable asset that can be minted against the stablecoin
+	DebtLimit types.Coin `protobuf:"bytes,3,opt,name=debt_limit,json=debtLimit,proto3" json:"debt_limit"`
+	// mint_fee is the fraction of minted stable asset sent to the liquidator as surplus
+	MintFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=mint_fee,json=mintFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mint_fee"`
+	// redeem_fee is the fraction of redeemed stable asset sent to the liquidator as surplus
+	RedeemFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=redeem_fee,json=redeemFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redeem_fee"`
+}
+
+func (m *PsmParam) Reset()         { *m = PsmParam{} }
+func (m *PsmParam) String() string { return proto.CompactTextString(m) }
+func (*PsmParam) ProtoMessage()    {}
+func (*PsmParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d54eab0f830602, []int{6}
+}
+func (m *PsmParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PsmParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PsmParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PsmParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PsmParam.Merge(m, src)
+}
+func (m *PsmParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *PsmParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_PsmParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PsmParam proto.InternalMessageInfo
+
+func (m *PsmParam) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PsmParam) GetDebtLimit() types.Coin {
+	if m != nil {
+		return m.DebtLimit
+	}
+	return types.Coin{}
+}
+
+// FlashMintParam defines the parameters of flash minting the stable asset.
+type FlashMintParam struct {
+	// max_amount is the most stable asset that can be flash minted in one message, flash minting is disabled when it is zero
+	MaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_amount,json=maxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount"`
+	// fee is the fraction of the flash minted amount that must be repaid on top of it, and is sent to the liquidator as surplus
+	Fee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
+}
+
+func (m *FlashMintParam) Reset()         { *m = FlashMintParam{} }
+func (m *FlashMintParam) String() string { return proto.CompactTextString(m) }
+func (*FlashMintParam) ProtoMessage()    {}
+func (*FlashMintParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86d54eab0f830602, []int{7}
+}
+func (m *FlashMintParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlashMintParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlashMintParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlashMintParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlashMintParam.Merge(m, src)
+}
+func (m *FlashMintParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlashMintParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlashMintParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlashMintParam proto.InternalMessageInfo
+
 // GenesisAccumulationTime defines the previous distribution time and its corresponding denom
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -355,7 +616,7 @@ func (m *GenesisAccumulationTime) Reset()         { *m = GenesisAccumulationTime
 func (m *GenesisAccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccumulationTime) ProtoMessage()    {}
 func (*GenesisAccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{4}
+	return fileDescriptor_86d54eab0f830602, []int{8}
 }
 func (m *GenesisAccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +669,7 @@ func (m *GenesisTotalPrincipal) Reset()         { *m = GenesisTotalPrincipal{} }
 func (m *GenesisTotalPrincipal) String() string { return proto.CompactTextString(m) }
 func (*GenesisTotalPrincipal) ProtoMessage()    {}
 func (*GenesisTotalPrincipal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4494a90aaab0034, []int{5}
+	return fileDescriptor_86d54eab0f830602, []int{9}
 }
 func (m *GenesisTotalPrincipal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,88 +710,119 @@ func init() {
 	proto.RegisterType((*Params)(nil), "aeth.cdp.v1beta1.Params")
 	proto.RegisterType((*DebtParam)(nil), "aeth.cdp.v1beta1.DebtParam")
 	proto.RegisterType((*CollateralParam)(nil), "aeth.cdp.v1beta1.CollateralParam")
+	proto.RegisterType((*PartialLiquidation)(nil), "aeth.cdp.v1beta1.PartialLiquidation")
+	proto.RegisterType((*StabilityFeeModel)(nil), "aeth.cdp.v1beta1.StabilityFeeModel")
+	proto.RegisterType((*PsmParam)(nil), "aeth.cdp.v1beta1.PsmParam")
+	proto.RegisterType((*FlashMintParam)(nil), "aeth.cdp.v1beta1.FlashMintParam")
 	proto.RegisterType((*GenesisAccumulationTime)(nil), "aeth.cdp.v1beta1.GenesisAccumulationTime")
 	proto.RegisterType((*GenesisTotalPrincipal)(nil), "aeth.cdp.v1beta1.GenesisTotalPrincipal")
 }
 
-func init() { proto.RegisterFile("aeth/cdp/v1beta1/genesis.proto", fileDescriptor_e4494a90aaab0034) }
-
-var fileDescriptor_e4494a90aaab0034 = []byte{
-	// 1179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xf6, 0x3a, 0x8a, 0x23, 0xd1, 0x8a, 0x24, 0x33, 0x4e, 0xb2, 0x76, 0x50, 0x49, 0x75, 0xd1,
-	0xc6, 0x3d, 0x58, 0x42, 0x52, 0x20, 0x40, 0x81, 0xa2, 0xa9, 0x65, 0xc1, 0x81, 0x11, 0x17, 0x10,
-	0xd6, 0x3e, 0xb5, 0x87, 0x05, 0x97, 0x4b, 0xc9, 0x84, 0x56, 0xcb, 0x2d, 0x49, 0xa9, 0xb6, 0x5f,
-	0xa1, 0x28, 0x1a, 0xf4, 0x25, 0x0a, 0xe4, 0xdc, 0x87, 0x48, 0x6f, 0x41, 0x4f, 0x45, 0x0f, 0x72,
-	0x21, 0xdf, 0xfb, 0x0c, 0x05, 0x7f, 0xf4, 0x63, 0xc9, 0x02, 0x02, 0x5b, 0xbd, 0x58, 0xcb, 0x19,
-	0xce, 0xf7, 0xcd, 0x90, 0x33, 0xc3, 0x31, 0x28, 0xb6, 0x51, 0x0f, 0x55, 0x71, 0x98, 0x54, 0x7b,
-	0xcf, 0x02, 0x22, 0xd1, 0xb3, 0x6a, 0x8b, 0xc4, 0x44, 0x50, 0x51, 0x49, 0x38, 0x93, 0x0c, 0x16,
-	0x94, 0xbe, 0x82, 0xc3, 0xa4, 0x62, 0xf5, 0x9b, 0x45, 0xcc, 0x44, 0x87, 0x89, 0x6a, 0x80, 0x04,
-	0x19, 0x19, 0x61, 0x46, 0x63, 0x63, 0xb1, 0xb9, 0x61, 0xf4, 0xbe, 0x5e, 0x55, 0xcd, 0xc2, 0xaa,
-	0xd6, 0x5b, 0xac, 0xc5, 0x8c, 0x5c, 0x7d, 0x59, 0x69, 0xa9, 0xc5, 0x58, 0x2b, 0x22, 0x55, 0xbd,
-	0x0a, 0xba, 0xcd, 0xaa, 0xa4, 0x1d, 0x22, 0x24, 0xea, 0x24, 0x76, 0xc3, 0xe6, 0x8c, 0x8f, 0xca,
-	0x1f, 0xad, 0xdb, 0xfa, 0x23, 0x05, 0xb2, 0xaf, 0x8c, 0xc7, 0x47, 0x12, 0x49, 0x02, 0x5f, 0x80,
-	0x95, 0x04, 0x71, 0xd4, 0x11, 0xae, 0x53, 0x76, 0xb6, 0x57, 0x9f, 0xbb, 0x95, 0xe9, 0x08, 0x2a,
-	0x0d, 0xad, 0xaf, 0xa5, 0xde, 0xf5, 0x4b, 0x4b, 0x9e, 0xdd, 0x0d, 0x5f, 0x82, 0x14, 0x0e, 0x13,
-	0xe1, 0x2e, 0x97, 0xef, 0x6c, 0xaf, 0x3e, 0x7f, 0x38, 0x6b, 0xb5, 0x57, 0x6f, 0xd4, 0xd6, 0x95,
-	0xc9, 0xa0, 0x5f, 0x4a, 0xed, 0xd5, 0x1b, 0xe2, 0xed, 0x85, 0xf9, 0xf5, 0xb4, 0x21, 0x7c, 0x05,
-	0xd2, 0x21, 0x49, 0x98, 0xa0, 0x52, 0xb8, 0x77, 0x34, 0xc8, 0xc6, 0x2c, 0x48, 0xdd, 0xec, 0xa8,
-	0x15, 0x14, 0xd0, 0xdb, 0x8b, 0x52, 0xda, 0x0a, 0x84, 0x37, 0x32, 0x86, 0x5f, 0x82, 0xbc, 0x90,
-	0x88, 0x4b, 0x1a, 0xb7, 0x7c, 0x1c, 0x26, 0x3e, 0x0d, 0xdd, 0x54, 0xd9, 0xd9, 0x4e, 0xd5, 0xd6,
-	0x06, 0xfd, 0xd2, 0xfd, 0x23, 0xab, 0xda, 0x0b, 0x93, 0x83, 0xba, 0x77, 0x5f, 0x4c, 0x2c, 0x43,
-	0xf8, 0x11, 0x00, 0x21, 0x09, 0xa4, 0x1f, 0x92, 0x98, 0x75, 0xdc, 0xbb, 0x65, 0x67, 0x3b, 0xe3,
-	0x65, 0x94, 0xa4, 0xae, 0x04, 0xf0, 0x09, 0xc8, 0xb4, 0x58, 0xcf, 0x6a, 0x57, 0xb4, 0x36, 0xdd,
-	0x62, 0x3d, 0xa3, 0xfc, 0xc9, 0x01, 0x4f, 0x12, 0x4e, 0x7a, 0x94, 0x75, 0x85, 0x8f, 0x30, 0xee,
-	0x76, 0xba, 0x11, 0x92, 0x94, 0xc5, 0xbe, 0xbe, 0x0f, 0xf7, 0x9e, 0x8e, 0xe9, 0xf3, 0xd9, 0x98,
-	0xec, 0xf1, 0xef, 0x4e, 0x98, 0x1c, 0xd3, 0x0e, 0xa9, 0x95, 0x6d, 0x8c, 0xee, 0x9c, 0x0d, 0xc2,
-	0xdb, 0x18, 0xf2, 0xcd, 0xa8, 0x20, 0x07, 0x05, 0xc9, 0x24, 0x8a, 0xfc, 0x84, 0xd3, 0x18, 0xd3,
-	0x04, 0x45, 0xc2, 0x4d, 0x6b, 0x0f, 0x9e, 0xce, 0xf5, 0xe0, 0x58, 0x19, 0x34, 0x86, 0xfb, 0x6b,
-	0x45, 0xcb, 0xff, 0xe8, 0x5a, 0xb5, 0xf0, 0xf2, 0xf2, 0xaa, 0x60, 0xeb, 0xdf, 0xbb, 0x60, 0xc5,
-	0xe4, 0x06, 0x3c, 0x01, 0x6b, 0x98, 0x45, 0x11, 0x92, 0x84, 0x2b, 0x1f, 0x86, 0x09, 0xa5, 0xf8,
-	0x3f, 0xbe, 0x26, 0x35, 0x46, 0x5b, 0xb5, 0x79, 0xcd, 0xb5, 0xcc, 0x85, 0x29, 0x85, 0xf0, 0x0a,
-	0x78, 0x4a, 0x02, 0xbf, 0xb1, 0x57, 0xa6, 0x39, 0xdc, 0x65, 0x9d, 0xb3, 0x4f, 0xae, 0x4b, 0x9c,
-	0x40, 0x1a, 0x70, 0x93, 0xb6, 0xfa, 0x56, 0xb5, 0x00, 0xbe, 0x06, 0x6b, 0xad, 0x88, 0x05, 0x28,
-	0xf2, 0x35, 0x50, 0x44, 0x3b, 0x54, 0xba, 0x77, 0x34, 0xd0, 0x46, 0xc5, 0xd6, 0x9f, 0x2a, 0xd6,
-	0x09, 0x77, 0x69, 0x6c, 0x61, 0xf2, 0xc6, 0x52, 0xa1, 0x1f, 0x2a, 0x3b, 0x78, 0x0a, 0x36, 0x44,
-	0x97, 0x27, 0x91, 0xca, 0x81, 0x2e, 0x36, 0xd7, 0x7f, 0xc2, 0x89, 0x38, 0x61, 0x91, 0x49, 0xc3,
-	0x4c, 0xed, 0x2b, 0x65, 0xf9, 0x77, 0xbf, 0xf4, 0x59, 0x8b, 0xca, 0x93, 0x6e, 0x50, 0xc1, 0xac,
-	0x63, 0xcb, 0xdc, 0xfe, 0xec, 0x88, 0xb0, 0x5d, 0x95, 0x67, 0x09, 0x11, 0x95, 0x83, 0x58, 0xfe,
-	0xf9, 0xfb, 0x0e, 0xb0, 0x5e, 0x1c, 0xc4, 0xd2, 0x7b, 0x6c, 0xe1, 0x77, 0x0d, 0xfa, 0xf1, 0x10,
-	0x1c, 0x46, 0xe0, 0xc1, 0x34, 0x73, 0xc4, 0xa4, 0x49, 0xe2, 0x5b, 0x72, 0xae, 0x5d, 0xe5, 0x3c,
-	0x64, 0x12, 0x72, 0xf0, 0x48, 0x9f, 0xd6, 0x6c, 0x90, 0x2b, 0x0b, 0x20, 0x5c, 0x57, 0xd8, 0x33,
-	0x11, 0x36, 0x41, 0xe1, 0x0a, 0xa7, 0x0a, 0xef, 0xde, 0x02, 0xd8, 0x72, 0x13, 0x6c, 0x2a, 0xb6,
-	0xa7, 0x20, 0x8f, 0x29, 0xc7, 0x5d, 0x2a, 0xfd, 0x80, 0x13, 0xd4, 0x26, 0xdc, 0x4d, 0x97, 0x9d,
-	0xed, 0xb4, 0x97, 0xb3, 0xe2, 0x9a, 0x91, 0x6e, 0xfd, 0xba, 0x0c, 0x32, 0xa3, 0xc4, 0x82, 0xeb,
-	0xe0, 0xae, 0xe9, 0x0c, 0x8e, 0xee, 0x0c, 0x66, 0xa1, 0xc0, 0x38, 0x69, 0x12, 0x4e, 0x62, 0x4c,
-	0x7c, 0x24, 0x04, 0x91, 0x3a, 0x49, 0x33, 0x5e, 0x6e, 0x24, 0xde, 0x55, 0x52, 0x48, 0x55, 0xc9,
-	0xc4, 0x3d, 0xc2, 0x85, 0x8a, 0xad, 0x89, 0xb0, 0x64, 0x5c, 0xa7, 0xe1, 0x6d, 0xc3, 0x2b, 0x8c,
-	0x61, 0xf7, 0x35, 0x2a, 0xfc, 0xde, 0xd6, 0x4c, 0x33, 0x62, 0x8c, 0x2f, 0x24, 0x2b, 0x75, 0x39,
-	0xed, 0x2b, 0xb8, 0xad, 0x5f, 0xd2, 0x20, 0x3f, 0x55, 0xb7, 0x73, 0x8e, 0x06, 0x82, 0x94, 0xc2,
-	0xb3, 0xe7, 0xa1, 0xbf, 0xd5, 0x29, 0x44, 0xf4, 0x87, 0x2e, 0x0d, 0x4d, 0xeb, 0xe4, 0xea, 0xe7,
-	0x06, 0xa7, 0x50, 0x27, 0x78, 0xc2, 0xc3, 0x3a, 0xc1, 0x5e, 0x61, 0x02, 0xd6, 0x53, 0x7f, 0xe1,
-	0xd7, 0xf6, 0x14, 0x4c, 0xc1, 0xa7, 0x3e, 0xac, 0xe0, 0x75, 0xa0, 0xa6, 0xd4, 0x11, 0x50, 0xaf,
-	0x47, 0x40, 0x23, 0x2a, 0xcf, 0xfc, 0x26, 0x21, 0x37, 0x28, 0xb5, 0x59, 0x37, 0xb3, 0x23, 0xc8,
-	0x7d, 0x42, 0xa0, 0x0f, 0xb2, 0xc3, 0x64, 0x17, 0xf4, 0x9c, 0x2c, 0xa4, 0xb6, 0x56, 0x2d, 0xe2,
-	0x11, 0x3d, 0x27, 0xb0, 0x03, 0x1e, 0x4c, 0x1e, 0x77, 0x42, 0x62, 0x14, 0xc9, 0xb3, 0x1b, 0x54,
-	0xd5, 0x6c, 0x24, 0x70, 0x02, 0xb8, 0x61, 0x70, 0xe1, 0x0b, 0x90, 0x13, 0x09, 0x93, 0x7e, 0x07,
-	0xf1, 0x36, 0x91, 0xea, 0x65, 0x4e, 0x6b, 0xa6, 0xc2, 0xa0, 0x5f, 0xca, 0x1e, 0x25, 0x4c, 0x7e,
-	0xab, 0x15, 0x07, 0x75, 0x2f, 0x2b, 0xc6, 0xab, 0x10, 0xbe, 0x06, 0x0f, 0x27, 0xdd, 0x1c, 0x9b,
-	0x67, 0xb4, 0xf9, 0xe3, 0x41, 0xbf, 0xf4, 0xe0, 0x70, 0xbc, 0x61, 0x84, 0x32, 0x19, 0xdc, 0x08,
-	0xac, 0x07, 0xdc, 0x36, 0x21, 0x09, 0xe1, 0x3e, 0x27, 0x3f, 0x22, 0x1e, 0xfa, 0x09, 0xe1, 0x98,
-	0xc4, 0x12, 0xb5, 0x88, 0x0b, 0x16, 0x10, 0xf8, 0x23, 0x83, 0xee, 0x69, 0xf0, 0xc6, 0x08, 0x5b,
-	0x0d, 0x08, 0x9f, 0xe0, 0x13, 0x82, 0xdb, 0xfe, 0xf8, 0x11, 0xa3, 0xe7, 0x26, 0x22, 0x1a, 0x87,
-	0xe4, 0xd4, 0xc7, 0xac, 0x1b, 0x4b, 0x77, 0x75, 0x01, 0x97, 0x5c, 0xd6, 0x44, 0x7b, 0xd3, 0x3c,
-	0x07, 0x8a, 0x66, 0x4f, 0xb1, 0x5c, 0xdf, 0x6e, 0xb2, 0xff, 0x47, 0xbb, 0xd9, 0xfa, 0x79, 0x19,
-	0x3c, 0x9e, 0x33, 0xc3, 0xe8, 0x5e, 0x3b, 0x1e, 0x14, 0x74, 0x3b, 0x30, 0x3d, 0x22, 0x37, 0x16,
-	0x1f, 0xab, 0xc6, 0x10, 0x80, 0xcd, 0xf9, 0xd3, 0x95, 0x7d, 0xf7, 0x37, 0x2b, 0x66, 0x14, 0xae,
-	0x0c, 0x47, 0xe1, 0xca, 0xf1, 0x70, 0x14, 0xae, 0xa5, 0x55, 0x50, 0x6f, 0x2e, 0x4a, 0x8e, 0xe7,
-	0xce, 0x9b, 0x9a, 0x20, 0x01, 0x79, 0x1a, 0x4b, 0xc2, 0x89, 0x90, 0x37, 0x6f, 0xc0, 0xb3, 0x09,
-	0x91, 0x1b, 0x82, 0xda, 0xf3, 0xf8, 0xcd, 0x01, 0x0f, 0xaf, 0x9d, 0xa9, 0x3e, 0xfc, 0x34, 0x08,
-	0xc8, 0x4f, 0x8d, 0x77, 0xa6, 0x8b, 0xde, 0xf6, 0x25, 0xbc, 0x3a, 0xd2, 0xd5, 0x5e, 0xbe, 0x1b,
-	0x14, 0x9d, 0xf7, 0x83, 0xa2, 0xf3, 0xcf, 0xa0, 0xe8, 0xbc, 0xb9, 0x2c, 0x2e, 0xbd, 0xbf, 0x2c,
-	0x2e, 0xfd, 0x75, 0x59, 0x5c, 0xfa, 0xee, 0xd3, 0x09, 0x7c, 0x35, 0x6c, 0xed, 0x44, 0x28, 0x10,
-	0xfa, 0xab, 0x7a, 0xaa, 0xff, 0xd5, 0xd0, 0x14, 0xc1, 0x8a, 0xbe, 0x89, 0x2f, 0xfe, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0xe6, 0x31, 0x9b, 0x4e, 0x27, 0x0d, 0x00, 0x00,
+func init() { proto.RegisterFile("aeth/cdp/v1beta1/genesis.proto", fileDescriptor_86d54eab0f830602) }
+
+var fileDescriptor_86d54eab0f830602 = []byte{
+	// 1608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0xdb, 0xce,
+	0x11, 0x37, 0x6d, 0x59, 0x16, 0xd7, 0xb2, 0x24, 0xaf, 0x9d, 0x84, 0x76, 0x50, 0x49, 0x55, 0x8a,
+	0xfe, 0x5d, 0x14, 0x91, 0x9a, 0x04, 0x08, 0x50, 0xa0, 0x68, 0x6b, 0x59, 0x75, 0x60, 0xc4, 0x6e,
+	0x05, 0xda, 0x45, 0xd1, 0xe6, 0x40, 0x50, 0xe4, 0x48, 0xda, 0x88, 0xe4, 0xb2, 0xbb, 0x2b, 0xc7,
+	0xc9, 0x2d, 0xd7, 0x16, 0x2d, 0x82, 0x7e, 0x89, 0x02, 0x39, 0xf7, 0xda, 0x7b, 0x8e, 0x41, 0x4f,
+	0x45, 0x0f, 0x4e, 0xa0, 0x7c, 0x91, 0x62, 0x97, 0x94, 0x44, 0x3d, 0xfc, 0x47, 0x12, 0x30, 0x17,
+	0x89, 0x3b, 0xb3, 0xf3, 0x9b, 0x07, 0xe7, 0xb1, 0x5c, 0x54, 0xb6, 0x41, 0xf4, 0x1b, 0x8e, 0x1b,
+	0x36, 0x2e, 0x1f, 0x74, 0x40, 0xd8, 0x0f, 0x1a, 0x3d, 0x08, 0x80, 0x13, 0x5e, 0x0f, 0x19, 0x15,
+	0x14, 0x97, 0x24, 0xbf, 0xee, 0xb8, 0x61, 0x3d, 0xe6, 0xef, 0x97, 0x1d, 0xca, 0x7d, 0xca, 0x1b,
+	0x1d, 0x9b, 0xc3, 0x44, 0xc8, 0xa1, 0x24, 0x88, 0x24, 0xf6, 0xf7, 0x22, 0xbe, 0xa5, 0x56, 0x8d,
+	0x68, 0x11, 0xb3, 0x76, 0x7b, 0xb4, 0x47, 0x23, 0xba, 0x7c, 0x8a, 0xa9, 0x95, 0x1e, 0xa5, 0x3d,
+	0x0f, 0x1a, 0x6a, 0xd5, 0x19, 0x76, 0x1b, 0x82, 0xf8, 0xc0, 0x85, 0xed, 0x87, 0xf1, 0x86, 0xfd,
+	0x05, 0x1b, 0x1d, 0x37, 0xe6, 0xd5, 0xfe, 0x92, 0x45, 0xf9, 0x27, 0x91, 0xc5, 0xe7, 0xc2, 0x16,
+	0x80, 0x1f, 0xa3, 0x6c, 0x68, 0x33, 0xdb, 0xe7, 0x86, 0x56, 0xd5, 0x0e, 0x36, 0x1f, 0x1a, 0xf5,
+	0x79, 0x0f, 0xea, 0x6d, 0xc5, 0x6f, 0x66, 0xde, 0x5d, 0x57, 0x56, 0xcc, 0x78, 0x37, 0xfe, 0x15,
+	0xca, 0x38, 0x6e, 0xc8, 0x8d, 0xd5, 0xea, 0xda, 0xc1, 0xe6, 0xc3, 0x5b, 0x8b, 0x52, 0x47, 0xad,
+	0x76, 0x73, 0x57, 0x8a, 0x8c, 0xae, 0x2b, 0x99, 0xa3, 0x56, 0x9b, 0xbf, 0xfd, 0x10, 0xfd, 0x9b,
+	0x4a, 0x10, 0x3f, 0x41, 0x39, 0x17, 0x42, 0xca, 0x89, 0xe0, 0xc6, 0x9a, 0x02, 0xd9, 0x5b, 0x04,
+	0x69, 0x45, 0x3b, 0x9a, 0x25, 0x09, 0xf4, 0xf6, 0x43, 0x25, 0x17, 0x13, 0xb8, 0x39, 0x11, 0xc6,
+	0x3f, 0x47, 0x45, 0x2e, 0x6c, 0x26, 0x48, 0xd0, 0xb3, 0x1c, 0x37, 0xb4, 0x88, 0x6b, 0x64, 0xaa,
+	0xda, 0x41, 0xa6, 0xb9, 0x3d, 0xba, 0xae, 0x6c, 0x9d, 0xc7, 0xac, 0x23, 0x37, 0x3c, 0x69, 0x99,
+	0x5b, 0x3c, 0xb1, 0x74, 0xf1, 0x0f, 0x10, 0x72, 0xa1, 0x23, 0x2c, 0x17, 0x02, 0xea, 0x1b, 0xeb,
+	0x55, 0xed, 0x40, 0x37, 0x75, 0x49, 0x69, 0x49, 0x02, 0xbe, 0x8b, 0xf4, 0x1e, 0xbd, 0x8c, 0xb9,
+	0x59, 0xc5, 0xcd, 0xf5, 0xe8, 0x65, 0xc4, 0xfc, 0xab, 0x86, 0xee, 0x86, 0x0c, 0x2e, 0x09, 0x1d,
+	0x72, 0xcb, 0x76, 0x9c, 0xa1, 0x3f, 0xf4, 0x6c, 0x41, 0x68, 0x60, 0xa9, 0xf7, 0x61, 0x6c, 0x28,
+	0x9f, 0x7e, 0xb2, 0xe8, 0x53, 0x1c, 0xfe, 0xc3, 0x84, 0xc8, 0x05, 0xf1, 0xa1, 0x59, 0x8d, 0x7d,
+	0x34, 0x6e, 0xd8, 0xc0, 0xcd, 0xbd, 0xb1, 0xbe, 0x05, 0x16, 0x66, 0xa8, 0x24, 0xa8, 0xb0, 0x3d,
+	0x2b, 0x64, 0x24, 0x70, 0x48, 0x68, 0x7b, 0xdc, 0xc8, 0x29, 0x0b, 0xbe, 0xbb, 0xd1, 0x82, 0x0b,
+	0x29, 0xd0, 0x1e, 0xef, 0x6f, 0x96, 0x63, 0xfd, 0xb7, 0x97, 0xb2, 0xb9, 0x59, 0x14, 0xb3, 0x04,
+	0xfc, 0x3b, 0xb4, 0xdd, 0xf3, 0x68, 0xc7, 0xf6, 0x2c, 0x0e, 0x42, 0x78, 0xe0, 0x43, 0x20, 0x0c,
+	0x5d, 0x65, 0x51, 0x6d, 0x89, 0x52, 0xb5, 0xf5, 0x7c, 0xb2, 0xd3, 0x2c, 0xf5, 0xe6, 0x28, 0x38,
+	0x40, 0xf9, 0x90, 0xfb, 0x16, 0x03, 0x0e, 0xec, 0x12, 0xb8, 0x81, 0xe2, 0xb4, 0x88, 0x8b, 0x42,
+	0x56, 0xd0, 0x34, 0xbd, 0x28, 0x09, 0x9a, 0x3f, 0x8b, 0x4d, 0x3e, 0xe8, 0x11, 0xd1, 0x1f, 0x76,
+	0xea, 0x0e, 0xf5, 0xe3, 0x0a, 0x8a, 0xff, 0xee, 0x73, 0x77, 0xd0, 0x10, 0x2f, 0x43, 0xe0, 0x4a,
+	0x80, 0x9b, 0x9b, 0x21, 0xf7, 0xcd, 0x18, 0xbf, 0xf6, 0xf7, 0x0d, 0x94, 0x8d, 0x92, 0x1b, 0xf7,
+	0xd1, 0xb6, 0x43, 0x3d, 0xcf, 0x16, 0xc0, 0x64, 0x10, 0xc7, 0x15, 0x21, 0xf5, 0xff, 0x70, 0x49,
+	0x6e, 0x4f, 0xb6, 0x2a, 0xf1, 0xa6, 0x11, 0xdb, 0x51, 0x9a, 0x63, 0x70, 0xb3, 0xe4, 0xcc, 0x51,
+	0xf0, 0xaf, 0xe3, 0x9c, 0x53, 0x3a, 0x8c, 0x55, 0x15, 0xae, 0xbb, 0xcb, 0x32, 0xbf, 0x23, 0x22,
+	0xf0, 0xa8, 0xee, 0x74, 0x77, 0x4c, 0xc0, 0x4f, 0x27, 0x71, 0x57, 0x40, 0x1e, 0xf1, 0x89, 0x30,
+	0xd6, 0xaa, 0xda, 0xf7, 0xc7, 0x2a, 0x82, 0x29, 0x46, 0x92, 0x12, 0xfd, 0x54, 0xca, 0xe1, 0x2b,
+	0xb4, 0xc7, 0x87, 0x2c, 0xf4, 0x64, 0x12, 0x0f, 0x9d, 0x28, 0x7f, 0xfb, 0x0c, 0x78, 0x9f, 0x7a,
+	0x51, 0x1d, 0xe9, 0xcd, 0x5f, 0x48, 0xc9, 0xff, 0x5d, 0x57, 0x7e, 0xfc, 0x19, 0x51, 0x3e, 0x09,
+	0xc4, 0x7f, 0xfe, 0x75, 0x1f, 0xc5, 0x56, 0x9c, 0x04, 0xc2, 0xbc, 0x13, 0xc3, 0x1f, 0x46, 0xe8,
+	0x17, 0x63, 0x70, 0xec, 0xa1, 0x9d, 0x79, 0xcd, 0x1e, 0x15, 0xc6, 0x7a, 0x0a, 0x3a, 0xb7, 0x67,
+	0x75, 0x9e, 0x52, 0x81, 0x19, 0xba, 0xad, 0xa2, 0xb5, 0xe8, 0x64, 0x36, 0x05, 0x85, 0xbb, 0x12,
+	0x7b, 0xc1, 0xc3, 0x2e, 0x2a, 0xcd, 0xe8, 0x94, 0xee, 0x6d, 0xa4, 0xa0, 0xad, 0x90, 0xd0, 0x26,
+	0x7d, 0xfb, 0x0e, 0x15, 0x1d, 0xc2, 0x9c, 0x21, 0x11, 0x56, 0x87, 0x81, 0x3d, 0x00, 0x66, 0xe4,
+	0xaa, 0xda, 0x41, 0xce, 0x2c, 0xc4, 0xe4, 0x66, 0x44, 0xc5, 0xa7, 0x08, 0xc9, 0x02, 0x8b, 0xd3,
+	0x5b, 0x57, 0xe9, 0xbd, 0xbf, 0xa4, 0xe1, 0x73, 0x3f, 0x4a, 0xbd, 0xed, 0x38, 0xaf, 0xf5, 0x31,
+	0x85, 0x9b, 0x7a, 0x38, 0x7e, 0xc4, 0x6d, 0x54, 0xea, 0x7a, 0x36, 0xef, 0x5b, 0x3e, 0x09, 0xc6,
+	0xf9, 0x8c, 0x54, 0x1a, 0x56, 0x17, 0x31, 0x8f, 0xe5, 0xce, 0x33, 0x12, 0xcc, 0x24, 0x75, 0xa1,
+	0x3b, 0x43, 0xad, 0xfd, 0x63, 0x15, 0xe9, 0x93, 0xc4, 0xc7, 0xbb, 0x68, 0x3d, 0x6a, 0xbd, 0x9a,
+	0x6a, 0xbd, 0xd1, 0x42, 0x3a, 0xcb, 0xa0, 0x0b, 0x0c, 0x02, 0x07, 0x2c, 0x9b, 0x73, 0x10, 0xaa,
+	0x88, 0x74, 0xb3, 0x30, 0x21, 0x1f, 0x4a, 0x2a, 0x26, 0xb2, 0xa4, 0x83, 0x4b, 0x60, 0x5c, 0xc6,
+	0xbe, 0x6b, 0x3b, 0x82, 0x32, 0x63, 0x2d, 0x85, 0xf0, 0x97, 0xa6, 0xb0, 0xc7, 0x0a, 0x15, 0x3f,
+	0x8b, 0x6b, 0xba, 0xeb, 0x51, 0xca, 0x52, 0xa9, 0x1a, 0x55, 0xee, 0xc7, 0x12, 0xae, 0xf6, 0x51,
+	0x47, 0xc5, 0xb9, 0xbe, 0x72, 0x43, 0x68, 0x30, 0xca, 0x48, 0xbc, 0x38, 0x1e, 0xea, 0x59, 0x46,
+	0xc1, 0x23, 0x7f, 0x1e, 0x12, 0x37, 0x9a, 0x4d, 0x4c, 0xfe, 0x7d, 0x45, 0x14, 0x5a, 0xe0, 0x24,
+	0x2c, 0x6c, 0x81, 0x63, 0x96, 0x12, 0xb0, 0xa6, 0xfc, 0xc5, 0xbf, 0x44, 0x28, 0xd1, 0x90, 0x32,
+	0x9f, 0xd7, 0x90, 0x74, 0x77, 0xd2, 0x8a, 0x6c, 0x24, 0xc7, 0x73, 0x87, 0x78, 0x44, 0xbc, 0xb4,
+	0xba, 0x00, 0xc6, 0x7a, 0x0a, 0x66, 0xe6, 0x27, 0x90, 0xc7, 0x00, 0xd8, 0x42, 0xf9, 0x71, 0x31,
+	0x72, 0xf2, 0x0a, 0x52, 0xa9, 0xfd, 0xcd, 0x18, 0xf1, 0x9c, 0xbc, 0x02, 0xec, 0xa3, 0x9d, 0x64,
+	0xb8, 0x43, 0x08, 0x6c, 0x4f, 0xbc, 0x34, 0x36, 0x52, 0xf0, 0x04, 0x27, 0x80, 0xdb, 0x11, 0x2e,
+	0x7e, 0x8c, 0x0a, 0x3c, 0xa4, 0xc2, 0xf2, 0x6d, 0x36, 0x00, 0x21, 0x8f, 0x3e, 0x39, 0xa5, 0xa9,
+	0x34, 0xba, 0xae, 0xe4, 0xcf, 0x43, 0x2a, 0xce, 0x14, 0xe3, 0xa4, 0x65, 0xe6, 0xf9, 0x74, 0xe5,
+	0xe2, 0xa7, 0xe8, 0x56, 0xd2, 0xcc, 0xa9, 0xb8, 0xae, 0xc4, 0xef, 0x8c, 0xae, 0x2b, 0x3b, 0xa7,
+	0xd3, 0x0d, 0x13, 0x94, 0x1d, 0x6f, 0x81, 0xe8, 0xe2, 0x4b, 0x64, 0x0c, 0x00, 0x42, 0x60, 0x16,
+	0x83, 0x17, 0x36, 0x73, 0xad, 0x10, 0x98, 0x03, 0x81, 0xb0, 0x7b, 0x60, 0xa0, 0x14, 0x1c, 0xbf,
+	0x1d, 0xa1, 0x9b, 0x0a, 0xbc, 0x3d, 0xc1, 0x96, 0x27, 0xb0, 0x7b, 0x4e, 0x1f, 0x9c, 0x81, 0x35,
+	0x1d, 0xb2, 0xe4, 0x55, 0xe4, 0x11, 0x09, 0x5c, 0xb8, 0xb2, 0x1c, 0x3a, 0x0c, 0x84, 0xb1, 0x99,
+	0xc2, 0x4b, 0xae, 0x2a, 0x45, 0x47, 0xf3, 0x7a, 0x4e, 0xa4, 0x9a, 0x23, 0xa9, 0x65, 0x79, 0xbb,
+	0xc9, 0x7f, 0x93, 0x76, 0x73, 0x8e, 0x76, 0x66, 0x0a, 0xc5, 0xf2, 0xa9, 0x0b, 0x9e, 0xb1, 0xa5,
+	0x2a, 0xee, 0xde, 0x62, 0xef, 0x3d, 0x4f, 0x94, 0xc0, 0x99, 0xdc, 0x6a, 0x6e, 0xf3, 0x79, 0x12,
+	0xfe, 0x3d, 0xda, 0x09, 0xe5, 0xd9, 0xd8, 0xf6, 0xac, 0xc4, 0x4b, 0x36, 0x0a, 0x0a, 0xf4, 0x47,
+	0x4b, 0xbf, 0x0a, 0xe4, 0xe6, 0x44, 0x96, 0x98, 0x38, 0x5c, 0xa0, 0xe1, 0x7b, 0x68, 0xcb, 0x1d,
+	0x0a, 0xa7, 0x3f, 0x1e, 0x82, 0x46, 0x51, 0x4d, 0xa6, 0xbc, 0x22, 0xc6, 0x33, 0xac, 0xf6, 0x1c,
+	0xe1, 0x45, 0x38, 0x7c, 0x81, 0xb2, 0x9d, 0x61, 0xb7, 0x0b, 0xcc, 0xd0, 0xbe, 0x38, 0x8c, 0x8b,
+	0x59, 0x14, 0x63, 0xd5, 0x5e, 0xaf, 0xa3, 0xed, 0x85, 0x80, 0xe0, 0x3f, 0x22, 0x5d, 0x76, 0x28,
+	0xd9, 0x1f, 0x21, 0x15, 0x75, 0x39, 0x09, 0x67, 0xca, 0x2f, 0x2c, 0x40, 0x45, 0x05, 0xed, 0x0f,
+	0x3d, 0x41, 0x42, 0x8f, 0x00, 0x33, 0x56, 0x53, 0x50, 0x50, 0x90, 0xa0, 0x67, 0x13, 0x4c, 0xdc,
+	0x46, 0x99, 0x01, 0x09, 0x06, 0xa9, 0xf4, 0x76, 0x85, 0x24, 0x0d, 0x7f, 0x3e, 0xf4, 0xc3, 0xa4,
+	0xe1, 0x99, 0x34, 0x0c, 0x97, 0xa0, 0x09, 0xc3, 0x1f, 0xa1, 0xad, 0x10, 0x7a, 0x89, 0x1e, 0x14,
+	0xb5, 0xfd, 0xe2, 0xe8, 0xba, 0xb2, 0xd9, 0x86, 0xde, 0xa4, 0xf7, 0x6c, 0x86, 0x93, 0x85, 0x8b,
+	0x1d, 0x54, 0x50, 0x42, 0x53, 0xd3, 0xb2, 0x29, 0x98, 0x26, 0x0d, 0x49, 0x58, 0xf6, 0x07, 0x94,
+	0xf3, 0xed, 0xab, 0x28, 0x27, 0xd2, 0xe8, 0xe0, 0x1b, 0xbe, 0x7d, 0x25, 0x53, 0xa2, 0xf6, 0x7a,
+	0x0d, 0xe5, 0xc6, 0x47, 0xaa, 0x1b, 0x66, 0xf9, 0xd2, 0x76, 0xb2, 0xfa, 0x4d, 0xda, 0xc9, 0xec,
+	0xdc, 0x5e, 0xfb, 0xe2, 0xb9, 0x2d, 0xc3, 0x24, 0x4f, 0x80, 0x72, 0x64, 0x67, 0x52, 0x09, 0x13,
+	0x09, 0x84, 0x9c, 0xd6, 0xcf, 0x10, 0x62, 0xe0, 0x02, 0xf8, 0xa9, 0x9d, 0x06, 0xf4, 0x08, 0xef,
+	0x18, 0xa0, 0xf6, 0x6f, 0x0d, 0x15, 0x66, 0x0f, 0xa5, 0x52, 0x9f, 0x7c, 0xdf, 0xb6, 0xaf, 0xc6,
+	0x86, 0x96, 0xc6, 0x31, 0xce, 0xb7, 0xaf, 0x0e, 0x15, 0x1c, 0xfe, 0x2d, 0x5a, 0x93, 0x5e, 0xa4,
+	0x51, 0xfa, 0x12, 0xa8, 0xf6, 0xb7, 0x55, 0x74, 0xe7, 0x86, 0x9b, 0x02, 0xf5, 0x41, 0x30, 0xfd,
+	0x9a, 0x55, 0x67, 0xc2, 0x28, 0xb9, 0x0a, 0x53, 0xf2, 0x85, 0x3c, 0x1d, 0x76, 0xd0, 0xfe, 0xcd,
+	0x77, 0x18, 0xf1, 0xc7, 0xe9, 0x7e, 0x3d, 0xba, 0x70, 0xaa, 0x8f, 0x2f, 0x9c, 0xea, 0x17, 0xe3,
+	0x0b, 0xa7, 0x66, 0x4e, 0xfa, 0xf1, 0xe6, 0x43, 0x45, 0x33, 0x8d, 0x9b, 0xee, 0x26, 0x64, 0x1b,
+	0x21, 0x81, 0x00, 0x06, 0x5c, 0x7c, 0xfd, 0x29, 0x7c, 0x49, 0x1b, 0x19, 0x83, 0x46, 0x59, 0x5c,
+	0xfb, 0xa7, 0x86, 0x6e, 0x2d, 0xbd, 0xb9, 0xf8, 0xfc, 0x68, 0x00, 0x2a, 0xce, 0x5d, 0xa2, 0xa4,
+	0x52, 0x71, 0x85, 0xd9, 0x8b, 0x93, 0xe6, 0x6f, 0xde, 0x8d, 0xca, 0xda, 0xfb, 0x51, 0x59, 0xfb,
+	0x38, 0x2a, 0x6b, 0x6f, 0x3e, 0x95, 0x57, 0xde, 0x7f, 0x2a, 0xaf, 0xfc, 0xf7, 0x53, 0x79, 0xe5,
+	0x4f, 0x3f, 0x4d, 0xe0, 0xfb, 0x74, 0x40, 0x84, 0x1d, 0x80, 0x78, 0x41, 0xd9, 0xa0, 0x21, 0xc7,
+	0x2f, 0xb0, 0xc6, 0x95, 0xba, 0xd6, 0x53, 0x8a, 0x3a, 0x59, 0xf5, 0x3e, 0x1e, 0xfd, 0x7f, 0x00,
+	0xd7, 0xd4, 0x9e, 0x8e, 0x93, 0x14, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -553,6 +845,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PsmReserves) > 0 {
+		for iNdEx := len(m.PsmReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PsmReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.GlobalSettlement != nil {
+		{
+			size, err := m.GlobalSettlement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.TotalPrincipals) > 0 {
 		for iNdEx := len(m.TotalPrincipals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -661,6 +979,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FlashMintParam.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.PsmParams) > 0 {
+		for iNdEx := len(m.PsmParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PsmParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.CircuitBreaker {
 		i--
 		if m.CircuitBreaker {
@@ -825,6 +1167,40 @@ func (m *CollateralParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DutchAuction {
+		i--
+		if m.DutchAuction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.PartialLiquidation != nil {
+		{
+			size, err := m.PartialLiquidation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.StabilityFeeModel != nil {
+		{
+			size, err := m.StabilityFeeModel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	{
 		size := m.ConversionFactor.Size()
 		i -= size
@@ -936,7 +1312,7 @@ func (m *CollateralParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisAccumulationTime) Marshal() (dAtA []byte, err error) {
+func (m *PartialLiquidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -946,45 +1322,30 @@ func (m *GenesisAccumulationTime) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisAccumulationTime) MarshalTo(dAtA []byte) (int, error) {
+func (m *PartialLiquidation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PartialLiquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.InterestFactor.Size()
+		size := m.Buffer.Size()
 		i -= size
-		if _, err := m.InterestFactor.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Buffer.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintGenesis(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x12
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisTotalPrincipal) Marshal() (dAtA []byte, err error) {
+func (m *StabilityFeeModel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -994,52 +1355,303 @@ func (m *GenesisTotalPrincipal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisTotalPrincipal) MarshalTo(dAtA []byte) (int, error) {
+func (m *StabilityFeeModel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisTotalPrincipal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StabilityFeeModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TotalPrincipal.Size()
+		size := m.MaxRate.Size()
 		i -= size
-		if _, err := m.TotalPrincipal.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+	dAtA[i] = 0x3a
+	{
+		size := m.PegMultiplier.Size()
+		i -= size
+		if _, err := m.PegMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.PegMarketID) > 0 {
+		i -= len(m.PegMarketID)
+		copy(dAtA[i:], m.PegMarketID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PegMarketID)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	{
+		size := m.JumpMultiplier.Size()
+		i -= size
+		if _, err := m.JumpMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Kink.Size()
+		i -= size
+		if _, err := m.Kink.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	var l int
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseMultiplier.Size()
+		i -= size
+		if _, err := m.BaseMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseRate.Size()
+		i -= size
+		if _, err := m.BaseRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PsmParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PsmParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PsmParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RedeemFee.Size()
+		i -= size
+		if _, err := m.RedeemFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MintFee.Size()
+		i -= size
+		if _, err := m.MintFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.DebtLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ConversionFactor.Size()
+		i -= size
+		if _, err := m.ConversionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlashMintParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlashMintParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlashMintParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisAccumulationTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAccumulationTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InterestFactor.Size()
+		i -= size
+		if _, err := m.InterestFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PreviousAccumulationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGenesis(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisTotalPrincipal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisTotalPrincipal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisTotalPrincipal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalPrincipal.Size()
+		i -= size
+		if _, err := m.TotalPrincipal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
@@ -1078,6 +1690,16 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.GlobalSettlement != nil {
+		l = m.GlobalSettlement.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PsmReserves) > 0 {
+		for _, e := range m.PsmReserves {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1108,6 +1730,14 @@ func (m *Params) Size() (n int) {
 	if m.CircuitBreaker {
 		n += 2
 	}
+	if len(m.PsmParams) > 0 {
+		for _, e := range m.PsmParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FlashMintParam.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1170,48 +1800,874 @@ func (m *CollateralParam) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ConversionFactor.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.StabilityFeeModel != nil {
+		l = m.StabilityFeeModel.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PartialLiquidation != nil {
+		l = m.PartialLiquidation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DutchAuction {
+		n += 2
+	}
 	return n
 }
 
-func (m *GenesisAccumulationTime) Size() (n int) {
+func (m *PartialLiquidation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.CollateralType)
+	l = m.Buffer.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *StabilityFeeModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Kink.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.JumpMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.PegMarketID)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime)
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.InterestFactor.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
+	l = m.PegMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *PsmParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.ConversionFactor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DebtLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MintFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RedeemFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FlashMintParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisAccumulationTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PreviousAccumulationTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.InterestFactor.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisTotalPrincipal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TotalPrincipal.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CDPs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CDPs = append(m.CDPs, CDP{})
+			if err := m.CDPs[len(m.CDPs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingCdpID", wireType)
+			}
+			m.StartingCdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingCdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DebtDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccumulationTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAccumulationTimes = append(m.PreviousAccumulationTimes, GenesisAccumulationTime{})
+			if err := m.PreviousAccumulationTimes[len(m.PreviousAccumulationTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPrincipals = append(m.TotalPrincipals, GenesisTotalPrincipal{})
+			if err := m.TotalPrincipals[len(m.TotalPrincipals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalSettlement == nil {
+				m.GlobalSettlement = &GlobalSettlement{}
+			}
+			if err := m.GlobalSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PsmReserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PsmReserves = append(m.PsmReserves, types.Coin{})
+			if err := m.PsmReserves[len(m.PsmReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralParams = append(m.CollateralParams, CollateralParam{})
+			if err := m.CollateralParams[len(m.CollateralParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtParam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalDebtLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalDebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusAuctionThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SurplusAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusAuctionLot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SurplusAuctionLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAuctionThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtAuctionLot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtAuctionLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CircuitBreaker = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PsmParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PsmParams = append(m.PsmParams, PsmParam{})
+			if err := m.PsmParams[len(m.PsmParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashMintParam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashMintParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GenesisTotalPrincipal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.TotalPrincipal.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *DebtParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1234,17 +2690,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DebtParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DebtParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1254,30 +2710,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CDPs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceAsset", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1287,31 +2742,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CDPs = append(m.CDPs, CDP{})
-			if err := m.CDPs[len(m.CDPs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReferenceAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1321,31 +2774,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingCdpID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtFloor", wireType)
 			}
-			m.StartingCdpID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1355,14 +2808,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartingCdpID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1390,11 +2908,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DebtDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1422,13 +2940,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GovDenom = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccumulationTimes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1438,29 +2956,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousAccumulationTimes = append(m.PreviousAccumulationTimes, GenesisAccumulationTime{})
-			if err := m.PreviousAccumulationTimes[len(m.PreviousAccumulationTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1487,66 +3005,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalPrincipals = append(m.TotalPrincipals, GenesisTotalPrincipal{})
-			if err := m.TotalPrincipals[len(m.TotalPrincipals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1556,31 +3023,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralParams = append(m.CollateralParams, CollateralParam{})
-			if err := m.CollateralParams[len(m.CollateralParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1590,30 +3057,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuctionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalDebtLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1623,28 +3091,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalDebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SurplusAuctionThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1672,13 +3141,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SurplusAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SurplusAuctionLot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationMarketID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1706,13 +3173,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SurplusAuctionLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LiquidationMarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtAuctionThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1740,13 +3205,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtAuctionLot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckCollateralizationIndexCount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1774,15 +3239,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtAuctionLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CheckCollateralizationIndexCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1792,67 +3257,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CircuitBreaker = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DebtParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DebtParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebtParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFeeModel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1862,29 +3291,33 @@ func (m *DebtParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if m.StabilityFeeModel == nil {
+				m.StabilityFeeModel = &StabilityFeeModel{}
+			}
+			if err := m.StabilityFeeModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1894,29 +3327,33 @@ func (m *DebtParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReferenceAsset = string(dAtA[iNdEx:postIndex])
+			if m.PartialLiquidation == nil {
+				m.PartialLiquidation = &PartialLiquidation{}
+			}
+			if err := m.PartialLiquidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuction", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1926,29 +3363,65 @@ func (m *DebtParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
+			m.DutchAuction = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartialLiquidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartialLiquidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartialLiquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtFloor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1976,7 +3449,7 @@ func (m *DebtParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2001,7 +3474,7 @@ func (m *DebtParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CollateralParam) Unmarshal(dAtA []byte) error {
+func (m *StabilityFeeModel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2024,15 +3497,15 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollateralParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: StabilityFeeModel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollateralParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StabilityFeeModel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2060,11 +3533,13 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.BaseRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2092,11 +3567,13 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2124,15 +3601,15 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DebtLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2142,28 +3619,29 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PegMarketID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2191,13 +3669,11 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PegMarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PegMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2225,13 +3701,13 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuctionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PegMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2259,13 +3735,63 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PsmParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PsmParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PsmParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2293,11 +3819,11 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationMarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2325,11 +3851,46 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidationMarketID = string(dAtA[iNdEx:postIndex])
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2357,13 +3918,13 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckCollateralizationIndexCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2391,13 +3952,63 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CheckCollateralizationIndexCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedeemFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlashMintParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlashMintParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlashMintParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2425,7 +4036,41 @@ func (m *CollateralParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
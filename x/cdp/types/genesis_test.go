@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/mokitanetwork/aether/x/cdp/types"
@@ -47,3 +48,45 @@ func TestGenesisTotalPrincipal(t *testing.T) {
 		})
 	}
 }
+
+func TestGlobalSettlement_Validate(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	xrp := types.NewSettlementCollateral("xrp-a", sdk.MustNewDecFromStr("0.25"), sdk.NewInt64Coin("xrp", 100))
+	btc := types.NewSettlementCollateral("btc-a", sdk.MustNewDecFromStr("8000"), sdk.NewInt64Coin("btc", 10))
+	overRedeemed := xrp
+	overRedeemed.Redeemed = sdk.NewInt64Coin("xrp", 101)
+	noPrice := types.NewSettlementCollateral("xrp-a", sdk.ZeroDec(), sdk.NewInt64Coin("xrp", 0))
+	noPriceReserved := xrp
+	noPriceReserved.Price = sdk.ZeroDec()
+	negativePrice := xrp
+	negativePrice.Price = sdk.MustNewDecFromStr("-0.25")
+
+	tests := []struct {
+		giveName       string
+		giveSettlement types.GlobalSettlement
+		wantIsError    bool
+		wantError      string
+	}{
+		{"valid", types.NewGlobalSettlement(startTime, types.SettlementCollaterals{xrp, btc}, sdk.NewInt64Coin("usdx", 100)), false, ""},
+		{"valid no collateral", types.NewGlobalSettlement(startTime, nil, sdk.NewInt64Coin("usdx", 0)), false, ""},
+		{"invalid zero start time", types.NewGlobalSettlement(time.Time{}, types.SettlementCollaterals{xrp}, sdk.NewInt64Coin("usdx", 100)), true, "start time cannot be zero"},
+		{"invalid duplicate collateral type", types.NewGlobalSettlement(startTime, types.SettlementCollaterals{xrp, xrp}, sdk.NewInt64Coin("usdx", 100)), true, "duplicate settlement collateral type"},
+		{"valid zero price", types.NewGlobalSettlement(startTime, types.SettlementCollaterals{noPrice, btc}, sdk.NewInt64Coin("usdx", 100)), false, ""},
+		{"invalid negative price", types.NewGlobalSettlement(startTime, types.SettlementCollaterals{negativePrice}, sdk.NewInt64Coin("usdx", 100)), true, "settlement price must not be negative"},
+		{"invalid zero price with reserved collateral", types.NewGlobalSettlement(startTime, types.SettlementCollaterals{noPriceReserved}, sdk.NewInt64Coin("usdx", 100)), true, "cannot be reserved at a zero settlement price"},
+		{"invalid collateral over redeemed", types.NewGlobalSettlement(startTime, types.SettlementCollaterals{overRedeemed}, sdk.NewInt64Coin("usdx", 100)), true, "exceeds reserved"},
+		{"invalid supply over redeemed", types.GlobalSettlement{StartTime: startTime, RedeemableSupply: sdk.NewInt64Coin("usdx", 100), Redeemed: sdk.NewInt64Coin("usdx", 101)}, true, "exceeds redeemable supply"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.giveName, func(t *testing.T) {
+			err := tt.giveSettlement.Validate()
+			if tt.wantIsError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
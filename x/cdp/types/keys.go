@@ -25,6 +25,9 @@ const (
 
 	// LiquidatorMacc module account for liquidator
 	LiquidatorMacc = "liquidator"
+
+	// PsmMacc module account holding the stablecoin reserves of the peg stability module
+	PsmMacc = "cdp_psm"
 )
 
 var sep = []byte(":")
@@ -45,6 +48,9 @@ var sep = []byte(":")
 // - 0x08:previousDistributionTime
 // - 0x09<marketID>:downTime
 // - 0x10:totalDistributed
+// - 0x14<collateralType>:<cdpID_Bytes>: cdpID of a multi-collateral cdp
+// - 0x15: GlobalSettlement
+// - 0x16<denom>: psm reserve
 
 // KVStore key prefixes
 var (
@@ -59,6 +65,9 @@ var (
 	PricefeedStatusKeyPrefix   = []byte{0x10}
 	PreviousAccrualTimePrefix  = []byte{0x12}
 	InterestFactorPrefix       = []byte{0x13}
+	MultiCollateralIndexPrefix = []byte{0x14}
+	GlobalSettlementKey        = []byte{0x15}
+	PsmReserveKeyPrefix        = []byte{0x16}
 )
 
 // GetCdpIDBytes returns the byte representation of the cdpID
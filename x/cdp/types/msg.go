@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -17,6 +18,17 @@ var (
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgTransferCDP{}
+	_ sdk.Msg = &MsgSetCDPManagers{}
+	_ sdk.Msg = &MsgDepositBasket{}
+	_ sdk.Msg = &MsgWithdrawBasket{}
+	_ sdk.Msg = &MsgSettleCDP{}
+	_ sdk.Msg = &MsgRedeemSettlement{}
+	_ sdk.Msg = &MsgPsmMint{}
+	_ sdk.Msg = &MsgPsmRedeem{}
+	_ sdk.Msg = &MsgFlashMint{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgFlashMint{}
 )
 
 // NewMsgCreateCDP returns a new MsgPlaceBid.
@@ -70,12 +82,13 @@ func (msg MsgCreateCDP) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgDeposit returns a new MsgDeposit
-func NewMsgDeposit(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) MsgDeposit {
+func NewMsgDeposit(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string, cdpID uint64) MsgDeposit {
 	return MsgDeposit{
 		Owner:          owner.String(),
 		Depositor:      depositor.String(),
 		Collateral:     collateral,
 		CollateralType: collateralType,
+		CdpID:          cdpID,
 	}
 }
 
@@ -121,12 +134,13 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgWithdraw returns a new MsgDeposit
-func NewMsgWithdraw(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) MsgWithdraw {
+func NewMsgWithdraw(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string, cdpID uint64) MsgWithdraw {
 	return MsgWithdraw{
 		Owner:          owner.String(),
 		Depositor:      depositor.String(),
 		Collateral:     collateral,
 		CollateralType: collateralType,
+		CdpID:          cdpID,
 	}
 }
 
@@ -172,10 +186,11 @@ func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgDrawDebt returns a new MsgDrawDebt
-func NewMsgDrawDebt(sender sdk.AccAddress, collateralType string, principal sdk.Coin) MsgDrawDebt {
+func NewMsgDrawDebt(sender sdk.AccAddress, collateralType string, cdpID uint64, principal sdk.Coin) MsgDrawDebt {
 	return MsgDrawDebt{
 		Sender:         sender.String(),
 		CollateralType: collateralType,
+		CdpID:          cdpID,
 		Principal:      principal,
 	}
 }
@@ -218,10 +233,11 @@ func (msg MsgDrawDebt) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgRepayDebt returns a new MsgRepayDebt
-func NewMsgRepayDebt(sender sdk.AccAddress, collateralType string, payment sdk.Coin) MsgRepayDebt {
+func NewMsgRepayDebt(sender sdk.AccAddress, collateralType string, cdpID uint64, payment sdk.Coin) MsgRepayDebt {
 	return MsgRepayDebt{
 		Sender:         sender.String(),
 		CollateralType: collateralType,
+		CdpID:          cdpID,
 		Payment:        payment,
 	}
 }
@@ -238,6 +254,11 @@ func (msg MsgRepayDebt) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
 	}
+	if msg.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+		}
+	}
 
 	if strings.TrimSpace(msg.CollateralType) == "" {
 		return errors.New("cdp collateral type cannot be blank")
@@ -264,11 +285,12 @@ func (msg MsgRepayDebt) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgLiquidate returns a new MsgLiquidate
-func NewMsgLiquidate(keeper, borrower sdk.AccAddress, ctype string) MsgLiquidate {
+func NewMsgLiquidate(keeper, borrower sdk.AccAddress, ctype string, cdpID uint64) MsgLiquidate {
 	return MsgLiquidate{
 		Keeper:         keeper.String(),
 		Borrower:       borrower.String(),
 		CollateralType: ctype,
+		CdpID:          cdpID,
 	}
 }
 
@@ -309,3 +331,504 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgTransferCDP returns a new MsgTransferCDP
+func NewMsgTransferCDP(sender, recipient sdk.AccAddress, collateralType string, cdpID uint64) MsgTransferCDP {
+	return MsgTransferCDP{
+		Sender:         sender.String(),
+		Recipient:      recipient.String(),
+		CollateralType: collateralType,
+		CdpID:          cdpID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgTransferCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgTransferCDP) Type() string { return "transfer_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTransferCDP) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s", err)
+	}
+	if sender.Equals(recipient) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient cannot be the sender")
+	}
+
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errors.New("cdp collateral type cannot be blank")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTransferCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgTransferCDP) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetCDPManagers returns a new MsgSetCDPManagers
+func NewMsgSetCDPManagers(sender sdk.AccAddress, collateralType string, cdpID uint64, managers []sdk.AccAddress) MsgSetCDPManagers {
+	managerAddrs := make([]string, len(managers))
+	for i, manager := range managers {
+		managerAddrs[i] = manager.String()
+	}
+	return MsgSetCDPManagers{
+		Sender:         sender.String(),
+		CollateralType: collateralType,
+		CdpID:          cdpID,
+		Managers:       managerAddrs,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetCDPManagers) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetCDPManagers) Type() string { return "set_cdp_managers" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetCDPManagers) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errors.New("cdp collateral type cannot be blank")
+	}
+	managers, err := msg.ManagerAddresses()
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid manager address %s", err)
+	}
+	return ValidateManagers(sender, managers)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetCDPManagers) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetCDPManagers) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ManagerAddresses parses the manager addresses of the msg
+func (msg MsgSetCDPManagers) ManagerAddresses() ([]sdk.AccAddress, error) {
+	if len(msg.Managers) == 0 {
+		return nil, nil
+	}
+	managers := make([]sdk.AccAddress, len(msg.Managers))
+	for i, manager := range msg.Managers {
+		addr, err := sdk.AccAddressFromBech32(manager)
+		if err != nil {
+			return nil, err
+		}
+		managers[i] = addr
+	}
+	return managers, nil
+}
+
+// NewMsgDepositBasket returns a new MsgDepositBasket
+func NewMsgDepositBasket(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string, cdpID uint64, basketType string) MsgDepositBasket {
+	return MsgDepositBasket{
+		Owner:          owner.String(),
+		Depositor:      depositor.String(),
+		Collateral:     collateral,
+		CollateralType: collateralType,
+		CdpID:          cdpID,
+		BasketType:     basketType,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositBasket) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositBasket) Type() string { return "deposit_basket_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositBasket) ValidateBasic() error {
+	return validateBasketMsg(msg.Owner, msg.Depositor, msg.Collateral, msg.CollateralType, msg.BasketType)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositBasket) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositBasket) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+// NewMsgWithdrawBasket returns a new MsgWithdrawBasket
+func NewMsgWithdrawBasket(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string, cdpID uint64, basketType string) MsgWithdrawBasket {
+	return MsgWithdrawBasket{
+		Owner:          owner.String(),
+		Depositor:      depositor.String(),
+		Collateral:     collateral,
+		CollateralType: collateralType,
+		CdpID:          cdpID,
+		BasketType:     basketType,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawBasket) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawBasket) Type() string { return "withdraw_basket_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawBasket) ValidateBasic() error {
+	return validateBasketMsg(msg.Owner, msg.Depositor, msg.Collateral, msg.CollateralType, msg.BasketType)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawBasket) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawBasket) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+// validateBasketMsg checks the fields shared by basket deposit and withdraw msgs
+func validateBasketMsg(owner, depositor string, collateral sdk.Coin, collateralType, basketType string) error {
+	_, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address %s", err)
+	}
+	_, err = sdk.AccAddressFromBech32(depositor)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address %s", err)
+	}
+
+	if !collateral.IsValid() || collateral.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "collateral amount %s", collateral)
+	}
+	if strings.TrimSpace(collateralType) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	if strings.TrimSpace(basketType) == "" {
+		return fmt.Errorf("basket collateral type cannot be empty")
+	}
+	if basketType == collateralType {
+		return fmt.Errorf("basket collateral type must differ from the cdp's collateral type %s", collateralType)
+	}
+	return nil
+}
+
+// NewMsgSettleCDP returns a new MsgSettleCDP
+func NewMsgSettleCDP(sender sdk.AccAddress, collateralType string, cdpID uint64) MsgSettleCDP {
+	return MsgSettleCDP{
+		Sender:         sender.String(),
+		CollateralType: collateralType,
+		CdpID:          cdpID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSettleCDP) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSettleCDP) Type() string { return "settle_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSettleCDP) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return errors.New("cdp collateral type cannot be blank")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSettleCDP) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSettleCDP) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgRedeemSettlement returns a new MsgRedeemSettlement
+func NewMsgRedeemSettlement(sender sdk.AccAddress, amount sdk.Coin) MsgRedeemSettlement {
+	return MsgRedeemSettlement{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRedeemSettlement) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRedeemSettlement) Type() string { return "redeem_settlement" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedeemSettlement) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "redemption amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedeemSettlement) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedeemSettlement) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgPsmMint returns a new MsgPsmMint
+func NewMsgPsmMint(sender sdk.AccAddress, amount sdk.Coin) MsgPsmMint {
+	return MsgPsmMint{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPsmMint) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPsmMint) Type() string { return "psm_mint" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPsmMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "psm mint amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPsmMint) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPsmMint) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgPsmRedeem returns a new MsgPsmRedeem
+func NewMsgPsmRedeem(sender sdk.AccAddress, amount sdk.Coin, denom string) MsgPsmRedeem {
+	return MsgPsmRedeem{
+		Sender: sender.String(),
+		Amount: amount,
+		Denom:  denom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPsmRedeem) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPsmRedeem) Type() string { return "psm_redeem" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPsmRedeem) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "psm redeem amount %s", msg.Amount)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPsmSwap, err.Error())
+	}
+	if msg.Denom == msg.Amount.Denom {
+		return sdkerrors.Wrapf(ErrInvalidPsmSwap, "cannot redeem %s for itself", msg.Denom)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPsmRedeem) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPsmRedeem) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgFlashMint returns a new MsgFlashMint
+func NewMsgFlashMint(sender sdk.AccAddress, amount sdk.Coin, msgs []sdk.Msg) (MsgFlashMint, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, m := range msgs {
+		any, err := codectypes.NewAnyWithValue(m)
+		if err != nil {
+			return MsgFlashMint{}, err
+		}
+		anys[i] = any
+	}
+	return MsgFlashMint{
+		Sender: sender.String(),
+		Amount: amount,
+		Msgs:   anys,
+	}, nil
+}
+
+// GetMessages returns the cached messages of the flash mint.
+func (msg MsgFlashMint) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, fmt.Errorf("flash mint messages contain %T which is not a sdk.Msg", any)
+		}
+		msgs[i] = m
+	}
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgFlashMint) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgFlashMint) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgFlashMint) Type() string { return "flash_mint" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgFlashMint) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", err)
+	}
+
+	if msg.Amount.IsZero() || !msg.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "flash mint amount %s", msg.Amount)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidFlashMintMsg, err.Error())
+	}
+	if len(msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidFlashMintMsg, "flash mint must contain at least one message")
+	}
+	for _, m := range msgs {
+		if err := ValidateFlashMintMsg(sender, m); err != nil {
+			return err
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgFlashMint) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgFlashMint) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateFlashMintMsg checks a message can be executed within a flash mint by sender.
+// Messages can only be signed by the sender, and flash mints cannot be nested.
+func ValidateFlashMintMsg(sender sdk.AccAddress, msg sdk.Msg) error {
+	if _, ok := msg.(*MsgFlashMint); ok {
+		return sdkerrors.Wrap(ErrInvalidFlashMintMsg, "flash mints cannot be nested")
+	}
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(sender) {
+		return sdkerrors.Wrapf(ErrInvalidFlashMintMsg, "%T must only be signed by the flash mint sender %s", msg, sender)
+	}
+	return nil
+}
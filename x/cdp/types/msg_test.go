@@ -71,6 +71,7 @@ func TestMsgDeposit(t *testing.T) {
 			tc.depositor,
 			tc.collateral,
 			tc.collateralType,
+			0,
 		)
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
@@ -103,6 +104,7 @@ func TestMsgWithdraw(t *testing.T) {
 			tc.depositor,
 			tc.collateral,
 			tc.collateralType,
+			0,
 		)
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
@@ -130,6 +132,7 @@ func TestMsgDrawDebt(t *testing.T) {
 		msg := NewMsgDrawDebt(
 			tc.sender,
 			tc.collateralType,
+			0,
 			tc.principal,
 		)
 		if tc.expectPass {
@@ -158,6 +161,7 @@ func TestMsgRepayDebt(t *testing.T) {
 		msg := NewMsgRepayDebt(
 			tc.sender,
 			tc.denom,
+			0,
 			tc.payment,
 		)
 		if tc.expectPass {
@@ -167,3 +171,279 @@ func TestMsgRepayDebt(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgTransferCDP(t *testing.T) {
+	tests := []struct {
+		description    string
+		sender         sdk.AccAddress
+		recipient      sdk.AccAddress
+		collateralType string
+		expectPass     bool
+	}{
+		{"transfer", addrs[0], addrs[1], "xrp-a", true},
+		{"transfer to self", addrs[0], addrs[0], "xrp-a", false},
+		{"transfer empty sender", sdk.AccAddress{}, addrs[1], "xrp-a", false},
+		{"transfer empty recipient", addrs[0], sdk.AccAddress{}, "xrp-a", false},
+		{"transfer empty collateral type", addrs[0], addrs[1], "", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTransferCDP(
+			tc.sender,
+			tc.recipient,
+			tc.collateralType,
+			0,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgSetCDPManagers(t *testing.T) {
+	tests := []struct {
+		description    string
+		sender         sdk.AccAddress
+		collateralType string
+		managers       []sdk.AccAddress
+		expectPass     bool
+	}{
+		{"set managers", addrs[0], "xrp-a", []sdk.AccAddress{addrs[1]}, true},
+		{"clear managers", addrs[0], "xrp-a", nil, true},
+		{"owner as manager", addrs[0], "xrp-a", []sdk.AccAddress{addrs[0]}, false},
+		{"duplicate manager", addrs[0], "xrp-a", []sdk.AccAddress{addrs[1], addrs[1]}, false},
+		{"empty sender", sdk.AccAddress{}, "xrp-a", []sdk.AccAddress{addrs[1]}, false},
+		{"empty collateral type", addrs[0], "", []sdk.AccAddress{addrs[1]}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSetCDPManagers(
+			tc.sender,
+			tc.collateralType,
+			0,
+			tc.managers,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgDepositBasket(t *testing.T) {
+	tests := []struct {
+		description    string
+		sender         sdk.AccAddress
+		depositor      sdk.AccAddress
+		collateral     sdk.Coin
+		collateralType string
+		basketType     string
+		expectPass     bool
+	}{
+		{"deposit basket", addrs[0], addrs[1], coinsSingle, "type-a", "type-b", true},
+		{"deposit basket same owner", addrs[0], addrs[0], coinsSingle, "type-a", "type-b", true},
+		{"deposit basket no collateral", addrs[0], addrs[1], coinsZero, "type-a", "type-b", false},
+		{"deposit basket empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, "type-a", "type-b", false},
+		{"deposit basket empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, "type-a", "type-b", false},
+		{"deposit basket empty type", addrs[0], addrs[0], coinsSingle, "", "type-b", false},
+		{"deposit basket empty basket type", addrs[0], addrs[0], coinsSingle, "type-a", "", false},
+		{"deposit basket same type", addrs[0], addrs[0], coinsSingle, "type-a", "type-a", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgDepositBasket(
+			tc.sender,
+			tc.depositor,
+			tc.collateral,
+			tc.collateralType,
+			0,
+			tc.basketType,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgWithdrawBasket(t *testing.T) {
+	tests := []struct {
+		description    string
+		sender         sdk.AccAddress
+		depositor      sdk.AccAddress
+		collateral     sdk.Coin
+		collateralType string
+		basketType     string
+		expectPass     bool
+	}{
+		{"withdraw basket", addrs[0], addrs[1], coinsSingle, "type-a", "type-b", true},
+		{"withdraw basket no collateral", addrs[0], addrs[1], coinsZero, "type-a", "type-b", false},
+		{"withdraw basket empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, "type-a", "type-b", false},
+		{"withdraw basket empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, "type-a", "type-b", false},
+		{"withdraw basket empty basket type", addrs[0], addrs[0], coinsSingle, "type-a", "", false},
+		{"withdraw basket same type", addrs[0], addrs[0], coinsSingle, "type-a", "type-a", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgWithdrawBasket(
+			tc.sender,
+			tc.depositor,
+			tc.collateral,
+			tc.collateralType,
+			0,
+			tc.basketType,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgSettleCDP(t *testing.T) {
+	tests := []struct {
+		description    string
+		sender         sdk.AccAddress
+		collateralType string
+		expectPass     bool
+	}{
+		{"settle", addrs[0], "xrp-a", true},
+		{"settle empty sender", sdk.AccAddress{}, "xrp-a", false},
+		{"settle empty collateral type", addrs[0], "", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgSettleCDP(
+			tc.sender,
+			tc.collateralType,
+			0,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgRedeemSettlement(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"redeem", addrs[0], coinsSingle, true},
+		{"redeem zero amount", addrs[0], coinsZero, false},
+		{"redeem empty sender", sdk.AccAddress{}, coinsSingle, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeemSettlement(
+			tc.sender,
+			tc.amount,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgPsmMint(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"psm mint", addrs[0], coinsSingle, true},
+		{"psm mint zero amount", addrs[0], coinsZero, false},
+		{"psm mint empty sender", sdk.AccAddress{}, coinsSingle, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgPsmMint(
+			tc.sender,
+			tc.amount,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgPsmRedeem(t *testing.T) {
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		denom       string
+		expectPass  bool
+	}{
+		{"psm redeem", addrs[0], sdk.NewInt64Coin("usdx", 1000), "erc20/usdc", true},
+		{"psm redeem zero amount", addrs[0], sdk.NewInt64Coin("usdx", 0), "erc20/usdc", false},
+		{"psm redeem empty sender", sdk.AccAddress{}, sdk.NewInt64Coin("usdx", 1000), "erc20/usdc", false},
+		{"psm redeem invalid denom", addrs[0], sdk.NewInt64Coin("usdx", 1000), "", false},
+		{"psm redeem same denom", addrs[0], sdk.NewInt64Coin("usdx", 1000), "usdx", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgPsmRedeem(
+			tc.sender,
+			tc.amount,
+			tc.denom,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgFlashMint(t *testing.T) {
+	repay := NewMsgRepayDebt(addrs[0], "type-a", 0, coinsSingle)
+	otherRepay := NewMsgRepayDebt(addrs[1], "type-a", 0, coinsSingle)
+	zeroRepay := NewMsgRepayDebt(addrs[0], "type-a", 0, coinsZero)
+	nested, err := NewMsgFlashMint(addrs[0], coinsSingle, []sdk.Msg{&repay})
+	require.NoError(t, err)
+
+	tests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		msgs        []sdk.Msg
+		expectPass  bool
+	}{
+		{"flash mint", addrs[0], coinsSingle, []sdk.Msg{&repay}, true},
+		{"flash mint zero amount", addrs[0], coinsZero, []sdk.Msg{&repay}, false},
+		{"flash mint empty sender", sdk.AccAddress{}, coinsSingle, []sdk.Msg{&repay}, false},
+		{"flash mint no msgs", addrs[0], coinsSingle, []sdk.Msg{}, false},
+		{"flash mint msg signed by another address", addrs[0], coinsSingle, []sdk.Msg{&repay, &otherRepay}, false},
+		{"flash mint invalid msg", addrs[0], coinsSingle, []sdk.Msg{&zeroRepay}, false},
+		{"flash mint nested", addrs[0], coinsSingle, []sdk.Msg{&nested}, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := NewMsgFlashMint(
+			tc.sender,
+			tc.amount,
+			tc.msgs,
+		)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
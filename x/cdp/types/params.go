@@ -19,9 +19,13 @@ var (
 	KeyDebtLot              = []byte("DebtLot")
 	KeySurplusThreshold     = []byte("SurplusThreshold")
 	KeySurplusLot           = []byte("SurplusLot")
+	KeyPsmParams            = []byte("PsmParams")
+	KeyFlashMintParam       = []byte("FlashMintParam")
 	DefaultGlobalDebt       = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
 	DefaultCircuitBreaker   = false
 	DefaultCollateralParams = CollateralParams{}
+	DefaultPsmParams        = PsmParams{}
+	DefaultFlashMintParam   = NewFlashMintParam(sdk.ZeroInt(), sdk.ZeroDec())
 	DefaultDebtParam        = DebtParam{
 		Denom:            "usdx",
 		ReferenceAsset:   "usd",
@@ -42,7 +46,7 @@ var (
 // NewParams returns a new params object
 func NewParams(
 	debtLimit sdk.Coin, collateralParams CollateralParams, debtParam DebtParam, surplusThreshold,
-	surplusLot, debtThreshold, debtLot sdk.Int, breaker bool,
+	surplusLot, debtThreshold, debtLot sdk.Int, breaker bool, psmParams PsmParams, flashMintParam FlashMintParam,
 ) Params {
 	return Params{
 		GlobalDebtLimit:         debtLimit,
@@ -53,6 +57,8 @@ func NewParams(
 		DebtAuctionThreshold:    debtThreshold,
 		DebtAuctionLot:          debtLot,
 		CircuitBreaker:          breaker,
+		PsmParams:               psmParams,
+		FlashMintParam:          flashMintParam,
 	}
 }
 
@@ -61,7 +67,7 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParam, DefaultSurplusThreshold,
 		DefaultSurplusLot, DefaultDebtThreshold, DefaultDebtLot,
-		DefaultCircuitBreaker,
+		DefaultCircuitBreaker, DefaultPsmParams, DefaultFlashMintParam,
 	)
 }
 
@@ -89,6 +95,54 @@ func NewCollateralParam(
 // CollateralParams array of CollateralParam
 type CollateralParams []CollateralParam
 
+// NewStabilityFeeModel returns a new StabilityFeeModel
+func NewStabilityFeeModel(
+	baseRate, baseMultiplier, kink, jumpMultiplier sdk.Dec, pegMarketID string, pegMultiplier, maxRate sdk.Dec,
+) StabilityFeeModel {
+	return StabilityFeeModel{
+		BaseRate:       baseRate,
+		BaseMultiplier: baseMultiplier,
+		Kink:           kink,
+		JumpMultiplier: jumpMultiplier,
+		PegMarketID:    pegMarketID,
+		PegMultiplier:  pegMultiplier,
+		MaxRate:        maxRate,
+	}
+}
+
+// Validate performs basic validation of stability fee model parameters
+func (m StabilityFeeModel) Validate() error {
+	if m.BaseRate.IsNil() || m.BaseRate.LT(sdk.OneDec()) || m.BaseRate.GT(stabilityFeeMax) {
+		return fmt.Errorf("base rate must be ≥ 1.0, ≤ %s, is %s", stabilityFeeMax, m.BaseRate)
+	}
+	if m.BaseMultiplier.IsNil() || m.BaseMultiplier.IsNegative() {
+		return fmt.Errorf("base multiplier must be non-negative, is %s", m.BaseMultiplier)
+	}
+	if m.Kink.IsNil() || m.Kink.IsNegative() || m.Kink.GT(sdk.OneDec()) {
+		return fmt.Errorf("kink must be between 0 and 1, is %s", m.Kink)
+	}
+	if m.JumpMultiplier.IsNil() || m.JumpMultiplier.IsNegative() {
+		return fmt.Errorf("jump multiplier must be non-negative, is %s", m.JumpMultiplier)
+	}
+	if m.PegMultiplier.IsNil() || m.PegMultiplier.IsNegative() {
+		return fmt.Errorf("peg multiplier must be non-negative, is %s", m.PegMultiplier)
+	}
+	if m.PegMultiplier.IsPositive() && strings.TrimSpace(m.PegMarketID) == "" {
+		return fmt.Errorf("peg market id cannot be blank when peg multiplier is positive")
+	}
+	if m.MaxRate.IsNil() || m.MaxRate.LT(m.BaseRate) || m.MaxRate.GT(stabilityFeeMax) {
+		return fmt.Errorf("max rate must be ≥ base rate %s, ≤ %s, is %s", m.BaseRate, stabilityFeeMax, m.MaxRate)
+	}
+	return nil
+}
+
+// NewPartialLiquidation returns a new PartialLiquidation
+func NewPartialLiquidation(buffer sdk.Dec) *PartialLiquidation {
+	return &PartialLiquidation{
+		Buffer: buffer,
+	}
+}
+
 // NewDebtParam returns a new DebtParam
 func NewDebtParam(denom, refAsset string, conversionFactor, debtFloor sdk.Int) DebtParam {
 	return DebtParam{
@@ -102,6 +156,33 @@ func NewDebtParam(denom, refAsset string, conversionFactor, debtFloor sdk.Int) D
 // DebtParams array of DebtParam
 type DebtParams []DebtParam
 
+// NewPsmParam returns a new PsmParam
+func NewPsmParam(denom string, conversionFactor sdk.Int, debtLimit sdk.Coin, mintFee, redeemFee sdk.Dec) PsmParam {
+	return PsmParam{
+		Denom:            denom,
+		ConversionFactor: conversionFactor,
+		DebtLimit:        debtLimit,
+		MintFee:          mintFee,
+		RedeemFee:        redeemFee,
+	}
+}
+
+// PsmParams array of PsmParam
+type PsmParams []PsmParam
+
+// NewFlashMintParam returns a new FlashMintParam
+func NewFlashMintParam(maxAmount sdk.Int, fee sdk.Dec) FlashMintParam {
+	return FlashMintParam{
+		MaxAmount: maxAmount,
+		Fee:       fee,
+	}
+}
+
+// IsEnabled returns true if stable asset can be flash minted
+func (p FlashMintParam) IsEnabled() bool {
+	return !p.MaxAmount.IsNil() && p.MaxAmount.IsPositive()
+}
+
 // ParamKeyTable Key declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -120,6 +201,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySurplusLot, &p.SurplusAuctionLot, validateSurplusAuctionLotParam),
 		paramtypes.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
 		paramtypes.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
+		paramtypes.NewParamSetPair(KeyPsmParams, &p.PsmParams, validatePsmParams),
+		paramtypes.NewParamSetPair(KeyFlashMintParam, &p.FlashMintParam, validateFlashMintParam),
 	}
 }
 
@@ -157,6 +240,24 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePsmParams(p.PsmParams); err != nil {
+		return err
+	}
+
+	if err := validateFlashMintParam(p.FlashMintParam); err != nil {
+		return err
+	}
+
+	for _, pp := range p.PsmParams {
+		if pp.Denom == p.DebtParam.Denom {
+			return fmt.Errorf("psm denom cannot be the debt denom %s", pp.Denom)
+		}
+		if pp.DebtLimit.Denom != p.GlobalDebtLimit.Denom {
+			return fmt.Errorf("psm debt limit denom %s does not match global debt limit denom %s",
+				pp.DebtLimit.Denom, p.GlobalDebtLimit.Denom)
+		}
+	}
+
 	if len(p.CollateralParams) == 0 { // default value OK
 		return nil
 	}
@@ -261,6 +362,21 @@ func validateCollateralParams(i interface{}) error {
 		if cp.StabilityFee.LT(sdk.OneDec()) || cp.StabilityFee.GT(stabilityFeeMax) {
 			return fmt.Errorf("stability fee must be ≥ 1.0, ≤ %s, is %s for %s", stabilityFeeMax, cp.StabilityFee, cp.Denom)
 		}
+		if cp.StabilityFeeModel != nil {
+			if err := cp.StabilityFeeModel.Validate(); err != nil {
+				return fmt.Errorf("invalid stability fee model for %s: %s", cp.Type, err)
+			}
+		}
+		if cp.PartialLiquidation != nil {
+			if cp.PartialLiquidation.Buffer.IsNil() || !cp.PartialLiquidation.Buffer.IsPositive() {
+				return fmt.Errorf("partial liquidation buffer must be positive, is %s for %s", cp.PartialLiquidation.Buffer, cp.Type)
+			}
+			// a cdp can only be restored if the seized collateral is worth less, relative to the debt it covers, than the target ratio
+			targetRatio := cp.LiquidationRatio.Add(cp.PartialLiquidation.Buffer)
+			if targetRatio.LTE(sdk.OneDec().Add(cp.LiquidationPenalty)) {
+				return fmt.Errorf("liquidation ratio plus partial liquidation buffer must exceed 1 plus the liquidation penalty, is %s for %s", targetRatio, cp.Type)
+			}
+		}
 		if cp.KeeperRewardPercentage.IsNegative() || cp.KeeperRewardPercentage.GT(sdk.OneDec()) {
 			return fmt.Errorf("keeper reward percentage should be between 0 and 1, is %s for %s", cp.KeeperRewardPercentage, cp.Denom)
 		}
@@ -344,3 +460,56 @@ func validateDebtAuctionLotParam(i interface{}) error {
 
 	return nil
 }
+
+func validatePsmParams(i interface{}) error {
+	psmParams, ok := i.(PsmParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denomDupMap := make(map[string]bool)
+	for _, pp := range psmParams {
+		if err := sdk.ValidateDenom(pp.Denom); err != nil {
+			return fmt.Errorf("psm denom invalid %s", pp.Denom)
+		}
+
+		if denomDupMap[pp.Denom] {
+			return fmt.Errorf("duplicate psm denom: %s", pp.Denom)
+		}
+		denomDupMap[pp.Denom] = true
+
+		if pp.ConversionFactor.IsNil() || pp.ConversionFactor.IsNegative() {
+			return fmt.Errorf("conversion factor should be non-negative, is %s for %s", pp.ConversionFactor, pp.Denom)
+		}
+		if !pp.DebtLimit.IsValid() {
+			return fmt.Errorf("psm debt limit should be positive, is %s for %s", pp.DebtLimit, pp.Denom)
+		}
+		if pp.MintFee.IsNil() || pp.MintFee.IsNegative() || pp.MintFee.GTE(sdk.OneDec()) {
+			return fmt.Errorf("mint fee should be between 0 and 1, is %s for %s", pp.MintFee, pp.Denom)
+		}
+		if pp.RedeemFee.IsNil() || pp.RedeemFee.IsNegative() || pp.RedeemFee.GTE(sdk.OneDec()) {
+			return fmt.Errorf("redeem fee should be between 0 and 1, is %s for %s", pp.RedeemFee, pp.Denom)
+		}
+	}
+
+	return nil
+}
+
+func validateFlashMintParam(i interface{}) error {
+	fmp, ok := i.(FlashMintParam)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if fmp.MaxAmount.IsNil() || fmp.MaxAmount.IsZero() { // flash minting disabled
+		return nil
+	}
+
+	if fmp.MaxAmount.IsNegative() {
+		return fmt.Errorf("flash mint max amount should be non-negative, is %s", fmp.MaxAmount)
+	}
+	if fmp.Fee.IsNil() || fmp.Fee.IsNegative() || fmp.Fee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("flash mint fee should be between 0 and 1, is %s", fmp.Fee)
+	}
+
+	return nil
+}
@@ -799,7 +799,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker)
+			params := types.NewParams(tc.args.globalDebtLimit, tc.args.collateralParams, tc.args.debtParam, tc.args.surplusThreshold, tc.args.surplusLot, tc.args.debtThreshold, tc.args.debtLot, tc.args.breaker, types.DefaultPsmParams, types.DefaultFlashMintParam)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
@@ -811,6 +811,296 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestStabilityFeeModelValidation() {
+	d := sdk.MustNewDecFromStr
+	validModel := types.NewStabilityFeeModel(d("1.000000000315522921"), d("0.000000001"), d("0.8"), d("0.00000001"), "usdx:usd", d("0.0000001"), d("1.000000051034942716"))
+
+	testCases := []struct {
+		name     string
+		model    func() types.StabilityFeeModel
+		contains string
+	}{
+		{
+			name:  "valid",
+			model: func() types.StabilityFeeModel { return validModel },
+		},
+		{
+			name: "valid without peg",
+			model: func() types.StabilityFeeModel {
+				m := validModel
+				m.PegMarketID = ""
+				m.PegMultiplier = sdk.ZeroDec()
+				return m
+			},
+		},
+		{
+			name: "base rate below one",
+			model: func() types.StabilityFeeModel {
+				m := validModel
+				m.BaseRate = d("0.99")
+				return m
+			},
+			contains: "base rate must be ≥ 1.0",
+		},
+		{
+			name: "kink above one",
+			model: func() types.StabilityFeeModel {
+				m := validModel
+				m.Kink = d("1.1")
+				return m
+			},
+			contains: "kink must be between 0 and 1",
+		},
+		{
+			name: "negative jump multiplier",
+			model: func() types.StabilityFeeModel {
+				m := validModel
+				m.JumpMultiplier = d("-0.1")
+				return m
+			},
+			contains: "jump multiplier must be non-negative",
+		},
+		{
+			name: "peg multiplier without market",
+			model: func() types.StabilityFeeModel {
+				m := validModel
+				m.PegMarketID = ""
+				return m
+			},
+			contains: "peg market id cannot be blank",
+		},
+		{
+			name: "max rate below base rate",
+			model: func() types.StabilityFeeModel {
+				m := validModel
+				m.MaxRate = sdk.OneDec()
+				return m
+			},
+			contains: "max rate must be ≥ base rate",
+		},
+		{
+			name:     "unset fields",
+			model:    func() types.StabilityFeeModel { return types.StabilityFeeModel{} },
+			contains: "base rate must be ≥ 1.0",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			model := tc.model()
+			err := model.Validate()
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.contains)
+			}
+
+			// the model is validated as part of the collateral params
+			cp := types.NewCollateralParam(
+				"bnb", "bnb-a", d("1.5"), sdk.NewInt64Coin("usdx", 2000000000000), d("1.000000001547125958"), sdk.NewInt(50000000000),
+				d("0.05"), "bnb:usd", "bnb:usd", d("0.01"), sdk.NewInt(10), sdk.NewInt(8),
+			)
+			cp.StabilityFeeModel = &model
+			params := types.NewParams(
+				sdk.NewInt64Coin("usdx", 4000000000000), types.CollateralParams{cp}, types.DefaultDebtParam,
+				types.DefaultSurplusThreshold, types.DefaultSurplusLot, types.DefaultDebtThreshold, types.DefaultDebtLot, false, types.DefaultPsmParams, types.DefaultFlashMintParam,
+			)
+			suite.Equal(tc.contains == "", params.Validate() == nil)
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestPartialLiquidationValidation() {
+	d := sdk.MustNewDecFromStr
+
+	testCases := []struct {
+		name               string
+		partialLiquidation *types.PartialLiquidation
+		liquidationRatio   sdk.Dec
+		contains           string
+	}{
+		{
+			name:               "disabled",
+			partialLiquidation: nil,
+			liquidationRatio:   d("1.5"),
+		},
+		{
+			name:               "valid",
+			partialLiquidation: types.NewPartialLiquidation(d("0.2")),
+			liquidationRatio:   d("1.5"),
+		},
+		{
+			name:               "zero buffer",
+			partialLiquidation: types.NewPartialLiquidation(sdk.ZeroDec()),
+			liquidationRatio:   d("1.5"),
+			contains:           "partial liquidation buffer must be positive",
+		},
+		{
+			name:               "unset buffer",
+			partialLiquidation: &types.PartialLiquidation{},
+			liquidationRatio:   d("1.5"),
+			contains:           "partial liquidation buffer must be positive",
+		},
+		{
+			name:               "target ratio equal to penalty",
+			partialLiquidation: types.NewPartialLiquidation(d("0.04")),
+			liquidationRatio:   d("1.01"),
+			contains:           "must exceed 1 plus the liquidation penalty",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cp := types.NewCollateralParam(
+				"bnb", "bnb-a", tc.liquidationRatio, sdk.NewInt64Coin("usdx", 2000000000000), d("1.000000001547125958"), sdk.NewInt(50000000000),
+				d("0.05"), "bnb:usd", "bnb:usd", d("0.01"), sdk.NewInt(10), sdk.NewInt(8),
+			)
+			cp.PartialLiquidation = tc.partialLiquidation
+			params := types.NewParams(
+				sdk.NewInt64Coin("usdx", 4000000000000), types.CollateralParams{cp}, types.DefaultDebtParam,
+				types.DefaultSurplusThreshold, types.DefaultSurplusLot, types.DefaultDebtThreshold, types.DefaultDebtLot, false, types.DefaultPsmParams, types.DefaultFlashMintParam,
+			)
+			err := params.Validate()
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.contains)
+			}
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestPsmParamsValidation() {
+	d := sdk.MustNewDecFromStr
+	usdc := types.NewPsmParam("erc20/usdc", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 1000000000000), d("0.001"), d("0.002"))
+
+	testCases := []struct {
+		name      string
+		psmParams types.PsmParams
+		contains  string
+	}{
+		{
+			name:      "disabled",
+			psmParams: types.PsmParams{},
+		},
+		{
+			name:      "valid",
+			psmParams: types.PsmParams{usdc},
+		},
+		{
+			name:      "zero fees",
+			psmParams: types.PsmParams{types.NewPsmParam("erc20/usdc", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 1000000000000), sdk.ZeroDec(), sdk.ZeroDec())},
+		},
+		{
+			name:      "duplicate denom",
+			psmParams: types.PsmParams{usdc, usdc},
+			contains:  "duplicate psm denom",
+		},
+		{
+			name:      "invalid denom",
+			psmParams: types.PsmParams{types.NewPsmParam("", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 1000000000000), d("0.001"), d("0.002"))},
+			contains:  "psm denom invalid",
+		},
+		{
+			name:      "debt denom",
+			psmParams: types.PsmParams{types.NewPsmParam("usdx", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 1000000000000), d("0.001"), d("0.002"))},
+			contains:  "psm denom cannot be the debt denom",
+		},
+		{
+			name:      "debt limit denom",
+			psmParams: types.PsmParams{types.NewPsmParam("erc20/usdc", sdk.NewInt(6), sdk.NewInt64Coin("susd", 1000000000000), d("0.001"), d("0.002"))},
+			contains:  "psm debt limit denom susd does not match global debt limit denom usdx",
+		},
+		{
+			name:      "negative conversion factor",
+			psmParams: types.PsmParams{types.NewPsmParam("erc20/usdc", sdk.NewInt(-1), sdk.NewInt64Coin("usdx", 1000000000000), d("0.001"), d("0.002"))},
+			contains:  "conversion factor should be non-negative",
+		},
+		{
+			name:      "mint fee of one",
+			psmParams: types.PsmParams{types.NewPsmParam("erc20/usdc", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 1000000000000), sdk.OneDec(), d("0.002"))},
+			contains:  "mint fee should be between 0 and 1",
+		},
+		{
+			name:      "negative redeem fee",
+			psmParams: types.PsmParams{types.NewPsmParam("erc20/usdc", sdk.NewInt(6), sdk.NewInt64Coin("usdx", 1000000000000), d("0.001"), d("-0.002"))},
+			contains:  "redeem fee should be between 0 and 1",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.NewParams(
+				sdk.NewInt64Coin("usdx", 4000000000000), types.DefaultCollateralParams, types.DefaultDebtParam,
+				types.DefaultSurplusThreshold, types.DefaultSurplusLot, types.DefaultDebtThreshold, types.DefaultDebtLot, false, tc.psmParams, types.DefaultFlashMintParam,
+			)
+			err := params.Validate()
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.contains)
+			}
+		})
+	}
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
+
+func (suite *ParamsTestSuite) TestFlashMintParamValidation() {
+	d := sdk.MustNewDecFromStr
+
+	testCases := []struct {
+		name           string
+		flashMintParam types.FlashMintParam
+		contains       string
+	}{
+		{
+			name:           "disabled",
+			flashMintParam: types.DefaultFlashMintParam,
+		},
+		{
+			name:           "unset",
+			flashMintParam: types.FlashMintParam{},
+		},
+		{
+			name:           "valid",
+			flashMintParam: types.NewFlashMintParam(sdk.NewInt(1000000000000), d("0.0005")),
+		},
+		{
+			name:           "zero fee",
+			flashMintParam: types.NewFlashMintParam(sdk.NewInt(1000000000000), sdk.ZeroDec()),
+		},
+		{
+			name:           "negative max amount",
+			flashMintParam: types.NewFlashMintParam(sdk.NewInt(-1), d("0.0005")),
+			contains:       "flash mint max amount should be non-negative",
+		},
+		{
+			name:           "negative fee",
+			flashMintParam: types.NewFlashMintParam(sdk.NewInt(1000000000000), d("-0.0005")),
+			contains:       "flash mint fee should be between 0 and 1",
+		},
+		{
+			name:           "fee of one",
+			flashMintParam: types.NewFlashMintParam(sdk.NewInt(1000000000000), sdk.OneDec()),
+			contains:       "flash mint fee should be between 0 and 1",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.NewParams(
+				sdk.NewInt64Coin("usdx", 4000000000000), types.DefaultCollateralParams, types.DefaultDebtParam,
+				types.DefaultSurplusThreshold, types.DefaultSurplusLot, types.DefaultDebtThreshold, types.DefaultDebtLot, false, types.DefaultPsmParams, tc.flashMintParam,
+			)
+			err := params.Validate()
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.contains)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeGlobalSettlement defines the type for a GlobalSettlementProposal
+	ProposalTypeGlobalSettlement = "GlobalSettlement"
+)
+
+// Assert GlobalSettlementProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = GlobalSettlementProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeGlobalSettlement)
+	govtypes.RegisterProposalTypeCodec(GlobalSettlementProposal{}, "cdp/GlobalSettlementProposal")
+}
+
+// NewGlobalSettlementProposal creates a new global settlement proposal.
+func NewGlobalSettlementProposal(title, description string) *GlobalSettlementProposal {
+	return &GlobalSettlementProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+// GetTitle returns the title of a global settlement proposal.
+func (p GlobalSettlementProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a global settlement proposal.
+func (p GlobalSettlementProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a global settlement proposal.
+func (p GlobalSettlementProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a global settlement proposal.
+func (p GlobalSettlementProposal) ProposalType() string { return ProposalTypeGlobalSettlement }
+
+// ValidateBasic stateless validation of a global settlement proposal.
+func (p GlobalSettlementProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements fmt.Stringer
+func (p GlobalSettlementProposal) String() string {
+	return fmt.Sprintf(`Global Settlement Proposal:
+  Title:       %s
+  Description: %s
+`, p.Title, p.Description)
+}
@@ -24,13 +24,15 @@ const (
 type QueryCdpParams struct {
 	CollateralType string         // get CDPs with this collateral type
 	Owner          sdk.AccAddress // get CDPs belonging to this owner
+	CdpID          uint64         // get the CDP with this id, zero selects the owner's only CDP of the collateral type
 }
 
 // NewQueryCdpParams returns QueryCdpParams
-func NewQueryCdpParams(owner sdk.AccAddress, collateralType string) QueryCdpParams {
+func NewQueryCdpParams(owner sdk.AccAddress, collateralType string, cdpID uint64) QueryCdpParams {
 	return QueryCdpParams{
 		Owner:          owner,
 		CollateralType: collateralType,
+		CdpID:          cdpID,
 	}
 }
 
@@ -60,13 +62,15 @@ func NewQueryCdpsParams(page, limit int, collateralType string, owner sdk.AccAdd
 type QueryCdpDeposits struct {
 	CollateralType string         // get CDPs with this collateral type
 	Owner          sdk.AccAddress // get CDPs belonging to this owner
+	CdpID          uint64         // get the CDP with this id, zero selects the owner's only CDP of the collateral type
 }
 
 // NewQueryCdpDeposits returns QueryCdpDeposits
-func NewQueryCdpDeposits(owner sdk.AccAddress, collateralType string) QueryCdpDeposits {
+func NewQueryCdpDeposits(owner sdk.AccAddress, collateralType string, cdpID uint64) QueryCdpDeposits {
 	return QueryCdpDeposits{
 		Owner:          owner,
 		CollateralType: collateralType,
+		CdpID:          cdpID,
 	}
 }
 
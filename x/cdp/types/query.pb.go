@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -45,7 +46,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{0}
+	return fileDescriptor_28283e7bcd84247a, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,7 +84,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{1}
+	return fileDescriptor_28283e7bcd84247a, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,7 +121,7 @@ func (m *QueryAccountsRequest) Reset()         { *m = QueryAccountsRequest{} }
 func (m *QueryAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountsRequest) ProtoMessage()    {}
 func (*QueryAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{2}
+	return fileDescriptor_28283e7bcd84247a, []int{2}
 }
 func (m *QueryAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +159,7 @@ func (m *QueryAccountsResponse) Reset()         { *m = QueryAccountsResponse{} }
 func (m *QueryAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountsResponse) ProtoMessage()    {}
 func (*QueryAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{3}
+	return fileDescriptor_28283e7bcd84247a, []int{3}
 }
 func (m *QueryAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,13 +199,15 @@ func (m *QueryAccountsResponse) GetAccounts() []types.ModuleAccount {
 type QueryCdpRequest struct {
 	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 	Owner          string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,3,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *QueryCdpRequest) Reset()         { *m = QueryCdpRequest{} }
 func (m *QueryCdpRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCdpRequest) ProtoMessage()    {}
 func (*QueryCdpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{4}
+	return fileDescriptor_28283e7bcd84247a, []int{4}
 }
 func (m *QueryCdpRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,6 +250,13 @@ func (m *QueryCdpRequest) GetOwner() string {
 	return ""
 }
 
+func (m *QueryCdpRequest) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // QueryCdpResponse defines the response type for the Query/Cdp RPC method.
 type QueryCdpResponse struct {
 	Cdp CDPResponse `protobuf:"bytes,1,opt,name=cdp,proto3" json:"cdp"`
@@ -256,7 +266,7 @@ func (m *QueryCdpResponse) Reset()         { *m = QueryCdpResponse{} }
 func (m *QueryCdpResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCdpResponse) ProtoMessage()    {}
 func (*QueryCdpResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{5}
+	return fileDescriptor_28283e7bcd84247a, []int{5}
 }
 func (m *QueryCdpResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,7 +316,7 @@ func (m *QueryCdpsRequest) Reset()         { *m = QueryCdpsRequest{} }
 func (m *QueryCdpsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCdpsRequest) ProtoMessage()    {}
 func (*QueryCdpsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{6}
+	return fileDescriptor_28283e7bcd84247a, []int{6}
 }
 func (m *QueryCdpsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +390,7 @@ func (m *QueryCdpsResponse) Reset()         { *m = QueryCdpsResponse{} }
 func (m *QueryCdpsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCdpsResponse) ProtoMessage()    {}
 func (*QueryCdpsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{7}
+	return fileDescriptor_28283e7bcd84247a, []int{7}
 }
 func (m *QueryCdpsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,13 +437,15 @@ func (m *QueryCdpsResponse) GetPagination() *query.PageResponse {
 type QueryDepositsRequest struct {
 	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 	Owner          string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,3,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
 func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsRequest) ProtoMessage()    {}
 func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{8}
+	return fileDescriptor_28283e7bcd84247a, []int{8}
 }
 func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,6 +488,13 @@ func (m *QueryDepositsRequest) GetOwner() string {
 	return ""
 }
 
+func (m *QueryDepositsRequest) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // QueryDepositsResponse defines the response type for the Query/Deposits RPC method.
 type QueryDepositsResponse struct {
 	Deposits Deposits `protobuf:"bytes,1,rep,name=deposits,proto3,castrepeated=Deposits" json:"deposits"`
@@ -485,7 +504,7 @@ func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
 func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsResponse) ProtoMessage()    {}
 func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{9}
+	return fileDescriptor_28283e7bcd84247a, []int{9}
 }
 func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +549,7 @@ func (m *QueryTotalPrincipalRequest) Reset()         { *m = QueryTotalPrincipalR
 func (m *QueryTotalPrincipalRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPrincipalRequest) ProtoMessage()    {}
 func (*QueryTotalPrincipalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{10}
+	return fileDescriptor_28283e7bcd84247a, []int{10}
 }
 func (m *QueryTotalPrincipalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +594,7 @@ func (m *QueryTotalPrincipalResponse) Reset()         { *m = QueryTotalPrincipal
 func (m *QueryTotalPrincipalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalPrincipalResponse) ProtoMessage()    {}
 func (*QueryTotalPrincipalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{11}
+	return fileDescriptor_28283e7bcd84247a, []int{11}
 }
 func (m *QueryTotalPrincipalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +639,7 @@ func (m *QueryTotalCollateralRequest) Reset()         { *m = QueryTotalCollatera
 func (m *QueryTotalCollateralRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalCollateralRequest) ProtoMessage()    {}
 func (*QueryTotalCollateralRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{12}
+	return fileDescriptor_28283e7bcd84247a, []int{12}
 }
 func (m *QueryTotalCollateralRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,7 +684,7 @@ func (m *QueryTotalCollateralResponse) Reset()         { *m = QueryTotalCollater
 func (m *QueryTotalCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalCollateralResponse) ProtoMessage()    {}
 func (*QueryTotalCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{13}
+	return fileDescriptor_28283e7bcd84247a, []int{13}
 }
 func (m *QueryTotalCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,32 +720,23 @@ func (m *QueryTotalCollateralResponse) GetTotalCollateral() TotalCollaterals {
 	return nil
 }
 
-// CDPResponse defines the state of a single collateralized debt position.
-type CDPResponse struct {
-	ID                     uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Owner                  string      `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Type                   string      `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Collateral             types1.Coin `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral"`
-	Principal              types1.Coin `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal"`
-	AccumulatedFees        types1.Coin `protobuf:"bytes,6,opt,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees"`
-	FeesUpdated            time.Time   `protobuf:"bytes,7,opt,name=fees_updated,json=feesUpdated,proto3,stdtime" json:"fees_updated"`
-	InterestFactor         string      `protobuf:"bytes,8,opt,name=interest_factor,json=interestFactor,proto3" json:"interest_factor,omitempty"`
-	CollateralValue        types1.Coin `protobuf:"bytes,9,opt,name=collateral_value,json=collateralValue,proto3" json:"collateral_value"`
-	CollateralizationRatio string      `protobuf:"bytes,10,opt,name=collateralization_ratio,json=collateralizationRatio,proto3" json:"collateralization_ratio,omitempty"`
+// QueryStabilityFeeRequest defines the request type for the Query/StabilityFee RPC method.
+type QueryStabilityFeeRequest struct {
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 }
 
-func (m *CDPResponse) Reset()         { *m = CDPResponse{} }
-func (m *CDPResponse) String() string { return proto.CompactTextString(m) }
-func (*CDPResponse) ProtoMessage()    {}
-func (*CDPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd68799328aaf74a, []int{14}
+func (m *QueryStabilityFeeRequest) Reset()         { *m = QueryStabilityFeeRequest{} }
+func (m *QueryStabilityFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStabilityFeeRequest) ProtoMessage()    {}
+func (*QueryStabilityFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{14}
 }
-func (m *CDPResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStabilityFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStabilityFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CDPResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStabilityFeeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -736,1341 +746,3268 @@ func (m *CDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *CDPResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CDPResponse.Merge(m, src)
+func (m *QueryStabilityFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStabilityFeeRequest.Merge(m, src)
 }
-func (m *CDPResponse) XXX_Size() int {
+func (m *QueryStabilityFeeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CDPResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CDPResponse.DiscardUnknown(m)
+func (m *QueryStabilityFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStabilityFeeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CDPResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStabilityFeeRequest proto.InternalMessageInfo
 
-func (m *CDPResponse) GetID() uint64 {
+func (m *QueryStabilityFeeRequest) GetCollateralType() string {
 	if m != nil {
-		return m.ID
+		return m.CollateralType
 	}
-	return 0
+	return ""
 }
 
-func (m *CDPResponse) GetOwner() string {
-	if m != nil {
-		return m.Owner
-	}
-	return ""
+// QueryStabilityFeeResponse defines the response type for the Query/StabilityFee RPC method.
+type QueryStabilityFeeResponse struct {
+	StabilityFees []StabilityFeeResponse `protobuf:"bytes,1,rep,name=stability_fees,json=stabilityFees,proto3" json:"stability_fees"`
 }
 
-func (m *CDPResponse) GetType() string {
-	if m != nil {
-		return m.Type
+func (m *QueryStabilityFeeResponse) Reset()         { *m = QueryStabilityFeeResponse{} }
+func (m *QueryStabilityFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStabilityFeeResponse) ProtoMessage()    {}
+func (*QueryStabilityFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{15}
+}
+func (m *QueryStabilityFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStabilityFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStabilityFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryStabilityFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStabilityFeeResponse.Merge(m, src)
+}
+func (m *QueryStabilityFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStabilityFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStabilityFeeResponse.DiscardUnknown(m)
 }
 
-func (m *CDPResponse) GetCollateral() types1.Coin {
+var xxx_messageInfo_QueryStabilityFeeResponse proto.InternalMessageInfo
+
+func (m *QueryStabilityFeeResponse) GetStabilityFees() []StabilityFeeResponse {
 	if m != nil {
-		return m.Collateral
+		return m.StabilityFees
 	}
-	return types1.Coin{}
+	return nil
 }
 
-func (m *CDPResponse) GetPrincipal() types1.Coin {
-	if m != nil {
-		return m.Principal
-	}
-	return types1.Coin{}
+// QueryGlobalSettlementRequest defines the request type for the Query/GlobalSettlement RPC method.
+type QueryGlobalSettlementRequest struct {
 }
 
-func (m *CDPResponse) GetAccumulatedFees() types1.Coin {
-	if m != nil {
-		return m.AccumulatedFees
+func (m *QueryGlobalSettlementRequest) Reset()         { *m = QueryGlobalSettlementRequest{} }
+func (m *QueryGlobalSettlementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGlobalSettlementRequest) ProtoMessage()    {}
+func (*QueryGlobalSettlementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{16}
+}
+func (m *QueryGlobalSettlementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGlobalSettlementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGlobalSettlementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return types1.Coin{}
+}
+func (m *QueryGlobalSettlementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGlobalSettlementRequest.Merge(m, src)
+}
+func (m *QueryGlobalSettlementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGlobalSettlementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGlobalSettlementRequest.DiscardUnknown(m)
 }
 
-func (m *CDPResponse) GetFeesUpdated() time.Time {
-	if m != nil {
-		return m.FeesUpdated
+var xxx_messageInfo_QueryGlobalSettlementRequest proto.InternalMessageInfo
+
+// QueryGlobalSettlementResponse defines the response type for the Query/GlobalSettlement RPC method.
+type QueryGlobalSettlementResponse struct {
+	GlobalSettlement GlobalSettlement `protobuf:"bytes,1,opt,name=global_settlement,json=globalSettlement,proto3" json:"global_settlement"`
+}
+
+func (m *QueryGlobalSettlementResponse) Reset()         { *m = QueryGlobalSettlementResponse{} }
+func (m *QueryGlobalSettlementResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGlobalSettlementResponse) ProtoMessage()    {}
+func (*QueryGlobalSettlementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{17}
+}
+func (m *QueryGlobalSettlementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGlobalSettlementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGlobalSettlementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return time.Time{}
+}
+func (m *QueryGlobalSettlementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGlobalSettlementResponse.Merge(m, src)
+}
+func (m *QueryGlobalSettlementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGlobalSettlementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGlobalSettlementResponse.DiscardUnknown(m)
 }
 
-func (m *CDPResponse) GetInterestFactor() string {
+var xxx_messageInfo_QueryGlobalSettlementResponse proto.InternalMessageInfo
+
+func (m *QueryGlobalSettlementResponse) GetGlobalSettlement() GlobalSettlement {
 	if m != nil {
-		return m.InterestFactor
+		return m.GlobalSettlement
 	}
-	return ""
+	return GlobalSettlement{}
 }
 
-func (m *CDPResponse) GetCollateralValue() types1.Coin {
-	if m != nil {
-		return m.CollateralValue
+// QueryPsmReservesRequest defines the request type for the Query/PsmReserves RPC method.
+type QueryPsmReservesRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryPsmReservesRequest) Reset()         { *m = QueryPsmReservesRequest{} }
+func (m *QueryPsmReservesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPsmReservesRequest) ProtoMessage()    {}
+func (*QueryPsmReservesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{18}
+}
+func (m *QueryPsmReservesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPsmReservesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPsmReservesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return types1.Coin{}
+}
+func (m *QueryPsmReservesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPsmReservesRequest.Merge(m, src)
+}
+func (m *QueryPsmReservesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPsmReservesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPsmReservesRequest.DiscardUnknown(m)
 }
 
-func (m *CDPResponse) GetCollateralizationRatio() string {
+var xxx_messageInfo_QueryPsmReservesRequest proto.InternalMessageInfo
+
+func (m *QueryPsmReservesRequest) GetDenom() string {
 	if m != nil {
-		return m.CollateralizationRatio
+		return m.Denom
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "aeth.cdp.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "aeth.cdp.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryAccountsRequest)(nil), "aeth.cdp.v1beta1.QueryAccountsRequest")
-	proto.RegisterType((*QueryAccountsResponse)(nil), "aeth.cdp.v1beta1.QueryAccountsResponse")
-	proto.RegisterType((*QueryCdpRequest)(nil), "aeth.cdp.v1beta1.QueryCdpRequest")
-	proto.RegisterType((*QueryCdpResponse)(nil), "aeth.cdp.v1beta1.QueryCdpResponse")
-	proto.RegisterType((*QueryCdpsRequest)(nil), "aeth.cdp.v1beta1.QueryCdpsRequest")
-	proto.RegisterType((*QueryCdpsResponse)(nil), "aeth.cdp.v1beta1.QueryCdpsResponse")
-	proto.RegisterType((*QueryDepositsRequest)(nil), "aeth.cdp.v1beta1.QueryDepositsRequest")
-	proto.RegisterType((*QueryDepositsResponse)(nil), "aeth.cdp.v1beta1.QueryDepositsResponse")
-	proto.RegisterType((*QueryTotalPrincipalRequest)(nil), "aeth.cdp.v1beta1.QueryTotalPrincipalRequest")
-	proto.RegisterType((*QueryTotalPrincipalResponse)(nil), "aeth.cdp.v1beta1.QueryTotalPrincipalResponse")
-	proto.RegisterType((*QueryTotalCollateralRequest)(nil), "aeth.cdp.v1beta1.QueryTotalCollateralRequest")
-	proto.RegisterType((*QueryTotalCollateralResponse)(nil), "aeth.cdp.v1beta1.QueryTotalCollateralResponse")
-	proto.RegisterType((*CDPResponse)(nil), "aeth.cdp.v1beta1.CDPResponse")
+// QueryPsmReservesResponse defines the response type for the Query/PsmReserves RPC method.
+type QueryPsmReservesResponse struct {
+	Reserves []PsmReserveResponse `protobuf:"bytes,1,rep,name=reserves,proto3" json:"reserves"`
 }
 
-func init() { proto.RegisterFile("aeth/cdp/v1beta1/query.proto", fileDescriptor_fd68799328aaf74a) }
-
-var fileDescriptor_fd68799328aaf74a = []byte{
-	// 1142 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdb, 0x54,
-	0x1c, 0x8f, 0xd3, 0xb4, 0x4b, 0x5f, 0xa7, 0x26, 0x3c, 0x42, 0xe7, 0x9a, 0x92, 0xa4, 0x1e, 0x5b,
-	0x0b, 0xa2, 0x36, 0x2b, 0xe2, 0xb7, 0xd0, 0xd4, 0xb4, 0x74, 0x1a, 0x12, 0x52, 0x31, 0x05, 0x24,
-	0x24, 0x14, 0x5e, 0xec, 0xd7, 0xcc, 0x90, 0xf8, 0x79, 0x7e, 0x76, 0x47, 0x99, 0x26, 0x04, 0x87,
-	0x89, 0xe3, 0x04, 0x07, 0x0e, 0x48, 0x68, 0x17, 0x2e, 0x9c, 0xf9, 0x23, 0x76, 0x9c, 0x80, 0x03,
-	0xa7, 0x0d, 0x5a, 0x0e, 0xfc, 0x19, 0xd3, 0x7b, 0xfe, 0x3a, 0x76, 0xe2, 0xa4, 0xed, 0x0e, 0xbb,
-	0x44, 0xf1, 0xf7, 0xd7, 0xe7, 0xf3, 0xfd, 0xfa, 0xfb, 0xc3, 0x68, 0xe9, 0x4b, 0xb2, 0x4f, 0x4c,
-	0xdb, 0xf1, 0xcd, 0xfd, 0x4b, 0x1d, 0x1a, 0x92, 0x4b, 0xe6, 0xf5, 0x88, 0x06, 0x07, 0x86, 0x1f,
-	0xb0, 0x90, 0xe1, 0xaa, 0xd0, 0x1a, 0xb6, 0xe3, 0x1b, 0xa0, 0xd5, 0xea, 0x36, 0xe3, 0x7d, 0xc6,
-	0x4d, 0x12, 0x85, 0xd7, 0x06, 0x2e, 0xe2, 0x21, 0xf6, 0xd0, 0x5e, 0x04, 0x7d, 0x87, 0x70, 0x1a,
-	0x87, 0x1a, 0x58, 0xf9, 0xa4, 0xeb, 0x7a, 0x24, 0x74, 0x99, 0x07, 0xb6, 0xf5, 0xac, 0x6d, 0x62,
-	0x65, 0x33, 0x37, 0xd1, 0x2f, 0xc6, 0xfa, 0xb6, 0x7c, 0x32, 0xe3, 0x07, 0x50, 0xd5, 0xba, 0xac,
-	0xcb, 0x62, 0xb9, 0xf8, 0x07, 0xd2, 0xa5, 0x2e, 0x63, 0xdd, 0x1e, 0x35, 0x89, 0xef, 0x9a, 0xc4,
-	0xf3, 0x58, 0x28, 0xd1, 0x12, 0x9f, 0x06, 0x68, 0xe5, 0x53, 0x27, 0xda, 0x33, 0x43, 0xb7, 0x4f,
-	0x79, 0x48, 0xfa, 0x3e, 0x18, 0x68, 0xb9, 0x5a, 0x88, 0xcc, 0x81, 0x6b, 0x4e, 0xd7, 0xa5, 0x1e,
-	0xe5, 0x2e, 0x04, 0xd7, 0x6b, 0x08, 0x7f, 0x20, 0xb2, 0xdd, 0x21, 0x01, 0xe9, 0x73, 0x8b, 0x5e,
-	0x8f, 0x28, 0x0f, 0xf5, 0x4f, 0xd0, 0xd3, 0x43, 0x52, 0xee, 0x33, 0x8f, 0x53, 0xfc, 0x1a, 0x9a,
-	0xf1, 0xa5, 0x44, 0x55, 0x9a, 0xca, 0xea, 0xdc, 0xba, 0x6a, 0x8c, 0xd6, 0xd9, 0x88, 0x3d, 0x5a,
-	0xa5, 0x7b, 0x0f, 0x1a, 0x05, 0x0b, 0xac, 0xdf, 0x2a, 0x7f, 0x7f, 0xb7, 0x51, 0xf8, 0xff, 0x6e,
-	0xa3, 0xa0, 0x2f, 0xa0, 0x9a, 0x0c, 0xbc, 0x61, 0xdb, 0x2c, 0xf2, 0xc2, 0x01, 0xe0, 0x67, 0xe8,
-	0x99, 0x11, 0x39, 0x40, 0x6e, 0xa1, 0x32, 0x01, 0x99, 0xaa, 0x34, 0xa7, 0x56, 0xe7, 0xd6, 0x75,
-	0x03, 0x2a, 0x2a, 0xdf, 0x5e, 0x82, 0xfb, 0x3e, 0x73, 0xa2, 0x1e, 0x05, 0x77, 0x80, 0x1f, 0x78,
-	0xea, 0x5f, 0xa0, 0x8a, 0x0c, 0xbf, 0xe9, 0xf8, 0x80, 0x88, 0x57, 0x50, 0xc5, 0x66, 0xbd, 0x1e,
-	0x09, 0x69, 0x40, 0x7a, 0xed, 0xf0, 0xc0, 0xa7, 0x32, 0xa9, 0x59, 0x6b, 0x3e, 0x15, 0xef, 0x1e,
-	0xf8, 0x14, 0x1b, 0x68, 0x9a, 0xdd, 0xf0, 0x68, 0xa0, 0x16, 0x85, 0xba, 0xa5, 0xfe, 0xf1, 0xfb,
-	0x5a, 0x0d, 0x18, 0x6c, 0x38, 0x4e, 0x40, 0x39, 0xff, 0x30, 0x0c, 0x5c, 0xaf, 0x6b, 0xc5, 0x66,
-	0xfa, 0x55, 0x54, 0x4d, 0xb1, 0x20, 0x8b, 0x57, 0xd1, 0x94, 0xed, 0xf8, 0x50, 0xb5, 0xe7, 0xf2,
-	0x55, 0xdb, 0xdc, 0xda, 0x49, 0x6c, 0x81, 0xbb, 0xb0, 0xd7, 0xff, 0x55, 0xd2, 0x58, 0xfc, 0x49,
-	0x13, 0xc7, 0x0b, 0xa8, 0xe8, 0x3a, 0xea, 0x54, 0x53, 0x59, 0x2d, 0xb5, 0x66, 0x0e, 0x1f, 0x34,
-	0x8a, 0x57, 0xb7, 0xac, 0xa2, 0xeb, 0xe0, 0x1a, 0x9a, 0x0e, 0x44, 0x43, 0xaa, 0x25, 0x09, 0x13,
-	0x3f, 0xe0, 0x6d, 0x84, 0xd2, 0xc1, 0x50, 0xa7, 0x65, 0x66, 0x17, 0x93, 0x57, 0x23, 0x26, 0xc3,
-	0x88, 0x07, 0x32, 0x6d, 0x8c, 0x2e, 0x85, 0x14, 0xac, 0x8c, 0xa7, 0xfe, 0xab, 0x82, 0x9e, 0xca,
-	0xe4, 0x08, 0x05, 0xbb, 0x82, 0x4a, 0xb6, 0xe3, 0x27, 0xaf, 0xfc, 0x84, 0x8a, 0xd5, 0x44, 0xc5,
-	0x7e, 0x7b, 0xd8, 0x38, 0x9b, 0x11, 0x72, 0x4b, 0x06, 0xc0, 0x57, 0x86, 0x68, 0x16, 0x25, 0xcd,
-	0x95, 0x13, 0x69, 0xc6, 0x31, 0x86, 0x78, 0x32, 0xe8, 0xdc, 0x2d, 0xea, 0x33, 0xee, 0x86, 0x4f,
-	0xfc, 0x75, 0xe8, 0x9f, 0xc3, 0x48, 0xa4, 0x80, 0x83, 0xda, 0x94, 0x1d, 0x90, 0x41, 0x7d, 0x16,
-	0xf3, 0xf5, 0x01, 0xaf, 0x56, 0x15, 0x6a, 0x53, 0x1e, 0x84, 0x19, 0x38, 0xeb, 0xef, 0x22, 0x4d,
-	0x22, 0xec, 0xb2, 0x90, 0xf4, 0x76, 0x02, 0xd7, 0xb3, 0x5d, 0x9f, 0xf4, 0x1e, 0x37, 0x31, 0xfd,
-	0x5b, 0x05, 0x3d, 0x3b, 0x36, 0x0e, 0xf0, 0xed, 0xa0, 0x4a, 0x28, 0x34, 0x6d, 0x3f, 0x51, 0x01,
-	0xed, 0x66, 0x9e, 0xf6, 0x70, 0x88, 0xd6, 0x39, 0x60, 0x5f, 0x19, 0x96, 0x73, 0x6b, 0x3e, 0x1c,
-	0x12, 0xe8, 0xdb, 0x59, 0x0a, 0x9b, 0x03, 0x7e, 0x8f, 0x9d, 0xcb, 0x6d, 0x05, 0x2d, 0x8d, 0x0f,
-	0x04, 0xc9, 0xec, 0xa1, 0x6a, 0x9c, 0x4c, 0xea, 0x08, 0xd9, 0x2c, 0x4f, 0xc8, 0x26, 0x0d, 0xd2,
-	0x52, 0x21, 0x9d, 0xea, 0x88, 0x82, 0x5b, 0x71, 0x85, 0x52, 0x89, 0xfe, 0x43, 0x09, 0xcd, 0x65,
-	0xda, 0x19, 0x86, 0x53, 0x19, 0x37, 0x9c, 0x99, 0xae, 0x4a, 0x46, 0x19, 0xa3, 0x92, 0x4c, 0x72,
-	0x4a, 0x0a, 0xe5, 0x7f, 0x7c, 0x19, 0xa1, 0x0c, 0xe7, 0x92, 0x9c, 0x84, 0xc5, 0xa1, 0x49, 0x18,
-	0xcc, 0x16, 0x73, 0x3d, 0x58, 0x43, 0x19, 0x17, 0xfc, 0x0e, 0x9a, 0x4d, 0xdf, 0xe0, 0xf4, 0xe9,
-	0xfc, 0x53, 0x0f, 0xfc, 0x1e, 0xaa, 0x12, 0xdb, 0x8e, 0xfa, 0x91, 0x88, 0xe7, 0xb4, 0xf7, 0x28,
-	0xe5, 0xea, 0xcc, 0xe9, 0xa2, 0x54, 0x32, 0x8e, 0xdb, 0x94, 0x8a, 0xa9, 0x3e, 0x2b, 0xfc, 0xdb,
-	0x91, 0xef, 0x08, 0x99, 0x7a, 0x46, 0xc6, 0xd1, 0x8c, 0xf8, 0x52, 0x1a, 0xc9, 0xa5, 0x34, 0x76,
-	0x93, 0x4b, 0xd9, 0x2a, 0x8b, 0x40, 0x77, 0x1e, 0x36, 0x14, 0x6b, 0x4e, 0x78, 0x7e, 0x14, 0x3b,
-	0x8a, 0xc6, 0x70, 0xbd, 0x90, 0x06, 0x94, 0x87, 0xed, 0x3d, 0x62, 0x87, 0x2c, 0x50, 0xcb, 0x71,
-	0x63, 0x24, 0xe2, 0x6d, 0x29, 0x15, 0xec, 0x33, 0x1d, 0xb4, 0x4f, 0x7a, 0x11, 0x55, 0x67, 0x4f,
-	0xc9, 0x3e, 0x75, 0xfc, 0x58, 0xf8, 0xe1, 0xd7, 0xd1, 0xb9, 0x54, 0xe4, 0x7e, 0x2d, 0xf7, 0x4b,
-	0x3b, 0x5e, 0xb1, 0x48, 0x82, 0x2f, 0xe4, 0xd4, 0x96, 0xf8, 0x5d, 0xff, 0xeb, 0x0c, 0x9a, 0x96,
-	0xdd, 0x89, 0x6f, 0xa0, 0x99, 0xf8, 0xd2, 0xe2, 0xe7, 0xf3, 0x6d, 0x97, 0x3f, 0xe8, 0xda, 0x85,
-	0x13, 0xac, 0xe2, 0x2e, 0xd3, 0x9b, 0xdf, 0xfd, 0xf9, 0xdf, 0x8f, 0x45, 0x0d, 0xab, 0x66, 0xee,
-	0xb3, 0x21, 0x3e, 0xe5, 0xf8, 0x1b, 0x54, 0x4e, 0x6e, 0x34, 0xbe, 0x38, 0x21, 0xe8, 0xc8, 0x71,
-	0xd7, 0x56, 0x4e, 0xb4, 0x03, 0x78, 0x5d, 0xc2, 0x2f, 0x61, 0x2d, 0x0f, 0x9f, 0x9c, 0x72, 0xfc,
-	0x93, 0x82, 0xe6, 0x87, 0xb7, 0x01, 0x7e, 0x69, 0x42, 0xfc, 0xb1, 0x7b, 0x4d, 0x5b, 0x3b, 0xa5,
-	0x35, 0x70, 0x5a, 0x95, 0x9c, 0x74, 0xdc, 0xcc, 0x73, 0x1a, 0xde, 0x41, 0xf8, 0x67, 0x05, 0x55,
-	0x46, 0x06, 0x1b, 0x1f, 0x0b, 0x96, 0xdb, 0x53, 0x9a, 0x71, 0x5a, 0x73, 0x20, 0xf7, 0x82, 0x24,
-	0x77, 0x1e, 0x2f, 0x4f, 0x20, 0x97, 0x61, 0xc2, 0x50, 0x49, 0x5c, 0x58, 0xac, 0x4f, 0x80, 0xc8,
-	0x7c, 0x62, 0x68, 0xe7, 0x8f, 0xb5, 0x01, 0xec, 0xba, 0xc4, 0x56, 0xf1, 0x82, 0x39, 0xee, 0xf3,
-	0x93, 0xe3, 0xdb, 0x0a, 0x9a, 0xda, 0x74, 0x7c, 0xbc, 0x3c, 0x39, 0x58, 0x82, 0xa7, 0x1f, 0x67,
-	0x02, 0x70, 0x6f, 0x48, 0xb8, 0x75, 0xfc, 0xf2, 0x78, 0x38, 0xf3, 0xa6, 0xdc, 0x7c, 0xb7, 0xcc,
-	0x9b, 0x23, 0x8b, 0xfe, 0x16, 0xfe, 0x45, 0x41, 0x83, 0xeb, 0x37, 0xb1, 0x67, 0x47, 0xce, 0xfa,
-	0xc4, 0x9e, 0x1d, 0xbd, 0xc6, 0xfa, 0x86, 0xe4, 0xf5, 0x36, 0x7e, 0x73, 0x02, 0xaf, 0xe4, 0xda,
-	0x4e, 0x26, 0xd8, 0xba, 0x7c, 0xef, 0xb0, 0xae, 0xdc, 0x3f, 0xac, 0x2b, 0xff, 0x1c, 0xd6, 0x95,
-	0x3b, 0x47, 0xf5, 0xc2, 0xfd, 0xa3, 0x7a, 0xe1, 0xef, 0xa3, 0x7a, 0xe1, 0xd3, 0x0b, 0x5d, 0x37,
-	0xbc, 0x16, 0x75, 0x0c, 0x9b, 0xf5, 0x65, 0xf8, 0xb5, 0x1e, 0xe9, 0xf0, 0x18, 0xe8, 0x2b, 0x09,
-	0x25, 0x02, 0xf0, 0xce, 0x8c, 0x5c, 0x78, 0xaf, 0x3c, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x73,
-	0xf8, 0x07, 0x15, 0x0d, 0x00, 0x00,
+func (m *QueryPsmReservesResponse) Reset()         { *m = QueryPsmReservesResponse{} }
+func (m *QueryPsmReservesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPsmReservesResponse) ProtoMessage()    {}
+func (*QueryPsmReservesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{19}
+}
+func (m *QueryPsmReservesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPsmReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPsmReservesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPsmReservesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPsmReservesResponse.Merge(m, src)
+}
+func (m *QueryPsmReservesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPsmReservesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPsmReservesResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPsmReservesResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries all parameters of the cdp module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Accounts queries the CDP module accounts.
-	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
-	// TotalPrincipal queries the total principal of a given collateral type.
-	TotalPrincipal(ctx context.Context, in *QueryTotalPrincipalRequest, opts ...grpc.CallOption) (*QueryTotalPrincipalResponse, error)
-	// TotalCollateral queries the total collateral of a given collateral type.
-	TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error)
-	// Cdps queries all active CDPs.
-	Cdps(ctx context.Context, in *QueryCdpsRequest, opts ...grpc.CallOption) (*QueryCdpsResponse, error)
-	// Cdp queries a CDP with the input owner address and collateral type.
-	Cdp(ctx context.Context, in *QueryCdpRequest, opts ...grpc.CallOption) (*QueryCdpResponse, error)
-	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
-	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+func (m *QueryPsmReservesResponse) GetReserves() []PsmReserveResponse {
+	if m != nil {
+		return m.Reserves
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// PsmReserveResponse defines the reserve of a stablecoin accepted by the peg stability module.
+type PsmReserveResponse struct {
+	Reserve types1.Coin `protobuf:"bytes,1,opt,name=reserve,proto3" json:"reserve"`
+	// minted is the stable asset backed by the reserve
+	Minted    types1.Coin `protobuf:"bytes,2,opt,name=minted,proto3" json:"minted"`
+	DebtLimit types1.Coin `protobuf:"bytes,3,opt,name=debt_limit,json=debtLimit,proto3" json:"debt_limit"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *PsmReserveResponse) Reset()         { *m = PsmReserveResponse{} }
+func (m *PsmReserveResponse) String() string { return proto.CompactTextString(m) }
+func (*PsmReserveResponse) ProtoMessage()    {}
+func (*PsmReserveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{20}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PsmReserveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PsmReserveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PsmReserveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PsmReserveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PsmReserveResponse.Merge(m, src)
+}
+func (m *PsmReserveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PsmReserveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PsmReserveResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
-	out := new(QueryAccountsResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/Accounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PsmReserveResponse proto.InternalMessageInfo
+
+func (m *PsmReserveResponse) GetReserve() types1.Coin {
+	if m != nil {
+		return m.Reserve
 	}
-	return out, nil
+	return types1.Coin{}
 }
 
-func (c *queryClient) TotalPrincipal(ctx context.Context, in *QueryTotalPrincipalRequest, opts ...grpc.CallOption) (*QueryTotalPrincipalResponse, error) {
-	out := new(QueryTotalPrincipalResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/TotalPrincipal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PsmReserveResponse) GetMinted() types1.Coin {
+	if m != nil {
+		return m.Minted
 	}
-	return out, nil
+	return types1.Coin{}
 }
 
-func (c *queryClient) TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error) {
-	out := new(QueryTotalCollateralResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/TotalCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PsmReserveResponse) GetDebtLimit() types1.Coin {
+	if m != nil {
+		return m.DebtLimit
 	}
-	return out, nil
+	return types1.Coin{}
 }
 
-func (c *queryClient) Cdps(ctx context.Context, in *QueryCdpsRequest, opts ...grpc.CallOption) (*QueryCdpsResponse, error) {
-	out := new(QueryCdpsResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/Cdps", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// StabilityFeeResponse defines the effective stability fee of a collateral type.
+type StabilityFeeResponse struct {
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// stability_fee is the per second fee rate currently charged
+	StabilityFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=stability_fee,json=stabilityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stability_fee"`
+	// utilization is the total principal divided by the debt limit
+	Utilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=utilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilization"`
+	// model_active is true when the fee is evaluated from the collateral type's stability fee model
+	ModelActive bool `protobuf:"varint,4,opt,name=model_active,json=modelActive,proto3" json:"model_active,omitempty"`
 }
 
-func (c *queryClient) Cdp(ctx context.Context, in *QueryCdpRequest, opts ...grpc.CallOption) (*QueryCdpResponse, error) {
-	out := new(QueryCdpResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/Cdp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StabilityFeeResponse) Reset()         { *m = StabilityFeeResponse{} }
+func (m *StabilityFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*StabilityFeeResponse) ProtoMessage()    {}
+func (*StabilityFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{21}
+}
+func (m *StabilityFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StabilityFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StabilityFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *StabilityFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StabilityFeeResponse.Merge(m, src)
+}
+func (m *StabilityFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StabilityFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StabilityFeeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
-	out := new(QueryDepositsResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/Deposits", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StabilityFeeResponse proto.InternalMessageInfo
+
+func (m *StabilityFeeResponse) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the cdp module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Accounts queries the CDP module accounts.
-	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
-	// TotalPrincipal queries the total principal of a given collateral type.
-	TotalPrincipal(context.Context, *QueryTotalPrincipalRequest) (*QueryTotalPrincipalResponse, error)
-	// TotalCollateral queries the total collateral of a given collateral type.
-	TotalCollateral(context.Context, *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error)
-	// Cdps queries all active CDPs.
-	Cdps(context.Context, *QueryCdpsRequest) (*QueryCdpsResponse, error)
-	// Cdp queries a CDP with the input owner address and collateral type.
-	Cdp(context.Context, *QueryCdpRequest) (*QueryCdpResponse, error)
-	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
-	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+func (m *StabilityFeeResponse) GetModelActive() bool {
+	if m != nil {
+		return m.ModelActive
+	}
+	return false
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryCdpRiskRequest defines the request type for the Query/CdpRisk RPC method.
+type QueryCdpRiskRequest struct {
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Owner          string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,3,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*QueryAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+func (m *QueryCdpRiskRequest) Reset()         { *m = QueryCdpRiskRequest{} }
+func (m *QueryCdpRiskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCdpRiskRequest) ProtoMessage()    {}
+func (*QueryCdpRiskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{22}
 }
-func (*UnimplementedQueryServer) TotalPrincipal(ctx context.Context, req *QueryTotalPrincipalRequest) (*QueryTotalPrincipalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalPrincipal not implemented")
+func (m *QueryCdpRiskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TotalCollateral(ctx context.Context, req *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalCollateral not implemented")
+func (m *QueryCdpRiskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCdpRiskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Cdps(ctx context.Context, req *QueryCdpsRequest) (*QueryCdpsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cdps not implemented")
+func (m *QueryCdpRiskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCdpRiskRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Cdp(ctx context.Context, req *QueryCdpRequest) (*QueryCdpResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cdp not implemented")
+func (m *QueryCdpRiskRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+func (m *QueryCdpRiskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCdpRiskRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryCdpRiskRequest proto.InternalMessageInfo
+
+func (m *QueryCdpRiskRequest) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
+	}
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryCdpRiskRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return ""
+}
+
+func (m *QueryCdpRiskRequest) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Query/Params",
+	return 0
+}
+
+// QueryCdpRiskResponse defines the response type for the Query/CdpRisk RPC method.
+type QueryCdpRiskResponse struct {
+	CdpRisk CdpRiskResponse `protobuf:"bytes,1,opt,name=cdp_risk,json=cdpRisk,proto3" json:"cdp_risk"`
+}
+
+func (m *QueryCdpRiskResponse) Reset()         { *m = QueryCdpRiskResponse{} }
+func (m *QueryCdpRiskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCdpRiskResponse) ProtoMessage()    {}
+func (*QueryCdpRiskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{23}
+}
+func (m *QueryCdpRiskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCdpRiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCdpRiskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+}
+func (m *QueryCdpRiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCdpRiskResponse.Merge(m, src)
+}
+func (m *QueryCdpRiskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCdpRiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCdpRiskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCdpRiskResponse proto.InternalMessageInfo
+
+func (m *QueryCdpRiskResponse) GetCdpRisk() CdpRiskResponse {
+	if m != nil {
+		return m.CdpRisk
 	}
-	return interceptor(ctx, in, info, handler)
+	return CdpRiskResponse{}
 }
 
-func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryRiskyCdpsRequest defines the request type for the Query/RiskyCdps RPC method.
+type QueryRiskyCdpsRequest struct {
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// ratio is the collateralization ratio below which cdps are returned, defaults to the liquidation ratio
+	Ratio      string             `protobuf:"bytes,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRiskyCdpsRequest) Reset()         { *m = QueryRiskyCdpsRequest{} }
+func (m *QueryRiskyCdpsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRiskyCdpsRequest) ProtoMessage()    {}
+func (*QueryRiskyCdpsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{24}
+}
+func (m *QueryRiskyCdpsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRiskyCdpsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRiskyCdpsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Accounts(ctx, in)
+}
+func (m *QueryRiskyCdpsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRiskyCdpsRequest.Merge(m, src)
+}
+func (m *QueryRiskyCdpsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRiskyCdpsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRiskyCdpsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRiskyCdpsRequest proto.InternalMessageInfo
+
+func (m *QueryRiskyCdpsRequest) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Query/Accounts",
+	return ""
+}
+
+func (m *QueryRiskyCdpsRequest) GetRatio() string {
+	if m != nil {
+		return m.Ratio
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
+	return ""
+}
+
+func (m *QueryRiskyCdpsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalPrincipal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalPrincipalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryRiskyCdpsResponse defines the response type for the Query/RiskyCdps RPC method.
+type QueryRiskyCdpsResponse struct {
+	CdpRisks   CdpRiskResponses    `protobuf:"bytes,1,rep,name=cdp_risks,json=cdpRisks,proto3,castrepeated=CdpRiskResponses" json:"cdp_risks"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRiskyCdpsResponse) Reset()         { *m = QueryRiskyCdpsResponse{} }
+func (m *QueryRiskyCdpsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRiskyCdpsResponse) ProtoMessage()    {}
+func (*QueryRiskyCdpsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{25}
+}
+func (m *QueryRiskyCdpsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRiskyCdpsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRiskyCdpsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalPrincipal(ctx, in)
+}
+func (m *QueryRiskyCdpsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRiskyCdpsResponse.Merge(m, src)
+}
+func (m *QueryRiskyCdpsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRiskyCdpsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRiskyCdpsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRiskyCdpsResponse proto.InternalMessageInfo
+
+func (m *QueryRiskyCdpsResponse) GetCdpRisks() CdpRiskResponses {
+	if m != nil {
+		return m.CdpRisks
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Query/TotalPrincipal",
+	return nil
+}
+
+func (m *QueryRiskyCdpsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalPrincipal(ctx, req.(*QueryTotalPrincipalRequest))
+	return nil
+}
+
+// CdpRiskResponse defines the liquidation risk of a cdp at current liquidation prices.
+type CdpRiskResponse struct {
+	CdpID uint64 `protobuf:"varint,1,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Type  string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// accrued_fees are the fees accrued since the cdp's fees were last synchronized
+	AccruedFees types1.Coin `protobuf:"bytes,4,opt,name=accrued_fees,json=accruedFees,proto3" json:"accrued_fees"`
+	// total_debt is the principal plus accumulated and accrued fees
+	TotalDebt              types1.Coin                            `protobuf:"bytes,5,opt,name=total_debt,json=totalDebt,proto3" json:"total_debt"`
+	CollateralizationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=collateralization_ratio,json=collateralizationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"collateralization_ratio"`
+	LiquidationRatio       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=liquidation_ratio,json=liquidationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_ratio"`
+	// price is the current liquidation price of the collateral
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// liquidation_price is the collateral price at or below which the cdp can be liquidated, with any basket collateral valued at current prices
+	LiquidationPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=liquidation_price,json=liquidationPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_price"`
+	// distance_to_liquidation is the fraction the collateral price can fall before the cdp can be liquidated, zero or negative if it can be liquidated
+	DistanceToLiquidation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=distance_to_liquidation,json=distanceToLiquidation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"distance_to_liquidation"`
+	Liquidatable          bool                                   `protobuf:"varint,11,opt,name=liquidatable,proto3" json:"liquidatable,omitempty"`
+	// partial_liquidation is true if liquidating the cdp would only seize part of its collateral
+	PartialLiquidation bool `protobuf:"varint,12,opt,name=partial_liquidation,json=partialLiquidation,proto3" json:"partial_liquidation,omitempty"`
+	// collateral_seized is the collateral liquidating the cdp would seize, zero if it cannot be liquidated
+	CollateralSeized types1.Coin       `protobuf:"bytes,13,opt,name=collateral_seized,json=collateralSeized,proto3" json:"collateral_seized"`
+	BasketSeized     BasketCollaterals `protobuf:"bytes,14,rep,name=basket_seized,json=basketSeized,proto3,castrepeated=BasketCollaterals" json:"basket_seized"`
+	// debt_covered is the debt the seized collateral is auctioned to cover, zero if the cdp cannot be liquidated
+	DebtCovered types1.Coin `protobuf:"bytes,15,opt,name=debt_covered,json=debtCovered,proto3" json:"debt_covered"`
+}
+
+func (m *CdpRiskResponse) Reset()         { *m = CdpRiskResponse{} }
+func (m *CdpRiskResponse) String() string { return proto.CompactTextString(m) }
+func (*CdpRiskResponse) ProtoMessage()    {}
+func (*CdpRiskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{26}
+}
+func (m *CdpRiskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CdpRiskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CdpRiskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CdpRiskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CdpRiskResponse.Merge(m, src)
+}
+func (m *CdpRiskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CdpRiskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CdpRiskResponse.DiscardUnknown(m)
 }
 
-func _Query_TotalCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalCollateralRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_CdpRiskResponse proto.InternalMessageInfo
+
+func (m *CdpRiskResponse) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalCollateral(ctx, in)
+	return 0
+}
+
+func (m *CdpRiskResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Query/TotalCollateral",
+	return ""
+}
+
+func (m *CdpRiskResponse) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalCollateral(ctx, req.(*QueryTotalCollateralRequest))
+	return ""
+}
+
+func (m *CdpRiskResponse) GetAccruedFees() types1.Coin {
+	if m != nil {
+		return m.AccruedFees
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Query_Cdps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCdpsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Cdps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Query/Cdps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Cdps(ctx, req.(*QueryCdpsRequest))
+func (m *CdpRiskResponse) GetTotalDebt() types1.Coin {
+	if m != nil {
+		return m.TotalDebt
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Query_Cdp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCdpRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Cdp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Query/Cdp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Cdp(ctx, req.(*QueryCdpRequest))
+func (m *CdpRiskResponse) GetLiquidatable() bool {
+	if m != nil {
+		return m.Liquidatable
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Query/Deposits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+func (m *CdpRiskResponse) GetPartialLiquidation() bool {
+	if m != nil {
+		return m.PartialLiquidation
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "aeth.cdp.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Accounts",
-			Handler:    _Query_Accounts_Handler,
-		},
-		{
-			MethodName: "TotalPrincipal",
-			Handler:    _Query_TotalPrincipal_Handler,
-		},
-		{
-			MethodName: "TotalCollateral",
-			Handler:    _Query_TotalCollateral_Handler,
-		},
-		{
-			MethodName: "Cdps",
-			Handler:    _Query_Cdps_Handler,
-		},
-		{
-			MethodName: "Cdp",
-			Handler:    _Query_Cdp_Handler,
-		},
-		{
-			MethodName: "Deposits",
-			Handler:    _Query_Deposits_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "aeth/cdp/v1beta1/query.proto",
+func (m *CdpRiskResponse) GetCollateralSeized() types1.Coin {
+	if m != nil {
+		return m.CollateralSeized
+	}
+	return types1.Coin{}
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CdpRiskResponse) GetBasketSeized() BasketCollaterals {
+	if m != nil {
+		return m.BasketSeized
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CdpRiskResponse) GetDebtCovered() types1.Coin {
+	if m != nil {
+		return m.DebtCovered
+	}
+	return types1.Coin{}
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// CDPResponse defines the state of a single collateralized debt position.
+type CDPResponse struct {
+	ID                     uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner                  string            `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Type                   string            `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Collateral             types1.Coin       `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral"`
+	Principal              types1.Coin       `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal"`
+	AccumulatedFees        types1.Coin       `protobuf:"bytes,6,opt,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees"`
+	FeesUpdated            time.Time         `protobuf:"bytes,7,opt,name=fees_updated,json=feesUpdated,proto3,stdtime" json:"fees_updated"`
+	InterestFactor         string            `protobuf:"bytes,8,opt,name=interest_factor,json=interestFactor,proto3" json:"interest_factor,omitempty"`
+	CollateralValue        types1.Coin       `protobuf:"bytes,9,opt,name=collateral_value,json=collateralValue,proto3" json:"collateral_value"`
+	CollateralizationRatio string            `protobuf:"bytes,10,opt,name=collateralization_ratio,json=collateralizationRatio,proto3" json:"collateralization_ratio,omitempty"`
+	Managers               []string          `protobuf:"bytes,11,rep,name=managers,proto3" json:"managers,omitempty"`
+	Basket                 BasketCollaterals `protobuf:"bytes,12,rep,name=basket,proto3,castrepeated=BasketCollaterals" json:"basket"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CDPResponse) Reset()         { *m = CDPResponse{} }
+func (m *CDPResponse) String() string { return proto.CompactTextString(m) }
+func (*CDPResponse) ProtoMessage()    {}
+func (*CDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28283e7bcd84247a, []int{27}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *CDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *CDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CDPResponse.Merge(m, src)
+}
+func (m *CDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CDPResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CDPResponse proto.InternalMessageInfo
+
+func (m *CDPResponse) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CDPResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *QueryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *CDPResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *QueryAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CDPResponse) GetCollateral() types1.Coin {
+	if m != nil {
+		return m.Collateral
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *QueryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CDPResponse) GetPrincipal() types1.Coin {
+	if m != nil {
+		return m.Principal
+	}
+	return types1.Coin{}
 }
 
-func (m *QueryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *CDPResponse) GetAccumulatedFees() types1.Coin {
+	if m != nil {
+		return m.AccumulatedFees
 	}
-	return len(dAtA) - i, nil
+	return types1.Coin{}
 }
 
-func (m *QueryCdpRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CDPResponse) GetFeesUpdated() time.Time {
+	if m != nil {
+		return m.FeesUpdated
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *QueryCdpRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CDPResponse) GetInterestFactor() string {
+	if m != nil {
+		return m.InterestFactor
+	}
+	return ""
 }
 
-func (m *QueryCdpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
+func (m *CDPResponse) GetCollateralValue() types1.Coin {
+	if m != nil {
+		return m.CollateralValue
 	}
-	return len(dAtA) - i, nil
+	return types1.Coin{}
 }
 
-func (m *QueryCdpResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CDPResponse) GetCollateralizationRatio() string {
+	if m != nil {
+		return m.CollateralizationRatio
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCdpResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CDPResponse) GetManagers() []string {
+	if m != nil {
+		return m.Managers
+	}
+	return nil
 }
 
-func (m *QueryCdpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Cdp.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *CDPResponse) GetBasket() BasketCollaterals {
+	if m != nil {
+		return m.Basket
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryCdpsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "aeth.cdp.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "aeth.cdp.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryAccountsRequest)(nil), "aeth.cdp.v1beta1.QueryAccountsRequest")
+	proto.RegisterType((*QueryAccountsResponse)(nil), "aeth.cdp.v1beta1.QueryAccountsResponse")
+	proto.RegisterType((*QueryCdpRequest)(nil), "aeth.cdp.v1beta1.QueryCdpRequest")
+	proto.RegisterType((*QueryCdpResponse)(nil), "aeth.cdp.v1beta1.QueryCdpResponse")
+	proto.RegisterType((*QueryCdpsRequest)(nil), "aeth.cdp.v1beta1.QueryCdpsRequest")
+	proto.RegisterType((*QueryCdpsResponse)(nil), "aeth.cdp.v1beta1.QueryCdpsResponse")
+	proto.RegisterType((*QueryDepositsRequest)(nil), "aeth.cdp.v1beta1.QueryDepositsRequest")
+	proto.RegisterType((*QueryDepositsResponse)(nil), "aeth.cdp.v1beta1.QueryDepositsResponse")
+	proto.RegisterType((*QueryTotalPrincipalRequest)(nil), "aeth.cdp.v1beta1.QueryTotalPrincipalRequest")
+	proto.RegisterType((*QueryTotalPrincipalResponse)(nil), "aeth.cdp.v1beta1.QueryTotalPrincipalResponse")
+	proto.RegisterType((*QueryTotalCollateralRequest)(nil), "aeth.cdp.v1beta1.QueryTotalCollateralRequest")
+	proto.RegisterType((*QueryTotalCollateralResponse)(nil), "aeth.cdp.v1beta1.QueryTotalCollateralResponse")
+	proto.RegisterType((*QueryStabilityFeeRequest)(nil), "aeth.cdp.v1beta1.QueryStabilityFeeRequest")
+	proto.RegisterType((*QueryStabilityFeeResponse)(nil), "aeth.cdp.v1beta1.QueryStabilityFeeResponse")
+	proto.RegisterType((*QueryGlobalSettlementRequest)(nil), "aeth.cdp.v1beta1.QueryGlobalSettlementRequest")
+	proto.RegisterType((*QueryGlobalSettlementResponse)(nil), "aeth.cdp.v1beta1.QueryGlobalSettlementResponse")
+	proto.RegisterType((*QueryPsmReservesRequest)(nil), "aeth.cdp.v1beta1.QueryPsmReservesRequest")
+	proto.RegisterType((*QueryPsmReservesResponse)(nil), "aeth.cdp.v1beta1.QueryPsmReservesResponse")
+	proto.RegisterType((*PsmReserveResponse)(nil), "aeth.cdp.v1beta1.PsmReserveResponse")
+	proto.RegisterType((*StabilityFeeResponse)(nil), "aeth.cdp.v1beta1.StabilityFeeResponse")
+	proto.RegisterType((*QueryCdpRiskRequest)(nil), "aeth.cdp.v1beta1.QueryCdpRiskRequest")
+	proto.RegisterType((*QueryCdpRiskResponse)(nil), "aeth.cdp.v1beta1.QueryCdpRiskResponse")
+	proto.RegisterType((*QueryRiskyCdpsRequest)(nil), "aeth.cdp.v1beta1.QueryRiskyCdpsRequest")
+	proto.RegisterType((*QueryRiskyCdpsResponse)(nil), "aeth.cdp.v1beta1.QueryRiskyCdpsResponse")
+	proto.RegisterType((*CdpRiskResponse)(nil), "aeth.cdp.v1beta1.CdpRiskResponse")
+	proto.RegisterType((*CDPResponse)(nil), "aeth.cdp.v1beta1.CDPResponse")
+}
+
+func init() { proto.RegisterFile("aeth/cdp/v1beta1/query.proto", fileDescriptor_28283e7bcd84247a) }
+
+var fileDescriptor_28283e7bcd84247a = []byte{
+	// 1969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5f, 0x6f, 0x1b, 0x59,
+	0x15, 0xcf, 0x24, 0x71, 0x6a, 0x1f, 0x3b, 0xb1, 0x73, 0xeb, 0xa6, 0x93, 0xa1, 0xb5, 0xdd, 0xd9,
+	0x6d, 0x9a, 0xed, 0x12, 0x0f, 0x1b, 0x54, 0xca, 0x02, 0x0b, 0xaa, 0x93, 0x4d, 0x55, 0x54, 0xa4,
+	0x32, 0x69, 0x01, 0xad, 0x04, 0x66, 0x3c, 0x73, 0xeb, 0x8e, 0x32, 0xf6, 0x4c, 0xe7, 0x5e, 0xa7,
+	0x74, 0x57, 0x2b, 0x04, 0x12, 0x2b, 0x24, 0x90, 0x28, 0x42, 0x68, 0x1f, 0x90, 0x96, 0x7d, 0xd9,
+	0x17, 0x9e, 0xf7, 0x8d, 0x47, 0x5e, 0xfa, 0xb8, 0x5a, 0x5e, 0x10, 0x0f, 0x5d, 0x48, 0x79, 0xe0,
+	0x63, 0xa0, 0xb9, 0x73, 0x66, 0x3c, 0x9e, 0xf1, 0x24, 0xee, 0x2a, 0x48, 0xfb, 0x92, 0x78, 0xce,
+	0xdf, 0xdf, 0x39, 0xf7, 0xdc, 0x73, 0xef, 0x3d, 0x70, 0xc1, 0xa0, 0xfc, 0x81, 0x66, 0x5a, 0x9e,
+	0x76, 0xf8, 0x5a, 0x8f, 0x72, 0xe3, 0x35, 0xed, 0xe1, 0x88, 0xfa, 0x8f, 0xdb, 0x9e, 0xef, 0x72,
+	0x97, 0xd4, 0x02, 0x6e, 0xdb, 0xb4, 0xbc, 0x36, 0x72, 0x95, 0x86, 0xe9, 0xb2, 0x81, 0xcb, 0x34,
+	0x63, 0xc4, 0x1f, 0xc4, 0x2a, 0xc1, 0x47, 0xa8, 0xa1, 0x5c, 0x45, 0x7e, 0xcf, 0x60, 0x34, 0x34,
+	0x15, 0x4b, 0x79, 0x46, 0xdf, 0x1e, 0x1a, 0xdc, 0x76, 0x87, 0x28, 0xdb, 0x48, 0xca, 0x46, 0x52,
+	0xa6, 0x6b, 0x47, 0xfc, 0xf5, 0x90, 0xdf, 0x15, 0x5f, 0x5a, 0xf8, 0x81, 0xac, 0x7a, 0xdf, 0xed,
+	0xbb, 0x21, 0x3d, 0xf8, 0x85, 0xd4, 0x0b, 0x7d, 0xd7, 0xed, 0x3b, 0x54, 0x33, 0x3c, 0x5b, 0x33,
+	0x86, 0x43, 0x97, 0x0b, 0x6f, 0x91, 0x4e, 0x13, 0xb9, 0xe2, 0xab, 0x37, 0xba, 0xaf, 0x71, 0x7b,
+	0x40, 0x19, 0x37, 0x06, 0x1e, 0x0a, 0x28, 0x99, 0x5c, 0x98, 0x56, 0xc4, 0x6b, 0x64, 0x78, 0x7d,
+	0x3a, 0xa4, 0xcc, 0x46, 0xe3, 0x6a, 0x1d, 0xc8, 0xf7, 0x83, 0x68, 0xef, 0x18, 0xbe, 0x31, 0x60,
+	0x3a, 0x7d, 0x38, 0xa2, 0x8c, 0xab, 0x3f, 0x84, 0xb3, 0x13, 0x54, 0xe6, 0xb9, 0x43, 0x46, 0xc9,
+	0xd7, 0x60, 0xc9, 0x13, 0x14, 0x59, 0x6a, 0x49, 0x9b, 0xe5, 0x6d, 0xb9, 0x9d, 0xce, 0x73, 0x3b,
+	0xd4, 0xe8, 0x2c, 0x3e, 0x7d, 0xd6, 0x9c, 0xd3, 0x51, 0xfa, 0x1b, 0xc5, 0x5f, 0x7f, 0xd8, 0x9c,
+	0xfb, 0xef, 0x87, 0xcd, 0x39, 0x75, 0x0d, 0xea, 0xc2, 0xf0, 0x0d, 0xd3, 0x74, 0x47, 0x43, 0x1e,
+	0x3b, 0xfc, 0x31, 0x9c, 0x4b, 0xd1, 0xd1, 0xe5, 0x2e, 0x14, 0x0d, 0xa4, 0xc9, 0x52, 0x6b, 0x61,
+	0xb3, 0xbc, 0xad, 0xb6, 0x31, 0xa3, 0x62, 0xf5, 0x22, 0xbf, 0xdf, 0x73, 0xad, 0x91, 0x43, 0x51,
+	0x1d, 0xdd, 0xc7, 0x9a, 0xea, 0x6f, 0x25, 0xa8, 0x0a, 0xfb, 0x3b, 0x96, 0x87, 0x2e, 0xc9, 0x15,
+	0xa8, 0x9a, 0xae, 0xe3, 0x18, 0x9c, 0xfa, 0x86, 0xd3, 0xe5, 0x8f, 0x3d, 0x2a, 0xa2, 0x2a, 0xe9,
+	0x2b, 0x63, 0xf2, 0xdd, 0xc7, 0x1e, 0x25, 0x6d, 0x28, 0xb8, 0x8f, 0x86, 0xd4, 0x97, 0xe7, 0x03,
+	0x76, 0x47, 0xfe, 0xf4, 0xe3, 0xad, 0x3a, 0x42, 0xb8, 0x61, 0x59, 0x3e, 0x65, 0x6c, 0x9f, 0xfb,
+	0xf6, 0xb0, 0xaf, 0x87, 0x62, 0xa4, 0x05, 0x4b, 0xa6, 0xe5, 0x75, 0x6d, 0x4b, 0x5e, 0x68, 0x49,
+	0x9b, 0x8b, 0x9d, 0xd2, 0xd1, 0xb3, 0x66, 0x61, 0xc7, 0xf2, 0x6e, 0xed, 0xea, 0x05, 0xd3, 0xf2,
+	0x6e, 0x59, 0xea, 0x2d, 0xa8, 0x8d, 0xd1, 0x60, 0xa0, 0xd7, 0x60, 0xc1, 0xb4, 0x3c, 0x4c, 0xec,
+	0xc5, 0x6c, 0x62, 0x77, 0x76, 0xef, 0x44, 0xb2, 0x18, 0x5e, 0x20, 0xaf, 0xfe, 0x5b, 0x1a, 0xdb,
+	0x62, 0xff, 0xf7, 0xd0, 0xd6, 0x60, 0x3e, 0x0e, 0x6b, 0xe9, 0xe8, 0x59, 0x73, 0xfe, 0xd6, 0xae,
+	0x3e, 0x6f, 0x5b, 0xa4, 0x0e, 0x05, 0x3f, 0xa8, 0x59, 0x79, 0x51, 0xb8, 0x09, 0x3f, 0xc8, 0x1e,
+	0xc0, 0x78, 0xef, 0xc8, 0x05, 0x11, 0xd9, 0x46, 0xb4, 0x7a, 0xc1, 0xe6, 0x69, 0x87, 0x7b, 0x76,
+	0x5c, 0x3b, 0x7d, 0x8a, 0x21, 0xe8, 0x09, 0x4d, 0xf5, 0x23, 0x09, 0x56, 0x13, 0x31, 0x62, 0xc2,
+	0x6e, 0xc2, 0xa2, 0x69, 0x79, 0x51, 0x55, 0x9c, 0x90, 0xb1, 0x7a, 0x90, 0xb1, 0xbf, 0x7c, 0xd6,
+	0xac, 0x24, 0x88, 0x4c, 0x17, 0x06, 0xc8, 0xcd, 0x09, 0x98, 0xf3, 0x02, 0xe6, 0x95, 0x13, 0x61,
+	0x86, 0x36, 0x26, 0x70, 0xfe, 0x5e, 0xc2, 0xea, 0xde, 0xa5, 0x9e, 0xcb, 0x6c, 0xce, 0xbe, 0x00,
+	0xa5, 0xf6, 0x53, 0x38, 0x97, 0x82, 0x14, 0xa7, 0xaf, 0x68, 0x21, 0x0d, 0x53, 0xb8, 0x9e, 0x4d,
+	0x21, 0x6a, 0x75, 0x6a, 0x98, 0xbe, 0x62, 0x6c, 0x26, 0x56, 0x56, 0xdf, 0x04, 0x45, 0x78, 0xb8,
+	0xeb, 0x72, 0xc3, 0xb9, 0xe3, 0xdb, 0x43, 0xd3, 0xf6, 0x0c, 0xe7, 0x45, 0x43, 0x57, 0x7f, 0x21,
+	0xc1, 0x97, 0xa6, 0xda, 0x41, 0xbc, 0x3d, 0xa8, 0xf2, 0x80, 0xd3, 0xf5, 0x22, 0x16, 0xc2, 0x6e,
+	0x65, 0x61, 0x4f, 0x9a, 0xe8, 0x9c, 0x47, 0xf4, 0xd5, 0x49, 0x3a, 0xd3, 0x57, 0xf8, 0x04, 0x41,
+	0xdd, 0x4b, 0x42, 0xd8, 0x89, 0xf1, 0xbd, 0x70, 0x2c, 0xef, 0x49, 0x70, 0x61, 0xba, 0x21, 0x0c,
+	0xe6, 0x3e, 0xd4, 0xc2, 0x60, 0xc6, 0x8a, 0x18, 0xcd, 0xa5, 0x9c, 0x68, 0xc6, 0x46, 0x3a, 0x32,
+	0x86, 0x53, 0x4b, 0x31, 0x98, 0x5e, 0xe5, 0x93, 0x14, 0x75, 0x07, 0x64, 0x81, 0x63, 0x9f, 0x1b,
+	0x3d, 0xdb, 0xb1, 0xf9, 0xe3, 0x3d, 0x4a, 0x5f, 0x38, 0x1a, 0x0f, 0xd6, 0xa7, 0x18, 0xc1, 0x48,
+	0xf6, 0x61, 0x85, 0x45, 0xf4, 0xee, 0x7d, 0x4a, 0xa3, 0x62, 0xda, 0xc8, 0xc6, 0x31, 0x4d, 0x1f,
+	0x5b, 0xd9, 0x32, 0x4b, 0xf0, 0x98, 0xda, 0xc0, 0xf4, 0xdd, 0x74, 0xdc, 0x9e, 0xe1, 0xec, 0x53,
+	0xce, 0x1d, 0x3a, 0xa0, 0x43, 0x1e, 0x9d, 0x16, 0x87, 0x70, 0x31, 0x87, 0x8f, 0xa8, 0xee, 0xc1,
+	0x6a, 0x5f, 0xf0, 0xba, 0x2c, 0x66, 0x62, 0x6b, 0x55, 0xb3, 0xc0, 0xd2, 0x66, 0x10, 0x54, 0xad,
+	0x9f, 0xa2, 0xab, 0x1a, 0x9c, 0x0f, 0x8f, 0x45, 0x36, 0xd0, 0x29, 0xa3, 0xfe, 0x21, 0x8d, 0xb7,
+	0x78, 0x1d, 0x0a, 0x16, 0x1d, 0xba, 0x03, 0xcc, 0x61, 0xf8, 0xa1, 0xf6, 0x40, 0xce, 0x2a, 0x20,
+	0xc6, 0x3d, 0x28, 0xfa, 0x48, 0xc3, 0x9c, 0xbd, 0x3c, 0xe5, 0x38, 0x8d, 0x15, 0x53, 0x19, 0x8b,
+	0x75, 0xd5, 0xa7, 0x12, 0x90, 0xac, 0x18, 0x79, 0x1d, 0xce, 0xa0, 0x08, 0x06, 0xbe, 0x3e, 0xd1,
+	0xd2, 0xe2, 0x26, 0xe9, 0xda, 0x43, 0x34, 0x19, 0xc9, 0x93, 0xeb, 0xb0, 0x34, 0xb0, 0x87, 0x9c,
+	0x5a, 0xf2, 0xfc, 0x6c, 0x9a, 0x28, 0x4e, 0xbe, 0x0d, 0x60, 0xd1, 0x1e, 0xef, 0x3a, 0xf6, 0xc0,
+	0xe6, 0xf2, 0xc2, 0x6c, 0xca, 0xa5, 0x40, 0xe5, 0x76, 0xa0, 0xa1, 0x7e, 0x34, 0x0f, 0xf5, 0xa9,
+	0x55, 0x36, 0x73, 0x03, 0x35, 0x60, 0x79, 0xa2, 0x1c, 0xb1, 0x91, 0x7e, 0x2b, 0xf0, 0xf4, 0xcf,
+	0x67, 0xcd, 0x8d, 0xbe, 0xcd, 0x1f, 0x8c, 0x7a, 0x6d, 0xd3, 0x1d, 0xe0, 0xbd, 0x0c, 0xff, 0x6d,
+	0x31, 0xeb, 0x40, 0x0b, 0xec, 0xb2, 0xf6, 0x2e, 0x35, 0x3f, 0xfd, 0x78, 0x0b, 0x10, 0xf5, 0x2e,
+	0x35, 0xf5, 0x4a, 0xb2, 0x3a, 0xc9, 0x4f, 0xa0, 0x3c, 0xe2, 0xb6, 0x63, 0xbf, 0x1d, 0x9e, 0x17,
+	0x0b, 0xa7, 0xe0, 0x20, 0x69, 0x90, 0x5c, 0x82, 0xca, 0xc0, 0xb5, 0xa8, 0xd3, 0x35, 0x4c, 0x6e,
+	0x1f, 0x52, 0x71, 0xa4, 0x16, 0xf5, 0xb2, 0xa0, 0xdd, 0x10, 0x24, 0xf5, 0x89, 0x04, 0x67, 0xe3,
+	0x0b, 0x84, 0xcd, 0x0e, 0xbe, 0x00, 0xe7, 0xcc, 0x5b, 0x50, 0x9f, 0x44, 0x84, 0x2b, 0xd7, 0x81,
+	0x62, 0xa0, 0xe9, 0xdb, 0xec, 0x00, 0xeb, 0x70, 0x4a, 0x87, 0x4b, 0x29, 0x45, 0xf5, 0x68, 0x86,
+	0x64, 0xf5, 0x03, 0x09, 0x0f, 0xb1, 0xe0, 0xeb, 0xf3, 0x5d, 0x74, 0xe2, 0x0b, 0xca, 0x7c, 0xfe,
+	0x05, 0x65, 0xe1, 0x73, 0x5f, 0x50, 0xfe, 0x2a, 0xc1, 0x5a, 0x1a, 0x20, 0xc6, 0xff, 0x23, 0x28,
+	0x45, 0xf1, 0xb3, 0xfc, 0x16, 0x9f, 0x4e, 0x40, 0xdc, 0xe2, 0x53, 0x0c, 0xa6, 0x17, 0x31, 0x29,
+	0xa7, 0x78, 0x6d, 0xf9, 0x5b, 0x11, 0xaa, 0xe9, 0x65, 0x1b, 0x2f, 0xb8, 0x34, 0x7d, 0xc1, 0x83,
+	0x8c, 0x26, 0x4a, 0x28, 0x2a, 0x14, 0x02, 0x8b, 0x62, 0x15, 0xc4, 0xae, 0xd0, 0xc5, 0x6f, 0xd2,
+	0x81, 0x8a, 0x61, 0x9a, 0xfe, 0x88, 0x5a, 0xe1, 0x01, 0xb1, 0x38, 0x5b, 0x5f, 0x28, 0xa3, 0x52,
+	0x70, 0x22, 0x04, 0x9d, 0x25, 0x3c, 0x30, 0x83, 0x66, 0x21, 0x17, 0x66, 0xb3, 0x50, 0x12, 0x2a,
+	0xbb, 0xb4, 0xc7, 0xc9, 0x08, 0xce, 0x8f, 0x2b, 0x02, 0x77, 0x5a, 0x37, 0xac, 0x88, 0xa5, 0x53,
+	0xd8, 0xc0, 0x6b, 0x19, 0xe3, 0x7a, 0xf0, 0x97, 0xd8, 0xb0, 0xea, 0xd8, 0x0f, 0x47, 0xb6, 0x95,
+	0x74, 0x78, 0xe6, 0x14, 0x1c, 0xd6, 0x12, 0x66, 0x43, 0x57, 0x3a, 0x14, 0x3c, 0xdf, 0x36, 0xa9,
+	0x5c, 0x3c, 0x05, 0xf3, 0xa1, 0xa9, 0x34, 0xfc, 0xd0, 0x7e, 0xe9, 0x94, 0xe1, 0xdf, 0x11, 0xae,
+	0x38, 0x9c, 0xb7, 0x6c, 0xc6, 0x8d, 0xa1, 0x49, 0xbb, 0xdc, 0xed, 0x26, 0xf8, 0x32, 0x9c, 0x82,
+	0xc3, 0x73, 0x91, 0xf1, 0xbb, 0xee, 0xed, 0xb1, 0x69, 0xa2, 0x42, 0x25, 0xf2, 0x64, 0xf4, 0x1c,
+	0x2a, 0x97, 0x45, 0xaf, 0x9d, 0xa0, 0x11, 0x0d, 0xce, 0x7a, 0x86, 0xcf, 0x6d, 0xc3, 0x99, 0x40,
+	0x55, 0x11, 0xa2, 0x04, 0x59, 0x49, 0xa3, 0xb7, 0x61, 0x35, 0xd1, 0x94, 0x18, 0xb5, 0xdf, 0xa6,
+	0x96, 0xbc, 0x3c, 0x5b, 0xc9, 0xd6, 0xc6, 0x9a, 0xfb, 0x42, 0x91, 0xf4, 0x60, 0xb9, 0x67, 0xb0,
+	0x03, 0xca, 0x23, 0x4b, 0x2b, 0xf8, 0x0a, 0xce, 0x34, 0x91, 0x8e, 0x10, 0x4b, 0x5c, 0x14, 0xd7,
+	0xb1, 0x8b, 0xac, 0xa6, 0x39, 0x4c, 0xaf, 0x84, 0x36, 0xd1, 0x47, 0x07, 0x2a, 0xe2, 0xdc, 0x36,
+	0xdd, 0x43, 0xea, 0x53, 0x4b, 0xae, 0xce, 0xb8, 0x43, 0x03, 0xa5, 0x9d, 0x50, 0x47, 0xfd, 0x55,
+	0x01, 0xca, 0x89, 0xc7, 0x15, 0x3e, 0x15, 0xa5, 0x69, 0x4f, 0xc5, 0x19, 0xfb, 0xc6, 0x77, 0x00,
+	0x12, 0xd7, 0xe3, 0x19, 0xbb, 0x46, 0x42, 0x85, 0xbc, 0x01, 0xa5, 0xf1, 0x63, 0x61, 0xd6, 0x9e,
+	0x11, 0x6b, 0x90, 0xef, 0x42, 0xcd, 0x30, 0xcd, 0xd1, 0x60, 0x14, 0xd8, 0xc3, 0xde, 0xb5, 0x34,
+	0x9b, 0x95, 0x6a, 0x42, 0x51, 0xf4, 0xaf, 0x9b, 0x50, 0x09, 0xf4, 0xbb, 0x23, 0xcf, 0x0a, 0x68,
+	0xa2, 0x07, 0x94, 0xb7, 0x95, 0x76, 0x38, 0xda, 0x69, 0x47, 0xa3, 0x9d, 0xf6, 0xdd, 0x68, 0xb4,
+	0xd3, 0x29, 0x06, 0x86, 0x9e, 0x7c, 0xd6, 0x94, 0xf4, 0x72, 0xa0, 0x79, 0x2f, 0x54, 0x0c, 0x4e,
+	0xbc, 0xe0, 0xae, 0xe5, 0x53, 0xc6, 0xbb, 0xf7, 0x0d, 0x93, 0xbb, 0x7e, 0xb8, 0xe1, 0xf5, 0x95,
+	0x88, 0xbc, 0x27, 0xa8, 0x01, 0xfa, 0x44, 0x15, 0x1e, 0x1a, 0xce, 0x28, 0xdc, 0xba, 0xb3, 0xa0,
+	0x1f, 0x2b, 0xfe, 0x20, 0xd0, 0x23, 0xd7, 0xf3, 0xbb, 0xa7, 0xd8, 0x9c, 0xb9, 0xfd, 0x4f, 0x81,
+	0xe2, 0xc0, 0x18, 0x1a, 0x7d, 0xea, 0x33, 0xb9, 0xdc, 0x5a, 0xd8, 0x2c, 0xe9, 0xf1, 0x37, 0xb9,
+	0x07, 0x4b, 0x61, 0x11, 0xca, 0x95, 0xd3, 0xa8, 0x68, 0x34, 0xb6, 0xfd, 0x74, 0x19, 0x0a, 0xe2,
+	0x2c, 0x26, 0x8f, 0x60, 0x29, 0x9c, 0x46, 0x91, 0x29, 0x17, 0xeb, 0xec, 0xd0, 0x4b, 0xb9, 0x7c,
+	0x82, 0x54, 0x58, 0xd8, 0x6a, 0xeb, 0x97, 0x7f, 0xff, 0xcf, 0x1f, 0xe6, 0x15, 0x22, 0x6b, 0x99,
+	0xd1, 0x5a, 0x38, 0xee, 0x22, 0x3f, 0x87, 0x62, 0x34, 0xc7, 0x22, 0x1b, 0x39, 0x46, 0x53, 0x03,
+	0x30, 0xe5, 0xca, 0x89, 0x72, 0xe8, 0x5e, 0x15, 0xee, 0x2f, 0x10, 0x25, 0xeb, 0x3e, 0x1a, 0x77,
+	0x91, 0xf7, 0x25, 0x58, 0x99, 0x7c, 0xeb, 0x92, 0x2f, 0xe7, 0xd8, 0x9f, 0xfa, 0x6a, 0x57, 0xb6,
+	0x66, 0x94, 0x46, 0x4c, 0x9b, 0x02, 0x93, 0x4a, 0x5a, 0x59, 0x4c, 0x93, 0x2f, 0x6c, 0xf2, 0x27,
+	0x09, 0xaa, 0xa9, 0x67, 0x2b, 0x39, 0xd6, 0x59, 0xe6, 0x15, 0xae, 0xb4, 0x67, 0x15, 0x47, 0x70,
+	0xaf, 0x08, 0x70, 0x2f, 0x91, 0x4b, 0x39, 0xe0, 0x12, 0x48, 0x7e, 0x27, 0x41, 0x25, 0xf9, 0xfe,
+	0x20, 0x57, 0x73, 0x7c, 0x4d, 0x79, 0x4f, 0x2b, 0xaf, 0xce, 0x24, 0x8b, 0xa0, 0x36, 0x04, 0xa8,
+	0x16, 0x69, 0x64, 0x41, 0x4d, 0x3c, 0x36, 0x5c, 0x58, 0x0c, 0xae, 0x93, 0x44, 0xcd, 0x31, 0x9e,
+	0xb8, 0x0c, 0x2b, 0x2f, 0x1d, 0x2b, 0x83, 0x8e, 0x1b, 0xc2, 0xb1, 0x4c, 0xd6, 0xb4, 0x69, 0x43,
+	0x63, 0x46, 0xde, 0x93, 0x60, 0x61, 0xc7, 0xf2, 0xc8, 0xa5, 0x7c, 0x63, 0x91, 0x3f, 0xf5, 0x38,
+	0x11, 0x74, 0xf7, 0x75, 0xe1, 0x6e, 0x9b, 0x7c, 0x65, 0xba, 0x3b, 0xed, 0x1d, 0xd1, 0xfe, 0xdf,
+	0xd5, 0xde, 0x49, 0x5d, 0xe3, 0xdf, 0x25, 0x1f, 0x48, 0x10, 0x4f, 0x9b, 0x72, 0x77, 0x51, 0x6a,
+	0xd0, 0xa6, 0x5c, 0x39, 0x51, 0x0e, 0x71, 0xdd, 0x10, 0xb8, 0xbe, 0x49, 0x5e, 0xcf, 0xc1, 0x15,
+	0x4d, 0xb7, 0x8e, 0x01, 0xf8, 0x67, 0x09, 0x6a, 0xe9, 0xc9, 0x01, 0xc9, 0x2b, 0xce, 0x9c, 0x49,
+	0x86, 0xa2, 0xcd, 0x2c, 0x8f, 0xc0, 0xaf, 0x0a, 0xe0, 0x2f, 0x13, 0x35, 0x0b, 0x3c, 0x3d, 0xae,
+	0x20, 0xbf, 0x91, 0xa0, 0x9c, 0x98, 0x3c, 0x90, 0x57, 0xf2, 0x1a, 0x5c, 0x66, 0x9c, 0xa1, 0x5c,
+	0x9d, 0x45, 0x14, 0x21, 0x5d, 0x16, 0x90, 0x9a, 0xe4, 0xe2, 0x94, 0x86, 0x98, 0xf0, 0xfe, 0xbe,
+	0x04, 0x67, 0xf0, 0x99, 0x41, 0x2e, 0x1f, 0x53, 0x3a, 0xe3, 0xf7, 0xac, 0xb2, 0x71, 0x92, 0x18,
+	0x22, 0x78, 0x43, 0x20, 0xb8, 0x4e, 0xae, 0xe5, 0xac, 0x66, 0xf0, 0xfa, 0x3a, 0x66, 0x25, 0xff,
+	0x28, 0x41, 0x29, 0x7e, 0xb9, 0x91, 0xbc, 0x1a, 0x4a, 0x3f, 0x3e, 0x95, 0xcd, 0x93, 0x05, 0x11,
+	0xdf, 0x35, 0x81, 0x4f, 0x23, 0x5b, 0x59, 0x7c, 0x7e, 0x24, 0x9c, 0xc5, 0xd5, 0x79, 0xf3, 0xe9,
+	0x51, 0x43, 0xfa, 0xe4, 0xa8, 0x21, 0xfd, 0xeb, 0xa8, 0x21, 0x3d, 0x79, 0xde, 0x98, 0xfb, 0xe4,
+	0x79, 0x63, 0xee, 0x1f, 0xcf, 0x1b, 0x73, 0x6f, 0xbd, 0x9a, 0xb8, 0x04, 0x0f, 0xdc, 0x03, 0x9b,
+	0x1b, 0x43, 0xca, 0x1f, 0xb9, 0xfe, 0x81, 0x70, 0x40, 0x7d, 0xed, 0x67, 0xc2, 0x49, 0x60, 0x86,
+	0xf5, 0x96, 0xc4, 0xed, 0xe2, 0xab, 0xff, 0x1b, 0x00, 0x59, 0x7b, 0x39, 0xdc, 0x33, 0x1b, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the cdp module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Accounts queries the CDP module accounts.
+	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
+	// TotalPrincipal queries the total principal of a given collateral type.
+	TotalPrincipal(ctx context.Context, in *QueryTotalPrincipalRequest, opts ...grpc.CallOption) (*QueryTotalPrincipalResponse, error)
+	// TotalCollateral queries the total collateral of a given collateral type.
+	TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error)
+	// StabilityFee queries the effective per second stability fee of a given collateral type.
+	StabilityFee(ctx context.Context, in *QueryStabilityFeeRequest, opts ...grpc.CallOption) (*QueryStabilityFeeResponse, error)
+	// Cdps queries all active CDPs.
+	Cdps(ctx context.Context, in *QueryCdpsRequest, opts ...grpc.CallOption) (*QueryCdpsResponse, error)
+	// Cdp queries a CDP with the input owner address and collateral type.
+	Cdp(ctx context.Context, in *QueryCdpRequest, opts ...grpc.CallOption) (*QueryCdpResponse, error)
+	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// GlobalSettlement queries the settlement prices and redemption progress of the cdp system's global settlement.
+	GlobalSettlement(ctx context.Context, in *QueryGlobalSettlementRequest, opts ...grpc.CallOption) (*QueryGlobalSettlementResponse, error)
+	// PsmReserves queries the stablecoins held by the peg stability module.
+	PsmReserves(ctx context.Context, in *QueryPsmReservesRequest, opts ...grpc.CallOption) (*QueryPsmReservesResponse, error)
+	// CdpRisk queries the liquidation price of a cdp and what liquidating it would seize at current prices.
+	CdpRisk(ctx context.Context, in *QueryCdpRiskRequest, opts ...grpc.CallOption) (*QueryCdpRiskResponse, error)
+	// RiskyCdps queries the cdps of a collateral type below a collateralization ratio, riskiest first.
+	RiskyCdps(ctx context.Context, in *QueryRiskyCdpsRequest, opts ...grpc.CallOption) (*QueryRiskyCdpsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCdpsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
+	out := new(QueryAccountsResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/Accounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCdpsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Ratio) > 0 {
-		i -= len(m.Ratio)
-		copy(dAtA[i:], m.Ratio)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ratio)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) TotalPrincipal(ctx context.Context, in *QueryTotalPrincipalRequest, opts ...grpc.CallOption) (*QueryTotalPrincipalResponse, error) {
+	out := new(QueryTotalPrincipalResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/TotalPrincipal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error) {
+	out := new(QueryTotalCollateralResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/TotalCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCdpsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StabilityFee(ctx context.Context, in *QueryStabilityFeeRequest, opts ...grpc.CallOption) (*QueryStabilityFeeResponse, error) {
+	out := new(QueryStabilityFeeResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/StabilityFee", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCdpsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Cdps(ctx context.Context, in *QueryCdpsRequest, opts ...grpc.CallOption) (*QueryCdpsResponse, error) {
+	out := new(QueryCdpsResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/Cdps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCdpsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) Cdp(ctx context.Context, in *QueryCdpRequest, opts ...grpc.CallOption) (*QueryCdpResponse, error) {
+	out := new(QueryCdpResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/Cdp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Cdps) > 0 {
-		for iNdEx := len(m.Cdps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Cdps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/Deposits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GlobalSettlement(ctx context.Context, in *QueryGlobalSettlementRequest, opts ...grpc.CallOption) (*QueryGlobalSettlementResponse, error) {
+	out := new(QueryGlobalSettlementResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/GlobalSettlement", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PsmReserves(ctx context.Context, in *QueryPsmReservesRequest, opts ...grpc.CallOption) (*QueryPsmReservesResponse, error) {
+	out := new(QueryPsmReservesResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/PsmReserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) CdpRisk(ctx context.Context, in *QueryCdpRiskRequest, opts ...grpc.CallOption) (*QueryCdpRiskResponse, error) {
+	out := new(QueryCdpRiskResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/CdpRisk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RiskyCdps(ctx context.Context, in *QueryRiskyCdpsRequest, opts ...grpc.CallOption) (*QueryRiskyCdpsResponse, error) {
+	out := new(QueryRiskyCdpsResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Query/RiskyCdps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the cdp module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Accounts queries the CDP module accounts.
+	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
+	// TotalPrincipal queries the total principal of a given collateral type.
+	TotalPrincipal(context.Context, *QueryTotalPrincipalRequest) (*QueryTotalPrincipalResponse, error)
+	// TotalCollateral queries the total collateral of a given collateral type.
+	TotalCollateral(context.Context, *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error)
+	// StabilityFee queries the effective per second stability fee of a given collateral type.
+	StabilityFee(context.Context, *QueryStabilityFeeRequest) (*QueryStabilityFeeResponse, error)
+	// Cdps queries all active CDPs.
+	Cdps(context.Context, *QueryCdpsRequest) (*QueryCdpsResponse, error)
+	// Cdp queries a CDP with the input owner address and collateral type.
+	Cdp(context.Context, *QueryCdpRequest) (*QueryCdpResponse, error)
+	// Deposits queries deposits associated with the CDP owned by an address for a collateral type.
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// GlobalSettlement queries the settlement prices and redemption progress of the cdp system's global settlement.
+	GlobalSettlement(context.Context, *QueryGlobalSettlementRequest) (*QueryGlobalSettlementResponse, error)
+	// PsmReserves queries the stablecoins held by the peg stability module.
+	PsmReserves(context.Context, *QueryPsmReservesRequest) (*QueryPsmReservesResponse, error)
+	// CdpRisk queries the liquidation price of a cdp and what liquidating it would seize at current prices.
+	CdpRisk(context.Context, *QueryCdpRiskRequest) (*QueryCdpRiskResponse, error)
+	// RiskyCdps queries the cdps of a collateral type below a collateralization ratio, riskiest first.
+	RiskyCdps(context.Context, *QueryRiskyCdpsRequest) (*QueryRiskyCdpsResponse, error)
 }
 
-func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryTotalPrincipalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryTotalPrincipalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*QueryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
 }
-
-func (m *QueryTotalPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) TotalPrincipal(ctx context.Context, req *QueryTotalPrincipalRequest) (*QueryTotalPrincipalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPrincipal not implemented")
 }
-
-func (m *QueryTotalPrincipalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) TotalCollateral(ctx context.Context, req *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalCollateral not implemented")
 }
-
-func (m *QueryTotalPrincipalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) StabilityFee(ctx context.Context, req *QueryStabilityFeeRequest) (*QueryStabilityFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StabilityFee not implemented")
+}
+func (*UnimplementedQueryServer) Cdps(ctx context.Context, req *QueryCdpsRequest) (*QueryCdpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cdps not implemented")
+}
+func (*UnimplementedQueryServer) Cdp(ctx context.Context, req *QueryCdpRequest) (*QueryCdpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cdp not implemented")
+}
+func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+func (*UnimplementedQueryServer) GlobalSettlement(ctx context.Context, req *QueryGlobalSettlementRequest) (*QueryGlobalSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobalSettlement not implemented")
+}
+func (*UnimplementedQueryServer) PsmReserves(ctx context.Context, req *QueryPsmReservesRequest) (*QueryPsmReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PsmReserves not implemented")
+}
+func (*UnimplementedQueryServer) CdpRisk(ctx context.Context, req *QueryCdpRiskRequest) (*QueryCdpRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CdpRisk not implemented")
+}
+func (*UnimplementedQueryServer) RiskyCdps(ctx context.Context, req *QueryRiskyCdpsRequest) (*QueryRiskyCdpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RiskyCdps not implemented")
 }
 
-func (m *QueryTotalPrincipalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalPrincipal) > 0 {
-		for iNdEx := len(m.TotalPrincipal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalPrincipal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryTotalCollateralRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0xa
+func _Query_TotalPrincipal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalPrincipalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPrincipal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/TotalPrincipal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPrincipal(ctx, req.(*QueryTotalPrincipalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalCollateralResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalCollateralRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/TotalCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalCollateral(ctx, req.(*QueryTotalCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_StabilityFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStabilityFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StabilityFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/StabilityFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StabilityFee(ctx, req.(*QueryStabilityFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalCollateral) > 0 {
-		for iNdEx := len(m.TotalCollateral) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalCollateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CDPResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Cdps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCdpsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CDPResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralizationRatio) > 0 {
-		i -= len(m.CollateralizationRatio)
-		copy(dAtA[i:], m.CollateralizationRatio)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralizationRatio)))
-		i--
-		dAtA[i] = 0x52
+	if interceptor == nil {
+		return srv.(QueryServer).Cdps(ctx, in)
 	}
-	{
-		size, err := m.CollateralValue.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/Cdps",
 	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.InterestFactor) > 0 {
-		i -= len(m.InterestFactor)
-		copy(dAtA[i:], m.InterestFactor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestFactor)))
-		i--
-		dAtA[i] = 0x42
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Cdps(ctx, req.(*QueryCdpsRequest))
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated):])
-	if err6 != nil {
-		return 0, err6
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Cdp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCdpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i -= n6
-	i = encodeVarintQuery(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).Cdp(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/Cdp",
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Cdp(ctx, req.(*QueryCdpRequest))
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).Deposits(ctx, in)
 	}
-	if m.ID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/Deposits",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_GlobalSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGlobalSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).GlobalSettlement(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/GlobalSettlement",
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GlobalSettlement(ctx, req.(*QueryGlobalSettlementRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PsmReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPsmReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PsmReserves(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/PsmReserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PsmReserves(ctx, req.(*QueryPsmReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCdpRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CdpRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCdpRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).CdpRisk(ctx, in)
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/CdpRisk",
 	}
-	return n
-}
-
-func (m *QueryCdpResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CdpRisk(ctx, req.(*QueryCdpRiskRequest))
 	}
-	var l int
-	_ = l
-	l = m.Cdp.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCdpsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_RiskyCdps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRiskyCdpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RiskyCdps(ctx, in)
 	}
-	if m.ID != 0 {
-		n += 1 + sovQuery(uint64(m.ID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Query/RiskyCdps",
 	}
-	l = len(m.Ratio)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RiskyCdps(ctx, req.(*QueryRiskyCdpsRequest))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCdpsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Cdps) > 0 {
-		for _, e := range m.Cdps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "aeth.cdp.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Accounts",
+			Handler:    _Query_Accounts_Handler,
+		},
+		{
+			MethodName: "TotalPrincipal",
+			Handler:    _Query_TotalPrincipal_Handler,
+		},
+		{
+			MethodName: "TotalCollateral",
+			Handler:    _Query_TotalCollateral_Handler,
+		},
+		{
+			MethodName: "StabilityFee",
+			Handler:    _Query_StabilityFee_Handler,
+		},
+		{
+			MethodName: "Cdps",
+			Handler:    _Query_Cdps_Handler,
+		},
+		{
+			MethodName: "Cdp",
+			Handler:    _Query_Cdp_Handler,
+		},
+		{
+			MethodName: "Deposits",
+			Handler:    _Query_Deposits_Handler,
+		},
+		{
+			MethodName: "GlobalSettlement",
+			Handler:    _Query_GlobalSettlement_Handler,
+		},
+		{
+			MethodName: "PsmReserves",
+			Handler:    _Query_PsmReserves_Handler,
+		},
+		{
+			MethodName: "CdpRisk",
+			Handler:    _Query_CdpRisk_Handler,
+		},
+		{
+			MethodName: "RiskyCdps",
+			Handler:    _Query_RiskyCdps_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aeth/cdp/v1beta1/query.proto",
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalPrincipalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalPrincipalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TotalPrincipal) > 0 {
-		for _, e := range m.TotalPrincipal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalCollateralRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TotalCollateral) > 0 {
-		for _, e := range m.TotalCollateral {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CDPResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCdpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovQuery(uint64(m.ID))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.CdpID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Principal.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cdp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Ratio) > 0 {
+		i -= len(m.Ratio)
+		copy(dAtA[i:], m.Ratio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ratio)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cdps) > 0 {
+		for iNdEx := len(m.Cdps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cdps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPrincipalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPrincipalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPrincipalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPrincipalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPrincipalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPrincipalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalPrincipal) > 0 {
+		for iNdEx := len(m.TotalPrincipal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalPrincipal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalCollateralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalCollateral) > 0 {
+		for iNdEx := len(m.TotalCollateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalCollateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStabilityFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStabilityFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStabilityFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStabilityFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStabilityFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStabilityFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StabilityFees) > 0 {
+		for iNdEx := len(m.StabilityFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StabilityFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalSettlementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalSettlementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalSettlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalSettlementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalSettlementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalSettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GlobalSettlement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPsmReservesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPsmReservesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPsmReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPsmReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPsmReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPsmReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reserves) > 0 {
+		for iNdEx := len(m.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PsmReserveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PsmReserveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PsmReserveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DebtLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Minted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Reserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StabilityFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StabilityFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StabilityFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ModelActive {
+		i--
+		if m.ModelActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Utilization.Size()
+		i -= size
+		if _, err := m.Utilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StabilityFee.Size()
+		i -= size
+		if _, err := m.StabilityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpRiskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpRiskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpRiskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCdpRiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCdpRiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCdpRiskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CdpRisk.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRiskyCdpsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRiskyCdpsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRiskyCdpsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ratio) > 0 {
+		i -= len(m.Ratio)
+		copy(dAtA[i:], m.Ratio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ratio)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRiskyCdpsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRiskyCdpsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRiskyCdpsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CdpRisks) > 0 {
+		for iNdEx := len(m.CdpRisks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CdpRisks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CdpRiskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CdpRiskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CdpRiskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DebtCovered.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.BasketSeized) > 0 {
+		for iNdEx := len(m.BasketSeized) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BasketSeized[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size, err := m.CollateralSeized.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.PartialLiquidation {
+		i--
+		if m.PartialLiquidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Liquidatable {
+		i--
+		if m.Liquidatable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.DistanceToLiquidation.Size()
+		i -= size
+		if _, err := m.DistanceToLiquidation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.LiquidationPrice.Size()
+		i -= size
+		if _, err := m.LiquidationPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.LiquidationRatio.Size()
+		i -= size
+		if _, err := m.LiquidationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CollateralizationRatio.Size()
+		i -= size
+		if _, err := m.CollateralizationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TotalDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.AccruedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CdpID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Basket) > 0 {
+		for iNdEx := len(m.Basket) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Basket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Managers) > 0 {
+		for iNdEx := len(m.Managers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Managers[iNdEx])
+			copy(dAtA[i:], m.Managers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Managers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.CollateralizationRatio) > 0 {
+		i -= len(m.CollateralizationRatio)
+		copy(dAtA[i:], m.CollateralizationRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralizationRatio)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.CollateralValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.InterestFactor) > 0 {
+		i -= len(m.InterestFactor)
+		copy(dAtA[i:], m.InterestFactor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestFactor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FeesUpdated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintQuery(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.AccumulatedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCdpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovQuery(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *QueryCdpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cdp.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCdpsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = len(m.Ratio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCdpsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Cdps) > 0 {
+		for _, e := range m.Cdps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovQuery(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *QueryDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalPrincipalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalPrincipalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalPrincipal) > 0 {
+		for _, e := range m.TotalPrincipal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalCollateral) > 0 {
+		for _, e := range m.TotalCollateral {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStabilityFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStabilityFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StabilityFees) > 0 {
+		for _, e := range m.StabilityFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGlobalSettlementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGlobalSettlementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GlobalSettlement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPsmReservesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPsmReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reserves) > 0 {
+		for _, e := range m.Reserves {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PsmReserveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DebtLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StabilityFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.StabilityFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Utilization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ModelActive {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCdpRiskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovQuery(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *QueryCdpRiskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CdpRisk.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRiskyCdpsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ratio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRiskyCdpsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CdpRisks) > 0 {
+		for _, e := range m.CdpRisks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CdpRiskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		n += 1 + sovQuery(uint64(m.CdpID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.AccruedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalDebt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CollateralizationRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LiquidationRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LiquidationPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DistanceToLiquidation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Liquidatable {
+		n += 2
+	}
+	if m.PartialLiquidation {
+		n += 2
+	}
+	l = m.CollateralSeized.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.BasketSeized) > 0 {
+		for _, e := range m.BasketSeized {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.DebtCovered.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Principal.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	l = m.AccumulatedFees.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FeesUpdated)
@@ -2079,22 +4016,2001 @@ func (m *CDPResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = m.CollateralValue.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.CollateralizationRatio)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	l = m.CollateralValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.CollateralizationRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Managers) > 0 {
+		for _, s := range m.Managers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Basket) > 0 {
+		for _, e := range m.Basket {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, types.ModuleAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCdpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCdpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCdpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCdpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCdpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cdp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cdp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCdpsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCdpsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ratio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCdpsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCdpsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCdpsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cdps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cdps = append(m.Cdps, CDPResponse{})
+			if err := m.Cdps[len(m.Cdps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPrincipalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPrincipalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPrincipalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPrincipalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPrincipal = append(m.TotalPrincipal, TotalPrincipal{})
+			if err := m.TotalPrincipal[len(m.TotalPrincipal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalCollateralRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCollateral = append(m.TotalCollateral, TotalCollateral{})
+			if err := m.TotalCollateral[len(m.TotalCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStabilityFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStabilityFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStabilityFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStabilityFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStabilityFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStabilityFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StabilityFees = append(m.StabilityFees, StabilityFeeResponse{})
+			if err := m.StabilityFees[len(m.StabilityFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalSettlementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalSettlementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalSettlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalSettlementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalSettlementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalSettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPsmReservesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPsmReservesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPsmReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPsmReservesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPsmReservesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPsmReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, PsmReserveResponse{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *PsmReserveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PsmReserveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PsmReserveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *StabilityFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2113,16 +6029,136 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StabilityFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StabilityFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StabilityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ModelActive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2144,7 +6180,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCdpRiskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2167,17 +6203,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCdpRiskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCdpRiskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2187,75 +6223,75 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2277,7 +6313,7 @@ func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCdpRiskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2300,15 +6336,15 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCdpRiskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCdpRiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpRisk", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2335,8 +6371,7 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, types.ModuleAccount{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CdpRisk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2361,7 +6396,7 @@ func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRiskyCdpsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2384,10 +6419,10 @@ func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCdpRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRiskyCdpsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCdpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRiskyCdpsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2424,7 +6459,7 @@ func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2452,7 +6487,43 @@ func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Ratio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2475,7 +6546,7 @@ func (m *QueryCdpRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCdpResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRiskyCdpsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2498,15 +6569,15 @@ func (m *QueryCdpResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCdpResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRiskyCdpsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCdpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRiskyCdpsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cdp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpRisks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2533,7 +6604,44 @@ func (m *QueryCdpResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Cdp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CdpRisks = append(m.CdpRisks, CdpRiskResponse{})
+			if err := m.CdpRisks[len(m.CdpRisks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2558,7 +6666,7 @@ func (m *QueryCdpResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
+func (m *CdpRiskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2581,17 +6689,17 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCdpsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CdpRiskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCdpsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CdpRiskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
 			}
-			var stringLen uint64
+			m.CdpID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2601,24 +6709,11 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CdpID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
@@ -2652,27 +6747,8 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2700,11 +6776,11 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ratio = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2731,66 +6807,13 @@ func (m *QueryCdpsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccruedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCdpsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCdpsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCdpsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cdps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDebt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2817,16 +6840,15 @@ func (m *QueryCdpsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cdps = append(m.Cdps, CDPResponse{})
-			if err := m.Cdps[len(m.Cdps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2836,81 +6858,29 @@ func (m *QueryCdpsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralizationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2938,11 +6908,13 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			if err := m.LiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2970,63 +6942,15 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3036,79 +6960,29 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LiquidationPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalPrincipalRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPrincipalRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPrincipalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistanceToLiquidation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3136,61 +7010,53 @@ func (m *QueryTotalPrincipalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.DistanceToLiquidation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidatable", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Liquidatable = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidation", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalPrincipalResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalPrincipalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PartialLiquidation = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrincipal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralSeized", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3217,66 +7083,15 @@ func (m *QueryTotalPrincipalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalPrincipal = append(m.TotalPrincipal, TotalPrincipal{})
-			if err := m.TotalPrincipal[len(m.TotalPrincipal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralSeized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalCollateralRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketSeized", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3286,77 +7101,29 @@ func (m *QueryTotalCollateralRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.BasketSeized = append(m.BasketSeized, BasketCollateral{})
+			if err := m.BasketSeized[len(m.BasketSeized)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalCollateralResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtCovered", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3383,8 +7150,7 @@ func (m *QueryTotalCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalCollateral = append(m.TotalCollateral, TotalCollateral{})
-			if err := m.TotalCollateral[len(m.TotalCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DebtCovered.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3750,6 +7516,72 @@ func (m *CDPResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.CollateralizationRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Managers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Managers = append(m.Managers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Basket = append(m.Basket, BasketCollateral{})
+			if err := m.Basket[len(m.Basket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGlobalSettlement returns a new GlobalSettlement
+func NewGlobalSettlement(startTime time.Time, collateral SettlementCollaterals, redeemableSupply sdk.Coin) GlobalSettlement {
+	return GlobalSettlement{
+		StartTime:        startTime,
+		Collateral:       collateral,
+		RedeemableSupply: redeemableSupply,
+		Redeemed:         sdk.NewCoin(redeemableSupply.Denom, sdk.ZeroInt()),
+	}
+}
+
+// Validate performs a basic validation of the global settlement fields.
+func (gs GlobalSettlement) Validate() error {
+	if gs.StartTime.IsZero() {
+		return fmt.Errorf("global settlement start time cannot be zero")
+	}
+	if err := gs.Collateral.Validate(); err != nil {
+		return err
+	}
+	if !gs.RedeemableSupply.IsValid() {
+		return fmt.Errorf("invalid redeemable supply: %s", gs.RedeemableSupply)
+	}
+	if !gs.Redeemed.IsValid() || gs.Redeemed.Denom != gs.RedeemableSupply.Denom {
+		return fmt.Errorf("invalid redeemed amount: %s", gs.Redeemed)
+	}
+	if gs.Redeemed.Amount.GT(gs.RedeemableSupply.Amount) {
+		return fmt.Errorf("redeemed %s exceeds redeemable supply %s", gs.Redeemed, gs.RedeemableSupply)
+	}
+	return nil
+}
+
+// GetCollateral returns the settlement of a collateral type
+func (gs GlobalSettlement) GetCollateral(collateralType string) (SettlementCollateral, bool) {
+	for _, sc := range gs.Collateral {
+		if sc.CollateralType == collateralType {
+			return sc, true
+		}
+	}
+	return SettlementCollateral{}, false
+}
+
+// NewSettlementCollateral returns a new SettlementCollateral with nothing redeemed
+func NewSettlementCollateral(collateralType string, price sdk.Dec, reserved sdk.Coin) SettlementCollateral {
+	return SettlementCollateral{
+		CollateralType: collateralType,
+		Price:          price,
+		Reserved:       reserved,
+		Redeemed:       sdk.NewCoin(reserved.Denom, sdk.ZeroInt()),
+	}
+}
+
+// Validate performs a basic validation of the settlement collateral fields.
+func (sc SettlementCollateral) Validate() error {
+	if strings.TrimSpace(sc.CollateralType) == "" {
+		return fmt.Errorf("collateral type cannot be empty")
+	}
+	if sc.Price.IsNil() || sc.Price.IsNegative() {
+		return fmt.Errorf("settlement price must not be negative, is %s for %s", sc.Price, sc.CollateralType)
+	}
+	if !sc.Reserved.IsValid() {
+		return fmt.Errorf("invalid reserved collateral for %s: %s", sc.CollateralType, sc.Reserved)
+	}
+	if sc.Price.IsZero() && sc.Reserved.IsPositive() {
+		return fmt.Errorf("collateral %s cannot be reserved at a zero settlement price for %s", sc.Reserved, sc.CollateralType)
+	}
+	if !sc.Redeemed.IsValid() || sc.Redeemed.Denom != sc.Reserved.Denom {
+		return fmt.Errorf("invalid redeemed collateral for %s: %s", sc.CollateralType, sc.Redeemed)
+	}
+	if sc.Redeemed.Amount.GT(sc.Reserved.Amount) {
+		return fmt.Errorf("redeemed %s exceeds reserved %s for %s", sc.Redeemed, sc.Reserved, sc.CollateralType)
+	}
+	return nil
+}
+
+// SettlementCollaterals a collection of SettlementCollateral
+type SettlementCollaterals []SettlementCollateral
+
+// Validate validates each settlement collateral and checks for duplicate collateral types
+func (scs SettlementCollaterals) Validate() error {
+	seen := make(map[string]bool)
+	for _, sc := range scs {
+		if seen[sc.CollateralType] {
+			return fmt.Errorf("duplicate settlement collateral type %s", sc.CollateralType)
+		}
+		seen[sc.CollateralType] = true
+		if err := sc.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -42,7 +43,7 @@ func (m *MsgCreateCDP) Reset()         { *m = MsgCreateCDP{} }
 func (m *MsgCreateCDP) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateCDP) ProtoMessage()    {}
 func (*MsgCreateCDP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{0}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{0}
 }
 func (m *MsgCreateCDP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -108,7 +109,7 @@ func (m *MsgCreateCDPResponse) Reset()         { *m = MsgCreateCDPResponse{} }
 func (m *MsgCreateCDPResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateCDPResponse) ProtoMessage()    {}
 func (*MsgCreateCDPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{1}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{1}
 }
 func (m *MsgCreateCDPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,13 +151,15 @@ type MsgDeposit struct {
 	Owner          string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	Collateral     types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
 	CollateralType string     `protobuf:"bytes,4,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,5,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
 func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgDeposit) ProtoMessage()    {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{2}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{2}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,6 +216,13 @@ func (m *MsgDeposit) GetCollateralType() string {
 	return ""
 }
 
+func (m *MsgDeposit) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // MsgDepositResponse defines the Msg/Deposit response type.
 type MsgDepositResponse struct {
 }
@@ -221,7 +231,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{3}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{3}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,13 +266,15 @@ type MsgWithdraw struct {
 	Owner          string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	Collateral     types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
 	CollateralType string     `protobuf:"bytes,4,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,5,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{4}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{4}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,6 +331,13 @@ func (m *MsgWithdraw) GetCollateralType() string {
 	return ""
 }
 
+func (m *MsgWithdraw) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // MsgWithdrawResponse defines the Msg/Withdraw response type.
 type MsgWithdrawResponse struct {
 }
@@ -327,7 +346,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{5}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{5}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,13 +380,15 @@ type MsgDrawDebt struct {
 	Sender         string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	CollateralType string     `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 	Principal      types.Coin `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,4,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *MsgDrawDebt) Reset()         { *m = MsgDrawDebt{} }
 func (m *MsgDrawDebt) String() string { return proto.CompactTextString(m) }
 func (*MsgDrawDebt) ProtoMessage()    {}
 func (*MsgDrawDebt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{6}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{6}
 }
 func (m *MsgDrawDebt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +438,13 @@ func (m *MsgDrawDebt) GetPrincipal() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgDrawDebt) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // MsgDrawDebtResponse defines the Msg/DrawDebt response type.
 type MsgDrawDebtResponse struct {
 }
@@ -425,7 +453,7 @@ func (m *MsgDrawDebtResponse) Reset()         { *m = MsgDrawDebtResponse{} }
 func (m *MsgDrawDebtResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDrawDebtResponse) ProtoMessage()    {}
 func (*MsgDrawDebtResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{7}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{7}
 }
 func (m *MsgDrawDebtResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,13 +487,17 @@ type MsgRepayDebt struct {
 	Sender         string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	CollateralType string     `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
 	Payment        types.Coin `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,4,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
+	// owner of the cdp when the sender repays as one of its managers, empty if the sender is the owner
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
 func (m *MsgRepayDebt) Reset()         { *m = MsgRepayDebt{} }
 func (m *MsgRepayDebt) String() string { return proto.CompactTextString(m) }
 func (*MsgRepayDebt) ProtoMessage()    {}
 func (*MsgRepayDebt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{8}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{8}
 }
 func (m *MsgRepayDebt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,6 +547,20 @@ func (m *MsgRepayDebt) GetPayment() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgRepayDebt) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
+func (m *MsgRepayDebt) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 // MsgRepayDebtResponse defines the Msg/RepayDebt response type.
 type MsgRepayDebtResponse struct {
 }
@@ -523,7 +569,7 @@ func (m *MsgRepayDebtResponse) Reset()         { *m = MsgRepayDebtResponse{} }
 func (m *MsgRepayDebtResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRepayDebtResponse) ProtoMessage()    {}
 func (*MsgRepayDebtResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{9}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{9}
 }
 func (m *MsgRepayDebtResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,13 +604,15 @@ type MsgLiquidate struct {
 	Keeper         string `protobuf:"bytes,1,opt,name=keeper,proto3" json:"keeper,omitempty"`
 	Borrower       string `protobuf:"bytes,2,opt,name=borrower,proto3" json:"borrower,omitempty"`
 	CollateralType string `protobuf:"bytes,3,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,4,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
 func (m *MsgLiquidate) Reset()         { *m = MsgLiquidate{} }
 func (m *MsgLiquidate) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidate) ProtoMessage()    {}
 func (*MsgLiquidate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{10}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{10}
 }
 func (m *MsgLiquidate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -614,6 +662,13 @@ func (m *MsgLiquidate) GetCollateralType() string {
 	return ""
 }
 
+func (m *MsgLiquidate) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
+}
+
 // MsgLiquidateResponse defines the Msg/Liquidate response type.
 type MsgLiquidateResponse struct {
 }
@@ -622,7 +677,7 @@ func (m *MsgLiquidateResponse) Reset()         { *m = MsgLiquidateResponse{} }
 func (m *MsgLiquidateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidateResponse) ProtoMessage()    {}
 func (*MsgLiquidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3b8c9334ad8ab0d3, []int{11}
+	return fileDescriptor_1ac2a7643e1a5c50, []int{11}
 }
 func (m *MsgLiquidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,988 +706,5449 @@ func (m *MsgLiquidateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLiquidateResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateCDP)(nil), "aeth.cdp.v1beta1.MsgCreateCDP")
-	proto.RegisterType((*MsgCreateCDPResponse)(nil), "aeth.cdp.v1beta1.MsgCreateCDPResponse")
-	proto.RegisterType((*MsgDeposit)(nil), "aeth.cdp.v1beta1.MsgDeposit")
-	proto.RegisterType((*MsgDepositResponse)(nil), "aeth.cdp.v1beta1.MsgDepositResponse")
-	proto.RegisterType((*MsgWithdraw)(nil), "aeth.cdp.v1beta1.MsgWithdraw")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "aeth.cdp.v1beta1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgDrawDebt)(nil), "aeth.cdp.v1beta1.MsgDrawDebt")
-	proto.RegisterType((*MsgDrawDebtResponse)(nil), "aeth.cdp.v1beta1.MsgDrawDebtResponse")
-	proto.RegisterType((*MsgRepayDebt)(nil), "aeth.cdp.v1beta1.MsgRepayDebt")
-	proto.RegisterType((*MsgRepayDebtResponse)(nil), "aeth.cdp.v1beta1.MsgRepayDebtResponse")
-	proto.RegisterType((*MsgLiquidate)(nil), "aeth.cdp.v1beta1.MsgLiquidate")
-	proto.RegisterType((*MsgLiquidateResponse)(nil), "aeth.cdp.v1beta1.MsgLiquidateResponse")
+// MsgTransferCDP defines a message to move a CDP, and the owner's deposit in it, to a new owner.
+type MsgTransferCDP struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient      string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	CollateralType string `protobuf:"bytes,3,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,4,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("aeth/cdp/v1beta1/tx.proto", fileDescriptor_3b8c9334ad8ab0d3) }
-
-var fileDescriptor_3b8c9334ad8ab0d3 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0x8e, 0x9b, 0x26, 0x6d, 0xb6, 0xbf, 0x7e, 0x90, 0x09, 0x28, 0x89, 0xc0, 0x8d, 0x22, 0x0a,
-	0xbd, 0xd4, 0xa6, 0x05, 0x21, 0x38, 0xa0, 0x8a, 0x24, 0x97, 0x4a, 0x44, 0xaa, 0x5c, 0x24, 0x24,
-	0x2e, 0xd1, 0xda, 0xbb, 0x72, 0xad, 0x26, 0xde, 0x65, 0x77, 0xdb, 0x34, 0x6f, 0xc1, 0x1b, 0x70,
-	0x41, 0xe2, 0x05, 0x78, 0x88, 0x1e, 0x2b, 0x4e, 0x9c, 0x02, 0x4a, 0x4e, 0x3c, 0x01, 0x57, 0x64,
-	0xaf, 0xbd, 0x8e, 0x52, 0x27, 0x04, 0x10, 0x17, 0x6e, 0xeb, 0xfd, 0x66, 0x3e, 0x7d, 0xdf, 0x78,
-	0x66, 0x16, 0x54, 0x4f, 0xe0, 0x19, 0xb4, 0x5c, 0x44, 0xad, 0xb3, 0x5d, 0x07, 0x0b, 0xb8, 0x6b,
-	0x89, 0x73, 0x93, 0x32, 0x22, 0x88, 0x7e, 0x3d, 0x84, 0x4c, 0x17, 0x51, 0x33, 0x86, 0x6a, 0x86,
-	0x4b, 0x78, 0x9f, 0x70, 0xcb, 0x81, 0x1c, 0xab, 0x78, 0x97, 0xf8, 0x81, 0xcc, 0xa8, 0x55, 0x25,
-	0xde, 0x8d, 0xbe, 0x2c, 0xf9, 0x11, 0x43, 0x65, 0x8f, 0x78, 0x44, 0xde, 0x87, 0x27, 0x79, 0xdb,
-	0xf8, 0xa6, 0x81, 0xff, 0x3a, 0xdc, 0x6b, 0x31, 0x0c, 0x05, 0x6e, 0xb5, 0x0f, 0xf5, 0x07, 0xa0,
-	0xc8, 0x71, 0x80, 0x30, 0xab, 0x68, 0x75, 0x6d, 0xbb, 0xd4, 0xac, 0x7c, 0xfa, 0xb8, 0x53, 0x8e,
-	0x89, 0x9e, 0x23, 0xc4, 0x30, 0xe7, 0x47, 0x82, 0xf9, 0x81, 0x67, 0xc7, 0x71, 0xfa, 0x3e, 0x00,
-	0x2e, 0xe9, 0xf5, 0xa0, 0xc0, 0x0c, 0xf6, 0x2a, 0x2b, 0x75, 0x6d, 0x7b, 0x63, 0xaf, 0x6a, 0xc6,
-	0x29, 0xa1, 0xd0, 0x44, 0xbd, 0xd9, 0x22, 0x7e, 0xd0, 0x5c, 0xbd, 0x18, 0x6d, 0xe6, 0xec, 0xa9,
-	0x14, 0xfd, 0x19, 0x28, 0x51, 0xe6, 0x07, 0xae, 0x4f, 0x61, 0xaf, 0x92, 0x5f, 0x2e, 0x3f, 0xcd,
-	0xd0, 0xef, 0x83, 0x6b, 0x29, 0x59, 0x57, 0x0c, 0x29, 0xae, 0xac, 0x86, 0xd2, 0xed, 0xff, 0xd3,
-	0xeb, 0x97, 0x43, 0x8a, 0x1b, 0x4f, 0x40, 0x79, 0xda, 0xaa, 0x8d, 0x39, 0x25, 0x01, 0xc7, 0x7a,
-	0x1d, 0x14, 0x5d, 0x44, 0xbb, 0x3e, 0x8a, 0x2c, 0xaf, 0x36, 0x4b, 0xe3, 0xd1, 0x66, 0xa1, 0x85,
-	0xe8, 0x41, 0xdb, 0x2e, 0xb8, 0x88, 0x1e, 0xa0, 0xc6, 0x48, 0x03, 0xa0, 0xc3, 0xbd, 0x36, 0xa6,
-	0x84, 0xfb, 0x42, 0x7f, 0x0c, 0x4a, 0x48, 0x1e, 0xc9, 0xcf, 0xcb, 0x94, 0x86, 0xea, 0x26, 0x28,
-	0x90, 0x41, 0x80, 0x59, 0x54, 0xa4, 0x45, 0x39, 0x32, 0x6c, 0xa6, 0xb2, 0xf9, 0x5f, 0xaf, 0xec,
-	0xd2, 0xa5, 0x29, 0x03, 0x3d, 0xf5, 0x97, 0x14, 0xa6, 0xf1, 0x45, 0x03, 0x1b, 0x1d, 0xee, 0xbd,
-	0xf2, 0xc5, 0x31, 0x62, 0x70, 0xf0, 0x0f, 0xfa, 0xbe, 0x09, 0x6e, 0x4c, 0x19, 0x54, 0xc6, 0x3f,
-	0x48, 0xe3, 0x6d, 0x06, 0x07, 0x6d, 0xec, 0x88, 0xdf, 0x18, 0x8a, 0x0c, 0x05, 0x2b, 0x59, 0x0a,
-	0xfe, 0xb0, 0xf9, 0x63, 0x03, 0x89, 0x50, 0x65, 0xe0, 0xbd, 0x1c, 0x6b, 0x1b, 0x53, 0x38, 0xfc,
-	0xdb, 0x0e, 0x9e, 0x82, 0x35, 0x0a, 0x87, 0x7d, 0x1c, 0x88, 0x65, 0xf5, 0x27, 0xf1, 0x8d, 0x5b,
-	0xd1, 0x44, 0x2a, 0x95, 0x4a, 0xfe, 0x3b, 0x29, 0xff, 0x85, 0xff, 0xe6, 0xd4, 0x47, 0x50, 0xe0,
-	0x50, 0xfe, 0x09, 0xc6, 0x74, 0x19, 0xf9, 0x32, 0x4e, 0x7f, 0x04, 0xd6, 0x1d, 0xc2, 0x18, 0x19,
-	0x2c, 0xd1, 0x76, 0x2a, 0x32, 0xcb, 0x74, 0x3e, 0xb3, 0x71, 0xa4, 0x72, 0x25, 0x30, 0x51, 0xbe,
-	0xf7, 0x3d, 0x0f, 0xf2, 0x1d, 0xee, 0xe9, 0x47, 0xa0, 0x94, 0xee, 0x54, 0xc3, 0x9c, 0x5d, 0xe4,
-	0xe6, 0xf4, 0x22, 0xaa, 0xdd, 0x5b, 0x8c, 0xab, 0x45, 0xd5, 0x01, 0x6b, 0xc9, 0x0a, 0xba, 0x9d,
-	0x99, 0x12, 0xa3, 0xb5, 0xbb, 0x8b, 0x50, 0x45, 0x77, 0x08, 0xd6, 0xd5, 0x68, 0xdf, 0xc9, 0xcc,
-	0x48, 0xe0, 0xda, 0xd6, 0x42, 0x78, 0x9a, 0x51, 0xcd, 0x4c, 0x36, 0x63, 0x02, 0xcf, 0x61, 0x9c,
-	0x6d, 0xe4, 0xb0, 0x8e, 0x69, 0x13, 0x67, 0xd7, 0x51, 0xe1, 0x73, 0xea, 0x78, 0xa5, 0xbd, 0x42,
-	0xd2, 0xb4, 0xb5, 0xb2, 0x49, 0x15, 0x3e, 0x87, 0xf4, 0xca, 0x9f, 0x6f, 0xee, 0x5f, 0x8c, 0x0d,
-	0xed, 0x72, 0x6c, 0x68, 0x5f, 0xc7, 0x86, 0xf6, 0x76, 0x62, 0xe4, 0x2e, 0x27, 0x46, 0xee, 0xf3,
-	0xc4, 0xc8, 0xbd, 0xde, 0xf2, 0x7c, 0x71, 0x7c, 0xea, 0x98, 0x2e, 0xe9, 0x5b, 0x21, 0xd7, 0x4e,
-	0x0f, 0x3a, 0x3c, 0x3a, 0x59, 0xe7, 0xd1, 0xc3, 0x1f, 0xf6, 0x19, 0x77, 0x8a, 0xd1, 0x8b, 0xfc,
-	0xf0, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x75, 0x8a, 0x38, 0x11, 0x08, 0x00, 0x00,
+func (m *MsgTransferCDP) Reset()         { *m = MsgTransferCDP{} }
+func (m *MsgTransferCDP) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferCDP) ProtoMessage()    {}
+func (*MsgTransferCDP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{12}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateCDP defines a method to create a new CDP.
-	CreateCDP(ctx context.Context, in *MsgCreateCDP, opts ...grpc.CallOption) (*MsgCreateCDPResponse, error)
-	// Deposit defines a method to deposit to a CDP.
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	// Withdraw defines a method to withdraw collateral from a CDP.
-	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	// DrawDebt defines a method to draw debt from a CDP.
-	DrawDebt(ctx context.Context, in *MsgDrawDebt, opts ...grpc.CallOption) (*MsgDrawDebtResponse, error)
-	// RepayDebt defines a method to repay debt from a CDP.
-	RepayDebt(ctx context.Context, in *MsgRepayDebt, opts ...grpc.CallOption) (*MsgRepayDebtResponse, error)
-	// Liquidate defines a method to attempt to liquidate a CDP whos
-	// collateralization ratio is under its liquidation ratio.
-	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+func (m *MsgTransferCDP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferCDP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferCDP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTransferCDP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferCDP.Merge(m, src)
+}
+func (m *MsgTransferCDP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferCDP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferCDP.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateCDP(ctx context.Context, in *MsgCreateCDP, opts ...grpc.CallOption) (*MsgCreateCDPResponse, error) {
-	out := new(MsgCreateCDPResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/CreateCDP", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferCDP proto.InternalMessageInfo
+
+func (m *MsgTransferCDP) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferCDP) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/Withdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferCDP) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DrawDebt(ctx context.Context, in *MsgDrawDebt, opts ...grpc.CallOption) (*MsgDrawDebtResponse, error) {
-	out := new(MsgDrawDebtResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/DrawDebt", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferCDP) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RepayDebt(ctx context.Context, in *MsgRepayDebt, opts ...grpc.CallOption) (*MsgRepayDebtResponse, error) {
-	out := new(MsgRepayDebtResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/RepayDebt", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgTransferCDPResponse defines the Msg/TransferCDP response type.
+type MsgTransferCDPResponse struct {
 }
 
-func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error) {
-	out := new(MsgLiquidateResponse)
-	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/Liquidate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferCDPResponse) Reset()         { *m = MsgTransferCDPResponse{} }
+func (m *MsgTransferCDPResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferCDPResponse) ProtoMessage()    {}
+func (*MsgTransferCDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{13}
+}
+func (m *MsgTransferCDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferCDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferCDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateCDP defines a method to create a new CDP.
-	CreateCDP(context.Context, *MsgCreateCDP) (*MsgCreateCDPResponse, error)
-	// Deposit defines a method to deposit to a CDP.
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	// Withdraw defines a method to withdraw collateral from a CDP.
-	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
-	// DrawDebt defines a method to draw debt from a CDP.
-	DrawDebt(context.Context, *MsgDrawDebt) (*MsgDrawDebtResponse, error)
-	// RepayDebt defines a method to repay debt from a CDP.
-	RepayDebt(context.Context, *MsgRepayDebt) (*MsgRepayDebtResponse, error)
-	// Liquidate defines a method to attempt to liquidate a CDP whos
-	// collateralization ratio is under its liquidation ratio.
-	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+func (m *MsgTransferCDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferCDPResponse.Merge(m, src)
+}
+func (m *MsgTransferCDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferCDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferCDPResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgTransferCDPResponse proto.InternalMessageInfo
+
+// MsgSetCDPManagers defines a message to replace the addresses allowed to manage a CDP on behalf of its owner.
+type MsgSetCDPManagers struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	CollateralType string `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID    uint64   `protobuf:"varint,3,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
+	Managers []string `protobuf:"bytes,4,rep,name=managers,proto3" json:"managers,omitempty"`
 }
 
-func (*UnimplementedMsgServer) CreateCDP(ctx context.Context, req *MsgCreateCDP) (*MsgCreateCDPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCDP not implemented")
+func (m *MsgSetCDPManagers) Reset()         { *m = MsgSetCDPManagers{} }
+func (m *MsgSetCDPManagers) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCDPManagers) ProtoMessage()    {}
+func (*MsgSetCDPManagers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{14}
 }
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *MsgSetCDPManagers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+func (m *MsgSetCDPManagers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCDPManagers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DrawDebt(ctx context.Context, req *MsgDrawDebt) (*MsgDrawDebtResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DrawDebt not implemented")
+func (m *MsgSetCDPManagers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCDPManagers.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RepayDebt(ctx context.Context, req *MsgRepayDebt) (*MsgRepayDebtResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RepayDebt not implemented")
+func (m *MsgSetCDPManagers) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
+func (m *MsgSetCDPManagers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCDPManagers.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgSetCDPManagers proto.InternalMessageInfo
 
-func _Msg_CreateCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateCDP)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateCDP(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Msg/CreateCDP",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateCDP(ctx, req.(*MsgCreateCDP))
+func (m *MsgSetCDPManagers) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Msg/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+func (m *MsgSetCDPManagers) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdraw)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdraw(ctx, in)
+func (m *MsgSetCDPManagers) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Msg/Withdraw",
+	return 0
+}
+
+func (m *MsgSetCDPManagers) GetManagers() []string {
+	if m != nil {
+		return m.Managers
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DrawDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDrawDebt)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DrawDebt(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Msg/DrawDebt",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DrawDebt(ctx, req.(*MsgDrawDebt))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgSetCDPManagersResponse defines the Msg/SetCDPManagers response type.
+type MsgSetCDPManagersResponse struct {
 }
 
-func _Msg_RepayDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRepayDebt)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RepayDebt(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Msg/RepayDebt",
+func (m *MsgSetCDPManagersResponse) Reset()         { *m = MsgSetCDPManagersResponse{} }
+func (m *MsgSetCDPManagersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCDPManagersResponse) ProtoMessage()    {}
+func (*MsgSetCDPManagersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{15}
+}
+func (m *MsgSetCDPManagersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCDPManagersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCDPManagersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RepayDebt(ctx, req.(*MsgRepayDebt))
+}
+func (m *MsgSetCDPManagersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCDPManagersResponse.Merge(m, src)
+}
+func (m *MsgSetCDPManagersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCDPManagersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCDPManagersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCDPManagersResponse proto.InternalMessageInfo
+
+// MsgDepositBasket defines a message to add collateral of another collateral type to a CDP's basket.
+type MsgDepositBasket struct {
+	Depositor      string     `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Owner          string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Collateral     types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
+	CollateralType string     `protobuf:"bytes,4,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,5,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
+	// basket_type is the collateral type of the deposited collateral
+	BasketType string `protobuf:"bytes,6,opt,name=basket_type,json=basketType,proto3" json:"basket_type,omitempty"`
+}
+
+func (m *MsgDepositBasket) Reset()         { *m = MsgDepositBasket{} }
+func (m *MsgDepositBasket) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositBasket) ProtoMessage()    {}
+func (*MsgDepositBasket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{16}
+}
+func (m *MsgDepositBasket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositBasket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositBasket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDepositBasket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositBasket.Merge(m, src)
+}
+func (m *MsgDepositBasket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositBasket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositBasket.DiscardUnknown(m)
 }
 
-func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidate)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositBasket proto.InternalMessageInfo
+
+func (m *MsgDepositBasket) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Liquidate(ctx, in)
+	return ""
+}
+
+func (m *MsgDepositBasket) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/aeth.cdp.v1beta1.Msg/Liquidate",
+	return ""
+}
+
+func (m *MsgDepositBasket) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Liquidate(ctx, req.(*MsgLiquidate))
+	return types.Coin{}
+}
+
+func (m *MsgDepositBasket) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "aeth.cdp.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateCDP",
-			Handler:    _Msg_CreateCDP_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "Withdraw",
-			Handler:    _Msg_Withdraw_Handler,
-		},
-		{
-			MethodName: "DrawDebt",
-			Handler:    _Msg_DrawDebt_Handler,
-		},
-		{
-			MethodName: "RepayDebt",
-			Handler:    _Msg_RepayDebt_Handler,
-		},
-		{
-			MethodName: "Liquidate",
-			Handler:    _Msg_Liquidate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "aeth/cdp/v1beta1/tx.proto",
+func (m *MsgDepositBasket) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
 }
 
-func (m *MsgCreateCDP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDepositBasket) GetBasketType() string {
+	if m != nil {
+		return m.BasketType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateCDP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDepositBasketResponse defines the Msg/DepositBasket response type.
+type MsgDepositBasketResponse struct {
 }
 
-func (m *MsgCreateCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgDepositBasketResponse) Reset()         { *m = MsgDepositBasketResponse{} }
+func (m *MsgDepositBasketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositBasketResponse) ProtoMessage()    {}
+func (*MsgDepositBasketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{17}
+}
+func (m *MsgDepositBasketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositBasketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositBasketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgDepositBasketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositBasketResponse.Merge(m, src)
+}
+func (m *MsgDepositBasketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositBasketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositBasketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositBasketResponse proto.InternalMessageInfo
+
+// MsgWithdrawBasket defines a message to remove collateral of another collateral type from a CDP's basket.
+type MsgWithdrawBasket struct {
+	Depositor      string     `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Owner          string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Collateral     types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
+	CollateralType string     `protobuf:"bytes,4,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,5,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
+	// basket_type is the collateral type of the withdrawn collateral
+	BasketType string `protobuf:"bytes,6,opt,name=basket_type,json=basketType,proto3" json:"basket_type,omitempty"`
+}
+
+func (m *MsgWithdrawBasket) Reset()         { *m = MsgWithdrawBasket{} }
+func (m *MsgWithdrawBasket) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawBasket) ProtoMessage()    {}
+func (*MsgWithdrawBasket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{18}
+}
+func (m *MsgWithdrawBasket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawBasket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawBasket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgWithdrawBasket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawBasket.Merge(m, src)
+}
+func (m *MsgWithdrawBasket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawBasket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawBasket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawBasket proto.InternalMessageInfo
+
+func (m *MsgWithdrawBasket) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateCDPResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawBasket) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateCDPResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawBasket) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
 }
 
-func (m *MsgCreateCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CdpID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgWithdrawBasket) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawBasket) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawBasket) GetBasketType() string {
+	if m != nil {
+		return m.BasketType
+	}
+	return ""
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+// MsgWithdrawBasketResponse defines the Msg/WithdrawBasket response type.
+type MsgWithdrawBasketResponse struct {
+}
+
+func (m *MsgWithdrawBasketResponse) Reset()         { *m = MsgWithdrawBasketResponse{} }
+func (m *MsgWithdrawBasketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawBasketResponse) ProtoMessage()    {}
+func (*MsgWithdrawBasketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{19}
+}
+func (m *MsgWithdrawBasketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawBasketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawBasketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgWithdrawBasketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawBasketResponse.Merge(m, src)
 }
-
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawBasketResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgWithdrawBasketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawBasketResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgWithdrawBasketResponse proto.InternalMessageInfo
 
-func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSettleCDP defines a message to close a CDP during global settlement and return the collateral not owed for its debt.
+type MsgSettleCDP struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	CollateralType string `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// cdp_id selects the cdp when the owner has several of the collateral type, zero selects the only one
+	CdpID uint64 `protobuf:"varint,3,opt,name=cdp_id,json=cdpId,proto3" json:"cdp_id,omitempty"`
 }
 
-func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSettleCDP) Reset()         { *m = MsgSettleCDP{} }
+func (m *MsgSettleCDP) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleCDP) ProtoMessage()    {}
+func (*MsgSettleCDP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{20}
+}
+func (m *MsgSettleCDP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleCDP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleCDP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSettleCDP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleCDP.Merge(m, src)
+}
+func (m *MsgSettleCDP) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleCDP) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleCDP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSettleCDP proto.InternalMessageInfo
+
+func (m *MsgSettleCDP) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSettleCDP) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSettleCDP) GetCdpID() uint64 {
+	if m != nil {
+		return m.CdpID
+	}
+	return 0
 }
 
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSettleCDPResponse defines the Msg/SettleCDP response type.
+type MsgSettleCDPResponse struct {
 }
 
-func (m *MsgDrawDebt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSettleCDPResponse) Reset()         { *m = MsgSettleCDPResponse{} }
+func (m *MsgSettleCDPResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleCDPResponse) ProtoMessage()    {}
+func (*MsgSettleCDPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{21}
+}
+func (m *MsgSettleCDPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleCDPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleCDPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSettleCDPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleCDPResponse.Merge(m, src)
+}
+func (m *MsgSettleCDPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleCDPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleCDPResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDrawDebt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSettleCDPResponse proto.InternalMessageInfo
+
+// MsgRedeemSettlement defines a message to redeem stable asset for a pro-rata share of the collateral reserved during global settlement.
+type MsgRedeemSettlement struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgDrawDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRedeemSettlement) Reset()         { *m = MsgRedeemSettlement{} }
+func (m *MsgRedeemSettlement) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemSettlement) ProtoMessage()    {}
+func (*MsgRedeemSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{22}
+}
+func (m *MsgRedeemSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRedeemSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemSettlement.Merge(m, src)
+}
+func (m *MsgRedeemSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemSettlement proto.InternalMessageInfo
+
+func (m *MsgRedeemSettlement) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDrawDebtResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemSettlement) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgDrawDebtResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRedeemSettlementResponse defines the Msg/RedeemSettlement response type.
+type MsgRedeemSettlementResponse struct {
 }
 
-func (m *MsgDrawDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRedeemSettlementResponse) Reset()         { *m = MsgRedeemSettlementResponse{} }
+func (m *MsgRedeemSettlementResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemSettlementResponse) ProtoMessage()    {}
+func (*MsgRedeemSettlementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{23}
 }
-
-func (m *MsgRepayDebt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemSettlementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemSettlementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemSettlementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRedeemSettlementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemSettlementResponse.Merge(m, src)
+}
+func (m *MsgRedeemSettlementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemSettlementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemSettlementResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRepayDebt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRedeemSettlementResponse proto.InternalMessageInfo
+
+// MsgPsmMint defines a message to deposit a stablecoin in the peg stability module and mint stable asset.
+type MsgPsmMint struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount of the stablecoin to deposit
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgRepayDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgPsmMint) Reset()         { *m = MsgPsmMint{} }
+func (m *MsgPsmMint) String() string { return proto.CompactTextString(m) }
+func (*MsgPsmMint) ProtoMessage()    {}
+func (*MsgPsmMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{24}
+}
+func (m *MsgPsmMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPsmMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPsmMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgPsmMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPsmMint.Merge(m, src)
+}
+func (m *MsgPsmMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPsmMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPsmMint.DiscardUnknown(m)
 }
 
-func (m *MsgRepayDebtResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPsmMint proto.InternalMessageInfo
+
+func (m *MsgPsmMint) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRepayDebtResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPsmMint) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *MsgRepayDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgPsmMintResponse defines the Msg/PsmMint response type.
+type MsgPsmMintResponse struct {
 }
 
-func (m *MsgLiquidate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPsmMintResponse) Reset()         { *m = MsgPsmMintResponse{} }
+func (m *MsgPsmMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPsmMintResponse) ProtoMessage()    {}
+func (*MsgPsmMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{25}
+}
+func (m *MsgPsmMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPsmMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPsmMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPsmMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPsmMintResponse.Merge(m, src)
+}
+func (m *MsgPsmMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPsmMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPsmMintResponse.DiscardUnknown(m)
 }
 
-func (m *MsgLiquidate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPsmMintResponse proto.InternalMessageInfo
+
+// MsgPsmRedeem defines a message to burn stable asset and withdraw a stablecoin from the peg stability module.
+type MsgPsmRedeem struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount of stable asset to redeem
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// denom of the stablecoin to withdraw
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralType) > 0 {
-		i -= len(m.CollateralType)
-		copy(dAtA[i:], m.CollateralType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Borrower) > 0 {
-		i -= len(m.Borrower)
-		copy(dAtA[i:], m.Borrower)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgPsmRedeem) Reset()         { *m = MsgPsmRedeem{} }
+func (m *MsgPsmRedeem) String() string { return proto.CompactTextString(m) }
+func (*MsgPsmRedeem) ProtoMessage()    {}
+func (*MsgPsmRedeem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{26}
+}
+func (m *MsgPsmRedeem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPsmRedeem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPsmRedeem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Keeper) > 0 {
-		i -= len(m.Keeper)
-		copy(dAtA[i:], m.Keeper)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Keeper)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgPsmRedeem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPsmRedeem.Merge(m, src)
+}
+func (m *MsgPsmRedeem) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPsmRedeem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPsmRedeem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPsmRedeem proto.InternalMessageInfo
+
+func (m *MsgPsmRedeem) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgLiquidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPsmRedeem) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPsmRedeem) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgPsmRedeemResponse defines the Msg/PsmRedeem response type.
+type MsgPsmRedeemResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPsmRedeemResponse) Reset()         { *m = MsgPsmRedeemResponse{} }
+func (m *MsgPsmRedeemResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPsmRedeemResponse) ProtoMessage()    {}
+func (*MsgPsmRedeemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{27}
+}
+func (m *MsgPsmRedeemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPsmRedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPsmRedeemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgCreateCDP) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPsmRedeemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPsmRedeemResponse.Merge(m, src)
+}
+func (m *MsgPsmRedeemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPsmRedeemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPsmRedeemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPsmRedeemResponse proto.InternalMessageInfo
+
+// MsgFlashMint defines a message to mint stable asset for the duration of a list of messages.
+// The minted amount plus the flash mint fee must be held by the sender once the messages have executed.
+type MsgFlashMint struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount of stable asset to mint
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// msgs are executed in order after minting, and must only be signed by the sender
+	Msgs []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *MsgFlashMint) Reset()         { *m = MsgFlashMint{} }
+func (m *MsgFlashMint) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashMint) ProtoMessage()    {}
+func (*MsgFlashMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{28}
+}
+func (m *MsgFlashMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgFlashMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashMint.Merge(m, src)
+}
+func (m *MsgFlashMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashMint proto.InternalMessageInfo
+
+func (m *MsgFlashMint) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Principal.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgFlashMint) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgCreateCDPResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFlashMint) GetMsgs() []*types1.Any {
+	if m != nil {
+		return m.Msgs
 	}
-	var l int
-	_ = l
-	if m.CdpID != 0 {
-		n += 1 + sovTx(uint64(m.CdpID))
-	}
-	return n
+	return nil
 }
 
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgFlashMintResponse defines the Msg/FlashMint response type.
+type MsgFlashMintResponse struct {
+}
+
+func (m *MsgFlashMintResponse) Reset()         { *m = MsgFlashMintResponse{} }
+func (m *MsgFlashMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashMintResponse) ProtoMessage()    {}
+func (*MsgFlashMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ac2a7643e1a5c50, []int{29}
+}
+func (m *MsgFlashMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgFlashMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashMintResponse.Merge(m, src)
+}
+func (m *MsgFlashMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashMintResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateCDP)(nil), "aeth.cdp.v1beta1.MsgCreateCDP")
+	proto.RegisterType((*MsgCreateCDPResponse)(nil), "aeth.cdp.v1beta1.MsgCreateCDPResponse")
+	proto.RegisterType((*MsgDeposit)(nil), "aeth.cdp.v1beta1.MsgDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "aeth.cdp.v1beta1.MsgDepositResponse")
+	proto.RegisterType((*MsgWithdraw)(nil), "aeth.cdp.v1beta1.MsgWithdraw")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "aeth.cdp.v1beta1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgDrawDebt)(nil), "aeth.cdp.v1beta1.MsgDrawDebt")
+	proto.RegisterType((*MsgDrawDebtResponse)(nil), "aeth.cdp.v1beta1.MsgDrawDebtResponse")
+	proto.RegisterType((*MsgRepayDebt)(nil), "aeth.cdp.v1beta1.MsgRepayDebt")
+	proto.RegisterType((*MsgRepayDebtResponse)(nil), "aeth.cdp.v1beta1.MsgRepayDebtResponse")
+	proto.RegisterType((*MsgLiquidate)(nil), "aeth.cdp.v1beta1.MsgLiquidate")
+	proto.RegisterType((*MsgLiquidateResponse)(nil), "aeth.cdp.v1beta1.MsgLiquidateResponse")
+	proto.RegisterType((*MsgTransferCDP)(nil), "aeth.cdp.v1beta1.MsgTransferCDP")
+	proto.RegisterType((*MsgTransferCDPResponse)(nil), "aeth.cdp.v1beta1.MsgTransferCDPResponse")
+	proto.RegisterType((*MsgSetCDPManagers)(nil), "aeth.cdp.v1beta1.MsgSetCDPManagers")
+	proto.RegisterType((*MsgSetCDPManagersResponse)(nil), "aeth.cdp.v1beta1.MsgSetCDPManagersResponse")
+	proto.RegisterType((*MsgDepositBasket)(nil), "aeth.cdp.v1beta1.MsgDepositBasket")
+	proto.RegisterType((*MsgDepositBasketResponse)(nil), "aeth.cdp.v1beta1.MsgDepositBasketResponse")
+	proto.RegisterType((*MsgWithdrawBasket)(nil), "aeth.cdp.v1beta1.MsgWithdrawBasket")
+	proto.RegisterType((*MsgWithdrawBasketResponse)(nil), "aeth.cdp.v1beta1.MsgWithdrawBasketResponse")
+	proto.RegisterType((*MsgSettleCDP)(nil), "aeth.cdp.v1beta1.MsgSettleCDP")
+	proto.RegisterType((*MsgSettleCDPResponse)(nil), "aeth.cdp.v1beta1.MsgSettleCDPResponse")
+	proto.RegisterType((*MsgRedeemSettlement)(nil), "aeth.cdp.v1beta1.MsgRedeemSettlement")
+	proto.RegisterType((*MsgRedeemSettlementResponse)(nil), "aeth.cdp.v1beta1.MsgRedeemSettlementResponse")
+	proto.RegisterType((*MsgPsmMint)(nil), "aeth.cdp.v1beta1.MsgPsmMint")
+	proto.RegisterType((*MsgPsmMintResponse)(nil), "aeth.cdp.v1beta1.MsgPsmMintResponse")
+	proto.RegisterType((*MsgPsmRedeem)(nil), "aeth.cdp.v1beta1.MsgPsmRedeem")
+	proto.RegisterType((*MsgPsmRedeemResponse)(nil), "aeth.cdp.v1beta1.MsgPsmRedeemResponse")
+	proto.RegisterType((*MsgFlashMint)(nil), "aeth.cdp.v1beta1.MsgFlashMint")
+	proto.RegisterType((*MsgFlashMintResponse)(nil), "aeth.cdp.v1beta1.MsgFlashMintResponse")
+}
+
+func init() { proto.RegisterFile("aeth/cdp/v1beta1/tx.proto", fileDescriptor_1ac2a7643e1a5c50) }
+
+var fileDescriptor_1ac2a7643e1a5c50 = []byte{
+	// 1114 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x8f, 0xdb, 0xd4,
+	0x17, 0x1d, 0xe7, 0xcf, 0xcc, 0xe4, 0xe6, 0xf7, 0x1b, 0xa6, 0x26, 0x54, 0x1e, 0x97, 0x7a, 0xa2,
+	0xb4, 0x13, 0x46, 0x8c, 0xc6, 0xa6, 0x43, 0x29, 0xb0, 0x40, 0xa8, 0x99, 0x80, 0x54, 0x89, 0x48,
+	0xa3, 0xa4, 0x12, 0x82, 0xcd, 0xe8, 0xc5, 0x7e, 0x75, 0xac, 0xc4, 0x7e, 0xc6, 0xcf, 0x43, 0x9a,
+	0x5d, 0x59, 0xb3, 0x80, 0x6f, 0x01, 0x4b, 0x24, 0xfa, 0x05, 0x58, 0x80, 0x0a, 0xab, 0x8a, 0x15,
+	0xab, 0x0a, 0xcd, 0xac, 0xf8, 0x02, 0xac, 0x51, 0xfc, 0xec, 0x67, 0x27, 0x75, 0x1c, 0x77, 0x68,
+	0x67, 0x53, 0x76, 0xb1, 0xcf, 0xb9, 0xd7, 0xf7, 0x9c, 0xf7, 0xef, 0xbe, 0xc0, 0x16, 0xc2, 0xfe,
+	0x40, 0xd3, 0x0d, 0x57, 0xfb, 0xf2, 0x46, 0x1f, 0xfb, 0xe8, 0x86, 0xe6, 0xdf, 0x57, 0x5d, 0x8f,
+	0xf8, 0x44, 0xdc, 0x9c, 0x42, 0xaa, 0x6e, 0xb8, 0x6a, 0x08, 0xc9, 0x8a, 0x4e, 0xa8, 0x4d, 0xa8,
+	0xd6, 0x47, 0x14, 0x73, 0xbe, 0x4e, 0x2c, 0x87, 0x45, 0xc8, 0x5b, 0x0c, 0x3f, 0x0e, 0x9e, 0x34,
+	0xf6, 0x10, 0x42, 0x35, 0x93, 0x98, 0x84, 0xbd, 0x9f, 0xfe, 0x8a, 0x02, 0x4c, 0x42, 0xcc, 0x11,
+	0xd6, 0x82, 0xa7, 0xfe, 0xc9, 0x3d, 0x0d, 0x39, 0x13, 0x06, 0x35, 0xfe, 0x12, 0xe0, 0x7f, 0x1d,
+	0x6a, 0x1e, 0x7a, 0x18, 0xf9, 0xf8, 0xb0, 0x7d, 0x24, 0xbe, 0x05, 0xab, 0x14, 0x3b, 0x06, 0xf6,
+	0x24, 0xa1, 0x2e, 0xec, 0x56, 0x5a, 0xd2, 0xef, 0x0f, 0xf7, 0x6b, 0xe1, 0x37, 0x6e, 0x1b, 0x86,
+	0x87, 0x29, 0xed, 0xf9, 0x9e, 0xe5, 0x98, 0xdd, 0x90, 0x27, 0x7e, 0x08, 0xa0, 0x93, 0xd1, 0x08,
+	0xf9, 0xd8, 0x43, 0x23, 0xa9, 0x50, 0x17, 0x76, 0xab, 0x07, 0x5b, 0x6a, 0x18, 0x32, 0xd5, 0x10,
+	0x09, 0x53, 0x0f, 0x89, 0xe5, 0xb4, 0x4a, 0x8f, 0x9e, 0x6c, 0xaf, 0x74, 0x13, 0x21, 0xe2, 0x07,
+	0x50, 0x71, 0x3d, 0xcb, 0xd1, 0x2d, 0x17, 0x8d, 0xa4, 0x62, 0xbe, 0xf8, 0x38, 0x42, 0x7c, 0x03,
+	0x5e, 0x89, 0x93, 0x1d, 0xfb, 0x13, 0x17, 0x4b, 0xa5, 0x69, 0xe9, 0xdd, 0x8d, 0xf8, 0xf5, 0xdd,
+	0x89, 0x8b, 0x1b, 0xef, 0x41, 0x2d, 0x29, 0xb5, 0x8b, 0xa9, 0x4b, 0x1c, 0x8a, 0xc5, 0x3a, 0xac,
+	0xea, 0x86, 0x7b, 0x6c, 0x19, 0x81, 0xe4, 0x52, 0xab, 0x72, 0xfa, 0x64, 0xbb, 0x7c, 0x68, 0xb8,
+	0x77, 0xda, 0xdd, 0xb2, 0x6e, 0xb8, 0x77, 0x8c, 0xc6, 0x83, 0x02, 0x40, 0x87, 0x9a, 0x6d, 0xec,
+	0x12, 0x6a, 0xf9, 0xe2, 0x2d, 0xa8, 0x18, 0xec, 0x27, 0x59, 0x6e, 0x53, 0x4c, 0x15, 0x55, 0x28,
+	0x93, 0xb1, 0x83, 0x3d, 0xa9, 0xb0, 0x24, 0x86, 0xd1, 0xe6, 0x9c, 0x2d, 0x3e, 0xbb, 0xb3, 0x79,
+	0xad, 0x49, 0x58, 0x50, 0x5e, 0x60, 0x41, 0x0d, 0xc4, 0xd8, 0x81, 0xc8, 0xba, 0xc6, 0x57, 0x05,
+	0xa8, 0x76, 0xa8, 0xf9, 0xa9, 0xe5, 0x0f, 0x0c, 0x0f, 0x8d, 0x5f, 0x4a, 0x67, 0x5e, 0x83, 0x57,
+	0x13, 0x16, 0x70, 0x6b, 0x7e, 0x15, 0x02, 0x6b, 0xda, 0x1e, 0x1a, 0xb7, 0x71, 0xdf, 0x3f, 0xc7,
+	0xc2, 0x4a, 0xa9, 0xb1, 0x90, 0x5a, 0xe3, 0xbf, 0x5c, 0x40, 0xb1, 0xc4, 0x52, 0xa6, 0xc4, 0x48,
+	0x0a, 0x97, 0xf8, 0x37, 0xdb, 0x3c, 0xba, 0xd8, 0x45, 0x93, 0x17, 0xad, 0xf1, 0x7d, 0x58, 0x73,
+	0xd1, 0xc4, 0xc6, 0x8e, 0x9f, 0x57, 0x61, 0xc4, 0x5f, 0xae, 0x2f, 0x9e, 0x7e, 0xe5, 0x5c, 0xd3,
+	0xaf, 0x71, 0x19, 0x6a, 0x49, 0xdd, 0xdc, 0x90, 0x9f, 0x98, 0x21, 0x9f, 0x58, 0x5f, 0x9c, 0x58,
+	0x06, 0xf2, 0xf1, 0xd4, 0x90, 0x21, 0xc6, 0x6e, 0x1e, 0x43, 0x18, 0x4f, 0xbc, 0x09, 0xeb, 0x7d,
+	0xe2, 0x79, 0x64, 0x9c, 0x63, 0x31, 0x70, 0x66, 0x9a, 0x8d, 0xc5, 0x25, 0xd3, 0x79, 0xd1, 0x58,
+	0x33, 0x6d, 0x5c, 0x02, 0xd7, 0xf6, 0xb3, 0x00, 0x1b, 0x1d, 0x6a, 0xde, 0xf5, 0x90, 0x43, 0xef,
+	0x61, 0xef, 0x7c, 0x67, 0xc5, 0x2d, 0xa8, 0x78, 0x58, 0xb7, 0x5c, 0x6b, 0x3a, 0x8e, 0xcb, 0xe4,
+	0xc5, 0xd4, 0xe7, 0xa9, 0x4f, 0x82, 0xcb, 0xb3, 0x32, 0xb8, 0xc2, 0x5f, 0x04, 0xb8, 0xd4, 0xa1,
+	0x66, 0x0f, 0xfb, 0x87, 0xed, 0xa3, 0x0e, 0x72, 0x90, 0x89, 0x3d, 0xfa, 0x22, 0xe7, 0x74, 0x5c,
+	0x6c, 0x71, 0xc1, 0xc4, 0xbc, 0x09, 0xeb, 0x76, 0x58, 0x88, 0x54, 0xaa, 0x17, 0xb3, 0x67, 0x43,
+	0xc4, 0x6c, 0x5c, 0x81, 0xad, 0xa7, 0x74, 0x70, 0x95, 0xdf, 0x15, 0x60, 0x33, 0xde, 0xc9, 0x5b,
+	0x88, 0x0e, 0xf1, 0x4b, 0x79, 0xa2, 0x89, 0xdb, 0x50, 0xed, 0x07, 0xea, 0x59, 0x9a, 0xd5, 0x20,
+	0x0d, 0xb0, 0x57, 0x41, 0xbf, 0x20, 0x83, 0x34, 0x6f, 0x14, 0x77, 0xf1, 0xfb, 0x02, 0x5c, 0x4a,
+	0xec, 0xfa, 0xff, 0xd9, 0xb8, 0xd8, 0x46, 0x36, 0x1b, 0x67, 0x9d, 0xe2, 0x3e, 0x7e, 0xcd, 0x76,
+	0xcc, 0x1e, 0xf6, 0xfd, 0xd1, 0x39, 0xfb, 0xcf, 0xe7, 0xb7, 0xdc, 0xc2, 0xbd, 0x8f, 0x17, 0xc3,
+	0xab, 0x7c, 0x20, 0x04, 0x07, 0x60, 0x17, 0x1b, 0x18, 0xdb, 0x0c, 0x0e, 0x4e, 0x96, 0x67, 0x2f,
+	0xf6, 0x5d, 0x58, 0x45, 0x36, 0x39, 0x09, 0x77, 0xbf, 0x1c, 0xa3, 0x16, 0xd2, 0x1b, 0x57, 0xe1,
+	0x4a, 0x4a, 0x05, 0xbc, 0xc2, 0x71, 0xd0, 0xa0, 0x1e, 0x51, 0xbb, 0x63, 0x5d, 0x6c, 0x5d, 0xac,
+	0x2f, 0x0c, 0x3f, 0xcc, 0xcb, 0xf9, 0x86, 0x0d, 0xeb, 0x11, 0xb5, 0x59, 0xc5, 0x17, 0x58, 0x91,
+	0x58, 0x83, 0xb2, 0x81, 0x1d, 0x62, 0x87, 0x27, 0x04, 0x7b, 0x08, 0x87, 0x96, 0x17, 0xc4, 0x2b,
+	0xfd, 0x81, 0x55, 0xfa, 0xf1, 0x08, 0xd1, 0xc1, 0x05, 0x7b, 0x27, 0xbe, 0x03, 0x25, 0x9b, 0x9a,
+	0x54, 0x2a, 0xd6, 0x8b, 0xbb, 0xd5, 0x83, 0x9a, 0xca, 0xae, 0x69, 0x6a, 0x74, 0x4d, 0x53, 0x6f,
+	0x3b, 0x93, 0x56, 0xf5, 0xb7, 0x87, 0xfb, 0x6b, 0xd4, 0x18, 0xaa, 0xd3, 0xd1, 0x0f, 0xe8, 0xa1,
+	0x14, 0x5e, 0x71, 0x24, 0xe5, 0xe0, 0x47, 0x80, 0x62, 0x87, 0x9a, 0x62, 0x0f, 0x2a, 0xf1, 0x7d,
+	0x4e, 0x51, 0xe7, 0xef, 0x97, 0x6a, 0xf2, 0x12, 0x24, 0x37, 0xb3, 0x71, 0x7e, 0x49, 0xea, 0xc0,
+	0x5a, 0x74, 0xfd, 0x79, 0x3d, 0x35, 0x24, 0x44, 0xe5, 0xeb, 0x59, 0x28, 0x4f, 0x77, 0x04, 0xeb,
+	0xfc, 0xd2, 0x70, 0x35, 0x35, 0x22, 0x82, 0xe5, 0x9d, 0x4c, 0x38, 0x99, 0x91, 0xf7, 0xda, 0xe9,
+	0x19, 0x23, 0x58, 0xde, 0xc9, 0x84, 0x79, 0xc6, 0x1e, 0x54, 0xe2, 0xd6, 0x36, 0xdd, 0x47, 0x8e,
+	0xcb, 0xcd, 0x6c, 0x3c, 0x99, 0x34, 0x6e, 0x0f, 0xd3, 0x93, 0x72, 0x5c, 0x6e, 0x66, 0xe3, 0x3c,
+	0xe9, 0x67, 0x50, 0x4d, 0xf6, 0x65, 0xf5, 0xd4, 0xb0, 0x04, 0x43, 0xde, 0x5d, 0xc6, 0xe0, 0xa9,
+	0xfb, 0xb0, 0x31, 0xd7, 0x10, 0x5d, 0x4b, 0x8d, 0x9d, 0x25, 0xc9, 0x7b, 0x39, 0x48, 0xfc, 0x1b,
+	0xc7, 0xf0, 0xff, 0xd9, 0x76, 0xa4, 0x91, 0x35, 0x87, 0x18, 0x47, 0x7e, 0x73, 0x39, 0x27, 0x29,
+	0x62, 0xee, 0xa4, 0xbe, 0x96, 0x39, 0xa9, 0xc2, 0x4f, 0xec, 0xe5, 0x20, 0x25, 0x07, 0x36, 0x3e,
+	0xc5, 0x94, 0x45, 0xf2, 0x19, 0x2e, 0x37, 0xb3, 0x71, 0x9e, 0x74, 0x00, 0x9b, 0x4f, 0x1d, 0x3a,
+	0x3b, 0x0b, 0x66, 0xda, 0x2c, 0x4d, 0xde, 0xcf, 0x45, 0x4b, 0xae, 0xef, 0xe8, 0xf4, 0x48, 0x5f,
+	0xdf, 0x21, 0x2a, 0x5f, 0xcf, 0x42, 0x93, 0x6e, 0xc4, 0x9b, 0xbf, 0xb2, 0x28, 0x84, 0xe1, 0x72,
+	0x33, 0x1b, 0x4f, 0x26, 0x8d, 0xf7, 0xe9, 0xf4, 0xa4, 0x1c, 0x97, 0x9b, 0xd9, 0x78, 0x94, 0xb4,
+	0xf5, 0xd1, 0xa3, 0x53, 0x45, 0x78, 0x7c, 0xaa, 0x08, 0x7f, 0x9e, 0x2a, 0xc2, 0xb7, 0x67, 0xca,
+	0xca, 0xe3, 0x33, 0x65, 0xe5, 0x8f, 0x33, 0x65, 0xe5, 0xf3, 0x3d, 0xd3, 0xf2, 0x07, 0x27, 0x7d,
+	0x55, 0x27, 0xb6, 0x66, 0x93, 0xa1, 0xe5, 0x23, 0x07, 0xfb, 0x63, 0xe2, 0x0d, 0xb5, 0x69, 0x66,
+	0xec, 0x69, 0xf7, 0x83, 0x7f, 0xf4, 0xa6, 0x1d, 0x07, 0xed, 0xaf, 0x06, 0xbb, 0xf6, 0xdb, 0xff,
+	0x0c, 0x00, 0x52, 0x80, 0x06, 0x36, 0xea, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateCDP defines a method to create a new CDP.
+	CreateCDP(ctx context.Context, in *MsgCreateCDP, opts ...grpc.CallOption) (*MsgCreateCDPResponse, error)
+	// Deposit defines a method to deposit to a CDP.
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// Withdraw defines a method to withdraw collateral from a CDP.
+	Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	// DrawDebt defines a method to draw debt from a CDP.
+	DrawDebt(ctx context.Context, in *MsgDrawDebt, opts ...grpc.CallOption) (*MsgDrawDebtResponse, error)
+	// RepayDebt defines a method to repay debt from a CDP.
+	RepayDebt(ctx context.Context, in *MsgRepayDebt, opts ...grpc.CallOption) (*MsgRepayDebtResponse, error)
+	// Liquidate defines a method to attempt to liquidate a CDP whos
+	// collateralization ratio is under its liquidation ratio.
+	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+	// TransferCDP defines a method to move a CDP to a new owner.
+	TransferCDP(ctx context.Context, in *MsgTransferCDP, opts ...grpc.CallOption) (*MsgTransferCDPResponse, error)
+	// SetCDPManagers defines a method to replace the managers of a CDP.
+	SetCDPManagers(ctx context.Context, in *MsgSetCDPManagers, opts ...grpc.CallOption) (*MsgSetCDPManagersResponse, error)
+	// DepositBasket defines a method to add collateral of another collateral type to a CDP.
+	DepositBasket(ctx context.Context, in *MsgDepositBasket, opts ...grpc.CallOption) (*MsgDepositBasketResponse, error)
+	// WithdrawBasket defines a method to remove collateral of another collateral type from a CDP.
+	WithdrawBasket(ctx context.Context, in *MsgWithdrawBasket, opts ...grpc.CallOption) (*MsgWithdrawBasketResponse, error)
+	// SettleCDP defines a method to close a CDP during global settlement, returning its excess collateral.
+	SettleCDP(ctx context.Context, in *MsgSettleCDP, opts ...grpc.CallOption) (*MsgSettleCDPResponse, error)
+	// RedeemSettlement defines a method to redeem stable asset for collateral during global settlement.
+	RedeemSettlement(ctx context.Context, in *MsgRedeemSettlement, opts ...grpc.CallOption) (*MsgRedeemSettlementResponse, error)
+	// PsmMint defines a method to mint stable asset 1:1 against a stablecoin held by the peg stability module.
+	PsmMint(ctx context.Context, in *MsgPsmMint, opts ...grpc.CallOption) (*MsgPsmMintResponse, error)
+	// PsmRedeem defines a method to burn stable asset for a stablecoin held by the peg stability module.
+	PsmRedeem(ctx context.Context, in *MsgPsmRedeem, opts ...grpc.CallOption) (*MsgPsmRedeemResponse, error)
+	// FlashMint defines a method to mint stable asset, execute messages with it, and repay it with a fee in the same message.
+	FlashMint(ctx context.Context, in *MsgFlashMint, opts ...grpc.CallOption) (*MsgFlashMintResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateCDP(ctx context.Context, in *MsgCreateCDP, opts ...grpc.CallOption) (*MsgCreateCDPResponse, error) {
+	out := new(MsgCreateCDPResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/CreateCDP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) Withdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/Withdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DrawDebt(ctx context.Context, in *MsgDrawDebt, opts ...grpc.CallOption) (*MsgDrawDebtResponse, error) {
+	out := new(MsgDrawDebtResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/DrawDebt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RepayDebt(ctx context.Context, in *MsgRepayDebt, opts ...grpc.CallOption) (*MsgRepayDebtResponse, error) {
+	out := new(MsgRepayDebtResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/RepayDebt", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error) {
+	out := new(MsgLiquidateResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/Liquidate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) TransferCDP(ctx context.Context, in *MsgTransferCDP, opts ...grpc.CallOption) (*MsgTransferCDPResponse, error) {
+	out := new(MsgTransferCDPResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/TransferCDP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Collateral.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetCDPManagers(ctx context.Context, in *MsgSetCDPManagers, opts ...grpc.CallOption) (*MsgSetCDPManagersResponse, error) {
+	out := new(MsgSetCDPManagersResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/SetCDPManagers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DepositBasket(ctx context.Context, in *MsgDepositBasket, opts ...grpc.CallOption) (*MsgDepositBasketResponse, error) {
+	out := new(MsgDepositBasketResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/DepositBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgDrawDebt) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) WithdrawBasket(ctx context.Context, in *MsgWithdrawBasket, opts ...grpc.CallOption) (*MsgWithdrawBasketResponse, error) {
+	out := new(MsgWithdrawBasketResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/WithdrawBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SettleCDP(ctx context.Context, in *MsgSettleCDP, opts ...grpc.CallOption) (*MsgSettleCDPResponse, error) {
+	out := new(MsgSettleCDPResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/SettleCDP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RedeemSettlement(ctx context.Context, in *MsgRedeemSettlement, opts ...grpc.CallOption) (*MsgRedeemSettlementResponse, error) {
+	out := new(MsgRedeemSettlementResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/RedeemSettlement", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Principal.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgDrawDebtResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) PsmMint(ctx context.Context, in *MsgPsmMint, opts ...grpc.CallOption) (*MsgPsmMintResponse, error) {
+	out := new(MsgPsmMintResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/PsmMint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgRepayDebt) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) PsmRedeem(ctx context.Context, in *MsgPsmRedeem, opts ...grpc.CallOption) (*MsgPsmRedeemResponse, error) {
+	out := new(MsgPsmRedeemResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/PsmRedeem", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) FlashMint(ctx context.Context, in *MsgFlashMint, opts ...grpc.CallOption) (*MsgFlashMintResponse, error) {
+	out := new(MsgFlashMintResponse)
+	err := c.cc.Invoke(ctx, "/aeth.cdp.v1beta1.Msg/FlashMint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateCDP defines a method to create a new CDP.
+	CreateCDP(context.Context, *MsgCreateCDP) (*MsgCreateCDPResponse, error)
+	// Deposit defines a method to deposit to a CDP.
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// Withdraw defines a method to withdraw collateral from a CDP.
+	Withdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	// DrawDebt defines a method to draw debt from a CDP.
+	DrawDebt(context.Context, *MsgDrawDebt) (*MsgDrawDebtResponse, error)
+	// RepayDebt defines a method to repay debt from a CDP.
+	RepayDebt(context.Context, *MsgRepayDebt) (*MsgRepayDebtResponse, error)
+	// Liquidate defines a method to attempt to liquidate a CDP whos
+	// collateralization ratio is under its liquidation ratio.
+	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+	// TransferCDP defines a method to move a CDP to a new owner.
+	TransferCDP(context.Context, *MsgTransferCDP) (*MsgTransferCDPResponse, error)
+	// SetCDPManagers defines a method to replace the managers of a CDP.
+	SetCDPManagers(context.Context, *MsgSetCDPManagers) (*MsgSetCDPManagersResponse, error)
+	// DepositBasket defines a method to add collateral of another collateral type to a CDP.
+	DepositBasket(context.Context, *MsgDepositBasket) (*MsgDepositBasketResponse, error)
+	// WithdrawBasket defines a method to remove collateral of another collateral type from a CDP.
+	WithdrawBasket(context.Context, *MsgWithdrawBasket) (*MsgWithdrawBasketResponse, error)
+	// SettleCDP defines a method to close a CDP during global settlement, returning its excess collateral.
+	SettleCDP(context.Context, *MsgSettleCDP) (*MsgSettleCDPResponse, error)
+	// RedeemSettlement defines a method to redeem stable asset for collateral during global settlement.
+	RedeemSettlement(context.Context, *MsgRedeemSettlement) (*MsgRedeemSettlementResponse, error)
+	// PsmMint defines a method to mint stable asset 1:1 against a stablecoin held by the peg stability module.
+	PsmMint(context.Context, *MsgPsmMint) (*MsgPsmMintResponse, error)
+	// PsmRedeem defines a method to burn stable asset for a stablecoin held by the peg stability module.
+	PsmRedeem(context.Context, *MsgPsmRedeem) (*MsgPsmRedeemResponse, error)
+	// FlashMint defines a method to mint stable asset, execute messages with it, and repay it with a fee in the same message.
+	FlashMint(context.Context, *MsgFlashMint) (*MsgFlashMintResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateCDP(ctx context.Context, req *MsgCreateCDP) (*MsgCreateCDPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCDP not implemented")
+}
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedMsgServer) Withdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (*UnimplementedMsgServer) DrawDebt(ctx context.Context, req *MsgDrawDebt) (*MsgDrawDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawDebt not implemented")
+}
+func (*UnimplementedMsgServer) RepayDebt(ctx context.Context, req *MsgRepayDebt) (*MsgRepayDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayDebt not implemented")
+}
+func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
+}
+func (*UnimplementedMsgServer) TransferCDP(ctx context.Context, req *MsgTransferCDP) (*MsgTransferCDPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferCDP not implemented")
+}
+func (*UnimplementedMsgServer) SetCDPManagers(ctx context.Context, req *MsgSetCDPManagers) (*MsgSetCDPManagersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCDPManagers not implemented")
+}
+func (*UnimplementedMsgServer) DepositBasket(ctx context.Context, req *MsgDepositBasket) (*MsgDepositBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositBasket not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawBasket(ctx context.Context, req *MsgWithdrawBasket) (*MsgWithdrawBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawBasket not implemented")
+}
+func (*UnimplementedMsgServer) SettleCDP(ctx context.Context, req *MsgSettleCDP) (*MsgSettleCDPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleCDP not implemented")
+}
+func (*UnimplementedMsgServer) RedeemSettlement(ctx context.Context, req *MsgRedeemSettlement) (*MsgRedeemSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemSettlement not implemented")
+}
+func (*UnimplementedMsgServer) PsmMint(ctx context.Context, req *MsgPsmMint) (*MsgPsmMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PsmMint not implemented")
+}
+func (*UnimplementedMsgServer) PsmRedeem(ctx context.Context, req *MsgPsmRedeem) (*MsgPsmRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PsmRedeem not implemented")
+}
+func (*UnimplementedMsgServer) FlashMint(ctx context.Context, req *MsgFlashMint) (*MsgFlashMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlashMint not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCDP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCDP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/CreateCDP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCDP(ctx, req.(*MsgCreateCDP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdraw(ctx, req.(*MsgWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DrawDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDrawDebt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DrawDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/DrawDebt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DrawDebt(ctx, req.(*MsgDrawDebt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepayDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayDebt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/RepayDebt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayDebt(ctx, req.(*MsgRepayDebt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Liquidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/Liquidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Liquidate(ctx, req.(*MsgLiquidate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferCDP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferCDP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/TransferCDP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferCDP(ctx, req.(*MsgTransferCDP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCDPManagers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCDPManagers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCDPManagers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/SetCDPManagers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCDPManagers(ctx, req.(*MsgSetCDPManagers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositBasket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/DepositBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositBasket(ctx, req.(*MsgDepositBasket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawBasket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/WithdrawBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawBasket(ctx, req.(*MsgWithdrawBasket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SettleCDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleCDP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettleCDP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/SettleCDP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleCDP(ctx, req.(*MsgSettleCDP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemSettlement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/RedeemSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemSettlement(ctx, req.(*MsgRedeemSettlement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PsmMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPsmMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PsmMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/PsmMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PsmMint(ctx, req.(*MsgPsmMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PsmRedeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPsmRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PsmRedeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/PsmRedeem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PsmRedeem(ctx, req.(*MsgPsmRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FlashMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlashMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlashMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.cdp.v1beta1.Msg/FlashMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlashMint(ctx, req.(*MsgFlashMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "aeth.cdp.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCDP",
+			Handler:    _Msg_CreateCDP_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Msg_Withdraw_Handler,
+		},
+		{
+			MethodName: "DrawDebt",
+			Handler:    _Msg_DrawDebt_Handler,
+		},
+		{
+			MethodName: "RepayDebt",
+			Handler:    _Msg_RepayDebt_Handler,
+		},
+		{
+			MethodName: "Liquidate",
+			Handler:    _Msg_Liquidate_Handler,
+		},
+		{
+			MethodName: "TransferCDP",
+			Handler:    _Msg_TransferCDP_Handler,
+		},
+		{
+			MethodName: "SetCDPManagers",
+			Handler:    _Msg_SetCDPManagers_Handler,
+		},
+		{
+			MethodName: "DepositBasket",
+			Handler:    _Msg_DepositBasket_Handler,
+		},
+		{
+			MethodName: "WithdrawBasket",
+			Handler:    _Msg_WithdrawBasket_Handler,
+		},
+		{
+			MethodName: "SettleCDP",
+			Handler:    _Msg_SettleCDP_Handler,
+		},
+		{
+			MethodName: "RedeemSettlement",
+			Handler:    _Msg_RedeemSettlement_Handler,
+		},
+		{
+			MethodName: "PsmMint",
+			Handler:    _Msg_PsmMint_Handler,
+		},
+		{
+			MethodName: "PsmRedeem",
+			Handler:    _Msg_PsmRedeem_Handler,
+		},
+		{
+			MethodName: "FlashMint",
+			Handler:    _Msg_FlashMint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aeth/cdp/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrawDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrawDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrawDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Principal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDrawDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDrawDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDrawDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keeper) > 0 {
+		i -= len(m.Keeper)
+		copy(dAtA[i:], m.Keeper)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Keeper)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCDPManagers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCDPManagers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCDPManagers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Managers) > 0 {
+		for iNdEx := len(m.Managers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Managers[iNdEx])
+			copy(dAtA[i:], m.Managers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Managers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCDPManagersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCDPManagersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCDPManagersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositBasket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositBasket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositBasket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BasketType) > 0 {
+		i -= len(m.BasketType)
+		copy(dAtA[i:], m.BasketType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositBasketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositBasketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositBasketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawBasket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawBasket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawBasket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BasketType) > 0 {
+		i -= len(m.BasketType)
+		copy(dAtA[i:], m.BasketType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawBasketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawBasketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawBasketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleCDP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleCDP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleCDP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CdpID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleCDPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleCDPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleCDPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemSettlementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemSettlementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemSettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPsmMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPsmMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPsmMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPsmMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPsmMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPsmMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPsmRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPsmRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPsmRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPsmRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPsmRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPsmRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Principal.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDrawDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgDrawDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRepayDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Payment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRepayDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keeper)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgTransferCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCDPManagers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	if len(m.Managers) > 0 {
+		for _, s := range m.Managers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetCDPManagersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositBasket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	l = len(m.BasketType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositBasketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawBasket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	l = len(m.BasketType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawBasketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSettleCDP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CdpID != 0 {
+		n += 1 + sovTx(uint64(m.CdpID))
+	}
+	return n
+}
+
+func (m *MsgSettleCDPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemSettlementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPsmMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPsmMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPsmRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPsmRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFlashMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFlashMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCDP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCDP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCDPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrawDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrawDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDrawDebtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDrawDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayDebtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keeper = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferCDP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferCDP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferCDP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferCDPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferCDPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCDPManagers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCDPManagers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCDPManagers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Managers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Managers = append(m.Managers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCDPManagersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCDPManagersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCDPManagersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositBasket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositBasket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositBasket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Payment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgRepayDebtResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgLiquidate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Keeper)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Borrower)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CollateralType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDepositBasketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositBasketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgLiquidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawBasket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1655,15 +6171,15 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCDP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawBasket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCDP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawBasket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1691,13 +6207,13 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1707,28 +6223,27 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1755,7 +6270,7 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1791,6 +6306,57 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 			}
 			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
+			}
+			m.CdpID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CdpID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1812,7 +6378,7 @@ func (m *MsgCreateCDP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawBasketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1835,31 +6401,12 @@ func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateCDPResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
-			}
-			m.CdpID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CdpID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			return fmt.Errorf("proto: MsgWithdrawBasketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1881,7 +6428,7 @@ func (m *MsgCreateCDPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleCDP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1904,15 +6451,15 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleCDP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleCDP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1940,11 +6487,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1972,46 +6519,13 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpID", wireType)
 			}
-			var stringLen uint64
+			m.CdpID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2021,24 +6535,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CdpID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2060,7 +6561,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSettleCDPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2083,10 +6584,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSettleCDPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSettleCDPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2110,7 +6611,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2133,15 +6634,15 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2169,43 +6670,11 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2232,42 +6701,10 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2289,7 +6726,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemSettlementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2312,10 +6749,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemSettlementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemSettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2339,7 +6776,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
+func (m *MsgPsmMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2362,10 +6799,10 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDrawDebt: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPsmMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDrawDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPsmMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2402,39 +6839,7 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2461,7 +6866,7 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2486,7 +6891,7 @@ func (m *MsgDrawDebt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPsmMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2509,10 +6914,10 @@ func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDrawDebtResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPsmMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDrawDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPsmMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2536,7 +6941,7 @@ func (m *MsgDrawDebtResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
+func (m *MsgPsmRedeem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2559,10 +6964,10 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayDebt: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPsmRedeem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPsmRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2599,9 +7004,9 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2611,29 +7016,30 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2643,24 +7049,23 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Payment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2683,7 +7088,7 @@ func (m *MsgRepayDebt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPsmRedeemResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2706,10 +7111,10 @@ func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayDebtResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPsmRedeemResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPsmRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2733,7 +7138,7 @@ func (m *MsgRepayDebtResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2756,15 +7161,15 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2792,13 +7197,13 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keeper = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2808,29 +7213,30 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2840,23 +7246,25 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2879,7 +7287,7 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2902,10 +7310,10 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
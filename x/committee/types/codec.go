@@ -11,6 +11,8 @@ import (
 	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	aethdisttypes "github.com/mokitanetwork/aether/x/aethdist/types"
+	cdptypes "github.com/mokitanetwork/aether/x/cdp/types"
+	pricefeedtypes "github.com/mokitanetwork/aether/x/pricefeed/types"
 )
 
 var (
@@ -37,6 +39,8 @@ func init() {
 	RegisterProposalTypeCodec(govtypes.TextProposal{}, "cosmos-sdk/TextProposal")
 	RegisterProposalTypeCodec(upgradetypes.SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal")
 	RegisterProposalTypeCodec(upgradetypes.CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal")
+	RegisterProposalTypeCodec(pricefeedtypes.ClearStaleMarketProposal{}, "pricefeed/ClearStaleMarketProposal")
+	RegisterProposalTypeCodec(cdptypes.GlobalSettlementProposal{}, "cdp/GlobalSettlementProposal")
 }
 
 // RegisterLegacyAminoCodec registers all the necessary types and interfaces for the module.
@@ -58,6 +62,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(TextPermission{}, "aeth/TextPermission", nil)
 	cdc.RegisterConcrete(SoftwareUpgradePermission{}, "aeth/SoftwareUpgradePermission", nil)
 	cdc.RegisterConcrete(ParamsChangePermission{}, "aeth/ParamsChangePermission", nil)
+	cdc.RegisterConcrete(ClearStaleMarketPermission{}, "aeth/ClearStaleMarketPermission", nil)
+	cdc.RegisterConcrete(GlobalSettlementPermission{}, "aeth/GlobalSettlementPermission", nil)
 
 	// Msgs
 	cdc.RegisterConcrete(&MsgSubmitProposal{}, "aeth/MsgSubmitProposal", nil)
@@ -93,6 +99,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&TextPermission{},
 		&SoftwareUpgradePermission{},
 		&ParamsChangePermission{},
+		&ClearStaleMarketPermission{},
+		&GlobalSettlementPermission{},
 	)
 
 	// Need to register PubProposal here since we use this as alias for the x/gov Content interface for all the proposal implementations used in this module.
@@ -107,6 +115,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&proposaltypes.ParameterChangeProposal{},
 		&upgradetypes.SoftwareUpgradeProposal{},
 		&upgradetypes.CancelSoftwareUpgradeProposal{},
+		&pricefeedtypes.ClearStaleMarketProposal{},
+		&cdptypes.GlobalSettlementProposal{},
 	)
 
 	registry.RegisterImplementations(
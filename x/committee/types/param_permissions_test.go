@@ -702,14 +702,24 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_SliceSubparamComparis
 				"base_asset": "xrp",
 				"quote_asset": "usdx",
 				"oracles": [],
-				"active": true
+				"active": true,
+				"twap_window": "0",
+				"max_price_change": "0",
+				"outlier_band": "0",
+				"source_markets": null,
+				"max_reference_deviation": "0"
 			},
 			{
 				"market_id": "btc:usd",
 				"base_asset": "btc",
 				"quote_asset": "usd",
 				"oracles": ["%s"],
-				"active": false
+				"active": false,
+				"twap_window": "0",
+				"max_price_change": "0",
+				"outlier_band": "0",
+				"source_markets": null,
+				"max_reference_deviation": "0"
 			}]`, oracles[1].String()),
 		},
 		{
@@ -720,14 +730,24 @@ func (s *ParamsChangeTestSuite) TestParamsChangePermission_SliceSubparamComparis
 				"base_asset": "xrp",
 				"quote_asset": "usdx",
 				"oracles": ["%s"],
-				"active": true
+				"active": true,
+				"twap_window": "0",
+				"max_price_change": "0",
+				"outlier_band": "0",
+				"source_markets": null,
+				"max_reference_deviation": "0"
 			},
 			{
 				"market_id": "btc:usd",
 				"base_asset": "btc",
 				"quote_asset": "usd",
 				"oracles": ["%s"],
-				"active": false
+				"active": false,
+				"twap_window": "0",
+				"max_price_change": "0",
+				"outlier_band": "0",
+				"source_markets": null,
+				"max_reference_deviation": "0"
 			}]`, oracles[0].String(), oracles[2].String()),
 		},
 	}
@@ -12,6 +12,9 @@ import (
 	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	proto "github.com/gogo/protobuf/proto"
+
+	cdptypes "github.com/mokitanetwork/aether/x/cdp/types"
+	pricefeedtypes "github.com/mokitanetwork/aether/x/pricefeed/types"
 )
 
 func init() {
@@ -22,6 +25,8 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(TextPermission{}, "aeth/TextPermission")
 	govtypes.RegisterProposalTypeCodec(SoftwareUpgradePermission{}, "aeth/SoftwareUpgradePermission")
 	govtypes.RegisterProposalTypeCodec(ParamsChangePermission{}, "aeth/ParamsChangePermission")
+	govtypes.RegisterProposalTypeCodec(ClearStaleMarketPermission{}, "aeth/ClearStaleMarketPermission")
+	govtypes.RegisterProposalTypeCodec(GlobalSettlementPermission{}, "aeth/GlobalSettlementPermission")
 }
 
 // Permission is anything with a method that validates whether a proposal is allowed by it or not.
@@ -63,6 +68,8 @@ var (
 	_ Permission = TextPermission{}
 	_ Permission = SoftwareUpgradePermission{}
 	_ Permission = ParamsChangePermission{}
+	_ Permission = ClearStaleMarketPermission{}
+	_ Permission = GlobalSettlementPermission{}
 )
 
 // Allows implement permission interface for GodPermission.
@@ -80,6 +87,18 @@ func (SoftwareUpgradePermission) Allows(_ sdk.Context, _ ParamKeeper, p PubPropo
 	return ok
 }
 
+// Allows implement permission interface for ClearStaleMarketPermission.
+func (ClearStaleMarketPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(*pricefeedtypes.ClearStaleMarketProposal)
+	return ok
+}
+
+// Allows implement permission interface for GlobalSettlementPermission.
+func (GlobalSettlementPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(*cdptypes.GlobalSettlementProposal)
+	return ok
+}
+
 // Allows implement permission interface for ParamsChangePermission.
 func (perm ParamsChangePermission) Allows(ctx sdk.Context, pk ParamKeeper, p PubProposal) bool {
 	proposal, ok := p.(*paramsproposal.ParameterChangeProposal)
@@ -32,7 +32,7 @@ func (m *GodPermission) Reset()         { *m = GodPermission{} }
 func (m *GodPermission) String() string { return proto.CompactTextString(m) }
 func (*GodPermission) ProtoMessage()    {}
 func (*GodPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{0}
+	return fileDescriptor_f210e9726ca3b32a, []int{0}
 }
 func (m *GodPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -69,7 +69,7 @@ func (m *SoftwareUpgradePermission) Reset()         { *m = SoftwareUpgradePermis
 func (m *SoftwareUpgradePermission) String() string { return proto.CompactTextString(m) }
 func (*SoftwareUpgradePermission) ProtoMessage()    {}
 func (*SoftwareUpgradePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{1}
+	return fileDescriptor_f210e9726ca3b32a, []int{1}
 }
 func (m *SoftwareUpgradePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -106,7 +106,7 @@ func (m *TextPermission) Reset()         { *m = TextPermission{} }
 func (m *TextPermission) String() string { return proto.CompactTextString(m) }
 func (*TextPermission) ProtoMessage()    {}
 func (*TextPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{2}
+	return fileDescriptor_f210e9726ca3b32a, []int{2}
 }
 func (m *TextPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,7 +144,7 @@ func (m *ParamsChangePermission) Reset()         { *m = ParamsChangePermission{}
 func (m *ParamsChangePermission) String() string { return proto.CompactTextString(m) }
 func (*ParamsChangePermission) ProtoMessage()    {}
 func (*ParamsChangePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{3}
+	return fileDescriptor_f210e9726ca3b32a, []int{3}
 }
 func (m *ParamsChangePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,7 +196,7 @@ func (m *AllowedParamsChange) Reset()         { *m = AllowedParamsChange{} }
 func (m *AllowedParamsChange) String() string { return proto.CompactTextString(m) }
 func (*AllowedParamsChange) ProtoMessage()    {}
 func (*AllowedParamsChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{4}
+	return fileDescriptor_f210e9726ca3b32a, []int{4}
 }
 func (m *AllowedParamsChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +267,7 @@ func (m *SubparamRequirement) Reset()         { *m = SubparamRequirement{} }
 func (m *SubparamRequirement) String() string { return proto.CompactTextString(m) }
 func (*SubparamRequirement) ProtoMessage()    {}
 func (*SubparamRequirement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{5}
+	return fileDescriptor_f210e9726ca3b32a, []int{5}
 }
 func (m *SubparamRequirement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,6 +317,80 @@ func (m *SubparamRequirement) GetAllowedSubparamAttrChanges() []string {
 	return nil
 }
 
+// ClearStaleMarketPermission allows proposals that clear the stale flag of pricefeed markets.
+type ClearStaleMarketPermission struct {
+}
+
+func (m *ClearStaleMarketPermission) Reset()         { *m = ClearStaleMarketPermission{} }
+func (m *ClearStaleMarketPermission) String() string { return proto.CompactTextString(m) }
+func (*ClearStaleMarketPermission) ProtoMessage()    {}
+func (*ClearStaleMarketPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f210e9726ca3b32a, []int{6}
+}
+func (m *ClearStaleMarketPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClearStaleMarketPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClearStaleMarketPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClearStaleMarketPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearStaleMarketPermission.Merge(m, src)
+}
+func (m *ClearStaleMarketPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClearStaleMarketPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearStaleMarketPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearStaleMarketPermission proto.InternalMessageInfo
+
+// GlobalSettlementPermission allows proposals that start global settlement of the cdp system.
+type GlobalSettlementPermission struct {
+}
+
+func (m *GlobalSettlementPermission) Reset()         { *m = GlobalSettlementPermission{} }
+func (m *GlobalSettlementPermission) String() string { return proto.CompactTextString(m) }
+func (*GlobalSettlementPermission) ProtoMessage()    {}
+func (*GlobalSettlementPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f210e9726ca3b32a, []int{7}
+}
+func (m *GlobalSettlementPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalSettlementPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GlobalSettlementPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GlobalSettlementPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalSettlementPermission.Merge(m, src)
+}
+func (m *GlobalSettlementPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalSettlementPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalSettlementPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalSettlementPermission proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GodPermission)(nil), "aeth.committee.v1beta1.GodPermission")
 	proto.RegisterType((*SoftwareUpgradePermission)(nil), "aeth.committee.v1beta1.SoftwareUpgradePermission")
@@ -324,43 +398,47 @@ func init() {
 	proto.RegisterType((*ParamsChangePermission)(nil), "aeth.committee.v1beta1.ParamsChangePermission")
 	proto.RegisterType((*AllowedParamsChange)(nil), "aeth.committee.v1beta1.AllowedParamsChange")
 	proto.RegisterType((*SubparamRequirement)(nil), "aeth.committee.v1beta1.SubparamRequirement")
+	proto.RegisterType((*ClearStaleMarketPermission)(nil), "aeth.committee.v1beta1.ClearStaleMarketPermission")
+	proto.RegisterType((*GlobalSettlementPermission)(nil), "aeth.committee.v1beta1.GlobalSettlementPermission")
 }
 
 func init() {
-	proto.RegisterFile("aeth/committee/v1beta1/permissions.proto", fileDescriptor_bdfaf7be16465ae4)
+	proto.RegisterFile("aeth/committee/v1beta1/permissions.proto", fileDescriptor_f210e9726ca3b32a)
 }
 
-var fileDescriptor_bdfaf7be16465ae4 = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_f210e9726ca3b32a = []byte{
+	// 486 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x80, 0xb3, 0xb8, 0x42, 0x74, 0x11, 0x55, 0xe5, 0x46, 0x91, 0x6b, 0x15, 0x27, 0xca, 0x29,
-	0x52, 0x54, 0x5b, 0x85, 0x1b, 0xb7, 0x04, 0x21, 0xae, 0x95, 0x0b, 0x17, 0x2e, 0xd6, 0x3a, 0x59,
-	0x5c, 0xab, 0x76, 0xd6, 0xec, 0x8c, 0xd3, 0x56, 0x42, 0xe2, 0x15, 0x78, 0x0d, 0x38, 0xf3, 0x10,
-	0x15, 0xa7, 0x1e, 0x39, 0x01, 0x4a, 0x1e, 0x83, 0x0b, 0xda, 0xf5, 0xaf, 0x54, 0x2b, 0xb7, 0x99,
-	0xd9, 0x6f, 0x66, 0xfd, 0xed, 0x7a, 0xe9, 0xe4, 0x8a, 0xad, 0x99, 0xb7, 0x10, 0x69, 0x1a, 0x23,
-	0x72, 0xee, 0xad, 0xcf, 0x42, 0x8e, 0xec, 0xcc, 0xcb, 0xb8, 0x4c, 0x63, 0x80, 0x58, 0xac, 0xc0,
-	0xcd, 0xa4, 0x40, 0x61, 0x0e, 0x14, 0xe9, 0xd6, 0xa4, 0x5b, 0x92, 0xf6, 0xf1, 0x42, 0x40, 0x2a,
-	0x20, 0xd0, 0x94, 0x57, 0x24, 0x45, 0x8b, 0xdd, 0x8f, 0x44, 0x24, 0x8a, 0xba, 0x8a, 0x8a, 0xea,
-	0x78, 0x48, 0x9f, 0xbd, 0x15, 0xcb, 0xf3, 0x7a, 0x83, 0x57, 0x07, 0x3f, 0x7f, 0x9c, 0xd2, 0x26,
-	0x1f, 0x4f, 0xe9, 0xf1, 0x85, 0xf8, 0x88, 0xd7, 0x4c, 0xf2, 0xf7, 0x59, 0x24, 0xd9, 0x92, 0xef,
-	0x80, 0x47, 0xf4, 0xe0, 0x1d, 0xbf, 0xc1, 0x1d, 0xc4, 0x37, 0x42, 0x07, 0xe7, 0x4c, 0xb2, 0x14,
-	0x5e, 0x5f, 0xb2, 0x55, 0xd4, 0x1a, 0x66, 0x7e, 0xa1, 0x03, 0x96, 0x24, 0xe2, 0x9a, 0x2f, 0x83,
-	0x4c, 0x13, 0xc1, 0x42, 0x23, 0x60, 0x91, 0x91, 0x31, 0x79, 0xfa, 0x62, 0xea, 0x76, 0x4b, 0xbb,
-	0xb3, 0xa2, 0xab, 0x3d, 0x76, 0x7e, 0x72, 0xf7, 0x7b, 0xd8, 0xfb, 0xfe, 0x67, 0xd8, 0xef, 0x58,
-	0x04, 0xbf, 0xcf, 0x3a, 0xaa, 0x0f, 0xbe, 0xf5, 0x1f, 0xa1, 0x47, 0x1d, 0xed, 0xa6, 0x4d, 0x9f,
-	0x40, 0x1e, 0x42, 0xc6, 0x16, 0xdc, 0x22, 0x23, 0x32, 0xd9, 0xf7, 0xeb, 0xdc, 0x3c, 0xa4, 0xc6,
-	0x15, 0xbf, 0xb5, 0x1e, 0xe9, 0xb2, 0x0a, 0xcd, 0x19, 0x7d, 0x0e, 0xf1, 0x2a, 0x4a, 0x78, 0x00,
-	0x79, 0xa8, 0xc5, 0x82, 0x4a, 0x93, 0x21, 0x4a, 0xb0, 0x8c, 0x91, 0x31, 0xd9, 0xf7, 0xed, 0x02,
-	0xba, 0x28, 0x99, 0x72, 0xdf, 0x99, 0x22, 0x4c, 0xa0, 0x27, 0x69, 0x9e, 0x60, 0x5c, 0x4f, 0x80,
-	0x40, 0xf2, 0x4f, 0x79, 0x2c, 0x79, 0xca, 0x57, 0x08, 0xd6, 0xde, 0xee, 0xf3, 0xa9, 0x66, 0xfa,
-	0x4d, 0xcf, 0x7c, 0x4f, 0x9d, 0x8f, 0x6f, 0xeb, 0xb1, 0xd5, 0x3a, 0xb4, 0x00, 0x18, 0x7f, 0xa6,
-	0x47, 0x1d, 0x8d, 0x95, 0x20, 0x69, 0x04, 0x0f, 0xa9, 0xb1, 0x66, 0x49, 0xa5, 0xbc, 0x66, 0x89,
-	0x52, 0xae, 0x14, 0x1b, 0x67, 0x44, 0x59, 0x5f, 0x68, 0xa9, 0x5c, 0x42, 0xb5, 0x33, 0xa2, 0x2c,
-	0xef, 0x62, 0xfe, 0xe6, 0x6e, 0xe3, 0x90, 0xfb, 0x8d, 0x43, 0xfe, 0x6e, 0x1c, 0xf2, 0x75, 0xeb,
-	0xf4, 0xee, 0xb7, 0x4e, 0xef, 0xd7, 0xd6, 0xe9, 0x7d, 0x98, 0x46, 0x31, 0x5e, 0xe6, 0xa1, 0xf2,
-	0xf4, 0x94, 0xf0, 0x69, 0xc2, 0x42, 0xd0, 0x91, 0x77, 0xd3, 0x7a, 0x3b, 0x78, 0x9b, 0x71, 0x08,
-	0x1f, 0xeb, 0xbf, 0xfc, 0xe5, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x78, 0x09, 0x36, 0x56, 0x5a,
-	0x03, 0x00, 0x00,
+	0x10, 0x80, 0x63, 0x52, 0x21, 0xba, 0x88, 0xaa, 0x72, 0xa3, 0x28, 0xb5, 0x8a, 0x13, 0xe5, 0x14,
+	0xa9, 0xc5, 0x56, 0xe0, 0xc6, 0x2d, 0xe9, 0xa1, 0x07, 0x84, 0x54, 0x39, 0x70, 0xe1, 0x62, 0xad,
+	0x93, 0xc1, 0xb1, 0xb2, 0xf6, 0x9a, 0x9d, 0x71, 0xd2, 0x4a, 0x48, 0xbc, 0x02, 0xaf, 0x01, 0x67,
+	0x1e, 0xa2, 0xe2, 0xd4, 0x23, 0x27, 0x40, 0xc9, 0x63, 0x70, 0x41, 0xfe, 0x4d, 0x24, 0x4c, 0x6e,
+	0x9e, 0xd9, 0x6f, 0x66, 0xf7, 0x9b, 0xf5, 0xb2, 0x01, 0x07, 0x9a, 0xdb, 0x53, 0x19, 0x86, 0x01,
+	0x11, 0x80, 0xbd, 0x1c, 0x7a, 0x40, 0x7c, 0x68, 0xc7, 0xa0, 0xc2, 0x00, 0x31, 0x90, 0x11, 0x5a,
+	0xb1, 0x92, 0x24, 0xf5, 0x76, 0x4a, 0x5a, 0x15, 0x69, 0x15, 0xa4, 0x71, 0x3a, 0x95, 0x18, 0x4a,
+	0x74, 0x33, 0xca, 0xce, 0x83, 0xbc, 0xc4, 0x68, 0xf9, 0xd2, 0x97, 0x79, 0x3e, 0xfd, 0xca, 0xb3,
+	0xfd, 0x2e, 0x7b, 0x72, 0x25, 0x67, 0xd7, 0xd5, 0x06, 0x2f, 0x8f, 0xbe, 0x7f, 0x7b, 0xc6, 0xb6,
+	0x71, 0xff, 0x9c, 0x9d, 0x4e, 0xe4, 0x7b, 0x5a, 0x71, 0x05, 0x6f, 0x63, 0x5f, 0xf1, 0x19, 0xec,
+	0x81, 0x7b, 0xec, 0xe8, 0x0d, 0xdc, 0xd0, 0x1e, 0xe2, 0x8b, 0xc6, 0xda, 0xd7, 0x5c, 0xf1, 0x10,
+	0x2f, 0xe7, 0x3c, 0xf2, 0x77, 0x9a, 0xe9, 0x9f, 0x58, 0x9b, 0x0b, 0x21, 0x57, 0x30, 0x73, 0xe3,
+	0x8c, 0x70, 0xa7, 0x19, 0x82, 0x1d, 0xad, 0xd7, 0x1c, 0x3c, 0x7e, 0x7e, 0x6e, 0xd5, 0x4b, 0x5b,
+	0xa3, 0xbc, 0x6a, 0xb7, 0xed, 0xf8, 0xec, 0xee, 0x67, 0xb7, 0xf1, 0xf5, 0x57, 0xb7, 0x55, 0xb3,
+	0x88, 0x4e, 0x8b, 0xd7, 0x64, 0xff, 0x39, 0xeb, 0x1f, 0x8d, 0x9d, 0xd4, 0x94, 0xeb, 0x06, 0x7b,
+	0x84, 0x89, 0x87, 0x31, 0x9f, 0x42, 0x47, 0xeb, 0x69, 0x83, 0x43, 0xa7, 0x8a, 0xf5, 0x63, 0xd6,
+	0x5c, 0xc0, 0x6d, 0xe7, 0x41, 0x96, 0x4e, 0x3f, 0xf5, 0x11, 0x7b, 0x8a, 0x41, 0xe4, 0x0b, 0x70,
+	0x31, 0xf1, 0x32, 0x31, 0xb7, 0xd4, 0xe4, 0x44, 0x0a, 0x3b, 0xcd, 0x5e, 0x73, 0x70, 0xe8, 0x18,
+	0x39, 0x34, 0x29, 0x98, 0x62, 0xdf, 0x51, 0x4a, 0xe8, 0xc8, 0xce, 0xc2, 0x44, 0x50, 0x50, 0x75,
+	0x40, 0x57, 0xc1, 0x87, 0x24, 0x50, 0x10, 0x42, 0x44, 0xd8, 0x39, 0xd8, 0x3f, 0x9f, 0xb2, 0xa7,
+	0xb3, 0xad, 0x19, 0x1f, 0xa4, 0xf3, 0x71, 0x8c, 0xac, 0x6d, 0xb9, 0x8e, 0x3b, 0x00, 0xf6, 0x3f,
+	0xb2, 0x93, 0x9a, 0xc2, 0x52, 0x50, 0xdb, 0x0a, 0x1e, 0xb3, 0xe6, 0x92, 0x8b, 0x52, 0x79, 0xc9,
+	0x45, 0xaa, 0x5c, 0x2a, 0x6e, 0x9d, 0x89, 0x54, 0x75, 0xa1, 0x85, 0x72, 0x01, 0x55, 0xce, 0x44,
+	0xaa, 0xb8, 0x8b, 0xfe, 0x05, 0x33, 0x2e, 0x05, 0x70, 0x35, 0x21, 0x2e, 0xe0, 0x35, 0x57, 0x0b,
+	0xd8, 0xf7, 0x57, 0x5d, 0x30, 0xe3, 0x4a, 0x48, 0x8f, 0x8b, 0x09, 0x10, 0x89, 0xec, 0xa0, 0xff,
+	0xa7, 0xc7, 0xaf, 0xee, 0xd6, 0xa6, 0x76, 0xbf, 0x36, 0xb5, 0xdf, 0x6b, 0x53, 0xfb, 0xbc, 0x31,
+	0x1b, 0xf7, 0x1b, 0xb3, 0xf1, 0x63, 0x63, 0x36, 0xde, 0x0d, 0xfd, 0x80, 0xe6, 0x89, 0x97, 0xce,
+	0xd0, 0x0e, 0xe5, 0x22, 0x20, 0x1e, 0x01, 0xad, 0xa4, 0x5a, 0xd8, 0xe9, 0x68, 0x41, 0xd9, 0x37,
+	0x3b, 0xaf, 0x93, 0x6e, 0x63, 0x40, 0xef, 0x61, 0xf6, 0x8e, 0x5e, 0xfc, 0x1d, 0x00, 0x46, 0x8e,
+	0xd3, 0x11, 0xbc, 0x03, 0x00, 0x00,
 }
 
 func (m *GodPermission) Marshal() (dAtA []byte, err error) {
@@ -575,6 +653,52 @@ func (m *SubparamRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClearStaleMarketPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearStaleMarketPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearStaleMarketPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalSettlementPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalSettlementPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSettlementPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPermissions(v)
 	base := offset
@@ -680,6 +804,24 @@ func (m *SubparamRequirement) Size() (n int) {
 	return n
 }
 
+func (m *ClearStaleMarketPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GlobalSettlementPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovPermissions(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1246,6 +1388,106 @@ func (m *SubparamRequirement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClearStaleMarketPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClearStaleMarketPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClearStaleMarketPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalSettlementPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalSettlementPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalSettlementPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPermissions(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
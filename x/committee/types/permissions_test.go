@@ -10,7 +10,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
+	cdptypes "github.com/mokitanetwork/aether/x/cdp/types"
 	"github.com/mokitanetwork/aether/x/committee/types"
+	pricefeedtypes "github.com/mokitanetwork/aether/x/pricefeed/types"
 )
 
 func TestPackPermissions_Success(t *testing.T) {
@@ -186,3 +188,17 @@ func newTestParamsChangeProposalWithChanges(changes []paramsproposal.ParamChange
 		changes,
 	)
 }
+
+func TestClearStaleMarketPermission_Allows(t *testing.T) {
+	permission := types.ClearStaleMarketPermission{}
+
+	require.True(t, permission.Allows(sdk.Context{}, nil, pricefeedtypes.NewClearStaleMarketProposal("A Title", "A description.", "btc:usd")))
+	require.False(t, permission.Allows(sdk.Context{}, nil, govtypes.NewTextProposal("A Title", "A description.")))
+}
+
+func TestGlobalSettlementPermission_Allows(t *testing.T) {
+	permission := types.GlobalSettlementPermission{}
+
+	require.True(t, permission.Allows(sdk.Context{}, nil, cdptypes.NewGlobalSettlementProposal("A Title", "A description.")))
+	require.False(t, permission.Allows(sdk.Context{}, nil, pricefeedtypes.NewClearStaleMarketProposal("A Title", "A description.", "btc:usd")))
+}
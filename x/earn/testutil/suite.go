@@ -149,6 +149,9 @@ func (suite *Suite) SetupTest() {
 		hardtypes.DefaultTotalSupplied,
 		hardtypes.DefaultTotalBorrowed,
 		hardtypes.DefaultTotalReserves,
+		hardtypes.DefaultIsolationDebts,
+		hardtypes.DefaultNonCollateralDenoms,
+		hardtypes.DefaultAdaptiveRates,
 	)
 
 	savingsGS := savingstypes.NewGenesisState(
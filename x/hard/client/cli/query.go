@@ -43,6 +43,8 @@ func GetQueryCmd() *cobra.Command {
 		queryInterestRateCmd(),
 		queryReserves(),
 		queryInterestFactorsCmd(),
+		queryCollateralCmd(),
+		queryInterestRateCurveCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -532,3 +534,55 @@ func queryInterestFactorsCmd() *cobra.Command {
 
 	return cmd
 }
+
+func queryCollateralCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "collateral [owner]",
+		Short:   "get the collateral of an account",
+		Long:    "get the deposits of an account that back its borrows, and the denoms it doesn't use as collateral",
+		Example: fmt.Sprintf(`%[1]s q %[2]s collateral aeth1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Collateral(context.Background(), &types.QueryCollateralRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryInterestRateCurveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "interest-rate-curve [denom]",
+		Short:   "get the interest rate curve of a money market",
+		Long:    "get the borrow and supply APY of a money market at utilizations from 0% to 100%, using its current interest rate model",
+		Example: fmt.Sprintf(`%[1]s q %[2]s interest-rate-curve usdx`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.InterestRateCurve(context.Background(), &types.QueryInterestRateCurveRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
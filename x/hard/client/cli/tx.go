@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 
 	"github.com/mokitanetwork/aether/x/hard/types"
 )
@@ -31,6 +33,9 @@ func GetTxCmd() *cobra.Command {
 		getCmdBorrow(),
 		getCmdRepay(),
 		getCmdLiquidate(),
+		getCmdLiquidatePartial(),
+		getCmdFlashLoan(),
+		getCmdSetCollateral(),
 	}
 
 	for _, cmd := range cmds {
@@ -203,3 +208,107 @@ func getCmdLiquidate() *cobra.Command {
 		},
 	}
 }
+
+func getCmdLiquidatePartial() *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidate-partial [borrower-addr] [repay] [collateral-denom]",
+		Short: "repay part of the borrow of a borrower that's over their loan-to-value ratio",
+		Long: strings.TrimSpace(`repay part of the borrow of a borrower that's over their loan-to-value ratio, receiving their deposit of collateral-denom at a discount.
+The repay amount is capped by the close factor of the borrowed money market.`),
+		Args: cobra.ExactArgs(3),
+		Example: fmt.Sprintf(
+			`%s tx %s liquidate-partial aeth1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j 1000000usdx bnb --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			repay, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLiquidatePartial(clientCtx.GetFromAddress(), borrower, repay, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdFlashLoan() *cobra.Command {
+	return &cobra.Command{
+		Use:   "flash-loan [amount] [msg-tx-json-file]",
+		Short: "flash loan coins from hard for the messages in a tx file",
+		Long: strings.TrimSpace(`borrow coins from hard, execute the messages of a generated tx file, then repay the borrowed amount plus the flash loan fee of the money market.
+The messages must be signed only by the sender, and the flash loan fails if the sender does not hold the repayment once they have executed.`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s flash-loan 1000000000usdx tx.json --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgFlashLoan(clientCtx.GetFromAddress(), amount, theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdSetCollateral() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-collateral [denoms] [enabled]",
+		Short: "enable or disable the use of deposits as collateral",
+		Long: strings.TrimSpace(`enable or disable the use of deposits of a comma separated list of denoms as collateral for borrows.
+Deposits that aren't used as collateral don't count towards borrowing power and aren't seized in liquidations.`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%[1]s tx %[2]s set-collateral bnb,uaeth false --from <key>
+%[1]s tx %[2]s set-collateral bnb true --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCollateral(clientCtx.GetFromAddress(), strings.Split(args[0], ","), enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
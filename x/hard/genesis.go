@@ -39,6 +39,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 	k.SetBorrowedCoins(ctx, gs.TotalBorrowed)
 	k.SetTotalReserves(ctx, gs.TotalReserves)
 
+	for _, debt := range gs.IsolationDebts {
+		k.SetIsolationDebt(ctx, debt.Denom, debt.Amount)
+	}
+
+	for _, nonCollateral := range gs.NonCollateralDenoms {
+		k.SetNonCollateralDenoms(ctx, nonCollateral)
+	}
+
+	for _, rate := range gs.AdaptiveRates {
+		k.SetAdaptiveRate(ctx, rate.Denom, rate.RateAtTarget)
+	}
+
 	// check if the module account exists
 	DepositModuleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 	if DepositModuleAccount == nil {
@@ -87,6 +99,24 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		totalReserves = types.DefaultTotalReserves
 	}
 
+	isolationDebts := types.DefaultIsolationDebts
+	k.IterateIsolationDebts(ctx, func(denom string, debt sdk.Dec) bool {
+		isolationDebts = isolationDebts.Add(sdk.NewDecCoinFromDec(denom, debt))
+		return false
+	})
+
+	nonCollateralDenoms := types.NonCollateralDenomsList{}
+	k.IterateNonCollateralDenoms(ctx, func(ncd types.NonCollateralDenoms) bool {
+		nonCollateralDenoms = append(nonCollateralDenoms, ncd)
+		return false
+	})
+
+	adaptiveRates := types.AdaptiveRates{}
+	k.IterateAdaptiveRates(ctx, func(denom string, rateAtTarget sdk.Dec) bool {
+		adaptiveRates = append(adaptiveRates, types.NewAdaptiveRate(denom, rateAtTarget))
+		return false
+	})
+
 	for _, mm := range params.MoneyMarkets {
 		supplyFactor, f := k.GetSupplyInterestFactor(ctx, mm.Denom)
 		if !f {
@@ -111,6 +141,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	}
 	return types.NewGenesisState(
 		params, gats, deposits, borrows,
-		totalSupplied, totalBorrowed, totalReserves,
+		totalSupplied, totalBorrowed, totalReserves, isolationDebts,
+		nonCollateralDenoms, adaptiveRates,
 	)
 }
@@ -114,6 +114,13 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 		totalSupplied,
 		totalBorrowed,
 		sdk.Coins{},
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("uaeth", sdk.MustNewDecFromStr("10.5"))),
+		types.NonCollateralDenomsList{
+			types.NewNonCollateralDenoms(suite.addrs[0], []string{"uaeth"}),
+		},
+		types.AdaptiveRates{
+			types.NewAdaptiveRate("uaeth", sdk.MustNewDecFromStr("0.08")),
+		},
 	)
 
 	suite.NotPanics(
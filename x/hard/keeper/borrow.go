@@ -91,6 +91,7 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	// Update total borrowed amount by newly borrowed coins. Don't add user's pending interest as
 	// it has already been included in the total borrowed coins by the BeginBlocker.
 	k.IncrementBorrowedCoins(ctx, coins)
+	k.incrementIsolationDebt(ctx, borrower, coins)
 
 	if !hasExistingBorrow {
 		k.AfterBorrowCreated(ctx, borrow)
@@ -137,12 +138,18 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		if !found {
 			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
 		}
+		if moneyMarket.BorrowDisabled {
+			return sdkerrors.Wrapf(types.ErrBorrowDisabled, "%s", coin.Denom)
+		}
 
 		// Calculate this coin's USD value and add it borrow's total USD value
 		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
 		if err != nil {
 			return sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
+		if k.pricefeedKeeper.IsMarketStale(ctx, moneyMarket.SpotMarketID) {
+			return sdkerrors.Wrapf(types.ErrPriceStale, "price for market %s is stale", moneyMarket.SpotMarketID)
+		}
 		coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
 
 		// Validate the requested borrow value for the asset against the money market's global borrow limit
@@ -169,8 +176,17 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 	if !found {
 		return sdkerrors.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s", borrower)
 	}
+	collateral := k.GetCollateral(ctx, deposit)
+
+	// Isolated collateral only backs borrows of markets borrowable in isolation, up to its debt ceiling
+	if isolatedDenom, found := k.getIsolatedCollateralDenom(ctx, collateral); found {
+		if err := k.validateIsolatedBorrow(ctx, isolatedDenom, amount); err != nil {
+			return err
+		}
+	}
+
 	totalBorrowableAmount := sdk.ZeroDec()
-	for _, coin := range deposit.Amount {
+	for _, coin := range collateral {
 		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
 		if !found {
 			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
@@ -181,6 +197,9 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		if err != nil {
 			return sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
+		if k.pricefeedKeeper.IsMarketStale(ctx, moneyMarket.SpotMarketID) {
+			return sdkerrors.Wrapf(types.ErrPriceStale, "price for market %s is stale", moneyMarket.SpotMarketID)
+		}
 		depositUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
 		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
 		totalBorrowableAmount = totalBorrowableAmount.Add(borrowableAmountForDeposit)
@@ -201,6 +220,9 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 			if err != nil {
 				return sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 			}
+			if k.pricefeedKeeper.IsMarketStale(ctx, moneyMarket.SpotMarketID) {
+				return sdkerrors.Wrapf(types.ErrPriceStale, "price for market %s is stale", moneyMarket.SpotMarketID)
+			}
 			coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
 			existingBorrowUSDValue = existingBorrowUSDValue.Add(coinUSDValue)
 		}
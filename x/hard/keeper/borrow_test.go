@@ -321,7 +321,7 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				},
 				sdk.NewDec(10),
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
 			)
 
 			// Pricefeed module genesis state
@@ -476,6 +476,9 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 		types.DefaultTotalSupplied,
 		types.DefaultTotalBorrowed,
 		types.DefaultTotalReserves,
+		types.DefaultIsolationDebts,
+		types.DefaultNonCollateralDenoms,
+		types.DefaultAdaptiveRates,
 	)
 
 	// Pricefeed module genesis state
@@ -561,3 +564,118 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 	)
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestBorrow_TWAPMarket() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	borrower := addrs[0]
+	depositor := addrs[1]
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+
+	// Initialize test app and set context
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: tmtime.Now()})
+
+	// Auth module genesis state
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("uaeth", sdk.NewInt(100*AETH_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))),
+		},
+		[]sdk.AccAddress{borrower, depositor},
+	)
+
+	// Hard module genesis state, with the collateral valued by the aeth:usd TWAP
+	hardGS := types.NewGenesisState(
+		types.NewParams(
+			types.MoneyMarkets{
+				types.NewMoneyMarket("usdx",
+					types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")), // Borrow Limit
+					"usdx:usd",                     // Market ID
+					sdk.NewInt(USDX_CF),            // Conversion Factor
+					model,                          // Interest Rate Model
+					sdk.MustNewDecFromStr("0.05"),  // Reserve Factor
+					sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+				types.NewMoneyMarket("uaeth",
+					types.NewBorrowLimit(false, sdk.NewDec(100000000*AETH_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
+					"aeth:usd:twap",                // Market ID
+					sdk.NewInt(AETH_CF),            // Conversion Factor
+					model,                          // Interest Rate Model
+					sdk.MustNewDecFromStr("0.05"),  // Reserve Factor
+					sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+			},
+			sdk.NewDec(10),
+		),
+		types.DefaultAccumulationTimes,
+		types.DefaultDeposits,
+		types.DefaultBorrows,
+		types.DefaultTotalSupplied,
+		types.DefaultTotalBorrowed,
+		types.DefaultTotalReserves,
+		types.DefaultIsolationDebts,
+		types.DefaultNonCollateralDenoms,
+		types.DefaultAdaptiveRates,
+	)
+
+	// Pricefeed module genesis state
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "aeth:usd", BaseAsset: "aeth", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, TwapWindow: time.Hour},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+		},
+	}
+
+	// Initialize test application
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)},
+	)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	setPrice := func(price sdk.Dec) {
+		_, err := pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "aeth:usd", price, suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+		suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.ctx, "aeth:usd"))
+	}
+
+	// aeth is priced at 2.00 and then spikes to 10.00 one minute later
+	startTime := suite.ctx.BlockTime()
+	setPrice(sdk.MustNewDecFromStr("2.00"))
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Minute))
+	setPrice(sdk.MustNewDecFromStr("10.00"))
+
+	// Run BeginBlocker once to transition MoneyMarkets
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF)))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("uaeth", sdk.NewInt(100*AETH_CF)))))
+
+	// the collateral is valued at the TWAP of 2.00 rather than the spot price of 10.00,
+	// so the borrow limit is 100 * 2.00 * 0.8 = 160 usdx
+	err := suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(150*USDX_CF))))
+	suite.Require().NoError(err)
+
+	// a stale market refuses new borrows even though its last accepted price is still stored
+	pricefeedKeeper.SetMarketStale(suite.ctx, "aeth:usd", pricefeedtypes.StaleReasonMaxPriceChange)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1*USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrPriceStale)
+}
@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/hard/types"
+)
+
+// IsCollateral returns true if deposits of denom back the depositor's borrows
+func (k Keeper) IsCollateral(ctx sdk.Context, depositor sdk.AccAddress, denom string) bool {
+	mm, found := k.GetMoneyMarket(ctx, denom)
+	if !found || mm.CollateralDisabled {
+		return false
+	}
+	nonCollateral, found := k.GetNonCollateralDenoms(ctx, depositor)
+	return !found || !nonCollateral.Contains(denom)
+}
+
+// SetCollateral enables or disables the use of a depositor's deposits of denoms as collateral for their borrows
+func (k Keeper) SetCollateral(ctx sdk.Context, depositor sdk.AccAddress, denoms []string, enabled bool) error {
+	for _, denom := range denoms {
+		mm, found := k.GetMoneyMarket(ctx, denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", denom)
+		}
+		if enabled && mm.CollateralDisabled {
+			return sdkerrors.Wrapf(types.ErrCollateralDisabled, "%s", denom)
+		}
+	}
+
+	deposit, hasDeposit := k.GetSyncedDeposit(ctx, depositor)
+	borrow, hasBorrow := k.GetSyncedBorrow(ctx, depositor)
+	previousIsolatedDenom, _ := k.getBorrowerIsolatedCollateralDenom(ctx, depositor)
+
+	previous, found := k.GetNonCollateralDenoms(ctx, depositor)
+	if !found {
+		previous = types.NewNonCollateralDenoms(depositor, nil)
+	}
+	nonCollateral := previous
+	for _, denom := range denoms {
+		if enabled {
+			nonCollateral = nonCollateral.Remove(denom)
+		} else {
+			nonCollateral = nonCollateral.Add(denom)
+		}
+	}
+	k.SetNonCollateralDenoms(ctx, nonCollateral)
+
+	if err := k.validateCollateralChange(ctx, deposit, hasDeposit, borrow, hasBorrow, previousIsolatedDenom); err != nil {
+		k.SetNonCollateralDenoms(ctx, previous)
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardSetCollateral,
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyDenoms, strings.Join(denoms, ",")),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+	return nil
+}
+
+// validateCollateralChange checks a depositor's collateral, after a change to their non-collateral denoms, still
+// follows the isolation rules and backs their borrows
+func (k Keeper) validateCollateralChange(ctx sdk.Context, deposit types.Deposit, hasDeposit bool,
+	borrow types.Borrow, hasBorrow bool, previousIsolatedDenom string,
+) error {
+	if !hasDeposit {
+		return nil
+	}
+	collateral := k.GetCollateral(ctx, deposit)
+	if err := k.validateIsolatedCollateral(ctx, collateral); err != nil {
+		return err
+	}
+	if !hasBorrow {
+		return nil
+	}
+	isolatedDenom, _ := k.getIsolatedCollateralDenom(ctx, collateral)
+	if isolatedDenom != previousIsolatedDenom {
+		return sdkerrors.Wrapf(types.ErrIsolatedCollateralInUse, "cannot change isolated collateral from '%s' to '%s'", previousIsolatedDenom, isolatedDenom)
+	}
+	valid, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return sdkerrors.Wrapf(types.ErrInsufficientLoanToValue, "remaining collateral %s does not back borrow %s", collateral, borrow.Amount)
+	}
+	return nil
+}
+
+// GetCollateral returns the coins of a deposit that back the depositor's borrows
+func (k Keeper) GetCollateral(ctx sdk.Context, deposit types.Deposit) sdk.Coins {
+	collateral := sdk.NewCoins()
+	for _, coin := range deposit.Amount {
+		if k.IsCollateral(ctx, deposit.Depositor, coin.Denom) {
+			collateral = collateral.Add(coin)
+		}
+	}
+	return collateral
+}
+
+// getIsolatedCollateralDenom returns the denom of the first isolated market in collateral
+func (k Keeper) getIsolatedCollateralDenom(ctx sdk.Context, collateral sdk.Coins) (string, bool) {
+	for _, coin := range collateral {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if found && mm.Isolated {
+			return coin.Denom, true
+		}
+	}
+	return "", false
+}
+
+// validateIsolatedCollateral checks that isolated collateral is not combined with other collateral
+func (k Keeper) validateIsolatedCollateral(ctx sdk.Context, collateral sdk.Coins) error {
+	isolatedDenom, found := k.getIsolatedCollateralDenom(ctx, collateral)
+	if found && len(collateral) > 1 {
+		return sdkerrors.Wrapf(types.ErrIsolatedCollateralMixed, "%s with %s", isolatedDenom, collateral)
+	}
+	return nil
+}
+
+// validateIsolatedBorrow checks a borrow backed by isolated collateral is of markets borrowable in isolation, and
+// that it fits under the isolated market's debt ceiling
+func (k Keeper) validateIsolatedBorrow(ctx sdk.Context, isolatedDenom string, amount sdk.Coins) error {
+	for _, coin := range amount {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		if !mm.BorrowableInIsolation {
+			return sdkerrors.Wrapf(types.ErrNotBorrowableInIsolation, "%s against %s", coin.Denom, isolatedDenom)
+		}
+	}
+
+	isolatedMarket, found := k.GetMoneyMarket(ctx, isolatedDenom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", isolatedDenom)
+	}
+	debt, _ := k.GetIsolationDebt(ctx, isolatedDenom)
+	proposedDebt := debt.Add(k.isolationDebtValue(ctx, amount))
+	if proposedDebt.GT(isolatedMarket.GetIsolationDebtCeiling()) {
+		return sdkerrors.Wrapf(types.ErrExceedsIsolationDebtCeiling,
+			"proposed borrow would result in %s debt backed by %s, but the ceiling is %s",
+			proposedDebt, isolatedDenom, isolatedMarket.GetIsolationDebtCeiling())
+	}
+	return nil
+}
+
+// isolationDebtValue returns the value of coins in whole units of their markets
+func (k Keeper) isolationDebtValue(ctx sdk.Context, coins sdk.Coins) sdk.Dec {
+	value := sdk.ZeroDec()
+	for _, coin := range coins {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		value = value.Add(sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(mm.ConversionFactor)))
+	}
+	return value
+}
+
+// getBorrowerIsolatedCollateralDenom returns the denom of the borrower's isolated collateral, if they have any
+func (k Keeper) getBorrowerIsolatedCollateralDenom(ctx sdk.Context, borrower sdk.AccAddress) (string, bool) {
+	deposit, found := k.GetDeposit(ctx, borrower)
+	if !found {
+		return "", false
+	}
+	return k.getIsolatedCollateralDenom(ctx, k.GetCollateral(ctx, deposit))
+}
+
+// incrementIsolationDebt adds borrowed coins to the debt of the borrower's isolated collateral, if they have any
+func (k Keeper) incrementIsolationDebt(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) {
+	isolatedDenom, found := k.getBorrowerIsolatedCollateralDenom(ctx, borrower)
+	if !found {
+		return
+	}
+	debt, _ := k.GetIsolationDebt(ctx, isolatedDenom)
+	k.SetIsolationDebt(ctx, isolatedDenom, debt.Add(k.isolationDebtValue(ctx, coins)))
+}
+
+// decrementIsolationDebt removes repaid coins from the debt of the borrower's isolated collateral, if they have any.
+// Debt doesn't include interest, so it's floored at zero.
+func (k Keeper) decrementIsolationDebt(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) {
+	isolatedDenom, found := k.getBorrowerIsolatedCollateralDenom(ctx, borrower)
+	if !found {
+		return
+	}
+	debt, _ := k.GetIsolationDebt(ctx, isolatedDenom)
+	debt = debt.Sub(k.isolationDebtValue(ctx, coins))
+	if debt.IsNegative() {
+		debt = sdk.ZeroDec()
+	}
+	k.SetIsolationDebt(ctx, isolatedDenom, debt)
+}
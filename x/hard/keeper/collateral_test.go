@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/hard"
+	"github.com/mokitanetwork/aether/x/hard/types"
+	pricefeedtypes "github.com/mokitanetwork/aether/x/pricefeed/types"
+)
+
+// setupCollateralModes creates a usdx market borrowable in isolation, an isolated bnb market with a debt ceiling of
+// 50 usdx, and a uaeth market that can neither be used as collateral nor borrowed. The depositor supplies 1000 usdx.
+func (suite *KeeperTestSuite) setupCollateralModes() (borrower, keeper sdk.AccAddress) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testdepositor")))
+	borrower = sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper = sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))),
+			sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF)), sdk.NewCoin("uaeth", sdk.NewInt(100*AETH_CF)), sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+		},
+		[]sdk.AccAddress{depositor, borrower, keeper},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	usdxMarket := types.NewMoneyMarket("usdx",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+		"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	usdxMarket.BorrowableInIsolation = true
+	usdxMarket.CloseFactor = sdk.MustNewDecFromStr("0.5")
+	bnbMarket := types.NewMoneyMarket("bnb",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")),
+		"bnb:usd", sdk.NewInt(BNB_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	bnbMarket.Isolated = true
+	bnbMarket.IsolationDebtCeiling = sdk.NewDec(50)
+	aethMarket := types.NewMoneyMarket("uaeth",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*AETH_CF), sdk.MustNewDecFromStr("0.8")),
+		"aeth:usd", sdk.NewInt(AETH_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	aethMarket.CollateralDisabled = true
+	aethMarket.BorrowDisabled = true
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{usdxMarket, bnbMarket, aethMarket},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "aeth:usd", BaseAsset: "aeth", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+			{
+				MarketID:      "bnb:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("10.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+			{
+				MarketID:      "aeth:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("2.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	err := suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+	suite.Require().NoError(err)
+	return borrower, keeper
+}
+
+func (suite *KeeperTestSuite) TestIsolatedCollateral() {
+	borrower, _ := suite.setupCollateralModes()
+
+	err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF))))
+	suite.Require().NoError(err)
+
+	// isolated bnb cannot be combined with other collateral, but can be with deposits that aren't collateral
+	err = suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrIsolatedCollateralMixed)
+	err = suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("uaeth", sdk.NewInt(100*AETH_CF))))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("uaeth", sdk.NewInt(AETH_CF))))
+	suite.Require().ErrorIs(err, types.ErrBorrowDisabled)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(BNB_CF))))
+	suite.Require().ErrorIs(err, types.ErrNotBorrowableInIsolation)
+
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(40*USDX_CF))))
+	suite.Require().NoError(err)
+	debt, found := suite.keeper.GetIsolationDebt(suite.ctx, "bnb")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(40), debt)
+
+	// 10 bnb x $10.00 price x 0.8 LTV backs $80 of borrows, but the debt ceiling is 50 usdx
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(20*USDX_CF))))
+	suite.Require().ErrorIs(err, types.ErrExceedsIsolationDebtCeiling)
+
+	err = suite.keeper.Repay(suite.ctx, borrower, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(15*USDX_CF))))
+	suite.Require().NoError(err)
+	debt, _ = suite.keeper.GetIsolationDebt(suite.ctx, "bnb")
+	suite.Require().Equal(sdk.NewDec(25), debt)
+
+	exported := hard.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("bnb", sdk.NewDec(25))), exported.IsolationDebts)
+}
+
+func (suite *KeeperTestSuite) TestCollateralDisabledLiquidation() {
+	borrower, keeper := suite.setupCollateralModes()
+
+	err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF)), sdk.NewCoin("uaeth", sdk.NewInt(100*AETH_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(40*USDX_CF))))
+	suite.Require().NoError(err)
+
+	// the $200 of uaeth deposited doesn't back the borrow
+	ltv, err := suite.keeper.GetStoreLTV(suite.ctx, borrower)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.4"), ltv)
+
+	// The bnb price drops to $4.00, making the borrow liquidatable
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	_, err = pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("4.00"), time.Now().Add(100*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+	_, _, err = suite.keeper.AttemptPartialLiquidation(suite.ctx, keeper, borrower, sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF)), "uaeth")
+	suite.Require().ErrorIs(err, types.ErrCollateralDisabled)
+	repaid, seized, err := suite.keeper.AttemptPartialLiquidation(suite.ctx, keeper, borrower, sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF)), "bnb")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin("usdx", sdk.NewInt(20*USDX_CF)), repaid)
+	suite.Require().Equal(sdk.NewCoin("bnb", sdk.NewInt(5*BNB_CF)), seized)
+	debt, _ := suite.keeper.GetIsolationDebt(suite.ctx, "bnb")
+	suite.Require().Equal(sdk.NewDec(20), debt)
+
+	// A full liquidation seizes the remaining bnb, leaving the uaeth deposit with the borrower
+	mm, _ := suite.keeper.GetMoneyMarket(suite.ctx, "usdx")
+	mm.CloseFactor = sdk.ZeroDec()
+	suite.keeper.SetMoneyMarket(suite.ctx, "usdx", mm)
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+	suite.Require().NoError(err)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("uaeth", sdk.NewInt(100*AETH_CF))), deposit.Amount)
+	suite.Require().Len(deposit.Index, 1)
+	_, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetIsolationDebt(suite.ctx, "bnb")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSetCollateral() {
+	borrower, keeper := suite.setupCollateralModes()
+
+	err := suite.keeper.SetCollateral(suite.ctx, borrower, []string{"atom"}, false)
+	suite.Require().ErrorIs(err, types.ErrMarketNotFound)
+	err = suite.keeper.SetCollateral(suite.ctx, borrower, []string{"uaeth"}, true)
+	suite.Require().ErrorIs(err, types.ErrCollateralDisabled)
+
+	// usdx deposits that aren't collateral can be combined with isolated bnb
+	err = suite.keeper.SetCollateral(suite.ctx, borrower, []string{"usdx"}, false)
+	suite.Require().NoError(err)
+	suite.Require().False(suite.keeper.IsCollateral(suite.ctx, borrower, "usdx"))
+	err = suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF)), sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(40*USDX_CF))))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.SetCollateral(suite.ctx, borrower, []string{"usdx"}, true)
+	suite.Require().ErrorIs(err, types.ErrIsolatedCollateralMixed)
+	err = suite.keeper.SetCollateral(suite.ctx, borrower, []string{"bnb"}, false)
+	suite.Require().ErrorIs(err, types.ErrIsolatedCollateralInUse)
+	suite.Require().False(suite.keeper.IsCollateral(suite.ctx, borrower, "usdx"))
+	suite.Require().True(suite.keeper.IsCollateral(suite.ctx, borrower, "bnb"))
+
+	// the $100 of usdx deposited doesn't back the borrow
+	ltv, err := suite.keeper.GetStoreLTV(suite.ctx, borrower)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.4"), ltv)
+
+	// The bnb price drops to $4.00 and a full liquidation seizes the bnb, leaving the usdx deposit with the borrower
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	_, err = pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("4.00"), time.Now().Add(100*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, "bnb:usd"))
+	mm, _ := suite.keeper.GetMoneyMarket(suite.ctx, "usdx")
+	mm.CloseFactor = sdk.ZeroDec()
+	suite.keeper.SetMoneyMarket(suite.ctx, "usdx", mm)
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+	suite.Require().NoError(err)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))), deposit.Amount)
+	_, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().False(found)
+
+	// Collateral backing a borrow cannot be disabled
+	err = suite.keeper.Deposit(suite.ctx, keeper, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, keeper, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.SetCollateral(suite.ctx, keeper, []string{"usdx"}, false)
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+	suite.Require().True(suite.keeper.IsCollateral(suite.ctx, keeper, "usdx"))
+
+	exported := hard.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(
+		types.NonCollateralDenomsList{types.NewNonCollateralDenoms(borrower, []string{"usdx"})},
+		exported.NonCollateralDenoms,
+	)
+}
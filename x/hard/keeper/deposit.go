@@ -36,6 +36,15 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		return err
 	}
 
+	proposedDeposit := types.NewDeposit(depositor, coins, types.SupplyInterestFactors{})
+	if syncedDeposit, found := k.GetDeposit(ctx, depositor); found {
+		proposedDeposit.Amount = syncedDeposit.Amount.Add(coins...)
+	}
+	err = k.validateIsolatedCollateral(ctx, k.GetCollateral(ctx, proposedDeposit))
+	if err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
 	if err != nil {
 		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
@@ -122,7 +122,7 @@ func (suite *KeeperTestSuite) TestDeposit() {
 				},
 				sdk.NewDec(10),
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
 			)
 
 			// Pricefeed module genesis state
@@ -284,7 +284,7 @@ func (suite *KeeperTestSuite) TestDecrementSuppliedCoins() {
 				},
 				sdk.MustNewDecFromStr("10"),
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
 			)
 			// Pricefeed module genesis state
 			pricefeedGS := pricefeedtypes.GenesisState{
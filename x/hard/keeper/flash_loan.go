@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/hard/types"
+)
+
+// FlashLoan lends amount from the hard module account to the sender, executes msgs, then takes the amount plus the
+// money market's flash loan fee back from the sender. The share of the fee set by the reserve factor is added to
+// reserves and the rest is paid to suppliers. If any msg fails, or the sender cannot repay, the flash loan fails and
+// none of its state changes are committed.
+func (k Keeper) FlashLoan(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, msgs []sdk.Msg) error {
+	mm, found := k.GetMoneyMarket(ctx, amount.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrMoneyMarketNotFound, "%s", amount.Denom)
+	}
+	if !mm.IsFlashLoanEnabled() {
+		return sdkerrors.Wrapf(types.ErrFlashLoanDisabled, "%s", amount.Denom)
+	}
+	if amount.Amount.GT(mm.GetFlashLoanMaxAmount()) {
+		return sdkerrors.Wrapf(types.ErrExceedsFlashLoanLimit, "%s > %s%s", amount, mm.GetFlashLoanMaxAmount(), amount.Denom)
+	}
+
+	// Accrue interest so the fee is paid to suppliers against the current protocol state
+	err := k.AccrueInterest(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	cashPrior := k.bankKeeper.GetBalance(ctx, macc.GetAddress(), amount.Denom).Amount
+	reservesPrior, foundReserves := k.GetTotalReserves(ctx)
+	if !foundReserves {
+		reservesPrior = sdk.NewCoins()
+	}
+	available := cashPrior.Sub(reservesPrior.AmountOf(amount.Denom))
+	if available.IsNegative() {
+		return sdkerrors.Wrapf(types.ErrReservesExceedCash, "reserves %s > cash %s%s", reservesPrior, cashPrior, amount.Denom)
+	}
+	if amount.Amount.GT(available) {
+		return sdkerrors.Wrapf(types.ErrExceedsProtocolBorrowableBalance, "requested flash loan %s > available to borrow %s%s", amount, available, amount.Denom)
+	}
+	borrowedPrior := sdk.ZeroInt()
+	borrowedCoinsPrior, foundBorrowedCoins := k.GetBorrowedCoins(ctx)
+	if foundBorrowedCoins {
+		borrowedPrior = borrowedCoinsPrior.AmountOf(amount.Denom)
+	}
+
+	fee := sdk.NewCoin(amount.Denom, amount.Amount.ToDec().Mul(mm.GetFlashLoanFee()).Ceil().TruncateInt())
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, sender, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	err = k.executeFlashLoanMsgs(ctx, sender, msgs)
+	if err != nil {
+		return err
+	}
+
+	repayment := amount.Add(fee)
+	if k.bankKeeper.SpendableCoins(ctx, sender).AmountOf(repayment.Denom).LT(repayment.Amount) {
+		return sdkerrors.Wrapf(types.ErrFlashLoanNotRepaid, "%s must hold %s", sender, repayment)
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleAccountName, sdk.NewCoins(repayment))
+	if err != nil {
+		return err
+	}
+
+	if fee.IsPositive() {
+		k.payFlashLoanFee(ctx, fee, mm.ReserveFactor, cashPrior, borrowedPrior, reservesPrior)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardFlashLoan,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		),
+	)
+	return nil
+}
+
+// executeFlashLoanMsgs routes each msg to its handler in order, emitting the events of each msg.
+func (k Keeper) executeFlashLoanMsgs(ctx sdk.Context, sender sdk.AccAddress, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		err := types.ValidateFlashLoanMsg(sender, msg)
+		if err != nil {
+			return err
+		}
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return sdkerrors.Wrapf(types.ErrInvalidFlashLoanMsg, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute flash loan message %s", sdk.MsgTypeURL(msg))
+		}
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+	return nil
+}
+
+// payFlashLoanFee splits a repaid flash loan fee between reserves and suppliers, in the same way as borrow interest
+// is split when it accrues. The supplier share is paid out through the supply interest factor.
+func (k Keeper) payFlashLoanFee(ctx sdk.Context, fee sdk.Coin, reserveFactor sdk.Dec, cashPrior, borrowedPrior sdk.Int, reservesPrior sdk.Coins) {
+	denom := fee.Denom
+	reservesNew := fee.Amount.ToDec().Mul(reserveFactor).TruncateInt()
+	supplyInterestNew := fee.Amount.Sub(reservesNew)
+
+	supplyInterestFactorPrior, found := k.GetSupplyInterestFactor(ctx, denom)
+	if !found {
+		supplyInterestFactorPrior = sdk.MustNewDecFromStr("1.0")
+	}
+	supplyInterestFactor := CalculateSupplyInterestFactor(supplyInterestNew.ToDec(), cashPrior.ToDec(), borrowedPrior.ToDec(), reservesPrior.AmountOf(denom).ToDec())
+	k.SetSupplyInterestFactor(ctx, denom, supplyInterestFactorPrior.Mul(supplyInterestFactor))
+
+	k.IncrementSuppliedCoins(ctx, sdk.NewCoins(sdk.NewCoin(denom, supplyInterestNew)))
+	k.SetTotalReserves(ctx, reservesPrior.Add(sdk.NewCoin(denom, reservesNew)))
+}
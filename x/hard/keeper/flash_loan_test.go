@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/hard"
+	"github.com/mokitanetwork/aether/x/hard/types"
+)
+
+// setupFlashLoan funds the depositor and sender with 100 usdx each, deposits the depositor's usdx to a market that
+// flash loans up to 50 usdx for a 1% fee, and returns both addresses.
+func (suite *KeeperTestSuite) setupFlashLoan() (depositor, sender sdk.AccAddress) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	depositor = sdk.AccAddress(crypto.AddressHash([]byte("testdepositor")))
+	sender = sdk.AccAddress(crypto.AddressHash([]byte("testsender")))
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+		},
+		[]sdk.AccAddress{depositor, sender},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	usdxMarket := types.NewMoneyMarket("usdx",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+		"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.05"),
+	)
+	usdxMarket.FlashLoanMaxAmount = sdk.NewInt(50 * USDX_CF)
+	usdxMarket.FlashLoanFee = sdk.MustNewDecFromStr("0.01")
+	bnbMarket := types.NewMoneyMarket("bnb",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")),
+		"bnb:usd", sdk.NewInt(BNB_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{usdxMarket, bnbMarket},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
+	)
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	err := suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))))
+	suite.Require().NoError(err)
+	return depositor, sender
+}
+
+func (suite *KeeperTestSuite) TestFlashLoan() {
+	depositor, sender := suite.setupFlashLoan()
+	other := sdk.AccAddress(crypto.AddressHash([]byte("testother")))
+
+	send := banktypes.NewMsgSend(sender, other, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF))))
+	err := suite.keeper.FlashLoan(suite.ctx, sender, sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF)), []sdk.Msg{send})
+	suite.Require().NoError(err)
+
+	// the sender repaid 10 usdx plus a 0.1 usdx fee, 20% of which went to reserves and the rest to suppliers
+	bk := suite.app.GetBankKeeper()
+	suite.Require().Equal(sdk.NewCoin("usdx", sdk.NewInt(94_900_000)), bk.GetBalance(suite.ctx, sender, "usdx"))
+	suite.Require().Equal(sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF)), bk.GetBalance(suite.ctx, other, "usdx"))
+	macc := suite.getModuleAccount(types.ModuleAccountName)
+	suite.Require().Equal(sdk.NewCoin("usdx", sdk.NewInt(100_100_000)), bk.GetBalance(suite.ctx, macc.GetAddress(), "usdx"))
+
+	reserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(20_000))), reserves)
+	supplied, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100_080_000))), supplied)
+	supplyInterestFactor, _ := suite.keeper.GetSupplyInterestFactor(suite.ctx, "usdx")
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.0008"), supplyInterestFactor)
+	deposit, _ := suite.keeper.GetSyncedDeposit(suite.ctx, depositor)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100_080_000))), deposit.Amount)
+
+	suite.Require().Contains(suite.ctx.EventManager().ABCIEvents(), abci.Event(sdk.NewEvent(
+		types.EventTypeHardFlashLoan,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "10000000usdx"),
+		sdk.NewAttribute(types.AttributeKeyFee, "100000usdx"),
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+	)))
+}
+
+func (suite *KeeperTestSuite) TestFlashLoanNotRepaid() {
+	_, sender := suite.setupFlashLoan()
+	other := sdk.AccAddress(crypto.AddressHash([]byte("testother")))
+
+	// the sender holds 110 usdx while the messages execute, leaving 10 usdx to repay 10.1 usdx
+	send := banktypes.NewMsgSend(sender, other, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))))
+	err := suite.keeper.FlashLoan(suite.ctx, sender, sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF)), []sdk.Msg{send})
+	suite.Require().ErrorIs(err, types.ErrFlashLoanNotRepaid)
+}
+
+func (suite *KeeperTestSuite) TestFlashLoanInvalid() {
+	_, sender := suite.setupFlashLoan()
+	other := sdk.AccAddress(crypto.AddressHash([]byte("testother")))
+	send := banktypes.NewMsgSend(sender, other, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(USDX_CF))))
+
+	err := suite.keeper.FlashLoan(suite.ctx, sender, sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF+1)), []sdk.Msg{send})
+	suite.Require().ErrorIs(err, types.ErrExceedsFlashLoanLimit)
+	err = suite.keeper.FlashLoan(suite.ctx, sender, sdk.NewCoin("bnb", sdk.NewInt(BNB_CF)), []sdk.Msg{send})
+	suite.Require().ErrorIs(err, types.ErrFlashLoanDisabled)
+	err = suite.keeper.FlashLoan(suite.ctx, sender, sdk.NewCoin("xrp", sdk.NewInt(1)), []sdk.Msg{send})
+	suite.Require().ErrorIs(err, types.ErrMoneyMarketNotFound)
+
+	// messages signed by another address cannot be executed
+	otherSend := banktypes.NewMsgSend(other, sender, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(USDX_CF))))
+	err = suite.keeper.FlashLoan(suite.ctx, sender, sdk.NewCoin("usdx", sdk.NewInt(USDX_CF)), []sdk.Msg{otherSend})
+	suite.Require().ErrorIs(err, types.ErrInvalidFlashLoanMsg)
+
+	// loans cannot exceed the cash available to borrow
+	mm, _ := suite.keeper.GetMoneyMarket(suite.ctx, "usdx")
+	mm.FlashLoanMaxAmount = sdk.NewInt(1000 * USDX_CF)
+	suite.keeper.SetMoneyMarket(suite.ctx, "usdx", mm)
+	err = suite.keeper.FlashLoan(suite.ctx, sender, sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF+1)), []sdk.Msg{send})
+	suite.Require().ErrorIs(err, types.ErrExceedsProtocolBorrowableBalance)
+}
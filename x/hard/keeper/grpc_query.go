@@ -447,7 +447,11 @@ func (s queryServer) InterestRate(ctx context.Context, req *types.QueryInterestR
 		}
 
 		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has ien borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		rateModel, err := s.keeper.GetRateModel(sdkCtx, moneyMarket)
+		if err != nil {
+			return nil, err
+		}
+		borrowAPY, err := CalculateBorrowRate(rateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
 		if err != nil {
 			return nil, err
 		}
@@ -543,3 +547,71 @@ func (s queryServer) InterestFactors(ctx context.Context, req *types.QueryIntere
 		InterestFactors: interestFactors,
 	}, nil
 }
+
+func (s queryServer) Collateral(ctx context.Context, req *types.QueryCollateralRequest) (*types.QueryCollateralResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	collateral := sdk.NewCoins()
+	deposit, found := s.keeper.GetSyncedDeposit(sdkCtx, owner)
+	if found {
+		collateral = s.keeper.GetCollateral(sdkCtx, deposit)
+	}
+
+	var nonCollateralDenoms []string
+	nonCollateral, found := s.keeper.GetNonCollateralDenoms(sdkCtx, owner)
+	if found {
+		nonCollateralDenoms = nonCollateral.Denoms
+	}
+
+	return &types.QueryCollateralResponse{
+		Collateral:          collateral,
+		NonCollateralDenoms: nonCollateralDenoms,
+	}, nil
+}
+
+// interestRateCurveSteps is the number of equal utilization steps between 0% and 100% in an interest rate curve
+const interestRateCurveSteps = 20
+
+func (s queryServer) InterestRateCurve(ctx context.Context, req *types.QueryInterestRateCurveRequest) (*types.QueryInterestRateCurveResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	moneyMarket, found := s.keeper.GetMoneyMarket(sdkCtx, req.Denom)
+	if !found {
+		return nil, types.ErrMoneyMarketNotFound
+	}
+
+	rateModel, err := s.keeper.GetRateModel(sdkCtx, moneyMarket)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []types.InterestRateCurvePoint
+	for i := int64(0); i <= interestRateCurveSteps; i++ {
+		utilization := sdk.NewDec(i).QuoInt64(interestRateCurveSteps)
+		borrowAPY := rateModel.BorrowRate(utilization)
+		supplyAPY := borrowAPY.Mul(utilization).Mul(sdk.OneDec().Sub(moneyMarket.ReserveFactor))
+		points = append(points, types.InterestRateCurvePoint{
+			Utilization:        utilization,
+			BorrowInterestRate: borrowAPY,
+			SupplyInterestRate: supplyAPY,
+		})
+	}
+
+	return &types.QueryInterestRateCurveResponse{
+		RateModelType: rateModel.GetRateModelType(),
+		Points:        points,
+	}, nil
+}
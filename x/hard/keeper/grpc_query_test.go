@@ -350,6 +350,26 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryBorrows() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryCollateral() {
+	suite.addDeposits()
+	err := suite.keeper.SetCollateral(suite.ctx, suite.addrs[0], []string{"busd"}, false)
+	suite.Require().NoError(err)
+
+	res, err := suite.queryServer.Collateral(sdk.WrapSDKContext(suite.ctx), &types.QueryCollateralRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("bnb", 100000000)), res.Collateral)
+	suite.Equal([]string{"busd"}, res.NonCollateralDenoms)
+
+	res, err = suite.queryServer.Collateral(sdk.WrapSDKContext(suite.ctx), &types.QueryCollateralRequest{
+		Owner: suite.addrs[1].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("bnb", 20000000)), res.Collateral)
+	suite.Empty(res.NonCollateralDenoms)
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryTotalDeposited() {
 	suite.addDeposits()
 
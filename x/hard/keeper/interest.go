@@ -37,6 +37,10 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 		// Update the interest rate in the store if the params have changed
 		if !moneyMarket.Equal(mm) {
 			k.SetMoneyMarket(ctx, mm.Denom, mm)
+			// An adaptive rate restarts from its initial rate at target if the market stops using it
+			if mm.RateModelType != types.RATE_MODEL_TYPE_ADAPTIVE {
+				k.DeleteAdaptiveRate(ctx, mm.Denom)
+			}
 		}
 		denomSet[mm.Denom] = true
 	}
@@ -52,6 +56,7 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 
 			// Delete the money market from the store
 			k.DeleteMoneyMarket(ctx, denom)
+			k.DeleteAdaptiveRate(ctx, denom)
 		}
 		return false
 	})
@@ -83,6 +88,7 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 		borrowedPrior = sdk.NewCoin(denom, borrowedCoinsPrior.AmountOf(denom))
 	}
 	if borrowedPrior.IsZero() {
+		k.adaptRateAtTarget(ctx, denom, sdk.ZeroDec(), timeElapsed)
 		k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
 		return nil
 	}
@@ -115,7 +121,11 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	}
 
 	// GetBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-	borrowRateApy, err := CalculateBorrowRate(mm.InterestRateModel, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+	rateModel, err := k.GetRateModel(ctx, mm)
+	if err != nil {
+		return err
+	}
+	borrowRateApy, err := CalculateBorrowRate(rateModel, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
 	if err != nil {
 		return err
 	}
@@ -150,25 +160,60 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	k.IncrementBorrowedCoins(ctx, totalBorrowInterestAccumulated)
 	k.IncrementSuppliedCoins(ctx, sdk.NewCoins(sdk.NewCoin(denom, supplyInterestNew)))
 	k.SetTotalReserves(ctx, reservesPrior.Add(sdk.NewCoin(denom, reservesNew)))
+	utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+	k.adaptRateAtTarget(ctx, denom, utilRatio, timeElapsed)
 	k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
 
 	return nil
 }
 
-// CalculateBorrowRate calculates the borrow rate, which is the current APY expressed as a decimal
-// based on the current utilization.
-func CalculateBorrowRate(model types.InterestRateModel, cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
-	utilRatio := CalculateUtilizationRatio(cash, borrows, reserves)
+// GetRateModel returns the interest rate model that sets a money market's borrow rate. Adaptive rate models
+// are returned as their curve at the market's current rate at target.
+func (k Keeper) GetRateModel(ctx sdk.Context, mm types.MoneyMarket) (types.RateModel, error) {
+	switch mm.RateModelType {
+	case types.RATE_MODEL_TYPE_JUMP:
+		return mm.InterestRateModel, nil
+	case types.RATE_MODEL_TYPE_ADAPTIVE:
+		if mm.AdaptiveRateModel == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidRateModel, "no adaptive rate model for %s", mm.Denom)
+		}
+		return mm.AdaptiveRateModel.Curve(k.getRateAtTarget(ctx, mm.Denom, *mm.AdaptiveRateModel)), nil
+	case types.RATE_MODEL_TYPE_FIXED:
+		if mm.FixedRateModel == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidRateModel, "no fixed rate model for %s", mm.Denom)
+		}
+		return *mm.FixedRateModel, nil
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRateModel, "unknown rate model type %s for %s", mm.RateModelType, mm.Denom)
+	}
+}
 
-	// Calculate normal borrow rate (under kink)
-	if utilRatio.LTE(model.Kink) {
-		return utilRatio.Mul(model.BaseMultiplier).Add(model.BaseRateAPY), nil
+// getRateAtTarget returns a money market's current rate at target, or the model's initial rate if it hasn't adapted yet
+func (k Keeper) getRateAtTarget(ctx sdk.Context, denom string, model types.AdaptiveRateModel) sdk.Dec {
+	rateAtTarget, found := k.GetAdaptiveRate(ctx, denom)
+	if !found {
+		return model.InitialRateAtTarget
+	}
+	return rateAtTarget
+}
+
+// adaptRateAtTarget moves the rate at target of a money market using an adaptive rate model
+// for the time its utilization was held
+func (k Keeper) adaptRateAtTarget(ctx sdk.Context, denom string, utilization sdk.Dec, secondsElapsed int64) {
+	mm, found := k.GetMoneyMarket(ctx, denom)
+	if !found || mm.RateModelType != types.RATE_MODEL_TYPE_ADAPTIVE || mm.AdaptiveRateModel == nil {
+		return
 	}
+	model := *mm.AdaptiveRateModel
+	rateAtTarget := k.getRateAtTarget(ctx, denom, model)
+	k.SetAdaptiveRate(ctx, denom, model.NextRateAtTarget(rateAtTarget, utilization, secondsElapsed))
+}
 
-	// Calculate jump borrow rate (over kink)
-	normalRate := model.Kink.Mul(model.BaseMultiplier).Add(model.BaseRateAPY)
-	excessUtil := utilRatio.Sub(model.Kink)
-	return excessUtil.Mul(model.JumpMultiplier).Add(normalRate), nil
+// CalculateBorrowRate calculates the borrow rate, which is the current APY expressed as a decimal
+// based on the current utilization.
+func CalculateBorrowRate(model types.RateModel, cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
+	utilRatio := CalculateUtilizationRatio(cash, borrows, reserves)
+	return model.BorrowRate(utilRatio), nil
 }
 
 // CalculateUtilizationRatio calculates an asset's current utilization rate
@@ -851,7 +851,7 @@ func (suite *KeeperTestSuite) TestBorrowInterest() {
 				},
 				sdk.NewDec(10),
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
 			)
 
 			// Pricefeed module genesis state
@@ -1263,7 +1263,7 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 				},
 				sdk.NewDec(10),
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
 			)
 
 			// Pricefeed module genesis state
@@ -1434,6 +1434,136 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 	}
 }
 
+// setupRateModel creates a usdx market using a rate model, deposits 100 usdx and borrows 80 usdx against it
+func (suite *KeeperTestSuite) setupRateModel(rateModelType types.RateModelType, adaptive *types.AdaptiveRateModel, fixed *types.FixedRateModel) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	user := sdk.AccAddress(crypto.AddressHash([]byte("testuser")))
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF)))},
+		[]sdk.AccAddress{user},
+	)
+
+	usdxMarket := types.NewMoneyMarket("usdx",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+		"usdx:usd", sdk.NewInt(USDX_CF),
+		types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+		sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	usdxMarket.RateModelType = rateModelType
+	usdxMarket.AdaptiveRateModel = adaptive
+	usdxMarket.FixedRateModel = fixed
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{usdxMarket},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	err := suite.keeper.Deposit(suite.ctx, user, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, user, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(80*USDX_CF))))
+	suite.Require().NoError(err)
+}
+
+// requireBorrowInterestFactor checks a day of interest at borrowRateAPY has been applied to usdx borrows
+func (suite *KeeperTestSuite) requireBorrowInterestFactor(borrowRateAPY sdk.Dec) {
+	borrowRateSPY, err := keeper.APYToSPY(sdk.OneDec().Add(borrowRateAPY))
+	suite.Require().NoError(err)
+	factor, found := suite.keeper.GetBorrowInterestFactor(suite.ctx, "usdx")
+	suite.Require().True(found)
+	suite.Require().Equal(keeper.CalculateBorrowInterestFactor(borrowRateSPY, sdk.NewInt(86400)), factor)
+}
+
+func (suite *KeeperTestSuite) TestAdaptiveRateModel() {
+	model := types.NewAdaptiveRateModel(
+		sdk.MustNewDecFromStr("0.5"),
+		sdk.MustNewDecFromStr("0.04"),
+		sdk.MustNewDecFromStr("0.001"),
+		sdk.MustNewDecFromStr("1"),
+		sdk.MustNewDecFromStr("50"),
+		sdk.MustNewDecFromStr("4"),
+	)
+	suite.setupRateModel(types.RATE_MODEL_TYPE_ADAPTIVE, &model, nil)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	// 80% utilization is over the 50% target, so the day's interest is charged above the rate at target,
+	// and the rate at target rises
+	suite.requireBorrowInterestFactor(model.Curve(sdk.MustNewDecFromStr("0.04")).BorrowRate(sdk.MustNewDecFromStr("0.8")))
+	rateAtTarget, found := suite.keeper.GetAdaptiveRate(suite.ctx, "usdx")
+	suite.Require().True(found)
+	suite.Require().Equal(model.NextRateAtTarget(sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.8"), 86400), rateAtTarget)
+	suite.Require().True(rateAtTarget.GT(sdk.MustNewDecFromStr("0.04")))
+
+	queryServer := keeper.NewQueryServerImpl(suite.keeper, suite.app.GetAccountKeeper(), suite.app.GetBankKeeper())
+	res, err := queryServer.InterestRateCurve(sdk.WrapSDKContext(suite.ctx), &types.QueryInterestRateCurveRequest{Denom: "usdx"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.RATE_MODEL_TYPE_ADAPTIVE, res.RateModelType)
+	suite.Require().Len(res.Points, 21)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.5"), res.Points[10].Utilization)
+	suite.Require().Equal(rateAtTarget, res.Points[10].BorrowInterestRate)
+	suite.Require().Equal(rateAtTarget.QuoInt64(2).Mul(sdk.MustNewDecFromStr("0.95")), res.Points[10].SupplyInterestRate)
+
+	exported := hard.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(types.AdaptiveRates{types.NewAdaptiveRate("usdx", rateAtTarget)}, exported.AdaptiveRates)
+
+	// Switching the market to another rate model clears its adaptive rate
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MoneyMarkets[0].RateModelType = types.RATE_MODEL_TYPE_JUMP
+	params.MoneyMarkets[0].AdaptiveRateModel = nil
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	_, found = suite.keeper.GetAdaptiveRate(suite.ctx, "usdx")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestFixedRateModel() {
+	model := types.NewFixedRateModel(sdk.MustNewDecFromStr("0.03"))
+	suite.setupRateModel(types.RATE_MODEL_TYPE_FIXED, nil, &model)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	suite.requireBorrowInterestFactor(sdk.MustNewDecFromStr("0.03"))
+
+	queryServer := keeper.NewQueryServerImpl(suite.keeper, suite.app.GetAccountKeeper(), suite.app.GetBankKeeper())
+	res, err := queryServer.InterestRateCurve(sdk.WrapSDKContext(suite.ctx), &types.QueryInterestRateCurveRequest{Denom: "usdx"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.RATE_MODEL_TYPE_FIXED, res.RateModelType)
+	for _, point := range res.Points {
+		suite.Require().Equal(sdk.MustNewDecFromStr("0.03"), point.BorrowInterestRate)
+	}
+}
+
 func TestInterestTestSuite(t *testing.T) {
 	suite.Run(t, new(InterestTestSuite))
 }
@@ -20,13 +20,14 @@ type Keeper struct {
 	bankKeeper      types.BankKeeper
 	pricefeedKeeper types.PricefeedKeeper
 	auctionKeeper   types.AuctionKeeper
+	router          types.MsgRouter
 	hooks           types.HARDHooks
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc codec.Codec, key sdk.StoreKey, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper,
-	pfk types.PricefeedKeeper, auk types.AuctionKeeper,
+	pfk types.PricefeedKeeper, auk types.AuctionKeeper, router types.MsgRouter,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -40,6 +41,7 @@ func NewKeeper(cdc codec.Codec, key sdk.StoreKey, paramstore paramtypes.Subspace
 		bankKeeper:      bk,
 		pricefeedKeeper: pfk,
 		auctionKeeper:   auk,
+		router:          router,
 		hooks:           nil,
 	}
 }
@@ -362,3 +364,120 @@ func (k Keeper) IterateSupplyInterestFactors(ctx sdk.Context, cb func(denom stri
 		}
 	}
 }
+
+// GetIsolationDebt returns the debt backed by deposits of an isolated market, in whole units of the borrowed markets
+func (k Keeper) GetIsolationDebt(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolationDebtPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	var debt sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &debt)
+	return debt.Dec, true
+}
+
+// SetIsolationDebt sets the debt backed by deposits of an isolated market, deleting it if it is zero
+func (k Keeper) SetIsolationDebt(ctx sdk.Context, denom string, debt sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolationDebtPrefix)
+	if debt.IsZero() {
+		store.Delete([]byte(denom))
+		return
+	}
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: debt})
+	store.Set([]byte(denom), bz)
+}
+
+// IterateIsolationDebts iterates over all isolation debts in the store and returns
+// both the debt and the key (denom) it's stored under
+func (k Keeper) IterateIsolationDebts(ctx sdk.Context, cb func(denom string, debt sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolationDebtPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var debt sdk.DecProto
+
+		k.cdc.MustUnmarshal(iterator.Value(), &debt)
+		if cb(string(iterator.Key()), debt.Dec) {
+			break
+		}
+	}
+}
+
+// GetNonCollateralDenoms returns the denoms a depositor has chosen not to use as collateral
+func (k Keeper) GetNonCollateralDenoms(ctx sdk.Context, depositor sdk.AccAddress) (types.NonCollateralDenoms, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.NonCollateralDenomsPrefix)
+	bz := store.Get(depositor.Bytes())
+	if len(bz) == 0 {
+		return types.NonCollateralDenoms{}, false
+	}
+	var nonCollateral types.NonCollateralDenoms
+	k.cdc.MustUnmarshal(bz, &nonCollateral)
+	return nonCollateral, true
+}
+
+// SetNonCollateralDenoms sets the denoms a depositor has chosen not to use as collateral, deleting them if there are none
+func (k Keeper) SetNonCollateralDenoms(ctx sdk.Context, nonCollateral types.NonCollateralDenoms) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.NonCollateralDenomsPrefix)
+	if len(nonCollateral.Denoms) == 0 {
+		store.Delete(nonCollateral.Depositor.Bytes())
+		return
+	}
+	bz := k.cdc.MustMarshal(&nonCollateral)
+	store.Set(nonCollateral.Depositor.Bytes(), bz)
+}
+
+// IterateNonCollateralDenoms iterates over all depositors' non-collateral denoms in the store and performs a callback function
+func (k Keeper) IterateNonCollateralDenoms(ctx sdk.Context, cb func(nonCollateral types.NonCollateralDenoms) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.NonCollateralDenomsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var nonCollateral types.NonCollateralDenoms
+		k.cdc.MustUnmarshal(iterator.Value(), &nonCollateral)
+		if cb(nonCollateral) {
+			break
+		}
+	}
+}
+
+// GetAdaptiveRate returns the current borrow APY at target utilization of a money market using an adaptive rate model
+func (k Keeper) GetAdaptiveRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AdaptiveRatePrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	var rate sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &rate)
+	return rate.Dec, true
+}
+
+// SetAdaptiveRate sets the current borrow APY at target utilization of a money market using an adaptive rate model
+func (k Keeper) SetAdaptiveRate(ctx sdk.Context, denom string, rateAtTarget sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AdaptiveRatePrefix)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: rateAtTarget})
+	store.Set([]byte(denom), bz)
+}
+
+// DeleteAdaptiveRate deletes the borrow APY at target utilization of a money market from the store
+func (k Keeper) DeleteAdaptiveRate(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AdaptiveRatePrefix)
+	store.Delete([]byte(denom))
+}
+
+// IterateAdaptiveRates iterates over all adaptive rates in the store and returns
+// both the rate at target and the key (denom) it's stored under
+func (k Keeper) IterateAdaptiveRates(ctx sdk.Context, cb func(denom string, rateAtTarget sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AdaptiveRatePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rate sdk.DecProto
+
+		k.cdc.MustUnmarshal(iterator.Value(), &rate)
+		if cb(string(iterator.Key()), rate.Dec) {
+			break
+		}
+	}
+}
@@ -53,25 +53,251 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 		return sdkerrors.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
 	}
 
+	// Borrows that can be partially liquidated are repaid by liquidators rather than seizing deposits, the rest of the
+	// position is liquidated in full
+	liquidated, partial := sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range borrow.Amount {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if found && mm.GetCloseFactor().IsPositive() {
+			partial = partial.Add(coin)
+		} else {
+			liquidated = liquidated.Add(coin)
+		}
+	}
+	if liquidated.Empty() {
+		return sdkerrors.Wrapf(types.ErrPartialLiquidationRequired, "borrow of %s", partial)
+	}
+
+	// Only collateral is seized, deposits that don't back borrows stay with the borrower
+	collateral := k.GetCollateral(ctx, deposit)
+	if !partial.Empty() {
+		// Seize only the share of the collateral backing the liquidated borrows, the rest backs the partial borrows
+		collateral, err = k.getLiquidatedCollateral(ctx, deposit, borrow, collateral, liquidated)
+		if err != nil {
+			return err
+		}
+	}
+	k.decrementIsolationDebt(ctx, borrower, liquidated)
+
 	// Sending coins to auction module with keeper address getting % of the profits
-	borrowDenoms := getDenoms(borrow.Amount)
-	depositDenoms := getDenoms(deposit.Amount)
-	err = k.SeizeDeposits(ctx, keeper, deposit, borrow, depositDenoms, borrowDenoms)
+	borrowDenoms := getDenoms(liquidated)
+	depositDenoms := getDenoms(collateral)
+	seizedDeposit := types.NewDeposit(borrower, collateral, deposit.Index)
+	seizedBorrow := types.NewBorrow(borrower, liquidated, borrow.Index)
+	err = k.SeizeDeposits(ctx, keeper, seizedDeposit, seizedBorrow, depositDenoms, borrowDenoms)
 	if err != nil {
 		return err
 	}
 
-	deposit.Amount = sdk.NewCoins()
-	k.DeleteDeposit(ctx, deposit)
+	for _, denom := range depositDenoms {
+		if collateral.AmountOf(denom).LT(deposit.Amount.AmountOf(denom)) {
+			continue
+		}
+		depositIndex, removed := deposit.Index.RemoveInterestFactor(denom)
+		if !removed {
+			return sdkerrors.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", denom)
+		}
+		deposit.Index = depositIndex
+	}
+	deposit.Amount = deposit.Amount.Sub(collateral)
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
 	k.AfterDepositModified(ctx, deposit)
 
-	borrow.Amount = sdk.NewCoins()
-	k.DeleteBorrow(ctx, borrow)
+	for _, denom := range borrowDenoms {
+		borrowIndex, removed := borrow.Index.RemoveInterestFactor(denom)
+		if !removed {
+			return sdkerrors.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", denom)
+		}
+		borrow.Index = borrowIndex
+	}
+	borrow.Amount = partial
+	if borrow.Amount.Empty() {
+		k.DeleteBorrow(ctx, borrow)
+	} else {
+		k.SetBorrow(ctx, borrow)
+	}
 	k.AfterBorrowModified(ctx, borrow)
 	return nil
 }
 
-// SeizeDeposits seizes a list of deposits and sends them to auction
+// getLiquidatedCollateral returns the share of collateral backing the liquidated part of a borrow, by USD value
+func (k Keeper) getLiquidatedCollateral(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow, collateral, liquidated sdk.Coins) (sdk.Coins, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return nil, err
+	}
+	usdValue := func(coins sdk.Coins) sdk.Dec {
+		value := sdk.ZeroDec()
+		for _, coin := range coins {
+			data := liqMap[coin.Denom]
+			value = value.Add(sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(data.conversionFactor)).Mul(data.price))
+		}
+		return value
+	}
+	borrowValue := usdValue(borrow.Amount)
+	if !borrowValue.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+	share := usdValue(liquidated).Quo(borrowValue)
+
+	seized := sdk.NewCoins()
+	for _, coin := range collateral {
+		seized = seized.Add(sdk.NewCoin(coin.Denom, share.MulInt(coin.Amount).TruncateInt()))
+	}
+	return seized, nil
+}
+
+// AttemptPartialLiquidation enables a keeper to repay part of an individual borrower's position that is over its LTV.
+// The keeper repays up to the borrow market's close factor of the borrow, and receives the borrower's deposit of
+// collateralDenom worth the repaid amount plus the collateral market's liquidation incentive.
+func (k Keeper) AttemptPartialLiquidation(ctx sdk.Context, keeper, borrower sdk.AccAddress, repay sdk.Coin, collateralDenom string) (sdk.Coin, sdk.Coin, error) {
+	deposit, found := k.GetDeposit(ctx, borrower)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrDepositNotFound
+	}
+
+	borrow, found := k.GetBorrow(ctx, borrower)
+	if !found {
+		return sdk.Coin{}, sdk.Coin{}, types.ErrBorrowNotFound
+	}
+
+	// Call incentive hooks
+	k.BeforeDepositModified(ctx, deposit)
+	k.BeforeBorrowModified(ctx, borrow)
+
+	k.SyncBorrowInterest(ctx, borrower)
+	k.SyncSupplyInterest(ctx, borrower)
+
+	deposit, _ = k.GetDeposit(ctx, borrower)
+	borrow, _ = k.GetBorrow(ctx, borrower)
+
+	isWithinRange, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if isWithinRange {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
+	}
+
+	borrowed := borrow.Amount.AmountOf(repay.Denom)
+	if !borrowed.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidRepaymentDenom, "%s", repay.Denom)
+	}
+	deposited := deposit.Amount.AmountOf(collateralDenom)
+	if !deposited.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidWithdrawDenom, "%s", collateralDenom)
+	}
+	if !k.IsCollateral(ctx, borrower, collateralDenom) {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrCollateralDisabled, "%s", collateralDenom)
+	}
+
+	borrowMarket, _ := k.GetMoneyMarket(ctx, repay.Denom)
+	closeFactor := borrowMarket.GetCloseFactor()
+	if !closeFactor.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrPartialLiquidationDisabled, "%s", repay.Denom)
+	}
+	collateralMarket, _ := k.GetMoneyMarket(ctx, collateralDenom)
+	bonus := sdk.OneDec().Add(collateralMarket.GetLiquidationIncentive())
+
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	bData, dData := liqMap[repay.Denom], liqMap[collateralDenom]
+	if !bData.price.IsPositive() || !dData.price.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrPriceNotFound, "no positive price for %s and %s", repay.Denom, collateralDenom)
+	}
+
+	// Repay at most the close factor of the borrow, or all of a borrow too small to split
+	maxRepay := closeFactor.MulInt(borrowed).TruncateInt()
+	if maxRepay.IsZero() {
+		maxRepay = borrowed
+	}
+	repayAmount := sdk.MinInt(repay.Amount, maxRepay)
+
+	repayUsdValue := sdk.NewDecFromInt(repayAmount).Quo(sdk.NewDecFromInt(bData.conversionFactor)).Mul(bData.price)
+	seizeAmount := repayUsdValue.Mul(bonus).MulInt(dData.conversionFactor).Quo(dData.price).TruncateInt()
+	if seizeAmount.GT(deposited) {
+		// Repay only as much as the whole deposit is worth
+		seizeAmount = deposited
+		seizeUsdValue := sdk.NewDecFromInt(deposited).Quo(sdk.NewDecFromInt(dData.conversionFactor)).Mul(dData.price)
+		repayAmount = seizeUsdValue.Quo(bonus).MulInt(bData.conversionFactor).Quo(bData.price).TruncateInt()
+	}
+	if !repayAmount.IsPositive() || !seizeAmount.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrLiquidationTooSmall, "repay %s%s for %s%s", repayAmount, repay.Denom, seizeAmount, collateralDenom)
+	}
+	repaid := sdk.NewCoin(repay.Denom, repayAmount)
+	seized := sdk.NewCoin(collateralDenom, seizeAmount)
+
+	// Reduce the isolation debt while the borrower still holds the collateral it's attributed to
+	k.decrementIsolationDebt(ctx, borrower, sdk.NewCoins(repaid))
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, keeper, types.ModuleAccountName, sdk.NewCoins(repaid)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, keeper, sdk.NewCoins(seized)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// If the borrow or deposit denom has been completely liquidated reset its index factor
+	if repaid.Amount.Equal(borrowed) {
+		borrowIndex, removed := borrow.Index.RemoveInterestFactor(repaid.Denom)
+		if !removed {
+			return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", repaid.Denom)
+		}
+		borrow.Index = borrowIndex
+	}
+	if seized.Amount.Equal(deposited) {
+		depositIndex, removed := deposit.Index.RemoveInterestFactor(seized.Denom)
+		if !removed {
+			return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", seized.Denom)
+		}
+		deposit.Index = depositIndex
+	}
+
+	borrow.Amount = borrow.Amount.Sub(sdk.NewCoins(repaid))
+	if borrow.Amount.Empty() {
+		k.DeleteBorrow(ctx, borrow)
+	} else {
+		k.SetBorrow(ctx, borrow)
+	}
+	deposit.Amount = deposit.Amount.Sub(sdk.NewCoins(seized))
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+
+	if err := k.DecrementBorrowedCoins(ctx, sdk.NewCoins(repaid)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if err := k.DecrementSuppliedCoins(ctx, sdk.NewCoins(seized)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// Call incentive hooks
+	k.AfterDepositModified(ctx, deposit)
+	k.AfterBorrowModified(ctx, borrow)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardPartialLiquidation,
+			sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
+			sdk.NewAttribute(types.AttributeKeyRepayCoins, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidatedCoins, seized.String()),
+		),
+	)
+
+	return repaid, seized, nil
+}
+
+// SeizeDeposits seizes the collateral of a deposit and sends it to auction. Deposits the depositor
+// doesn't use as collateral are not seized.
 func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit,
 	borrow types.Borrow, dDenoms, bDenoms []string,
 ) error {
@@ -79,10 +305,11 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 	if err != nil {
 		return err
 	}
+	collateral := k.GetCollateral(ctx, deposit)
 
-	// Seize % of every deposit and send to the keeper
+	// Seize % of every collateral deposit and send to the keeper
 	keeperRewardCoins := sdk.Coins{}
-	for _, depCoin := range deposit.Amount {
+	for _, depCoin := range collateral {
 		mm, _ := k.GetMoneyMarket(ctx, depCoin.Denom)
 		keeperReward := mm.KeeperRewardPercentage.MulInt(depCoin.Amount).TruncateInt()
 		if keeperReward.GT(sdk.ZeroInt()) {
@@ -100,8 +327,8 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 		}
 	}
 
-	// All deposit amounts not given to keeper as rewards are eligible to be auctioned off
-	aucDeposits := deposit.Amount.Sub(keeperRewardCoins)
+	// All collateral amounts not given to keeper as rewards are eligible to be auctioned off
+	aucDeposits := collateral.Sub(keeperRewardCoins)
 
 	// Build valuation map to hold deposit coin USD valuations
 	depositCoinValues := types.NewValuationMap()
@@ -193,7 +420,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = full borrow amount, lot = maxLotSize
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				err := k.startCollateralAuction(ctx, lot, bid, returnAddrs, weights, debt)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -244,7 +471,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = maxBid, lot = whole deposit amount
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				err := k.startCollateralAuction(ctx, lot, bid, returnAddrs, weights, debt)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -301,7 +528,7 @@ func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, bo
 	}
 
 	totalBorrowableUSDAmount := sdk.ZeroDec()
-	for _, depCoin := range deposit.Amount {
+	for _, depCoin := range k.GetCollateral(ctx, deposit) {
 		lData := liqMap[depCoin.Denom]
 		usdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
 		borrowableUSDAmountForDeposit := usdValue.Mul(lData.ltv)
@@ -352,7 +579,7 @@ func (k Keeper) CalculateLtv(ctx sdk.Context, deposit types.Deposit, borrow type
 
 	// Build valuation map to hold deposit coin USD valuations
 	depositCoinValues := types.NewValuationMap()
-	for _, depCoin := range deposit.Amount {
+	for _, depCoin := range k.GetCollateral(ctx, deposit) {
 		dData := liqMap[depCoin.Denom]
 		dCoinUsdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(dData.conversionFactor)).Mul(dData.price)
 		depositCoinValues.Increment(depCoin.Denom, dCoinUsdValue)
@@ -395,6 +622,9 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 		if err != nil {
 			return liqMap, err
 		}
+		if k.pricefeedKeeper.IsMarketStale(ctx, mm.SpotMarketID) {
+			return liqMap, sdkerrors.Wrapf(types.ErrPriceStale, "price for market %s is stale", mm.SpotMarketID)
+		}
 
 		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
 	}
@@ -425,3 +655,26 @@ func removeDuplicates(one []string, two []string) []string {
 	sort.Strings(res)
 	return res
 }
+
+// startCollateralAuction starts a dutch auction for the lot if its money market uses them, otherwise a collateral auction
+func (k Keeper) startCollateralAuction(
+	ctx sdk.Context, lot, bid sdk.Coin, returnAddrs []sdk.AccAddress, weights []sdk.Int, debt sdk.Coin,
+) error {
+	lotMarket, found := k.GetMoneyMarket(ctx, lot.Denom)
+	if found && lotMarket.DutchAuction {
+		bidMarket, found := k.GetMoneyMarket(ctx, bid.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", bid.Denom)
+		}
+		// the ratio of the usd prices converts to bid base units per lot base unit using the conversion factors
+		priceConversion := sdk.NewDecFromInt(bidMarket.ConversionFactor).QuoInt(lotMarket.ConversionFactor)
+		_, err := k.auctionKeeper.StartDutchAuction(
+			ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt,
+			lotMarket.SpotMarketID, bidMarket.SpotMarketID, priceConversion,
+		)
+		return err
+	}
+
+	_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+	return err
+}
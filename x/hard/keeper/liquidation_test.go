@@ -603,7 +603,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				},
 				sdk.NewDec(10),
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
 			)
 
 			// Pricefeed module genesis state
@@ -762,3 +762,347 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestKeeperLiquidationDutchAuction() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testdepositor")))
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF))),
+			sdk.NewCoins(),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+		},
+		[]sdk.AccAddress{borrower, keeper, depositor},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	bnbMarket := types.NewMoneyMarket("bnb",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")),
+		"bnb:usd", sdk.NewInt(BNB_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	bnbMarket.DutchAuction = true
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("usdx",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+				"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+			),
+			bnbMarket,
+		},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+			{
+				MarketID:      "bnb:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("10.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	suite.auctionKeeper = tApp.GetAuctionKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	// 10 bnb x $10.00 price = $100 backs a 79 usdx borrow
+	err := suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(79*USDX_CF))))
+	suite.Require().NoError(err)
+
+	// The bnb price halves, making the borrow liquidatable
+	pfKeeper := tApp.GetPriceFeedKeeper()
+	_, err = pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("5.00"), time.Now().Add(100*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+	suite.Require().NoError(err)
+
+	// The bnb lot is priced in usdx from both spot markets, converted from 8 to 6 decimals, plus the 20% price buffer
+	auctions := suite.auctionKeeper.GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 1)
+	auction, ok := auctions[0].(*auctiontypes.DutchAuction)
+	suite.Require().True(ok)
+	suite.Equal("bnb", auction.Lot.Denom)
+	suite.Equal("usdx", auction.MaxBid.Denom)
+	suite.Equal("bnb:usd", auction.LotMarketID)
+	suite.Equal("usdx:usd", auction.BidMarketID)
+	suite.Equal(sdk.MustNewDecFromStr("0.01"), auction.PriceConversion)
+	suite.Equal(sdk.MustNewDecFromStr("0.06"), auction.StartPrice)
+	suite.Equal([]sdk.AccAddress{borrower}, auction.LotReturns.Addresses)
+}
+
+func (suite *KeeperTestSuite) TestKeeperPartialLiquidation() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testdepositor")))
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+		},
+		[]sdk.AccAddress{borrower, keeper, depositor},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	usdxMarket := types.NewMoneyMarket("usdx",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+		"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	usdxMarket.CloseFactor = sdk.MustNewDecFromStr("0.5")
+	bnbMarket := types.NewMoneyMarket("bnb",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")),
+		"bnb:usd", sdk.NewInt(BNB_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	bnbMarket.LiquidationIncentive = sdk.MustNewDecFromStr("0.1")
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{usdxMarket, bnbMarket},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+			{
+				MarketID:      "bnb:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("10.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	// 10 bnb x $10.00 price = $100 backs a 79 usdx borrow
+	err := suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(79*USDX_CF))))
+	suite.Require().NoError(err)
+
+	// A partial liquidation of a borrow within its LTV fails
+	_, _, err = suite.keeper.AttemptPartialLiquidation(suite.ctx, keeper, borrower, sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF)), "bnb")
+	suite.Require().ErrorIs(err, types.ErrBorrowNotLiquidatable)
+
+	// The bnb price drops to $9.00, making the borrow liquidatable
+	pfKeeper := tApp.GetPriceFeedKeeper()
+	_, err = pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("9.00"), time.Now().Add(100*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+	// The usdx borrow has a close factor, so the borrower's deposits cannot be seized in full
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+	suite.Require().ErrorIs(err, types.ErrPartialLiquidationRequired)
+
+	_, _, err = suite.keeper.AttemptPartialLiquidation(suite.ctx, keeper, borrower, sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF)), "usdx")
+	suite.Require().ErrorIs(err, types.ErrInvalidWithdrawDenom)
+
+	// The repayment is capped at half the borrow, and paid for with bnb worth 10% more
+	repaid, seized, err := suite.keeper.AttemptPartialLiquidation(suite.ctx, keeper, borrower, sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF)), "bnb")
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("usdx", sdk.NewInt(39_500_000)), repaid)
+	suite.Equal(sdk.NewCoin("bnb", sdk.NewInt(482_777_777)), seized)
+
+	suite.Equal(
+		sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF-39_500_000)), sdk.NewCoin("bnb", sdk.NewInt(482_777_777))),
+		suite.getAccountCoins(suite.getAccount(keeper)),
+	)
+	borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(39_500_000))), borrow.Amount)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF-482_777_777))), deposit.Amount)
+
+	totalBorrowed, found := suite.keeper.GetBorrowedCoins(suite.ctx)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(39_500_000))), totalBorrowed)
+	totalSupplied, found := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt(10*BNB_CF-482_777_777), totalSupplied.AmountOf("bnb"))
+}
+
+func (suite *KeeperTestSuite) TestKeeperLiquidationMixedCloseFactors() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testdepositor")))
+
+	authGS := app.NewFundedGenStateWithCoins(
+		tApp.AppCodec(),
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF)), sdk.NewCoin("busd", sdk.NewInt(100*BUSD_CF))),
+		},
+		[]sdk.AccAddress{borrower, keeper, depositor},
+	)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	usdxMarket := types.NewMoneyMarket("usdx",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+		"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	usdxMarket.CloseFactor = sdk.MustNewDecFromStr("0.5")
+	busdMarket := types.NewMoneyMarket("busd",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*BUSD_CF), sdk.MustNewDecFromStr("0.9")),
+		"busd:usd", sdk.NewInt(BUSD_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	bnbMarket := types.NewMoneyMarket("bnb",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")),
+		"bnb:usd", sdk.NewInt(BNB_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"),
+	)
+	bnbMarket.LiquidationIncentive = sdk.MustNewDecFromStr("0.1")
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{usdxMarket, busdMarket, bnbMarket},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves, types.DefaultIsolationDebts, types.DefaultNonCollateralDenoms, types.DefaultAdaptiveRates,
+	)
+
+	pricefeedGS := pricefeedtypes.GenesisState{
+		Params: pricefeedtypes.Params{
+			Markets: []pricefeedtypes.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "busd:usd", BaseAsset: "busd", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeedtypes.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+			{
+				MarketID:      "busd:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+			{
+				MarketID:      "bnb:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("10.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeedtypes.ModuleName: tApp.AppCodec().MustMarshalJSON(&pricefeedGS)},
+		app.GenesisState{types.ModuleName: tApp.AppCodec().MustMarshalJSON(&hardGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	suite.auctionKeeper = tApp.GetAuctionKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	// 10 bnb x $10.00 price = $100 backs a 40 usdx and 39 busd borrow
+	err := suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF)), sdk.NewCoin("busd", sdk.NewInt(100*BUSD_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(40*USDX_CF)), sdk.NewCoin("busd", sdk.NewInt(39*BUSD_CF))))
+	suite.Require().NoError(err)
+
+	// The bnb price drops to $9.00, making the borrow liquidatable
+	pfKeeper := tApp.GetPriceFeedKeeper()
+	_, err = pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("9.00"), time.Now().Add(100*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pfKeeper.SetCurrentPrices(suite.ctx, "bnb:usd"))
+
+	// The busd borrow has no close factor, so it is liquidated in full with the 39/79 share of the bnb backing it
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+	suite.Require().NoError(err)
+
+	seized := sdk.NewInt(493_670_886)
+	keeperReward := sdk.NewInt(24_683_544)
+	suite.Equal(
+		sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF)), sdk.NewCoin("bnb", keeperReward)),
+		suite.getAccountCoins(suite.getAccount(keeper)),
+	)
+	auctions := suite.auctionKeeper.GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 1)
+	suite.Equal("busd", auctions[0].GetBid().Denom)
+
+	// The usdx borrow and the bnb backing it stay with the borrower
+	borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(40*USDX_CF))), borrow.Amount)
+	suite.Equal(types.BorrowInterestFactors{types.NewBorrowInterestFactor("usdx", sdk.OneDec())}, borrow.Index)
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*BNB_CF).Sub(seized))), deposit.Amount)
+
+	// Only a keeper liquidation of the remaining borrow is refused, it can still be partially liquidated
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+	suite.Require().ErrorIs(err, types.ErrPartialLiquidationRequired)
+	repaid, _, err := suite.keeper.AttemptPartialLiquidation(suite.ctx, keeper, borrower, sdk.NewCoin("usdx", sdk.NewInt(20*USDX_CF)), "bnb")
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin("usdx", sdk.NewInt(20*USDX_CF)), repaid)
+}
@@ -144,3 +144,82 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) LiquidatePartial(goCtx context.Context, msg *types.MsgLiquidatePartial) (*types.MsgLiquidatePartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	keeper, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, err
+	}
+
+	repaid, seized, err := k.keeper.AttemptPartialLiquidation(ctx, keeper, borrower, msg.Repay, msg.CollateralDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Keeper),
+		),
+	)
+	return &types.MsgLiquidatePartialResponse{Repaid: repaid, Seized: seized}, nil
+}
+
+func (k msgServer) FlashLoan(goCtx context.Context, msg *types.MsgFlashLoan) (*types.MsgFlashLoanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.FlashLoan(ctx, sender, msg.Amount, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgFlashLoanResponse{}, nil
+}
+
+func (k msgServer) SetCollateral(goCtx context.Context, msg *types.MsgSetCollateral) (*types.MsgSetCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.SetCollateral(ctx, depositor, msg.Denoms, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+	return &types.MsgSetCollateralResponse{}, nil
+}
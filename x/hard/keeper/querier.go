@@ -428,7 +428,11 @@ func queryGetInterestRate(ctx sdk.Context, req abci.RequestQuery, k Keeper, lega
 		}
 
 		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		rateModel, err := k.GetRateModel(ctx, moneyMarket)
+		if err != nil {
+			return nil, err
+		}
+		borrowAPY, err := CalculateBorrowRate(rateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
 		if err != nil {
 			return nil, err
 		}
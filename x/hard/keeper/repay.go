@@ -65,6 +65,7 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 	if err != nil {
 		return err
 	}
+	k.decrementIsolationDebt(ctx, owner, payment)
 
 	// Call incentive hook
 	k.AfterBorrowModified(ctx, borrow)
@@ -14,7 +14,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdraw{}, "hard/MsgWithdraw", nil)
 	cdc.RegisterConcrete(&MsgBorrow{}, "hard/MsgBorrow", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "hard/MsgLiquidate", nil)
+	cdc.RegisterConcrete(&MsgLiquidatePartial{}, "hard/MsgLiquidatePartial", nil)
 	cdc.RegisterConcrete(&MsgRepay{}, "hard/MsgRepay", nil)
+	cdc.RegisterConcrete(&MsgFlashLoan{}, "hard/MsgFlashLoan", nil)
+	cdc.RegisterConcrete(&MsgSetCollateral{}, "hard/MsgSetCollateral", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -23,7 +26,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdraw{},
 		&MsgBorrow{},
 		&MsgLiquidate{},
+		&MsgLiquidatePartial{},
 		&MsgRepay{},
+		&MsgFlashLoan{},
+		&MsgSetCollateral{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
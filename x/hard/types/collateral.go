@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewNonCollateralDenoms returns a new NonCollateralDenoms with the denoms sorted
+func NewNonCollateralDenoms(depositor sdk.AccAddress, denoms []string) NonCollateralDenoms {
+	sorted := make([]string, len(denoms))
+	copy(sorted, denoms)
+	sort.Strings(sorted)
+	return NonCollateralDenoms{
+		Depositor: depositor,
+		Denoms:    sorted,
+	}
+}
+
+// Contains returns true if denom is one of the non-collateral denoms
+func (ncd NonCollateralDenoms) Contains(denom string) bool {
+	for _, d := range ncd.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// Add returns the non-collateral denoms with denom included
+func (ncd NonCollateralDenoms) Add(denom string) NonCollateralDenoms {
+	if ncd.Contains(denom) {
+		return ncd
+	}
+	return NewNonCollateralDenoms(ncd.Depositor, append(ncd.Denoms, denom))
+}
+
+// Remove returns the non-collateral denoms with denom excluded
+func (ncd NonCollateralDenoms) Remove(denom string) NonCollateralDenoms {
+	var denoms []string
+	for _, d := range ncd.Denoms {
+		if d != denom {
+			denoms = append(denoms, d)
+		}
+	}
+	return NewNonCollateralDenoms(ncd.Depositor, denoms)
+}
+
+// Validate performs basic validation of NonCollateralDenoms
+func (ncd NonCollateralDenoms) Validate() error {
+	if ncd.Depositor.Empty() {
+		return fmt.Errorf("depositor cannot be empty")
+	}
+	if len(ncd.Denoms) == 0 {
+		return fmt.Errorf("non-collateral denoms of %s cannot be empty", ncd.Depositor)
+	}
+	seen := make(map[string]bool)
+	for _, denom := range ncd.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate non-collateral denom %s for %s", denom, ncd.Depositor)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+// NonCollateralDenomsList is a slice of NonCollateralDenoms
+type NonCollateralDenomsList []NonCollateralDenoms
+
+// Validate validates NonCollateralDenomsList
+func (ncdl NonCollateralDenomsList) Validate() error {
+	depositors := make(map[string]bool)
+	for _, ncd := range ncdl {
+		if err := ncd.Validate(); err != nil {
+			return err
+		}
+		if depositors[ncd.Depositor.String()] {
+			return fmt.Errorf("duplicate non-collateral depositor: %s", ncd.Depositor)
+		}
+		depositors[ncd.Depositor.String()] = true
+	}
+	return nil
+}
@@ -69,4 +69,34 @@ var (
 	ErrExceedsProtocolBorrowableBalance = sdkerrors.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = sdkerrors.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrPriceStale error for when a price has been flagged as stale by the pricefeed circuit breaker
+	ErrPriceStale = sdkerrors.Register(ModuleName, 33, "price is stale")
+	// ErrPartialLiquidationDisabled error for when a partial liquidation repays a borrow whose market has no close factor
+	ErrPartialLiquidationDisabled = sdkerrors.Register(ModuleName, 34, "partial liquidation disabled for market")
+	// ErrPartialLiquidationRequired error for when a full liquidation is attempted on a borrow that can be partially liquidated
+	ErrPartialLiquidationRequired = sdkerrors.Register(ModuleName, 35, "borrow must be partially liquidated")
+	// ErrLiquidationTooSmall error for when a partial liquidation would repay or seize nothing
+	ErrLiquidationTooSmall = sdkerrors.Register(ModuleName, 36, "liquidation amount too small")
+	// ErrFlashLoanDisabled error for flash loans of a money market without a flash loan max amount
+	ErrFlashLoanDisabled = sdkerrors.Register(ModuleName, 37, "flash loans are disabled for market")
+	// ErrExceedsFlashLoanLimit error for flash loans of more than the money market's flash loan max amount
+	ErrExceedsFlashLoanLimit = sdkerrors.Register(ModuleName, 38, "flash loan exceeds max amount")
+	// ErrFlashLoanNotRepaid error for flash loans that are not repaid with their fee once their messages have executed
+	ErrFlashLoanNotRepaid = sdkerrors.Register(ModuleName, 39, "flash loan not repaid")
+	// ErrInvalidFlashLoanMsg error for flash loan messages that cannot be executed
+	ErrInvalidFlashLoanMsg = sdkerrors.Register(ModuleName, 40, "invalid flash loan message")
+	// ErrBorrowDisabled error for borrows of a money market with borrowing disabled
+	ErrBorrowDisabled = sdkerrors.Register(ModuleName, 41, "borrowing is disabled for market")
+	// ErrCollateralDisabled error for using deposits of a money market with collateral disabled as collateral
+	ErrCollateralDisabled = sdkerrors.Register(ModuleName, 42, "market cannot be used as collateral")
+	// ErrIsolatedCollateralMixed error for deposits combining isolated collateral with other collateral
+	ErrIsolatedCollateralMixed = sdkerrors.Register(ModuleName, 43, "isolated collateral cannot be combined with other collateral")
+	// ErrNotBorrowableInIsolation error for borrows against isolated collateral of a money market that is not borrowable in isolation
+	ErrNotBorrowableInIsolation = sdkerrors.Register(ModuleName, 44, "market cannot be borrowed against isolated collateral")
+	// ErrExceedsIsolationDebtCeiling error for borrows against isolated collateral over its money market's debt ceiling
+	ErrExceedsIsolationDebtCeiling = sdkerrors.Register(ModuleName, 45, "borrow exceeds isolation debt ceiling")
+	// ErrIsolatedCollateralInUse error for changing the isolated collateral backing an outstanding borrow
+	ErrIsolatedCollateralInUse = sdkerrors.Register(ModuleName, 46, "isolated collateral backs an outstanding borrow")
+	// ErrInvalidRateModel error for money markets whose interest rate model cannot be used
+	ErrInvalidRateModel = sdkerrors.Register(ModuleName, 47, "invalid interest rate model")
 )
@@ -1,6 +1,7 @@
 package types // noalias
 
 import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -41,11 +42,16 @@ type StakingKeeper interface {
 // PricefeedKeeper defines the expected interface for the pricefeed
 type PricefeedKeeper interface {
 	GetCurrentPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
+	IsMarketStale(sdk.Context, string) bool
 }
 
 // AuctionKeeper expected interface for the auction keeper (noalias)
 type AuctionKeeper interface {
 	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, error)
+	StartDutchAuction(
+		ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin,
+		lotMarketID, bidMarketID string, priceConversion sdk.Dec,
+	) (uint64, error)
 }
 
 // HARDHooks event hooks for other keepers to run code in response to HARD modifications
@@ -57,3 +63,8 @@ type HARDHooks interface {
 	BeforeBorrowModified(ctx sdk.Context, borrow Borrow)
 	AfterBorrowModified(ctx sdk.Context, borrow Borrow)
 }
+
+// MsgRouter expected interface for routing the messages executed by a flash loan
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
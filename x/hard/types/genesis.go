@@ -10,7 +10,8 @@ import (
 // NewGenesisState returns a new genesis state
 func NewGenesisState(
 	params Params, prevAccumulationTimes GenesisAccumulationTimes, deposits Deposits,
-	borrows Borrows, totalSupplied, totalBorrowed, totalReserves sdk.Coins,
+	borrows Borrows, totalSupplied, totalBorrowed, totalReserves sdk.Coins, isolationDebts sdk.DecCoins,
+	nonCollateralDenoms NonCollateralDenomsList, adaptiveRates AdaptiveRates,
 ) GenesisState {
 	return GenesisState{
 		Params:                    params,
@@ -20,6 +21,9 @@ func NewGenesisState(
 		TotalSupplied:             totalSupplied,
 		TotalBorrowed:             totalBorrowed,
 		TotalReserves:             totalReserves,
+		IsolationDebts:            isolationDebts,
+		NonCollateralDenoms:       nonCollateralDenoms,
+		AdaptiveRates:             adaptiveRates,
 	}
 }
 
@@ -33,6 +37,9 @@ func DefaultGenesisState() GenesisState {
 		TotalSupplied:             DefaultTotalSupplied,
 		TotalBorrowed:             DefaultTotalBorrowed,
 		TotalReserves:             DefaultTotalReserves,
+		IsolationDebts:            DefaultIsolationDebts,
+		NonCollateralDenoms:       DefaultNonCollateralDenoms,
+		AdaptiveRates:             DefaultAdaptiveRates,
 	}
 }
 
@@ -61,6 +68,15 @@ func (gs GenesisState) Validate() error {
 	if !gs.TotalReserves.IsValid() {
 		return fmt.Errorf("invalid total reserves coins: %s", gs.TotalReserves)
 	}
+	if !gs.IsolationDebts.IsValid() {
+		return fmt.Errorf("invalid isolation debts: %s", gs.IsolationDebts)
+	}
+	if err := gs.NonCollateralDenoms.Validate(); err != nil {
+		return err
+	}
+	if err := gs.AdaptiveRates.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -39,13 +39,19 @@ type GenesisState struct {
 	TotalSupplied             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_supplied,json=totalSupplied,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_supplied"`
 	TotalBorrowed             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_borrowed,json=totalBorrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_borrowed"`
 	TotalReserves             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_reserves,json=totalReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reserves"`
+	// isolation_debts is the debt backed by deposits of each isolated money market, in whole units of the borrowed markets
+	IsolationDebts github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,8,rep,name=isolation_debts,json=isolationDebts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"isolation_debts"`
+	// non_collateral_denoms lists the denoms each depositor has chosen not to use as collateral
+	NonCollateralDenoms NonCollateralDenomsList `protobuf:"bytes,9,rep,name=non_collateral_denoms,json=nonCollateralDenoms,proto3,castrepeated=NonCollateralDenomsList" json:"non_collateral_denoms"`
+	// adaptive_rates is the current borrow APY at target utilization of each money market using an adaptive rate model
+	AdaptiveRates AdaptiveRates `protobuf:"bytes,10,rep,name=adaptive_rates,json=adaptiveRates,proto3,castrepeated=AdaptiveRates" json:"adaptive_rates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20a1f6c2cf728e74, []int{0}
+	return fileDescriptor_9ab624d2121a8c46, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,6 +129,27 @@ func (m *GenesisState) GetTotalReserves() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetIsolationDebts() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.IsolationDebts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNonCollateralDenoms() NonCollateralDenomsList {
+	if m != nil {
+		return m.NonCollateralDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdaptiveRates() AdaptiveRates {
+	if m != nil {
+		return m.AdaptiveRates
+	}
+	return nil
+}
+
 // GenesisAccumulationTime stores the previous distribution time and its corresponding denom.
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -135,7 +162,7 @@ func (m *GenesisAccumulationTime) Reset()         { *m = GenesisAccumulationTime
 func (m *GenesisAccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccumulationTime) ProtoMessage()    {}
 func (*GenesisAccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20a1f6c2cf728e74, []int{1}
+	return fileDescriptor_9ab624d2121a8c46, []int{1}
 }
 func (m *GenesisAccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,47 +210,55 @@ func init() {
 	proto.RegisterType((*GenesisAccumulationTime)(nil), "aeth.hard.v1beta1.GenesisAccumulationTime")
 }
 
-func init() { proto.RegisterFile("aeth/hard/v1beta1/genesis.proto", fileDescriptor_20a1f6c2cf728e74) }
+func init() { proto.RegisterFile("aeth/hard/v1beta1/genesis.proto", fileDescriptor_9ab624d2121a8c46) }
 
-var fileDescriptor_20a1f6c2cf728e74 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa6, 0x6d, 0xc2, 0x16, 0x5a, 0xb0, 0x2a, 0x70, 0x02, 0xb2, 0xa3, 0x1e, 0x20,
-	0x42, 0x8a, 0x4d, 0xcb, 0x81, 0x0b, 0x07, 0x30, 0x11, 0x1f, 0x37, 0xe4, 0xe6, 0xc4, 0xc5, 0x5a,
-	0x3b, 0x5b, 0xd7, 0xaa, 0x9d, 0xb5, 0x76, 0xd6, 0x81, 0xbc, 0x03, 0x42, 0x7d, 0x0e, 0xce, 0x3c,
-	0x01, 0xa7, 0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x8b, 0x92, 0x17, 0x41, 0xfb, 0x91, 0xa4, 0x28, 0x89,
-	0xc4, 0x81, 0x9e, 0xe2, 0xdd, 0xfd, 0xcf, 0xff, 0x37, 0x3b, 0x3b, 0x13, 0xe4, 0x9c, 0xe0, 0x21,
-	0xf6, 0x8e, 0x31, 0xeb, 0x7b, 0xc3, 0xfd, 0x88, 0x70, 0xbc, 0xef, 0x25, 0x64, 0x40, 0x20, 0x05,
-	0xb7, 0x60, 0x94, 0x53, 0xf3, 0x8e, 0x10, 0xb8, 0x42, 0xe0, 0x6a, 0x41, 0xd3, 0x8e, 0x29, 0xe4,
-	0x14, 0xbc, 0x08, 0x03, 0x99, 0x45, 0xc5, 0x34, 0x1d, 0xa8, 0x90, 0x66, 0x43, 0x9d, 0x87, 0x72,
-	0xe5, 0xa9, 0x85, 0x3e, 0xda, 0x4d, 0x68, 0x42, 0xd5, 0xbe, 0xf8, 0xd2, 0xbb, 0x4e, 0x42, 0x69,
-	0x92, 0x11, 0x4f, 0xae, 0xa2, 0xf2, 0xc8, 0xe3, 0x69, 0x4e, 0x80, 0xe3, 0xbc, 0xd0, 0x82, 0x07,
-	0x8b, 0x59, 0xca, 0x8c, 0xe4, 0xe9, 0xde, 0xf7, 0x0d, 0x74, 0xf3, 0x8d, 0x4a, 0xfa, 0x90, 0x63,
-	0x4e, 0xcc, 0x67, 0x68, 0xb3, 0xc0, 0x0c, 0xe7, 0x60, 0x19, 0x2d, 0xa3, 0xbd, 0x75, 0xd0, 0x70,
-	0x17, 0x2e, 0xe1, 0xbe, 0x97, 0x02, 0x7f, 0xfd, 0xec, 0xc2, 0xa9, 0x04, 0x5a, 0x6e, 0x7e, 0x36,
-	0xd0, 0xfd, 0x82, 0x91, 0x61, 0x4a, 0x4b, 0x08, 0x71, 0x1c, 0x97, 0x79, 0x99, 0x61, 0x9e, 0xd2,
-	0x41, 0x28, 0x33, 0xb2, 0xd6, 0x5a, 0xd5, 0xf6, 0xd6, 0xc1, 0xe3, 0x25, 0x76, 0x9a, 0xff, 0xf2,
-	0x4a, 0x4c, 0x2f, 0xcd, 0x89, 0xdf, 0x12, 0xfe, 0x5f, 0x2f, 0x1d, 0x6b, 0x85, 0x00, 0x82, 0xc6,
-	0x14, 0xb8, 0x70, 0x64, 0xbe, 0x45, 0xf5, 0x3e, 0x29, 0x28, 0xa4, 0x1c, 0xac, 0xaa, 0x44, 0x37,
-	0x97, 0xa0, 0xbb, 0x4a, 0xe2, 0xdf, 0xd6, 0xa8, 0xba, 0xde, 0x80, 0x60, 0x16, 0x6d, 0x76, 0x51,
-	0x2d, 0xa2, 0x8c, 0xd1, 0x8f, 0x60, 0xad, 0x4b, 0xa3, 0x65, 0x25, 0xf1, 0xa5, 0xc2, 0xdf, 0xd1,
-	0x3e, 0x35, 0xb5, 0x86, 0x60, 0x1a, 0x6a, 0x32, 0xb4, 0xcd, 0x29, 0xc7, 0x59, 0x08, 0x65, 0x51,
-	0x64, 0x29, 0xe9, 0x5b, 0x1b, 0xda, 0x4c, 0x3f, 0xb2, 0xe8, 0x88, 0x99, 0xdd, 0x2b, 0x9a, 0x0e,
-	0xfc, 0x27, 0xda, 0xac, 0x9d, 0xa4, 0xfc, 0xb8, 0x8c, 0xdc, 0x98, 0xe6, 0xba, 0x23, 0xf4, 0x4f,
-	0x07, 0xfa, 0x27, 0x1e, 0x1f, 0x15, 0x04, 0x64, 0x00, 0x04, 0xb7, 0x24, 0xe2, 0x50, 0x13, 0xe6,
-	0x4c, 0x95, 0x04, 0xe9, 0x5b, 0x9b, 0xd7, 0xc5, 0xf4, 0x35, 0x61, 0xce, 0x64, 0x04, 0x08, 0x1b,
-	0x12, 0xb0, 0x6a, 0xd7, 0xc5, 0x0c, 0x34, 0x61, 0xef, 0x4b, 0x15, 0xdd, 0x5b, 0xd1, 0x23, 0xe6,
-	0x23, 0xb4, 0x13, 0xd3, 0x2c, 0xc3, 0x9c, 0x30, 0x9c, 0x85, 0xc2, 0x44, 0x36, 0xf6, 0x8d, 0x60,
-	0x7b, 0xbe, 0xdd, 0x1b, 0x15, 0xc4, 0x8c, 0x50, 0x73, 0x75, 0xfb, 0x5a, 0x6b, 0x72, 0x18, 0x9a,
-	0xae, 0x9a, 0x36, 0x77, 0x3a, 0x6d, 0x6e, 0x6f, 0x3a, 0x6d, 0x7e, 0x5d, 0xdc, 0xe2, 0xf4, 0xd2,
-	0x31, 0x02, 0x6b, 0x55, 0x57, 0x9a, 0x0c, 0xdd, 0x95, 0xcf, 0x3f, 0x0a, 0xd3, 0x01, 0x27, 0x8c,
-	0x00, 0x0f, 0x8f, 0x70, 0xcc, 0x29, 0xb3, 0xaa, 0x22, 0x27, 0xff, 0xb9, 0xf0, 0xf8, 0x75, 0xe1,
-	0x3c, 0xfc, 0x87, 0x4a, 0x74, 0x49, 0xfc, 0xe3, 0x5b, 0x07, 0xe9, 0xaa, 0x76, 0x49, 0x1c, 0xec,
-	0x2a, 0xef, 0x77, 0xda, 0xfa, 0xb5, 0x74, 0x16, 0x4c, 0xf5, 0xfc, 0x0b, 0xcc, 0xf5, 0xff, 0xc1,
-	0x54, 0xde, 0x7f, 0x33, 0xfd, 0x17, 0x67, 0x63, 0xdb, 0x38, 0x1f, 0xdb, 0xc6, 0xef, 0xb1, 0x6d,
-	0x9c, 0x4e, 0xec, 0xca, 0xf9, 0xc4, 0xae, 0xfc, 0x9c, 0xd8, 0x95, 0x0f, 0x57, 0x29, 0x62, 0x8a,
-	0x3a, 0x19, 0x8e, 0x40, 0x7e, 0x79, 0x9f, 0xd4, 0x9f, 0x94, 0x24, 0x45, 0x9b, 0xb2, 0xc2, 0x4f,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x16, 0xde, 0x3f, 0x0d, 0x64, 0x05, 0x00, 0x00,
+var fileDescriptor_9ab624d2121a8c46 = []byte{
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xc1, 0x4e, 0xdb, 0x4c,
+	0x10, 0xc7, 0x13, 0xc2, 0x07, 0x61, 0xf9, 0x08, 0xad, 0x0b, 0xc5, 0xa4, 0x28, 0x8e, 0x38, 0x50,
+	0xd4, 0x16, 0xbb, 0xc0, 0xa1, 0x97, 0x5e, 0x30, 0x11, 0x6d, 0xa5, 0xaa, 0xaa, 0x0c, 0xa7, 0x4a,
+	0x95, 0xb5, 0xb6, 0x87, 0xb0, 0x22, 0xf6, 0x5a, 0x3b, 0x9b, 0x50, 0xda, 0x57, 0xa8, 0x2a, 0xce,
+	0x7d, 0x84, 0x9e, 0xfb, 0x10, 0x1c, 0x51, 0x4f, 0x55, 0x0f, 0x50, 0xc1, 0x8b, 0x54, 0x5e, 0x6f,
+	0x42, 0xaa, 0x24, 0x12, 0x87, 0x72, 0x82, 0x99, 0xf9, 0xcf, 0xff, 0x37, 0xb6, 0x77, 0x27, 0xc4,
+	0xa2, 0x20, 0x0f, 0x9c, 0x03, 0x2a, 0x22, 0xa7, 0xb3, 0x1e, 0x80, 0xa4, 0xeb, 0x4e, 0x13, 0x12,
+	0x40, 0x86, 0x76, 0x2a, 0xb8, 0xe4, 0xc6, 0xdd, 0x4c, 0x60, 0x67, 0x02, 0x5b, 0x0b, 0xaa, 0xb5,
+	0x90, 0x63, 0xcc, 0xd1, 0x09, 0x28, 0x42, 0xaf, 0x2b, 0xe4, 0x2c, 0xc9, 0x5b, 0xaa, 0x8b, 0x79,
+	0xdd, 0x57, 0x91, 0x93, 0x07, 0xba, 0x34, 0xd7, 0xe4, 0x4d, 0x9e, 0xe7, 0xb3, 0xff, 0x74, 0xd6,
+	0x6a, 0x72, 0xde, 0x6c, 0x81, 0xa3, 0xa2, 0xa0, 0xbd, 0xef, 0x48, 0x16, 0x03, 0x4a, 0x1a, 0xa7,
+	0x5a, 0xb0, 0x34, 0x38, 0xa5, 0x9a, 0x48, 0x55, 0x97, 0xbf, 0x96, 0xc9, 0xff, 0x2f, 0xf2, 0xa1,
+	0x77, 0x25, 0x95, 0x60, 0x3c, 0x23, 0x13, 0x29, 0x15, 0x34, 0x46, 0xb3, 0x58, 0x2f, 0xae, 0x4e,
+	0x6f, 0x2c, 0xda, 0x03, 0x0f, 0x61, 0xbf, 0x55, 0x02, 0x77, 0xfc, 0xf4, 0xdc, 0x2a, 0x78, 0x5a,
+	0x6e, 0x7c, 0x2e, 0x92, 0x07, 0xa9, 0x80, 0x0e, 0xe3, 0x6d, 0xf4, 0x69, 0x18, 0xb6, 0xe3, 0x76,
+	0x8b, 0x4a, 0xc6, 0x13, 0x5f, 0x4d, 0x64, 0x8e, 0xd5, 0x4b, 0xab, 0xd3, 0x1b, 0x8f, 0x86, 0xd8,
+	0x69, 0xfe, 0x56, 0x5f, 0xcf, 0x1e, 0x8b, 0xc1, 0xad, 0x67, 0xfe, 0xdf, 0x2e, 0x2c, 0x73, 0x84,
+	0x00, 0xbd, 0xc5, 0x2e, 0x70, 0xa0, 0x64, 0xbc, 0x24, 0xe5, 0x08, 0x52, 0x8e, 0x4c, 0xa2, 0x59,
+	0x52, 0xe8, 0xea, 0x10, 0x74, 0x23, 0x97, 0xb8, 0x77, 0x34, 0xaa, 0xac, 0x13, 0xe8, 0xf5, 0xba,
+	0x8d, 0x06, 0x99, 0x0c, 0xb8, 0x10, 0xfc, 0x08, 0xcd, 0xf1, 0x7a, 0x69, 0xc4, 0x2b, 0x71, 0x95,
+	0xc2, 0x9d, 0xd5, 0x3e, 0x93, 0x79, 0x8c, 0x5e, 0xb7, 0xd5, 0x10, 0xa4, 0x22, 0xb9, 0xa4, 0x2d,
+	0x1f, 0xdb, 0x69, 0xda, 0x62, 0x10, 0x99, 0xff, 0x69, 0x33, 0xfd, 0x91, 0xb3, 0x13, 0xd1, 0xb3,
+	0xdb, 0xe6, 0x2c, 0x71, 0x9f, 0x6a, 0xb3, 0xd5, 0x26, 0x93, 0x07, 0xed, 0xc0, 0x0e, 0x79, 0xac,
+	0x4f, 0x84, 0xfe, 0xb3, 0x86, 0xd1, 0xa1, 0x23, 0x8f, 0x53, 0x40, 0xd5, 0x80, 0xde, 0x8c, 0x42,
+	0xec, 0x6a, 0xc2, 0x35, 0x33, 0x1f, 0x02, 0x22, 0x73, 0xe2, 0xb6, 0x98, 0xae, 0x26, 0x5c, 0x33,
+	0x05, 0x20, 0x88, 0x0e, 0xa0, 0x39, 0x79, 0x5b, 0x4c, 0x4f, 0x13, 0x8c, 0x8f, 0x64, 0x96, 0x21,
+	0xd7, 0xa7, 0x2d, 0x82, 0x40, 0xa2, 0x59, 0x56, 0xd0, 0xa5, 0xa1, 0xd0, 0x06, 0x84, 0x8a, 0xbb,
+	0xa9, 0xb9, 0x8f, 0x6f, 0xc0, 0xd5, 0x3d, 0xe8, 0x55, 0x7a, 0xa4, 0x46, 0x06, 0x32, 0x3e, 0x91,
+	0xf9, 0x84, 0x27, 0x7e, 0xc8, 0x5b, 0x2d, 0x2a, 0x41, 0xd0, 0x96, 0x1f, 0x41, 0xc2, 0x63, 0x34,
+	0xa7, 0xd4, 0x04, 0x2b, 0x43, 0xce, 0xca, 0x1b, 0x9e, 0x6c, 0xf7, 0xe4, 0x0d, 0xa5, 0x76, 0x2d,
+	0x3d, 0xcb, 0xc2, 0x90, 0xe2, 0x6b, 0x86, 0xd2, 0xbb, 0x97, 0x0c, 0x16, 0x8c, 0xf7, 0xa4, 0x42,
+	0x23, 0x9a, 0x4a, 0xd6, 0x01, 0x5f, 0x50, 0x09, 0x68, 0x12, 0x45, 0xb5, 0x86, 0x50, 0xb7, 0xb4,
+	0xd0, 0xa3, 0x12, 0xdc, 0x79, 0x8d, 0x9b, 0xe9, 0xcf, 0xa2, 0x37, 0x43, 0xfb, 0xc3, 0xe5, 0x2f,
+	0x25, 0xb2, 0x30, 0xe2, 0xee, 0x19, 0x0f, 0xc9, 0x6c, 0xdf, 0x33, 0x67, 0x2f, 0x49, 0x2d, 0x8c,
+	0x29, 0xaf, 0x72, 0x9d, 0xde, 0x3b, 0x4e, 0xc1, 0x08, 0x48, 0x75, 0xf4, 0x5a, 0x30, 0xc7, 0xd4,
+	0x92, 0xa9, 0xda, 0xf9, 0x16, 0xb3, 0xbb, 0x5b, 0xcc, 0xde, 0xeb, 0x6e, 0x31, 0xb7, 0x9c, 0x8d,
+	0x7a, 0x72, 0x61, 0x15, 0x3d, 0x73, 0xd4, 0x6d, 0x37, 0x04, 0xb9, 0xaf, 0xae, 0xd5, 0xb1, 0xcf,
+	0x12, 0x09, 0x02, 0x50, 0xfa, 0xfb, 0x34, 0x94, 0x5c, 0x98, 0xa5, 0x6c, 0x26, 0xf7, 0x79, 0xe6,
+	0xf1, 0xeb, 0xdc, 0x5a, 0xb9, 0xd9, 0x97, 0xfe, 0xf1, 0x7d, 0x8d, 0xe4, 0xf9, 0x2c, 0xf2, 0xe6,
+	0x72, 0xef, 0x57, 0xda, 0x7a, 0x47, 0x39, 0x67, 0xcc, 0xfc, 0x5a, 0x0d, 0x30, 0xc7, 0xff, 0x05,
+	0x33, 0xf7, 0xfe, 0x9b, 0xe9, 0xee, 0x9c, 0x5e, 0xd6, 0x8a, 0x67, 0x97, 0xb5, 0xe2, 0xef, 0xcb,
+	0x5a, 0xf1, 0xe4, 0xaa, 0x56, 0x38, 0xbb, 0xaa, 0x15, 0x7e, 0x5e, 0xd5, 0x0a, 0xef, 0x9e, 0xf4,
+	0x51, 0x62, 0x7e, 0xc8, 0x24, 0x4d, 0x40, 0x1e, 0x71, 0x71, 0xe8, 0x64, 0x27, 0x01, 0x84, 0xf3,
+	0x21, 0xff, 0x09, 0x50, 0xbc, 0x60, 0x42, 0xbd, 0xe7, 0xcd, 0x3f, 0x03, 0x00, 0x71, 0x19, 0x3f,
+	0xeb, 0xc2, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +281,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdaptiveRates) > 0 {
+		for iNdEx := len(m.AdaptiveRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdaptiveRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.NonCollateralDenoms) > 0 {
+		for iNdEx := len(m.NonCollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonCollateralDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.IsolationDebts) > 0 {
+		for iNdEx := len(m.IsolationDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IsolationDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.TotalReserves) > 0 {
 		for iNdEx := len(m.TotalReserves) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -456,6 +533,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.IsolationDebts) > 0 {
+		for _, e := range m.IsolationDebts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NonCollateralDenoms) > 0 {
+		for _, e := range m.NonCollateralDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdaptiveRates) > 0 {
+		for _, e := range m.AdaptiveRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -750,6 +845,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IsolationDebts = append(m.IsolationDebts, types.DecCoin{})
+			if err := m.IsolationDebts[len(m.IsolationDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonCollateralDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonCollateralDenoms = append(m.NonCollateralDenoms, NonCollateralDenoms{})
+			if err := m.NonCollateralDenoms[len(m.NonCollateralDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdaptiveRates = append(m.AdaptiveRates, AdaptiveRate{})
+			if err := m.AdaptiveRates[len(m.AdaptiveRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
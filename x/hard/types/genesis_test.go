@@ -33,6 +33,9 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 		ts     sdk.Coins
 		tb     sdk.Coins
 		tr     sdk.Coins
+		ids    sdk.DecCoins
+		ncds   types.NonCollateralDenomsList
+		ars    types.AdaptiveRates
 	}
 	testCases := []struct {
 		name        string
@@ -50,6 +53,9 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 				ts:     types.DefaultTotalSupplied,
 				tb:     types.DefaultTotalBorrowed,
 				tr:     types.DefaultTotalReserves,
+				ids:    types.DefaultIsolationDebts,
+				ncds:   types.DefaultNonCollateralDenoms,
+				ars:    types.DefaultAdaptiveRates,
 			},
 			expectPass:  true,
 			expectedErr: "",
@@ -71,14 +77,91 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 				ts:   sdk.Coins{},
 				tb:   sdk.Coins{},
 				tr:   sdk.Coins{},
+				ids:  sdk.NewDecCoins(sdk.NewDecCoinFromDec("bnb", sdk.MustNewDecFromStr("1000.5"))),
+				ncds: types.NonCollateralDenomsList{
+					types.NewNonCollateralDenoms(sdk.AccAddress("test1"), []string{"bnb", "usdx"}),
+				},
+				ars: types.AdaptiveRates{
+					types.NewAdaptiveRate("usdx", sdk.MustNewDecFromStr("0.04")),
+				},
 			},
 			expectPass:  true,
 			expectedErr: "",
 		},
+		{
+			name: "invalid isolation debts",
+			args: args{
+				params: types.DefaultParams(),
+				gats:   types.DefaultAccumulationTimes,
+				deps:   types.DefaultDeposits,
+				brws:   types.DefaultBorrows,
+				ts:     types.DefaultTotalSupplied,
+				tb:     types.DefaultTotalBorrowed,
+				tr:     types.DefaultTotalReserves,
+				ids:    sdk.DecCoins{sdk.DecCoin{Denom: "bnb", Amount: sdk.MustNewDecFromStr("-1")}},
+			},
+			expectPass:  false,
+			expectedErr: "invalid isolation debts",
+		},
+		{
+			name: "duplicate non-collateral depositors",
+			args: args{
+				params: types.DefaultParams(),
+				gats:   types.DefaultAccumulationTimes,
+				deps:   types.DefaultDeposits,
+				brws:   types.DefaultBorrows,
+				ts:     types.DefaultTotalSupplied,
+				tb:     types.DefaultTotalBorrowed,
+				tr:     types.DefaultTotalReserves,
+				ids:    types.DefaultIsolationDebts,
+				ncds: types.NonCollateralDenomsList{
+					types.NewNonCollateralDenoms(sdk.AccAddress("test1"), []string{"bnb"}),
+					types.NewNonCollateralDenoms(sdk.AccAddress("test1"), []string{"usdx"}),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate non-collateral depositor",
+		},
+		{
+			name: "duplicate non-collateral denoms",
+			args: args{
+				params: types.DefaultParams(),
+				gats:   types.DefaultAccumulationTimes,
+				deps:   types.DefaultDeposits,
+				brws:   types.DefaultBorrows,
+				ts:     types.DefaultTotalSupplied,
+				tb:     types.DefaultTotalBorrowed,
+				tr:     types.DefaultTotalReserves,
+				ids:    types.DefaultIsolationDebts,
+				ncds: types.NonCollateralDenomsList{
+					types.NewNonCollateralDenoms(sdk.AccAddress("test1"), []string{"bnb", "bnb"}),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate non-collateral denom",
+		},
+		{
+			name: "negative adaptive rate",
+			args: args{
+				params: types.DefaultParams(),
+				gats:   types.DefaultAccumulationTimes,
+				deps:   types.DefaultDeposits,
+				brws:   types.DefaultBorrows,
+				ts:     types.DefaultTotalSupplied,
+				tb:     types.DefaultTotalBorrowed,
+				tr:     types.DefaultTotalReserves,
+				ids:    types.DefaultIsolationDebts,
+				ars: types.AdaptiveRates{
+					types.NewAdaptiveRate("usdx", sdk.MustNewDecFromStr("-0.04")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "rate at target must not be negative",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			gs := types.NewGenesisState(tc.args.params, tc.args.gats, tc.args.deps, tc.args.brws, tc.args.ts, tc.args.tb, tc.args.tr)
+			gs := types.NewGenesisState(tc.args.params, tc.args.gats, tc.args.deps, tc.args.brws, tc.args.ts, tc.args.tb, tc.args.tr, tc.args.ids, tc.args.ncds, tc.args.ars)
 			err := gs.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
@@ -26,6 +26,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RateModelType is the interest rate model a money market uses to set its borrow rate.
+type RateModelType int32
+
+const (
+	// RATE_MODEL_TYPE_JUMP sets the borrow rate from utilization with the jump-rate interest_rate_model.
+	RATE_MODEL_TYPE_JUMP RateModelType = 0
+	// RATE_MODEL_TYPE_ADAPTIVE sets the borrow rate with a curve that moves over time to bring utilization
+	// towards a target.
+	RATE_MODEL_TYPE_ADAPTIVE RateModelType = 1
+	// RATE_MODEL_TYPE_FIXED sets a borrow rate that doesn't depend on utilization.
+	RATE_MODEL_TYPE_FIXED RateModelType = 2
+)
+
+var RateModelType_name = map[int32]string{
+	0: "RATE_MODEL_TYPE_JUMP",
+	1: "RATE_MODEL_TYPE_ADAPTIVE",
+	2: "RATE_MODEL_TYPE_FIXED",
+}
+
+var RateModelType_value = map[string]int32{
+	"RATE_MODEL_TYPE_JUMP":     0,
+	"RATE_MODEL_TYPE_ADAPTIVE": 1,
+	"RATE_MODEL_TYPE_FIXED":    2,
+}
+
+func (x RateModelType) String() string {
+	return proto.EnumName(RateModelType_name, int32(x))
+}
+
+func (RateModelType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3df4e86915784b15, []int{0}
+}
+
 // Params defines the parameters for the hard module.
 type Params struct {
 	MoneyMarkets          MoneyMarkets                           `protobuf:"bytes,1,rep,name=money_markets,json=moneyMarkets,proto3,castrepeated=MoneyMarkets" json:"money_markets"`
@@ -36,7 +69,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{0}
+	return fileDescriptor_3df4e86915784b15, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -74,13 +107,42 @@ type MoneyMarket struct {
 	InterestRateModel      InterestRateModel                      `protobuf:"bytes,5,opt,name=interest_rate_model,json=interestRateModel,proto3" json:"interest_rate_model"`
 	ReserveFactor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_factor"`
 	KeeperRewardPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
+	// dutch_auction sells liquidated deposits of this market in descending price dutch auctions instead of collateral auctions
+	DutchAuction bool `protobuf:"varint,8,opt,name=dutch_auction,json=dutchAuction,proto3" json:"dutch_auction,omitempty"`
+	// close_factor is the largest fraction of a borrow of this market that can be repaid in one partial liquidation.
+	// Zero disables partial liquidations of borrows of this market.
+	CloseFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=close_factor,json=closeFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close_factor"`
+	// liquidation_incentive is the bonus, as a fraction of the repaid value, paid in deposits of this market to partial liquidators
+	LiquidationIncentive github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=liquidation_incentive,json=liquidationIncentive,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_incentive"`
+	// flash_loan_max_amount is the largest amount of this market that can be flash loaned at once.
+	// Zero disables flash loans of this market.
+	FlashLoanMaxAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=flash_loan_max_amount,json=flashLoanMaxAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"flash_loan_max_amount"`
+	// flash_loan_fee is the fraction of a flash loan that is repaid on top of it, split between reserves and suppliers
+	FlashLoanFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=flash_loan_fee,json=flashLoanFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"flash_loan_fee"`
+	// collateral_disabled stops deposits of this market from backing borrows. They still earn supply interest.
+	CollateralDisabled bool `protobuf:"varint,13,opt,name=collateral_disabled,json=collateralDisabled,proto3" json:"collateral_disabled,omitempty"`
+	// borrow_disabled stops this market from being borrowed
+	BorrowDisabled bool `protobuf:"varint,14,opt,name=borrow_disabled,json=borrowDisabled,proto3" json:"borrow_disabled,omitempty"`
+	// isolated deposits of this market can only back borrows of markets that are borrowable in isolation,
+	// and cannot be combined with deposits of other collateral
+	Isolated bool `protobuf:"varint,15,opt,name=isolated,proto3" json:"isolated,omitempty"`
+	// isolation_debt_ceiling is the largest debt, in whole units of the borrowed markets, that isolated deposits of this market can back
+	IsolationDebtCeiling github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=isolation_debt_ceiling,json=isolationDebtCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"isolation_debt_ceiling"`
+	// borrowable_in_isolation allows this market to be borrowed against isolated deposits
+	BorrowableInIsolation bool `protobuf:"varint,17,opt,name=borrowable_in_isolation,json=borrowableInIsolation,proto3" json:"borrowable_in_isolation,omitempty"`
+	// rate_model_type selects the interest rate model that sets this market's borrow rate
+	RateModelType RateModelType `protobuf:"varint,18,opt,name=rate_model_type,json=rateModelType,proto3,enum=aeth.hard.v1beta1.RateModelType" json:"rate_model_type,omitempty"`
+	// adaptive_rate_model sets the borrow rate when rate_model_type is RATE_MODEL_TYPE_ADAPTIVE
+	AdaptiveRateModel *AdaptiveRateModel `protobuf:"bytes,19,opt,name=adaptive_rate_model,json=adaptiveRateModel,proto3" json:"adaptive_rate_model,omitempty"`
+	// fixed_rate_model sets the borrow rate when rate_model_type is RATE_MODEL_TYPE_FIXED
+	FixedRateModel *FixedRateModel `protobuf:"bytes,20,opt,name=fixed_rate_model,json=fixedRateModel,proto3" json:"fixed_rate_model,omitempty"`
 }
 
 func (m *MoneyMarket) Reset()         { *m = MoneyMarket{} }
 func (m *MoneyMarket) String() string { return proto.CompactTextString(m) }
 func (*MoneyMarket) ProtoMessage()    {}
 func (*MoneyMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{1}
+	return fileDescriptor_3df4e86915784b15, []int{1}
 }
 func (m *MoneyMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,7 +182,7 @@ func (m *BorrowLimit) Reset()         { *m = BorrowLimit{} }
 func (m *BorrowLimit) String() string { return proto.CompactTextString(m) }
 func (*BorrowLimit) ProtoMessage()    {}
 func (*BorrowLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{2}
+	return fileDescriptor_3df4e86915784b15, []int{2}
 }
 func (m *BorrowLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +223,7 @@ func (m *InterestRateModel) Reset()         { *m = InterestRateModel{} }
 func (m *InterestRateModel) String() string { return proto.CompactTextString(m) }
 func (*InterestRateModel) ProtoMessage()    {}
 func (*InterestRateModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{3}
+	return fileDescriptor_3df4e86915784b15, []int{3}
 }
 func (m *InterestRateModel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,6 +252,134 @@ func (m *InterestRateModel) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InterestRateModel proto.InternalMessageInfo
 
+// AdaptiveRateModel is an interest rate curve around a target utilization. The rate at the target moves up while
+// utilization is over the target, and down while it is under, at a speed proportional to the distance from the target.
+type AdaptiveRateModel struct {
+	// target_utilization is the utilization the model steers the market towards
+	TargetUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=target_utilization,json=targetUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_utilization"`
+	// initial_rate_at_target is the borrow APY at target utilization before the rate has adapted
+	InitialRateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=initial_rate_at_target,json=initialRateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_rate_at_target"`
+	// min_rate_at_target is the lowest the borrow APY at target utilization can adapt to
+	MinRateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_rate_at_target,json=minRateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_rate_at_target"`
+	// max_rate_at_target is the highest the borrow APY at target utilization can adapt to
+	MaxRateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_rate_at_target,json=maxRateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate_at_target"`
+	// adjustment_speed is the fraction the rate at target changes by per year at 0% or 100% utilization
+	AdjustmentSpeed github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=adjustment_speed,json=adjustmentSpeed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adjustment_speed"`
+	// curve_steepness is the borrow APY at 100% utilization as a multiple of the rate at target. The APY at 0%
+	// utilization is the rate at target divided by the steepness.
+	CurveSteepness github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=curve_steepness,json=curveSteepness,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"curve_steepness"`
+}
+
+func (m *AdaptiveRateModel) Reset()         { *m = AdaptiveRateModel{} }
+func (m *AdaptiveRateModel) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveRateModel) ProtoMessage()    {}
+func (*AdaptiveRateModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3df4e86915784b15, []int{4}
+}
+func (m *AdaptiveRateModel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdaptiveRateModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdaptiveRateModel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdaptiveRateModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveRateModel.Merge(m, src)
+}
+func (m *AdaptiveRateModel) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdaptiveRateModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveRateModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveRateModel proto.InternalMessageInfo
+
+// FixedRateModel is a borrow rate that doesn't depend on utilization, such as for stable assets.
+type FixedRateModel struct {
+	BorrowRateAPY github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=borrow_rate_apy,json=borrowRateApy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"borrow_rate_apy"`
+}
+
+func (m *FixedRateModel) Reset()         { *m = FixedRateModel{} }
+func (m *FixedRateModel) String() string { return proto.CompactTextString(m) }
+func (*FixedRateModel) ProtoMessage()    {}
+func (*FixedRateModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3df4e86915784b15, []int{5}
+}
+func (m *FixedRateModel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixedRateModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixedRateModel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FixedRateModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixedRateModel.Merge(m, src)
+}
+func (m *FixedRateModel) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixedRateModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixedRateModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixedRateModel proto.InternalMessageInfo
+
+// AdaptiveRate is the current borrow APY at target utilization of a money market using an adaptive rate model.
+type AdaptiveRate struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate_at_target,json=rateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_at_target"`
+}
+
+func (m *AdaptiveRate) Reset()         { *m = AdaptiveRate{} }
+func (m *AdaptiveRate) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveRate) ProtoMessage()    {}
+func (*AdaptiveRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3df4e86915784b15, []int{6}
+}
+func (m *AdaptiveRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdaptiveRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdaptiveRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdaptiveRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveRate.Merge(m, src)
+}
+func (m *AdaptiveRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdaptiveRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdaptiveRate proto.InternalMessageInfo
+
 // Deposit defines an amount of coins deposited into a hard module account.
 type Deposit struct {
 	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
@@ -201,7 +391,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{4}
+	return fileDescriptor_3df4e86915784b15, []int{7}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +431,7 @@ func (m *Borrow) Reset()         { *m = Borrow{} }
 func (m *Borrow) String() string { return proto.CompactTextString(m) }
 func (*Borrow) ProtoMessage()    {}
 func (*Borrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{5}
+	return fileDescriptor_3df4e86915784b15, []int{8}
 }
 func (m *Borrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,7 +470,7 @@ func (m *SupplyInterestFactor) Reset()         { *m = SupplyInterestFactor{} }
 func (m *SupplyInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactor) ProtoMessage()    {}
 func (*SupplyInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{6}
+	return fileDescriptor_3df4e86915784b15, []int{9}
 }
 func (m *SupplyInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +509,7 @@ func (m *BorrowInterestFactor) Reset()         { *m = BorrowInterestFactor{} }
 func (m *BorrowInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactor) ProtoMessage()    {}
 func (*BorrowInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{7}
+	return fileDescriptor_3df4e86915784b15, []int{10}
 }
 func (m *BorrowInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,6 +538,45 @@ func (m *BorrowInterestFactor) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BorrowInterestFactor proto.InternalMessageInfo
 
+// NonCollateralDenoms lists the denoms whose deposits a depositor has chosen not to use as collateral
+type NonCollateralDenoms struct {
+	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
+	Denoms    []string                                      `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *NonCollateralDenoms) Reset()         { *m = NonCollateralDenoms{} }
+func (m *NonCollateralDenoms) String() string { return proto.CompactTextString(m) }
+func (*NonCollateralDenoms) ProtoMessage()    {}
+func (*NonCollateralDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3df4e86915784b15, []int{11}
+}
+func (m *NonCollateralDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonCollateralDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonCollateralDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NonCollateralDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonCollateralDenoms.Merge(m, src)
+}
+func (m *NonCollateralDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonCollateralDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonCollateralDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonCollateralDenoms proto.InternalMessageInfo
+
 // CoinsProto defines a Protobuf wrapper around a Coins slice
 type CoinsProto struct {
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
@@ -357,7 +586,7 @@ func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
 func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
 func (*CoinsProto) ProtoMessage()    {}
 func (*CoinsProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{8}
+	return fileDescriptor_3df4e86915784b15, []int{12}
 }
 func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,78 +616,119 @@ func (m *CoinsProto) XXX_DiscardUnknown() {
 var xxx_messageInfo_CoinsProto proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("aeth.hard.v1beta1.RateModelType", RateModelType_name, RateModelType_value)
 	proto.RegisterType((*Params)(nil), "aeth.hard.v1beta1.Params")
 	proto.RegisterType((*MoneyMarket)(nil), "aeth.hard.v1beta1.MoneyMarket")
 	proto.RegisterType((*BorrowLimit)(nil), "aeth.hard.v1beta1.BorrowLimit")
 	proto.RegisterType((*InterestRateModel)(nil), "aeth.hard.v1beta1.InterestRateModel")
+	proto.RegisterType((*AdaptiveRateModel)(nil), "aeth.hard.v1beta1.AdaptiveRateModel")
+	proto.RegisterType((*FixedRateModel)(nil), "aeth.hard.v1beta1.FixedRateModel")
+	proto.RegisterType((*AdaptiveRate)(nil), "aeth.hard.v1beta1.AdaptiveRate")
 	proto.RegisterType((*Deposit)(nil), "aeth.hard.v1beta1.Deposit")
 	proto.RegisterType((*Borrow)(nil), "aeth.hard.v1beta1.Borrow")
 	proto.RegisterType((*SupplyInterestFactor)(nil), "aeth.hard.v1beta1.SupplyInterestFactor")
 	proto.RegisterType((*BorrowInterestFactor)(nil), "aeth.hard.v1beta1.BorrowInterestFactor")
+	proto.RegisterType((*NonCollateralDenoms)(nil), "aeth.hard.v1beta1.NonCollateralDenoms")
 	proto.RegisterType((*CoinsProto)(nil), "aeth.hard.v1beta1.CoinsProto")
 }
 
-func init() { proto.RegisterFile("aeth/hard/v1beta1/hard.proto", fileDescriptor_23a5de800263a2ff) }
-
-var fileDescriptor_23a5de800263a2ff = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x14, 0xf7, 0x9e, 0x3f, 0x92, 0x8c, 0xed, 0x23, 0xde, 0xdc, 0xa1, 0x4d, 0x04, 0xeb, 0xc8, 0x42,
-	0x70, 0x8d, 0x6d, 0x02, 0x82, 0x8a, 0xe6, 0x16, 0x0b, 0x38, 0x81, 0x25, 0x6b, 0x8f, 0x20, 0x25,
-	0x42, 0x5a, 0xc6, 0xbb, 0x2f, 0x77, 0x83, 0x3d, 0x3b, 0xab, 0x99, 0xb1, 0x63, 0x77, 0xb4, 0x34,
-	0x88, 0x3f, 0x82, 0x8a, 0x0e, 0xe9, 0xfe, 0x88, 0x2b, 0xa3, 0x54, 0x88, 0xc2, 0x80, 0xaf, 0xa3,
-	0xa6, 0xa2, 0x42, 0xf3, 0xe1, 0x8f, 0x5c, 0x1c, 0x29, 0xa7, 0x58, 0x88, 0x6a, 0x77, 0xe6, 0xbd,
-	0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x9b, 0x99, 0x87, 0xde, 0x18, 0xe0, 0x31, 0x6e, 0x9f, 0x62, 0x9e,
-	0xb4, 0xc7, 0xf7, 0xfa, 0x20, 0xf1, 0x3d, 0x3d, 0x68, 0x65, 0x9c, 0x49, 0xe6, 0xd6, 0x94, 0xb5,
-	0xa5, 0x27, 0xac, 0xf5, 0x8e, 0x1f, 0x33, 0x41, 0x99, 0x68, 0xf7, 0xb1, 0x80, 0xe5, 0x92, 0x98,
-	0x91, 0xd4, 0x2c, 0xb9, 0x73, 0xdb, 0xd8, 0x23, 0x3d, 0x6a, 0x9b, 0x81, 0x35, 0xed, 0x9d, 0xb0,
-	0x13, 0x66, 0xe6, 0xd5, 0x9f, 0x99, 0x6d, 0xfc, 0xed, 0xa0, 0x52, 0x0f, 0x73, 0x4c, 0x85, 0xfb,
-	0x00, 0x55, 0x29, 0x4b, 0x61, 0x1a, 0x51, 0xcc, 0x07, 0x20, 0x85, 0xe7, 0xdc, 0xcd, 0x1f, 0x94,
-	0xdf, 0xf3, 0x5b, 0xcf, 0xd1, 0x68, 0x75, 0x95, 0x5f, 0x57, 0xbb, 0x05, 0x7b, 0xe7, 0xb3, 0x7a,
-	0xee, 0xe7, 0xdf, 0xeb, 0x95, 0xb5, 0x49, 0x11, 0x56, 0xe8, 0xda, 0xc8, 0xfd, 0xc1, 0x41, 0x1e,
-	0x25, 0x29, 0xa1, 0x23, 0x1a, 0xf5, 0x19, 0xe7, 0xec, 0x71, 0x34, 0x12, 0x49, 0x34, 0xc6, 0xc3,
-	0x11, 0x78, 0x3b, 0x77, 0x9d, 0x83, 0x1b, 0xc1, 0x7d, 0x05, 0xf3, 0xdb, 0xac, 0xfe, 0xf6, 0x09,
-	0x91, 0xa7, 0xa3, 0x7e, 0x2b, 0x66, 0xd4, 0xf2, 0xb7, 0x9f, 0xa6, 0x48, 0x06, 0x6d, 0x39, 0xcd,
-	0x40, 0xb4, 0x3a, 0x10, 0xcf, 0x67, 0xf5, 0xfd, 0xae, 0x41, 0x0c, 0x34, 0xe0, 0xfd, 0xe3, 0xce,
-	0x57, 0x0a, 0xee, 0xe9, 0x59, 0x13, 0xd9, 0xbc, 0x3b, 0x10, 0x87, 0xfb, 0xf4, 0x19, 0x27, 0x91,
-	0x68, 0xa7, 0xc6, 0x79, 0x01, 0x95, 0xd7, 0xf8, 0xba, 0x7b, 0xa8, 0x98, 0x40, 0xca, 0xa8, 0xe7,
-	0x28, 0x32, 0xa1, 0x19, 0xb8, 0x9f, 0xa2, 0x8a, 0x65, 0x3b, 0x24, 0x94, 0x48, 0xcd, 0x74, 0xb3,
-	0x20, 0x06, 0xfe, 0x0b, 0xe5, 0x15, 0x14, 0x54, 0x26, 0x61, 0xb9, 0xbf, 0x9a, 0x72, 0x3f, 0x44,
-	0xbb, 0x22, 0x63, 0xd2, 0x2a, 0x1b, 0x91, 0xc4, 0xcb, 0xeb, 0xa4, 0x6f, 0xce, 0x67, 0xf5, 0xca,
-	0x71, 0xc6, 0xa4, 0xa1, 0x71, 0xd4, 0x09, 0x2b, 0x62, 0x35, 0x4a, 0x5c, 0x82, 0x6a, 0x31, 0x4b,
-	0xc7, 0xc0, 0x05, 0x61, 0x69, 0xf4, 0x08, 0xc7, 0x92, 0x71, 0xaf, 0xa0, 0x97, 0x7e, 0x74, 0x05,
-	0xbd, 0x8e, 0x52, 0xb9, 0x26, 0xcb, 0x51, 0x2a, 0xc3, 0x9b, 0x2b, 0xd8, 0x4f, 0x34, 0xaa, 0xfb,
-	0x10, 0xdd, 0x22, 0xa9, 0x04, 0x0e, 0x42, 0x46, 0x1c, 0x4b, 0x88, 0x28, 0x4b, 0x60, 0xe8, 0x15,
-	0x75, 0xca, 0x6f, 0x6d, 0x48, 0xf9, 0xc8, 0x7a, 0x87, 0x58, 0x42, 0x57, 0xf9, 0xda, 0xc4, 0x6b,
-	0xe4, 0xb2, 0xc1, 0x8d, 0xd1, 0x2e, 0x07, 0x01, 0x7c, 0x0c, 0x8b, 0x1c, 0x4a, 0x57, 0xce, 0xa1,
-	0x03, 0xf1, 0xa5, 0xad, 0xad, 0x5a, 0x4c, 0x9b, 0xc0, 0x18, 0x79, 0x03, 0x80, 0x0c, 0x78, 0xc4,
-	0xe1, 0x31, 0xe6, 0x49, 0x94, 0x01, 0x8f, 0x21, 0x95, 0xf8, 0x04, 0xbc, 0x6b, 0x5b, 0x08, 0xf7,
-	0xba, 0x41, 0x0f, 0x35, 0x78, 0x6f, 0x89, 0xdd, 0xf8, 0x7e, 0x07, 0x95, 0xd7, 0xb6, 0xdf, 0xfd,
-	0x00, 0x55, 0x4f, 0xb1, 0x88, 0x28, 0x9e, 0xd8, 0xaa, 0x51, 0x25, 0x75, 0x3d, 0xa8, 0xfd, 0x35,
-	0xab, 0x3f, 0x6b, 0x08, 0xcb, 0xa7, 0x58, 0x74, 0xf1, 0xc4, 0x2c, 0xc3, 0xa8, 0x4a, 0xf1, 0x44,
-	0x9f, 0x90, 0x55, 0xb1, 0xbd, 0x2a, 0xe7, 0x8a, 0x85, 0x34, 0x21, 0xbe, 0x41, 0xd5, 0x21, 0xc3,
-	0x69, 0x24, 0x99, 0x3d, 0x79, 0xf9, 0x2d, 0x84, 0x28, 0x2b, 0xc8, 0x2f, 0x99, 0x39, 0x56, 0x3f,
-	0xe5, 0x51, 0xed, 0xb9, 0xba, 0x70, 0x19, 0xaa, 0xaa, 0xfb, 0xca, 0x94, 0x15, 0xce, 0xa6, 0xe6,
-	0x90, 0x05, 0x9f, 0x5f, 0xf9, 0xc4, 0x97, 0x03, 0x2c, 0x40, 0xe1, 0x1e, 0xf6, 0x1e, 0x5c, 0xa6,
-	0xd1, 0x5f, 0x98, 0xb2, 0xa9, 0x0b, 0xe8, 0x35, 0x1d, 0x90, 0x8e, 0x86, 0x92, 0x64, 0x43, 0x02,
-	0x7c, 0x2b, 0x6a, 0xee, 0x2a, 0xd0, 0xee, 0x12, 0xd3, 0xed, 0xa1, 0xc2, 0x80, 0xa4, 0x83, 0xad,
-	0xc8, 0xa8, 0x91, 0x14, 0xf1, 0x6f, 0x47, 0x34, 0x5b, 0x27, 0x5e, 0xd8, 0x06, 0x71, 0x05, 0xba,
-	0x22, 0xde, 0x38, 0xdb, 0x41, 0xd7, 0x3a, 0x90, 0x31, 0x41, 0xa4, 0xfb, 0x08, 0xdd, 0x48, 0xcc,
-	0x2f, 0xe3, 0x76, 0x63, 0x3e, 0xfb, 0x67, 0x56, 0x6f, 0xbe, 0x44, 0xa0, 0xc3, 0x38, 0x3e, 0x4c,
-	0x12, 0x0e, 0x42, 0x3c, 0x3d, 0x6b, 0xde, 0xb2, 0xf1, 0xec, 0x4c, 0x30, 0x95, 0x20, 0xc2, 0x15,
-	0xb4, 0x1b, 0xa3, 0x12, 0xa6, 0x6c, 0x94, 0xaa, 0xc2, 0x56, 0xcf, 0xca, 0xed, 0x96, 0x5d, 0xa0,
-	0x44, 0x5d, 0x5e, 0x2a, 0x1f, 0x33, 0x92, 0x06, 0xef, 0xda, 0x17, 0xe5, 0xe0, 0x25, 0x38, 0xa8,
-	0x05, 0x22, 0xb4, 0xd0, 0xee, 0xd7, 0xa8, 0x48, 0xd2, 0x04, 0x26, 0x5e, 0x5e, 0xc7, 0x78, 0x67,
-	0xc3, 0xb5, 0x75, 0x3c, 0xca, 0xb2, 0xe1, 0x74, 0x51, 0xa4, 0xe6, 0xee, 0x08, 0xde, 0xb4, 0x11,
-	0xf7, 0x37, 0x59, 0x45, 0x68, 0x40, 0x1b, 0xbf, 0xec, 0xa0, 0x92, 0x39, 0xe9, 0x6e, 0x82, 0xae,
-	0x9b, 0xfb, 0x1d, 0xb6, 0x2f, 0xda, 0x12, 0xf9, 0x7f, 0xa3, 0x99, 0x49, 0xfa, 0x45, 0x9a, 0x6d,
-	0xb2, 0x2e, 0x35, 0xfb, 0xce, 0x41, 0x7b, 0x9b, 0x44, 0x7d, 0xc1, 0x8b, 0x1b, 0xa2, 0xe2, 0x7a,
-	0x53, 0xf0, 0x6a, 0x65, 0x6f, 0xa0, 0x34, 0x85, 0x4d, 0x1c, 0xff, 0x43, 0x0a, 0x0c, 0x21, 0x2d,
-	0x7a, 0x4f, 0xf7, 0x75, 0x18, 0x15, 0x55, 0xcb, 0xb6, 0x68, 0xb0, 0xb6, 0xba, 0xab, 0x06, 0x39,
-	0xe8, 0x9c, 0xff, 0xe9, 0xe7, 0xce, 0xe7, 0xbe, 0xf3, 0x64, 0xee, 0x3b, 0x7f, 0xcc, 0x7d, 0xe7,
-	0xc7, 0x0b, 0x3f, 0xf7, 0xe4, 0xc2, 0xcf, 0xfd, 0x7a, 0xe1, 0xe7, 0x1e, 0xae, 0xe7, 0xa2, 0x76,
-	0xbb, 0x39, 0xc4, 0x7d, 0xa1, 0xff, 0xda, 0x13, 0xd3, 0x8d, 0x6a, 0xc8, 0x7e, 0x49, 0xf7, 0x88,
-	0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x40, 0xda, 0xa7, 0xd8, 0xa7, 0x0a, 0x00, 0x00,
+func init() { proto.RegisterFile("aeth/hard/v1beta1/hard.proto", fileDescriptor_3df4e86915784b15) }
+
+var fileDescriptor_3df4e86915784b15 = []byte{
+	// 1479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xe6, 0xab, 0xc9, 0xf8, 0x23, 0xf6, 0xe4, 0x83, 0x6d, 0x54, 0x9c, 0x60, 0x10, 0x8d,
+	0x10, 0x89, 0x69, 0x11, 0x3d, 0x71, 0xb1, 0xeb, 0x84, 0xba, 0xad, 0xc1, 0xda, 0x24, 0x15, 0xad,
+	0x90, 0x96, 0xf1, 0xee, 0x24, 0x9e, 0x7a, 0x77, 0x67, 0xbb, 0x33, 0x9b, 0xda, 0x9c, 0xb8, 0x51,
+	0x2e, 0x88, 0x0b, 0x07, 0xce, 0xc0, 0x85, 0x1b, 0x52, 0xff, 0x88, 0x1e, 0xab, 0x9e, 0x10, 0x87,
+	0x00, 0xe9, 0x8d, 0x33, 0x27, 0x4e, 0x68, 0x66, 0x36, 0xeb, 0x8d, 0xe3, 0x48, 0x8d, 0xba, 0x54,
+	0x9c, 0xec, 0x99, 0xf7, 0xe6, 0xf7, 0x7e, 0xef, 0x63, 0xdf, 0x9b, 0x5d, 0x70, 0x09, 0x61, 0xde,
+	0xa9, 0x74, 0x50, 0x60, 0x57, 0x0e, 0xae, 0xb4, 0x31, 0x47, 0x57, 0xe4, 0x62, 0xc3, 0x0f, 0x28,
+	0xa7, 0xb0, 0x28, 0xa4, 0x1b, 0x72, 0x23, 0x92, 0x2e, 0x97, 0x2c, 0xca, 0x5c, 0xca, 0x2a, 0x6d,
+	0xc4, 0x70, 0x7c, 0xc4, 0xa2, 0xc4, 0x53, 0x47, 0x96, 0x2f, 0x2a, 0xb9, 0x29, 0x57, 0x15, 0xb5,
+	0x88, 0x44, 0x0b, 0xfb, 0x74, 0x9f, 0xaa, 0x7d, 0xf1, 0x4f, 0xed, 0x96, 0xff, 0xd6, 0xc0, 0x74,
+	0x0b, 0x05, 0xc8, 0x65, 0xf0, 0x2e, 0xc8, 0xb9, 0xd4, 0xc3, 0x7d, 0xd3, 0x45, 0x41, 0x17, 0x73,
+	0xa6, 0x6b, 0xab, 0x13, 0x6b, 0x99, 0xab, 0xa5, 0x8d, 0x53, 0x34, 0x36, 0x9a, 0x42, 0xaf, 0x29,
+	0xd5, 0x6a, 0x0b, 0x4f, 0x0e, 0x57, 0xc6, 0x7e, 0xfe, 0x7d, 0x25, 0x9b, 0xd8, 0x64, 0x46, 0xd6,
+	0x4d, 0xac, 0xe0, 0x37, 0x1a, 0xd0, 0x5d, 0xe2, 0x11, 0x37, 0x74, 0xcd, 0x36, 0x0d, 0x02, 0xfa,
+	0xd0, 0x0c, 0x99, 0x6d, 0x1e, 0x20, 0x27, 0xc4, 0xfa, 0xf8, 0xaa, 0xb6, 0x36, 0x5b, 0xdb, 0x15,
+	0x30, 0xbf, 0x1d, 0xae, 0xbc, 0xbd, 0x4f, 0x78, 0x27, 0x6c, 0x6f, 0x58, 0xd4, 0x8d, 0xf8, 0x47,
+	0x3f, 0xeb, 0xcc, 0xee, 0x56, 0x78, 0xdf, 0xc7, 0x6c, 0xa3, 0x8e, 0xad, 0xa3, 0xc3, 0x95, 0xc5,
+	0xa6, 0x42, 0xac, 0x49, 0xc0, 0xdd, 0xed, 0xfa, 0x1d, 0x01, 0xf7, 0xec, 0xf1, 0x3a, 0x88, 0xfc,
+	0xae, 0x63, 0xcb, 0x58, 0x74, 0x4f, 0x28, 0x31, 0x5b, 0x2a, 0x95, 0x7f, 0xca, 0x82, 0x4c, 0x82,
+	0x2f, 0x5c, 0x00, 0x53, 0x36, 0xf6, 0xa8, 0xab, 0x6b, 0x82, 0x8c, 0xa1, 0x16, 0xf0, 0x23, 0x90,
+	0x8d, 0xd8, 0x3a, 0xc4, 0x25, 0x5c, 0x32, 0x1d, 0x1d, 0x10, 0x05, 0x7f, 0x5b, 0x68, 0xd5, 0x26,
+	0x85, 0x27, 0x46, 0xa6, 0x3d, 0xd8, 0x82, 0xd7, 0x40, 0x9e, 0xf9, 0x94, 0x47, 0x91, 0x35, 0x89,
+	0xad, 0x4f, 0x48, 0xa7, 0x0b, 0x47, 0x87, 0x2b, 0xd9, 0x6d, 0x9f, 0x72, 0x45, 0xa3, 0x51, 0x37,
+	0xb2, 0x6c, 0xb0, 0xb2, 0x21, 0x01, 0x45, 0x8b, 0x7a, 0x07, 0x38, 0x60, 0x84, 0x7a, 0xe6, 0x1e,
+	0xb2, 0x38, 0x0d, 0xf4, 0x49, 0x79, 0xf4, 0xc3, 0x73, 0xc4, 0xab, 0xe1, 0xf1, 0x44, 0x58, 0x1a,
+	0x1e, 0x37, 0x0a, 0x03, 0xd8, 0x2d, 0x89, 0x0a, 0xef, 0x81, 0x79, 0xe2, 0x71, 0x1c, 0x60, 0xc6,
+	0xcd, 0x00, 0x71, 0x6c, 0xba, 0xd4, 0xc6, 0x8e, 0x3e, 0x25, 0x5d, 0x7e, 0x6b, 0x84, 0xcb, 0x8d,
+	0x48, 0xdb, 0x40, 0x1c, 0x37, 0x85, 0x6e, 0xe4, 0x78, 0x91, 0x0c, 0x0b, 0xa0, 0x05, 0xf2, 0x01,
+	0x66, 0x38, 0x38, 0xc0, 0xc7, 0x3e, 0x4c, 0x9f, 0xdb, 0x87, 0x3a, 0xb6, 0x86, 0x52, 0x9b, 0x8b,
+	0x30, 0x23, 0x07, 0x0e, 0x80, 0xde, 0xc5, 0xd8, 0xc7, 0x81, 0x19, 0xe0, 0x87, 0x28, 0xb0, 0x4d,
+	0x1f, 0x07, 0x16, 0xf6, 0x38, 0xda, 0xc7, 0xfa, 0x85, 0x14, 0xcc, 0x2d, 0x29, 0x74, 0x43, 0x82,
+	0xb7, 0x62, 0x6c, 0xf8, 0x26, 0xc8, 0xd9, 0x21, 0xb7, 0x3a, 0x26, 0x0a, 0x2d, 0x4e, 0xa8, 0xa7,
+	0xcf, 0xac, 0x6a, 0x6b, 0x33, 0x46, 0x56, 0x6e, 0x56, 0xd5, 0x1e, 0x34, 0x41, 0xd6, 0x72, 0x28,
+	0x8b, 0xfd, 0x9f, 0x4d, 0x81, 0x50, 0x46, 0x22, 0x46, 0xde, 0x3f, 0x00, 0x8b, 0x0e, 0x79, 0x10,
+	0x12, 0x1b, 0x09, 0x7b, 0x26, 0xf1, 0x04, 0x3d, 0x72, 0x80, 0x75, 0x90, 0x82, 0xa5, 0x85, 0x04,
+	0x74, 0xe3, 0x18, 0x19, 0x52, 0xb0, 0xb8, 0xe7, 0x20, 0xd6, 0x31, 0x1d, 0x8a, 0x3c, 0xd3, 0x45,
+	0x3d, 0x13, 0xb9, 0x34, 0xf4, 0xb8, 0x9e, 0x49, 0xa1, 0x40, 0xa1, 0x84, 0xbe, 0x4d, 0x91, 0xd7,
+	0x44, 0xbd, 0xaa, 0xc4, 0x85, 0x6d, 0x90, 0x4f, 0x18, 0xdc, 0xc3, 0x58, 0xcf, 0xa6, 0xe0, 0x5c,
+	0x36, 0xb6, 0xb4, 0x85, 0x31, 0xac, 0x80, 0x79, 0x8b, 0x3a, 0x0e, 0xe2, 0x38, 0x40, 0x8e, 0x69,
+	0x13, 0x86, 0xda, 0x0e, 0xb6, 0xf5, 0x9c, 0xcc, 0x29, 0x1c, 0x88, 0xea, 0x91, 0x04, 0x5e, 0x06,
+	0x73, 0x51, 0x8f, 0x88, 0x95, 0xf3, 0x52, 0x39, 0xaf, 0xb6, 0x63, 0xc5, 0x65, 0x30, 0x43, 0x18,
+	0x15, 0xc7, 0x6d, 0x7d, 0x4e, 0x6a, 0xc4, 0x6b, 0x18, 0x80, 0x25, 0xf5, 0x5f, 0xe4, 0xce, 0xc6,
+	0x6d, 0x6e, 0x5a, 0x98, 0x38, 0xc4, 0xdb, 0xd7, 0x0b, 0x69, 0xa4, 0x2f, 0xc6, 0xae, 0xe3, 0x36,
+	0xbf, 0xae, 0x90, 0xe1, 0x35, 0xf0, 0x9a, 0x62, 0x28, 0xe8, 0x99, 0xc4, 0x33, 0x63, 0x2d, 0xbd,
+	0x28, 0xe9, 0x2d, 0x0e, 0xc4, 0x0d, 0xaf, 0x71, 0x2c, 0x84, 0x37, 0xc0, 0xdc, 0xa0, 0x3f, 0x98,
+	0xc2, 0xa6, 0x0e, 0x57, 0xb5, 0xb5, 0xfc, 0xd5, 0xd5, 0x11, 0x4d, 0x22, 0xee, 0x01, 0x3b, 0x7d,
+	0x1f, 0x1b, 0xb9, 0x20, 0xb9, 0x84, 0x3b, 0x60, 0x1e, 0xd9, 0xc8, 0x17, 0xc5, 0x94, 0x6c, 0x39,
+	0xf3, 0x67, 0xb6, 0x9c, 0x6a, 0xa4, 0x1d, 0xa3, 0x1a, 0x45, 0x34, 0xbc, 0x05, 0x6f, 0x81, 0xc2,
+	0x1e, 0xe9, 0x61, 0x3b, 0x09, 0xb9, 0x20, 0x21, 0xdf, 0x18, 0x01, 0xb9, 0x25, 0x54, 0x07, 0x78,
+	0xf9, 0xbd, 0x13, 0xeb, 0xf2, 0xd7, 0xe3, 0x20, 0x93, 0xe8, 0xed, 0xf0, 0x03, 0x90, 0xeb, 0x20,
+	0x26, 0x8b, 0x5d, 0x8d, 0x04, 0x31, 0x2f, 0x66, 0x6a, 0xc5, 0xbf, 0x0e, 0x57, 0x4e, 0x0a, 0x8c,
+	0x4c, 0x07, 0xb1, 0x26, 0xea, 0xa9, 0x63, 0x08, 0xe4, 0x5c, 0xd4, 0x93, 0xe3, 0x6f, 0x30, 0x49,
+	0x5e, 0xba, 0x70, 0x23, 0x48, 0x65, 0xe2, 0x73, 0x90, 0x93, 0x8f, 0x05, 0xa7, 0xd1, 0x58, 0x9d,
+	0x48, 0xa3, 0xc5, 0x08, 0xc8, 0x1d, 0xaa, 0x66, 0xe6, 0x8f, 0x13, 0xa0, 0x78, 0xaa, 0xe9, 0x43,
+	0x0a, 0x72, 0xe2, 0x32, 0xa2, 0xa2, 0x8d, 0xfc, 0xbe, 0x9a, 0xa0, 0xb5, 0x5b, 0xe7, 0x1e, 0xe7,
+	0x99, 0x1a, 0x62, 0x32, 0x8d, 0xd5, 0xd6, 0xdd, 0x61, 0x1a, 0xed, 0x63, 0x91, 0xdf, 0x87, 0x18,
+	0xcc, 0x49, 0x83, 0x6e, 0xe8, 0x70, 0xe2, 0x3b, 0x04, 0x07, 0xa9, 0x44, 0x33, 0x2f, 0x40, 0x9b,
+	0x31, 0x26, 0x6c, 0x81, 0xc9, 0x2e, 0xf1, 0xba, 0xa9, 0x84, 0x51, 0x22, 0x09, 0xe2, 0xf7, 0x43,
+	0xd7, 0x4f, 0x12, 0x9f, 0x4c, 0x83, 0xb8, 0x00, 0x1d, 0x10, 0x2f, 0x7f, 0x3f, 0x05, 0x8a, 0xa7,
+	0x1e, 0x14, 0xd8, 0x05, 0x90, 0xa3, 0x60, 0x1f, 0x73, 0x33, 0xe4, 0xc4, 0x21, 0x5f, 0xa8, 0x07,
+	0x5d, 0x4b, 0xc1, 0x7e, 0x51, 0xe1, 0xee, 0x0e, 0x60, 0xe1, 0x03, 0xb0, 0x44, 0x3c, 0xc2, 0x09,
+	0x72, 0xa2, 0xb2, 0xe0, 0xa6, 0x52, 0x4a, 0x25, 0x53, 0xf3, 0x11, 0xb6, 0x2c, 0x08, 0xbe, 0x23,
+	0x81, 0x21, 0x01, 0xd0, 0x25, 0xde, 0xb0, 0xb9, 0x34, 0x92, 0x37, 0xe7, 0x12, 0xef, 0x94, 0x29,
+	0xd4, 0x1b, 0x36, 0x35, 0x99, 0x8a, 0x29, 0xd4, 0x3b, 0x61, 0x6a, 0x1f, 0x14, 0x90, 0x7d, 0x3f,
+	0x64, 0xdc, 0xc5, 0x1e, 0x37, 0x99, 0x8f, 0xb1, 0xad, 0x4f, 0xa5, 0x61, 0x68, 0x80, 0xba, 0x2d,
+	0x40, 0x45, 0x6d, 0x5a, 0xa1, 0xb8, 0x9f, 0x31, 0x8e, 0xb1, 0xef, 0x61, 0xc6, 0x52, 0xb9, 0xa2,
+	0xe5, 0x25, 0xe8, 0xf6, 0x31, 0x66, 0xf9, 0x2b, 0x0d, 0xe4, 0x4f, 0x76, 0x5c, 0x18, 0xc6, 0xf3,
+	0x73, 0xa8, 0x83, 0x34, 0xcf, 0xdd, 0x41, 0x72, 0xaa, 0x53, 0x8f, 0xee, 0x21, 0xb9, 0xf6, 0x40,
+	0xe8, 0xf7, 0xcb, 0x8f, 0x34, 0x90, 0x4d, 0x3e, 0x25, 0x67, 0xbc, 0x01, 0xb4, 0x41, 0xfe, 0x3f,
+	0xa8, 0xe0, 0x6c, 0x90, 0x48, 0x72, 0xf9, 0xf1, 0x38, 0xb8, 0x50, 0xc7, 0x3e, 0x65, 0x84, 0xc3,
+	0x3d, 0x30, 0x6b, 0xab, 0xbf, 0x34, 0x88, 0xe2, 0x70, 0xe3, 0x9f, 0xc3, 0x95, 0xf5, 0x17, 0x30,
+	0x53, 0xb5, 0xac, 0xaa, 0x6d, 0x07, 0x98, 0xb1, 0x67, 0x8f, 0xd7, 0xe7, 0x23, 0x6b, 0xd1, 0x4e,
+	0xad, 0xcf, 0x31, 0x33, 0x06, 0xd0, 0xd0, 0x02, 0xd3, 0xd1, 0x65, 0x6d, 0x5c, 0xbe, 0xe4, 0x5d,
+	0xdc, 0x88, 0x0e, 0x88, 0x2e, 0x18, 0x0f, 0xc7, 0xeb, 0x94, 0x78, 0xb5, 0xf7, 0xa2, 0xf7, 0xbb,
+	0xb5, 0x17, 0xe0, 0x20, 0x0e, 0x30, 0x23, 0x82, 0x86, 0x9f, 0x81, 0x29, 0xe2, 0xd9, 0xb8, 0xa7,
+	0x4f, 0x48, 0x1b, 0x97, 0x47, 0x8c, 0xdf, 0xed, 0xd0, 0xf7, 0x9d, 0xfe, 0xf1, 0x54, 0x51, 0x77,
+	0xd9, 0xda, 0xeb, 0x91, 0xc5, 0xc5, 0x51, 0x52, 0x66, 0x28, 0xd0, 0xf2, 0x2f, 0xe3, 0x60, 0x5a,
+	0x25, 0x1c, 0xda, 0x60, 0x46, 0x65, 0x17, 0xa7, 0x1f, 0xb4, 0x18, 0xf9, 0x7f, 0x13, 0x33, 0xe5,
+	0xf4, 0x59, 0x31, 0x1b, 0x25, 0x8d, 0x63, 0xf6, 0xa5, 0x06, 0x16, 0x46, 0x05, 0xf5, 0x8c, 0xea,
+	0x37, 0xc0, 0x54, 0xf2, 0x15, 0xfd, 0xe5, 0x8a, 0x5e, 0x41, 0x49, 0x0a, 0xa3, 0x38, 0xbe, 0x42,
+	0x0a, 0xdf, 0x69, 0x60, 0xfe, 0x63, 0xea, 0x5d, 0x1f, 0x5c, 0xe6, 0x85, 0x29, 0xf6, 0xca, 0x1e,
+	0xbe, 0x25, 0x30, 0x2d, 0x9d, 0x63, 0xb2, 0x90, 0x66, 0x8d, 0x68, 0x55, 0xa6, 0x00, 0xc8, 0x62,
+	0x68, 0xc9, 0xaf, 0x3f, 0x08, 0x4c, 0x89, 0x0f, 0x3b, 0xc7, 0x9f, 0x61, 0x52, 0xad, 0x36, 0x85,
+	0xfc, 0x4e, 0x07, 0xe4, 0x4e, 0x5c, 0xd0, 0xa1, 0x0e, 0x16, 0x8c, 0xea, 0xce, 0xa6, 0xd9, 0xfc,
+	0xa4, 0xbe, 0x79, 0xdb, 0xdc, 0xb9, 0xdb, 0xda, 0x34, 0x6f, 0xee, 0x36, 0x5b, 0x85, 0x31, 0x78,
+	0x09, 0xe8, 0xc3, 0x92, 0x6a, 0xbd, 0xda, 0xda, 0x69, 0xdc, 0xd9, 0x2c, 0x68, 0xf0, 0x22, 0x58,
+	0x1c, 0x96, 0x6e, 0x35, 0x3e, 0xdd, 0xac, 0x17, 0xc6, 0x97, 0x27, 0x1f, 0xfd, 0x50, 0x1a, 0xab,
+	0xdd, 0x7c, 0xf2, 0x67, 0x69, 0xec, 0xc9, 0x51, 0x49, 0x7b, 0x7a, 0x54, 0xd2, 0xfe, 0x38, 0x2a,
+	0x69, 0xdf, 0x3e, 0x2f, 0x8d, 0x3d, 0x7d, 0x5e, 0x1a, 0xfb, 0xf5, 0x79, 0x69, 0xec, 0xde, 0xbb,
+	0x09, 0xe2, 0x2e, 0xed, 0x12, 0x8e, 0x3c, 0xcc, 0x1f, 0xd2, 0xa0, 0x5b, 0x11, 0xd5, 0x8f, 0x83,
+	0x4a, 0x4f, 0x7d, 0x23, 0x93, 0x2e, 0xb4, 0xa7, 0xe5, 0x97, 0xab, 0xf7, 0xff, 0x1d, 0x00, 0xb9,
+	0x07, 0x92, 0xbb, 0x3d, 0x13, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -528,6 +798,145 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FixedRateModel != nil {
+		{
+			size, err := m.FixedRateModel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHard(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.AdaptiveRateModel != nil {
+		{
+			size, err := m.AdaptiveRateModel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHard(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.RateModelType != 0 {
+		i = encodeVarintHard(dAtA, i, uint64(m.RateModelType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.BorrowableInIsolation {
+		i--
+		if m.BorrowableInIsolation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.IsolationDebtCeiling.Size()
+		i -= size
+		if _, err := m.IsolationDebtCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.Isolated {
+		i--
+		if m.Isolated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BorrowDisabled {
+		i--
+		if m.BorrowDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.CollateralDisabled {
+		i--
+		if m.CollateralDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.FlashLoanFee.Size()
+		i -= size
+		if _, err := m.FlashLoanFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.FlashLoanMaxAmount.Size()
+		i -= size
+		if _, err := m.FlashLoanMaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.LiquidationIncentive.Size()
+		i -= size
+		if _, err := m.LiquidationIncentive.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.CloseFactor.Size()
+		i -= size
+		if _, err := m.CloseFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.DutchAuction {
+		i--
+		if m.DutchAuction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.KeeperRewardPercentage.Size()
 		i -= size
@@ -711,7 +1120,7 @@ func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
+func (m *AdaptiveRateModel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -721,67 +1130,223 @@ func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *AdaptiveRateModel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AdaptiveRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintHard(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	{
+		size := m.CurveSteepness.Size()
+		i -= size
+		if _, err := m.CurveSteepness.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintHard(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AdjustmentSpeed.Size()
+		i -= size
+		if _, err := m.AdjustmentSpeed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintHard(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxRateAtTarget.Size()
+		i -= size
+		if _, err := m.MaxRateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Borrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinRateAtTarget.Size()
+		i -= size
+		if _, err := m.MinRateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Borrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InitialRateAtTarget.Size()
+		i -= size
+		if _, err := m.InitialRateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TargetUtilization.Size()
+		i -= size
+		if _, err := m.TargetUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FixedRateModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixedRateModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixedRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BorrowRateAPY.Size()
+		i -= size
+		if _, err := m.BorrowRateAPY.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AdaptiveRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdaptiveRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdaptiveRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RateAtTarget.Size()
+		i -= size
+		if _, err := m.RateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Borrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Borrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *Borrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -907,6 +1472,45 @@ func (m *BorrowInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NonCollateralDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NonCollateralDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonCollateralDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintHard(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CoinsProto) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -996,6 +1600,42 @@ func (m *MoneyMarket) Size() (n int) {
 	n += 1 + l + sovHard(uint64(l))
 	l = m.KeeperRewardPercentage.Size()
 	n += 1 + l + sovHard(uint64(l))
+	if m.DutchAuction {
+		n += 2
+	}
+	l = m.CloseFactor.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.LiquidationIncentive.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.FlashLoanMaxAmount.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.FlashLoanFee.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if m.CollateralDisabled {
+		n += 2
+	}
+	if m.BorrowDisabled {
+		n += 2
+	}
+	if m.Isolated {
+		n += 2
+	}
+	l = m.IsolationDebtCeiling.Size()
+	n += 2 + l + sovHard(uint64(l))
+	if m.BorrowableInIsolation {
+		n += 3
+	}
+	if m.RateModelType != 0 {
+		n += 2 + sovHard(uint64(m.RateModelType))
+	}
+	if m.AdaptiveRateModel != nil {
+		l = m.AdaptiveRateModel.Size()
+		n += 2 + l + sovHard(uint64(l))
+	}
+	if m.FixedRateModel != nil {
+		l = m.FixedRateModel.Size()
+		n += 2 + l + sovHard(uint64(l))
+	}
 	return n
 }
 
@@ -1032,6 +1672,53 @@ func (m *InterestRateModel) Size() (n int) {
 	return n
 }
 
+func (m *AdaptiveRateModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TargetUtilization.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.InitialRateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.MinRateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.MaxRateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.AdjustmentSpeed.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.CurveSteepness.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *FixedRateModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BorrowRateAPY.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *AdaptiveRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.RateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
 func (m *Deposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1112,6 +1799,25 @@ func (m *BorrowInterestFactor) Size() (n int) {
 	return n
 }
 
+func (m *NonCollateralDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *CoinsProto) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1433,20 +2139,959 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DutchAuction = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationIncentive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationIncentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashLoanMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashLoanMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashLoanFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlashLoanFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CollateralDisabled = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BorrowDisabled = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isolated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Isolated = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsolationDebtCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IsolationDebtCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowableInIsolation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BorrowableInIsolation = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateModelType", wireType)
+			}
+			m.RateModelType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateModelType |= RateModelType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdaptiveRateModel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdaptiveRateModel == nil {
+				m.AdaptiveRateModel = &AdaptiveRateModel{}
+			}
+			if err := m.AdaptiveRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedRateModel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FixedRateModel == nil {
+				m.FixedRateModel = &FixedRateModel{}
+			}
+			if err := m.FixedRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BorrowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMaxLimit = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterestRateModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterestRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdaptiveRateModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdaptiveRateModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdaptiveRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialRateAtTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialRateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRateAtTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateAtTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxRateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentSpeed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1474,13 +3119,13 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AdjustmentSpeed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveSteepness", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1508,7 +3153,7 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurveSteepness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1533,7 +3178,7 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
+func (m *FixedRateModel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1556,17 +3201,17 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BorrowLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: FixedRateModel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FixedRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowRateAPY", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1576,15 +3221,79 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasMaxLimit = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdaptiveRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdaptiveRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdaptiveRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1612,13 +3321,11 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateAtTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1646,7 +3353,7 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1671,7 +3378,7 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
+func (m *Deposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1694,15 +3401,15 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterestRateModel: wiretype end group for non-group")
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterestRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1730,15 +3437,13 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1748,65 +3453,31 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHard
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHard
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthHard
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1816,23 +3487,23 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Index = append(m.Index, SupplyInterestFactor{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1857,7 +3528,7 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *Borrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1880,15 +3551,15 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: Borrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Borrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1916,7 +3587,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			m.Borrower = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1981,7 +3652,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index, SupplyInterestFactor{})
+			m.Index = append(m.Index, BorrowInterestFactor{})
 			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2007,7 +3678,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Borrow) Unmarshal(dAtA []byte) error {
+func (m *SupplyInterestFactor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2030,15 +3701,15 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Borrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplyInterestFactor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Borrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplyInterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2066,47 +3737,13 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHard
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthHard
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthHard
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -2116,23 +3753,23 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index, BorrowInterestFactor{})
-			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2157,7 +3794,7 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SupplyInterestFactor) Unmarshal(dAtA []byte) error {
+func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2180,10 +3817,10 @@ func (m *SupplyInterestFactor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyInterestFactor: wiretype end group for non-group")
+			return fmt.Errorf("proto: BorrowInterestFactor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyInterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BorrowInterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2273,7 +3910,7 @@ func (m *SupplyInterestFactor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
+func (m *NonCollateralDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2296,15 +3933,15 @@ func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BorrowInterestFactor: wiretype end group for non-group")
+			return fmt.Errorf("proto: NonCollateralDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BorrowInterestFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NonCollateralDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2332,11 +3969,11 @@ func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2364,9 +4001,7 @@ func (m *BorrowInterestFactor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31,6 +31,9 @@ var (
 	BorrowInterestFactorPrefix    = []byte{0x08} // denom -> sdk.Dec
 	SupplyInterestFactorPrefix    = []byte{0x09} // denom -> sdk.Dec
 	DelegatorInterestFactorPrefix = []byte{0x10} // denom -> sdk.Dec
+	IsolationDebtPrefix           = []byte{0x11} // denom -> sdk.Dec
+	NonCollateralDenomsPrefix     = []byte{0x12} // depositor -> NonCollateralDenoms
+	AdaptiveRatePrefix            = []byte{0x13} // denom -> sdk.Dec
 )
 
 // DepositTypeIteratorKey returns an interator prefix for interating over deposits by deposit denom
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -12,6 +15,11 @@ var (
 	_ sdk.Msg = &MsgBorrow{}
 	_ sdk.Msg = &MsgRepay{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgLiquidatePartial{}
+	_ sdk.Msg = &MsgFlashLoan{}
+	_ sdk.Msg = &MsgSetCollateral{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgFlashLoan{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -225,3 +233,208 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgLiquidatePartial returns a new MsgLiquidatePartial
+func NewMsgLiquidatePartial(keeper, borrower sdk.AccAddress, repay sdk.Coin, collateralDenom string) MsgLiquidatePartial {
+	return MsgLiquidatePartial{
+		Keeper:          keeper.String(),
+		Borrower:        borrower.String(),
+		Repay:           repay,
+		CollateralDenom: collateralDenom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLiquidatePartial) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLiquidatePartial) Type() string { return "liquidate_partial" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgLiquidatePartial) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Repay.IsValid() || msg.Repay.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "repay amount %s", msg.Repay)
+	}
+	if err := sdk.ValidateDenom(msg.CollateralDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDepositDenom, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLiquidatePartial) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLiquidatePartial) GetSigners() []sdk.AccAddress {
+	keeper, err := sdk.AccAddressFromBech32(msg.Keeper)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{keeper}
+}
+
+// NewMsgFlashLoan returns a new MsgFlashLoan
+func NewMsgFlashLoan(sender sdk.AccAddress, amount sdk.Coin, msgs []sdk.Msg) (MsgFlashLoan, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, m := range msgs {
+		any, err := codectypes.NewAnyWithValue(m)
+		if err != nil {
+			return MsgFlashLoan{}, err
+		}
+		anys[i] = any
+	}
+	return MsgFlashLoan{
+		Sender: sender.String(),
+		Amount: amount,
+		Msgs:   anys,
+	}, nil
+}
+
+// GetMessages returns the cached messages executed by the flash loan.
+func (msg MsgFlashLoan) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, fmt.Errorf("flash loan messages contain %T which is not a sdk.Msg", any)
+		}
+		msgs[i] = m
+	}
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgFlashLoan) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgFlashLoan) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgFlashLoan) Type() string { return "flash_loan" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgFlashLoan) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "flash loan amount %s", msg.Amount)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidFlashLoanMsg, err.Error())
+	}
+	if len(msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidFlashLoanMsg, "flash loan must contain at least one message")
+	}
+	for _, m := range msgs {
+		if err := ValidateFlashLoanMsg(sender, m); err != nil {
+			return err
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgFlashLoan) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgFlashLoan) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateFlashLoanMsg checks a message can be executed within a flash loan taken out by sender.
+// Flash loans cannot be nested and their messages must only be signed by the sender.
+func ValidateFlashLoanMsg(sender sdk.AccAddress, msg sdk.Msg) error {
+	if _, ok := msg.(*MsgFlashLoan); ok {
+		return sdkerrors.Wrap(ErrInvalidFlashLoanMsg, "flash loans cannot be nested")
+	}
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(sender) {
+		return sdkerrors.Wrapf(ErrInvalidFlashLoanMsg, "%T must only be signed by the flash loan sender %s", msg, sender)
+	}
+	return nil
+}
+
+// NewMsgSetCollateral returns a new MsgSetCollateral
+func NewMsgSetCollateral(depositor sdk.AccAddress, denoms []string, enabled bool) MsgSetCollateral {
+	return MsgSetCollateral{
+		Depositor: depositor.String(),
+		Denoms:    denoms,
+		Enabled:   enabled,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetCollateral) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetCollateral) Type() string { return "hard_set_collateral" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if len(msg.Denoms) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "denoms cannot be empty")
+	}
+	seen := make(map[string]bool)
+	for _, denom := range msg.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if seen[denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetCollateral) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
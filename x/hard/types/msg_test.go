@@ -189,6 +189,118 @@ func (suite *MsgTestSuite) TestMsgRepay() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgFlashLoan() {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	deposit := types.NewMsgDeposit(addrs[0], sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000000))))
+	otherDeposit := types.NewMsgDeposit(addrs[1], sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000000))))
+	nested, err := types.NewMsgFlashLoan(addrs[0], sdk.NewCoin("usdx", sdk.NewInt(1000000)), []sdk.Msg{&deposit})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		amount      sdk.Coin
+		msgs        []sdk.Msg
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:       "valid",
+			amount:     sdk.NewCoin("usdx", sdk.NewInt(1000000)),
+			msgs:       []sdk.Msg{&deposit},
+			expectPass: true,
+		},
+		{
+			name:        "zero amount",
+			amount:      sdk.NewCoin("usdx", sdk.ZeroInt()),
+			msgs:        []sdk.Msg{&deposit},
+			expectPass:  false,
+			expectedErr: "flash loan amount",
+		},
+		{
+			name:        "no messages",
+			amount:      sdk.NewCoin("usdx", sdk.NewInt(1000000)),
+			msgs:        []sdk.Msg{},
+			expectPass:  false,
+			expectedErr: "at least one message",
+		},
+		{
+			name:        "message signed by another address",
+			amount:      sdk.NewCoin("usdx", sdk.NewInt(1000000)),
+			msgs:        []sdk.Msg{&otherDeposit},
+			expectPass:  false,
+			expectedErr: "must only be signed by the flash loan sender",
+		},
+		{
+			name:        "nested flash loan",
+			amount:      sdk.NewCoin("usdx", sdk.NewInt(1000000)),
+			msgs:        []sdk.Msg{&nested},
+			expectPass:  false,
+			expectedErr: "flash loans cannot be nested",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg, err := types.NewMsgFlashLoan(addrs[0], tc.amount, tc.msgs)
+			suite.Require().NoError(err)
+			err = msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgSetCollateral() {
+	testCases := []struct {
+		name        string
+		denoms      []string
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:       "valid",
+			denoms:     []string{"bnb", "usdx"},
+			expectPass: true,
+		},
+		{
+			name:        "no denoms",
+			denoms:      []string{},
+			expectPass:  false,
+			expectedErr: "denoms cannot be empty",
+		},
+		{
+			name:        "invalid denom",
+			denoms:      []string{"b"},
+			expectPass:  false,
+			expectedErr: "invalid denom",
+		},
+		{
+			name:        "duplicate denom",
+			denoms:      []string{"bnb", "bnb"},
+			expectPass:  false,
+			expectedErr: "duplicate denom",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgSetCollateral(sdk.AccAddress("test1"), tc.denoms, false)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
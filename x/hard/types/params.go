@@ -18,6 +18,9 @@ var (
 	DefaultTotalSupplied         = sdk.Coins{}
 	DefaultTotalBorrowed         = sdk.Coins{}
 	DefaultTotalReserves         = sdk.Coins{}
+	DefaultIsolationDebts        = sdk.DecCoins{}
+	DefaultNonCollateralDenoms   = NonCollateralDenomsList{}
+	DefaultAdaptiveRates         = AdaptiveRates{}
 	DefaultDeposits              = Deposits{}
 	DefaultBorrows               = Borrows{}
 )
@@ -71,6 +74,11 @@ func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string,
 		InterestRateModel:      interestRateModel,
 		ReserveFactor:          reserveFactor,
 		KeeperRewardPercentage: keeperRewardPercentage,
+		CloseFactor:            sdk.ZeroDec(),
+		LiquidationIncentive:   sdk.ZeroDec(),
+		FlashLoanMaxAmount:     sdk.ZeroInt(),
+		FlashLoanFee:           sdk.ZeroDec(),
+		IsolationDebtCeiling:   sdk.ZeroDec(),
 	}
 }
 
@@ -100,6 +108,57 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("keeper reward percentage must be between 0.0-1.0")
 	}
 
+	closeFactor := mm.GetCloseFactor()
+	if closeFactor.IsNegative() || closeFactor.GT(sdk.OneDec()) {
+		return fmt.Errorf("close factor must be between 0.0-1.0")
+	}
+
+	liquidationIncentive := mm.GetLiquidationIncentive()
+	if liquidationIncentive.IsNegative() || liquidationIncentive.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquidation incentive must be between 0.0-1.0")
+	}
+	// seizing deposits worth more than the repaid borrow they back would leave the borrower less healthy
+	if liquidationIncentive.IsPositive() && mm.BorrowLimit.LoanToValue.Mul(sdk.OneDec().Add(liquidationIncentive)).GTE(sdk.OneDec()) {
+		return fmt.Errorf("loan-to-value %s with liquidation incentive %s must be less than 1.0", mm.BorrowLimit.LoanToValue, liquidationIncentive)
+	}
+
+	if mm.GetFlashLoanMaxAmount().IsNegative() {
+		return fmt.Errorf("flash loan max amount cannot be negative")
+	}
+
+	flashLoanFee := mm.GetFlashLoanFee()
+	if flashLoanFee.IsNegative() || flashLoanFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("flash loan fee must be between 0.0-1.0, excluding 1.0")
+	}
+
+	if mm.Isolated && mm.CollateralDisabled {
+		return fmt.Errorf("isolated market %s must be usable as collateral", mm.Denom)
+	}
+
+	if mm.GetIsolationDebtCeiling().IsNegative() {
+		return fmt.Errorf("isolation debt ceiling cannot be negative")
+	}
+
+	switch mm.RateModelType {
+	case RATE_MODEL_TYPE_JUMP:
+	case RATE_MODEL_TYPE_ADAPTIVE:
+		if mm.AdaptiveRateModel == nil {
+			return fmt.Errorf("adaptive rate model must be set for market %s", mm.Denom)
+		}
+		if err := mm.AdaptiveRateModel.Validate(); err != nil {
+			return err
+		}
+	case RATE_MODEL_TYPE_FIXED:
+		if mm.FixedRateModel == nil {
+			return fmt.Errorf("fixed rate model must be set for market %s", mm.Denom)
+		}
+		if err := mm.FixedRateModel.Validate(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown rate model type: %s", mm.RateModelType)
+	}
+
 	return nil
 }
 
@@ -126,9 +185,100 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if mm.DutchAuction != mmCompareTo.DutchAuction {
+		return false
+	}
+	if !mm.GetCloseFactor().Equal(mmCompareTo.GetCloseFactor()) {
+		return false
+	}
+	if !mm.GetLiquidationIncentive().Equal(mmCompareTo.GetLiquidationIncentive()) {
+		return false
+	}
+	if !mm.GetFlashLoanMaxAmount().Equal(mmCompareTo.GetFlashLoanMaxAmount()) {
+		return false
+	}
+	if !mm.GetFlashLoanFee().Equal(mmCompareTo.GetFlashLoanFee()) {
+		return false
+	}
+	if mm.CollateralDisabled != mmCompareTo.CollateralDisabled {
+		return false
+	}
+	if mm.BorrowDisabled != mmCompareTo.BorrowDisabled {
+		return false
+	}
+	if mm.Isolated != mmCompareTo.Isolated {
+		return false
+	}
+	if !mm.GetIsolationDebtCeiling().Equal(mmCompareTo.GetIsolationDebtCeiling()) {
+		return false
+	}
+	if mm.BorrowableInIsolation != mmCompareTo.BorrowableInIsolation {
+		return false
+	}
+	if mm.RateModelType != mmCompareTo.RateModelType {
+		return false
+	}
+	if (mm.AdaptiveRateModel == nil) != (mmCompareTo.AdaptiveRateModel == nil) ||
+		(mm.AdaptiveRateModel != nil && !mm.AdaptiveRateModel.Equal(*mmCompareTo.AdaptiveRateModel)) {
+		return false
+	}
+	if (mm.FixedRateModel == nil) != (mmCompareTo.FixedRateModel == nil) ||
+		(mm.FixedRateModel != nil && !mm.FixedRateModel.Equal(*mmCompareTo.FixedRateModel)) {
+		return false
+	}
 	return true
 }
 
+// GetCloseFactor returns the fraction of a borrow of the market that can be repaid in one partial liquidation.
+// An unset close factor is zero, disabling partial liquidations.
+func (mm MoneyMarket) GetCloseFactor() sdk.Dec {
+	if mm.CloseFactor.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return mm.CloseFactor
+}
+
+// GetLiquidationIncentive returns the bonus paid in deposits of the market to partial liquidators.
+// An unset liquidation incentive is zero.
+func (mm MoneyMarket) GetLiquidationIncentive() sdk.Dec {
+	if mm.LiquidationIncentive.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return mm.LiquidationIncentive
+}
+
+// GetFlashLoanMaxAmount returns the largest amount of the market that can be flash loaned at once.
+// An unset max amount is zero, disabling flash loans.
+func (mm MoneyMarket) GetFlashLoanMaxAmount() sdk.Int {
+	if mm.FlashLoanMaxAmount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return mm.FlashLoanMaxAmount
+}
+
+// GetFlashLoanFee returns the fraction of a flash loan of the market repaid on top of it.
+// An unset fee is zero.
+func (mm MoneyMarket) GetFlashLoanFee() sdk.Dec {
+	if mm.FlashLoanFee.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return mm.FlashLoanFee
+}
+
+// IsFlashLoanEnabled returns true if the market can be flash loaned
+func (mm MoneyMarket) IsFlashLoanEnabled() bool {
+	return mm.GetFlashLoanMaxAmount().IsPositive()
+}
+
+// GetIsolationDebtCeiling returns the largest debt, in whole units of the borrowed markets, that isolated deposits of the market can back.
+// An unset ceiling is zero.
+func (mm MoneyMarket) GetIsolationDebtCeiling() sdk.Dec {
+	if mm.IsolationDebtCeiling.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return mm.IsolationDebtCeiling
+}
+
 // MoneyMarkets slice of MoneyMarket
 type MoneyMarkets []MoneyMarket
 
@@ -56,6 +56,169 @@ func (suite *ParamTestSuite) TestParamValidation() {
 			expectPass:  false,
 			expectedErr: "conversion '0' factor must be ≥ one",
 		},
+		{
+			name: "valid: partial liquidation",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					partialLiquidationMarket(sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.1")),
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: close factor > one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					partialLiquidationMarket(sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("1.1"), sdk.MustNewDecFromStr("0.1")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "close factor must be between 0.0-1.0",
+		},
+		{
+			name: "invalid: negative liquidation incentive",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					partialLiquidationMarket(sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("-0.1")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "liquidation incentive must be between 0.0-1.0",
+		},
+		{
+			name: "invalid: liquidation incentive too large for loan-to-value",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					partialLiquidationMarket(sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.25")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "with liquidation incentive 0.250000000000000000 must be less than 1.0",
+		},
+		{
+			name: "valid: flash loans enabled",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					flashLoanMarket(sdk.NewInt(1000000000), sdk.MustNewDecFromStr("0.001")),
+				},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: negative flash loan max amount",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					flashLoanMarket(sdk.NewInt(-1), sdk.MustNewDecFromStr("0.001")),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "flash loan max amount cannot be negative",
+		},
+		{
+			name: "invalid: flash loan fee of one",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					flashLoanMarket(sdk.NewInt(1000000000), sdk.OneDec()),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "flash loan fee must be between 0.0-1.0",
+		},
+		{
+			name: "invalid: isolated market with collateral disabled",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					isolatedMarket(true, sdk.NewDec(1000000)),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "isolated market btcb must be usable as collateral",
+		},
+		{
+			name: "invalid: negative isolation debt ceiling",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					isolatedMarket(false, sdk.NewDec(-1)),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "isolation debt ceiling cannot be negative",
+		},
+		{
+			name: "valid: adaptive rate model",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					rateModelMarket(types.RATE_MODEL_TYPE_ADAPTIVE, adaptiveRateModel(sdk.MustNewDecFromStr("0.04")), nil),
+				},
+			},
+			expectPass: true,
+		},
+		{
+			name: "valid: fixed rate model",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					rateModelMarket(types.RATE_MODEL_TYPE_FIXED, nil, &types.FixedRateModel{BorrowRateAPY: sdk.MustNewDecFromStr("0.03")}),
+				},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid: adaptive rate model not set",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					rateModelMarket(types.RATE_MODEL_TYPE_ADAPTIVE, nil, &types.FixedRateModel{BorrowRateAPY: sdk.MustNewDecFromStr("0.03")}),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "adaptive rate model must be set for market btcb",
+		},
+		{
+			name: "invalid: initial rate at target over max",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					rateModelMarket(types.RATE_MODEL_TYPE_ADAPTIVE, adaptiveRateModel(sdk.MustNewDecFromStr("2")), nil),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "initial rate at target must be between the min and max rates at target",
+		},
+		{
+			name: "invalid: negative fixed rate",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					rateModelMarket(types.RATE_MODEL_TYPE_FIXED, nil, &types.FixedRateModel{BorrowRateAPY: sdk.MustNewDecFromStr("-0.03")}),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "fixed borrow rate APY must not be negative",
+		},
+		{
+			name: "invalid: unknown rate model type",
+			args: args{
+				minBorrowVal: types.DefaultMinimumBorrowUSDValue,
+				mms: types.MoneyMarkets{
+					rateModelMarket(types.RateModelType(3), nil, nil),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "unknown rate model type",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
@@ -71,6 +234,56 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 }
 
+func partialLiquidationMarket(ltv, closeFactor, liquidationIncentive sdk.Dec) types.MoneyMarket {
+	mm := types.NewMoneyMarket(
+		"btcb",
+		types.NewBorrowLimit(false, sdk.MustNewDecFromStr("100000000000"), ltv),
+		"btc:usd",
+		sdk.NewInt(100000000),
+		types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+		sdk.MustNewDecFromStr("0.05"),
+		sdk.MustNewDecFromStr("0.05"),
+	)
+	mm.CloseFactor = closeFactor
+	mm.LiquidationIncentive = liquidationIncentive
+	return mm
+}
+
+func flashLoanMarket(maxAmount sdk.Int, fee sdk.Dec) types.MoneyMarket {
+	mm := partialLiquidationMarket(sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec(), sdk.ZeroDec())
+	mm.FlashLoanMaxAmount = maxAmount
+	mm.FlashLoanFee = fee
+	return mm
+}
+
+func isolatedMarket(collateralDisabled bool, debtCeiling sdk.Dec) types.MoneyMarket {
+	mm := partialLiquidationMarket(sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec(), sdk.ZeroDec())
+	mm.Isolated = true
+	mm.CollateralDisabled = collateralDisabled
+	mm.IsolationDebtCeiling = debtCeiling
+	return mm
+}
+
+func rateModelMarket(rateModelType types.RateModelType, adaptive *types.AdaptiveRateModel, fixed *types.FixedRateModel) types.MoneyMarket {
+	mm := partialLiquidationMarket(sdk.MustNewDecFromStr("0.5"), sdk.ZeroDec(), sdk.ZeroDec())
+	mm.RateModelType = rateModelType
+	mm.AdaptiveRateModel = adaptive
+	mm.FixedRateModel = fixed
+	return mm
+}
+
+func adaptiveRateModel(initialRateAtTarget sdk.Dec) *types.AdaptiveRateModel {
+	model := types.NewAdaptiveRateModel(
+		sdk.MustNewDecFromStr("0.9"),
+		initialRateAtTarget,
+		sdk.MustNewDecFromStr("0.001"),
+		sdk.MustNewDecFromStr("1"),
+		sdk.MustNewDecFromStr("50"),
+		sdk.MustNewDecFromStr("4"),
+	)
+	return &model
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
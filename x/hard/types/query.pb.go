@@ -42,7 +42,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{0}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -80,7 +80,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{1}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -124,7 +124,7 @@ func (m *QueryAccountsRequest) Reset()         { *m = QueryAccountsRequest{} }
 func (m *QueryAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountsRequest) ProtoMessage()    {}
 func (*QueryAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{2}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{2}
 }
 func (m *QueryAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +162,7 @@ func (m *QueryAccountsResponse) Reset()         { *m = QueryAccountsResponse{} }
 func (m *QueryAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountsResponse) ProtoMessage()    {}
 func (*QueryAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{3}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{3}
 }
 func (m *QueryAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,7 +209,7 @@ func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
 func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsRequest) ProtoMessage()    {}
 func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{4}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{4}
 }
 func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +269,7 @@ func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
 func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsResponse) ProtoMessage()    {}
 func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{5}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{5}
 }
 func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,7 +323,7 @@ func (m *QueryUnsyncedDepositsRequest) Reset()         { *m = QueryUnsyncedDepos
 func (m *QueryUnsyncedDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnsyncedDepositsRequest) ProtoMessage()    {}
 func (*QueryUnsyncedDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{6}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{6}
 }
 func (m *QueryUnsyncedDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,7 +383,7 @@ func (m *QueryUnsyncedDepositsResponse) Reset()         { *m = QueryUnsyncedDepo
 func (m *QueryUnsyncedDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnsyncedDepositsResponse) ProtoMessage()    {}
 func (*QueryUnsyncedDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{7}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{7}
 }
 func (m *QueryUnsyncedDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,7 +435,7 @@ func (m *QueryTotalDepositedRequest) Reset()         { *m = QueryTotalDepositedR
 func (m *QueryTotalDepositedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalDepositedRequest) ProtoMessage()    {}
 func (*QueryTotalDepositedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{8}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{8}
 }
 func (m *QueryTotalDepositedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +480,7 @@ func (m *QueryTotalDepositedResponse) Reset()         { *m = QueryTotalDeposited
 func (m *QueryTotalDepositedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalDepositedResponse) ProtoMessage()    {}
 func (*QueryTotalDepositedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{9}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{9}
 }
 func (m *QueryTotalDepositedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +527,7 @@ func (m *QueryBorrowsRequest) Reset()         { *m = QueryBorrowsRequest{} }
 func (m *QueryBorrowsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBorrowsRequest) ProtoMessage()    {}
 func (*QueryBorrowsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{10}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{10}
 }
 func (m *QueryBorrowsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +587,7 @@ func (m *QueryBorrowsResponse) Reset()         { *m = QueryBorrowsResponse{} }
 func (m *QueryBorrowsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBorrowsResponse) ProtoMessage()    {}
 func (*QueryBorrowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{11}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{11}
 }
 func (m *QueryBorrowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +641,7 @@ func (m *QueryUnsyncedBorrowsRequest) Reset()         { *m = QueryUnsyncedBorrow
 func (m *QueryUnsyncedBorrowsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnsyncedBorrowsRequest) ProtoMessage()    {}
 func (*QueryUnsyncedBorrowsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{12}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{12}
 }
 func (m *QueryUnsyncedBorrowsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,7 +701,7 @@ func (m *QueryUnsyncedBorrowsResponse) Reset()         { *m = QueryUnsyncedBorro
 func (m *QueryUnsyncedBorrowsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnsyncedBorrowsResponse) ProtoMessage()    {}
 func (*QueryUnsyncedBorrowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{13}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{13}
 }
 func (m *QueryUnsyncedBorrowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +753,7 @@ func (m *QueryTotalBorrowedRequest) Reset()         { *m = QueryTotalBorrowedReq
 func (m *QueryTotalBorrowedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalBorrowedRequest) ProtoMessage()    {}
 func (*QueryTotalBorrowedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{14}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{14}
 }
 func (m *QueryTotalBorrowedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,7 +798,7 @@ func (m *QueryTotalBorrowedResponse) Reset()         { *m = QueryTotalBorrowedRe
 func (m *QueryTotalBorrowedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalBorrowedResponse) ProtoMessage()    {}
 func (*QueryTotalBorrowedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{15}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{15}
 }
 func (m *QueryTotalBorrowedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -843,7 +843,7 @@ func (m *QueryInterestRateRequest) Reset()         { *m = QueryInterestRateReque
 func (m *QueryInterestRateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInterestRateRequest) ProtoMessage()    {}
 func (*QueryInterestRateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{16}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{16}
 }
 func (m *QueryInterestRateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -888,7 +888,7 @@ func (m *QueryInterestRateResponse) Reset()         { *m = QueryInterestRateResp
 func (m *QueryInterestRateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInterestRateResponse) ProtoMessage()    {}
 func (*QueryInterestRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{17}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{17}
 }
 func (m *QueryInterestRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +933,7 @@ func (m *QueryReservesRequest) Reset()         { *m = QueryReservesRequest{} }
 func (m *QueryReservesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryReservesRequest) ProtoMessage()    {}
 func (*QueryReservesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{18}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{18}
 }
 func (m *QueryReservesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +978,7 @@ func (m *QueryReservesResponse) Reset()         { *m = QueryReservesResponse{} }
 func (m *QueryReservesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryReservesResponse) ProtoMessage()    {}
 func (*QueryReservesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{19}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{19}
 }
 func (m *QueryReservesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1023,7 +1023,7 @@ func (m *QueryInterestFactorsRequest) Reset()         { *m = QueryInterestFactor
 func (m *QueryInterestFactorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInterestFactorsRequest) ProtoMessage()    {}
 func (*QueryInterestFactorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{20}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{20}
 }
 func (m *QueryInterestFactorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,7 +1068,7 @@ func (m *QueryInterestFactorsResponse) Reset()         { *m = QueryInterestFacto
 func (m *QueryInterestFactorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInterestFactorsResponse) ProtoMessage()    {}
 func (*QueryInterestFactorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{21}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{21}
 }
 func (m *QueryInterestFactorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1104,6 +1104,106 @@ func (m *QueryInterestFactorsResponse) GetInterestFactors() InterestFactors {
 	return nil
 }
 
+// QueryCollateralRequest is the request type for the Query/Collateral RPC method.
+type QueryCollateralRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryCollateralRequest) Reset()         { *m = QueryCollateralRequest{} }
+func (m *QueryCollateralRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralRequest) ProtoMessage()    {}
+func (*QueryCollateralRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f0a9c594fd53d2, []int{22}
+}
+func (m *QueryCollateralRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollateralRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollateralRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollateralRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralRequest.Merge(m, src)
+}
+func (m *QueryCollateralRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollateralRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollateralRequest proto.InternalMessageInfo
+
+func (m *QueryCollateralRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryCollateralResponse is the response type for the Query/Collateral RPC method.
+type QueryCollateralResponse struct {
+	// collateral is the owner's deposits, including interest, that back their borrows
+	Collateral github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=collateral,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collateral"`
+	// non_collateral_denoms are the denoms the owner has chosen not to use as collateral
+	NonCollateralDenoms []string `protobuf:"bytes,2,rep,name=non_collateral_denoms,json=nonCollateralDenoms,proto3" json:"non_collateral_denoms,omitempty"`
+}
+
+func (m *QueryCollateralResponse) Reset()         { *m = QueryCollateralResponse{} }
+func (m *QueryCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralResponse) ProtoMessage()    {}
+func (*QueryCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f0a9c594fd53d2, []int{23}
+}
+func (m *QueryCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralResponse.Merge(m, src)
+}
+func (m *QueryCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollateralResponse proto.InternalMessageInfo
+
+func (m *QueryCollateralResponse) GetCollateral() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Collateral
+	}
+	return nil
+}
+
+func (m *QueryCollateralResponse) GetNonCollateralDenoms() []string {
+	if m != nil {
+		return m.NonCollateralDenoms
+	}
+	return nil
+}
+
 // DepositResponse defines an amount of coins deposited into a hard module account.
 type DepositResponse struct {
 	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
@@ -1115,7 +1215,7 @@ func (m *DepositResponse) Reset()         { *m = DepositResponse{} }
 func (m *DepositResponse) String() string { return proto.CompactTextString(m) }
 func (*DepositResponse) ProtoMessage()    {}
 func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{22}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{24}
 }
 func (m *DepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1276,7 @@ func (m *SupplyInterestFactorResponse) Reset()         { *m = SupplyInterestFact
 func (m *SupplyInterestFactorResponse) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactorResponse) ProtoMessage()    {}
 func (*SupplyInterestFactorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{23}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{25}
 }
 func (m *SupplyInterestFactorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1230,7 +1330,7 @@ func (m *BorrowResponse) Reset()         { *m = BorrowResponse{} }
 func (m *BorrowResponse) String() string { return proto.CompactTextString(m) }
 func (*BorrowResponse) ProtoMessage()    {}
 func (*BorrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{24}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{26}
 }
 func (m *BorrowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1291,7 +1391,7 @@ func (m *BorrowInterestFactorResponse) Reset()         { *m = BorrowInterestFact
 func (m *BorrowInterestFactorResponse) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactorResponse) ProtoMessage()    {}
 func (*BorrowInterestFactorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{25}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{27}
 }
 func (m *BorrowInterestFactorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1347,7 +1447,7 @@ func (m *MoneyMarketInterestRate) Reset()         { *m = MoneyMarketInterestRate
 func (m *MoneyMarketInterestRate) String() string { return proto.CompactTextString(m) }
 func (*MoneyMarketInterestRate) ProtoMessage()    {}
 func (*MoneyMarketInterestRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{26}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{28}
 }
 func (m *MoneyMarketInterestRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1410,7 +1510,7 @@ func (m *InterestFactor) Reset()         { *m = InterestFactor{} }
 func (m *InterestFactor) String() string { return proto.CompactTextString(m) }
 func (*InterestFactor) ProtoMessage()    {}
 func (*InterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1eedf429c9bff7da, []int{27}
+	return fileDescriptor_b9f0a9c594fd53d2, []int{29}
 }
 func (m *InterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1460,6 +1560,144 @@ func (m *InterestFactor) GetSupplyInterestFactor() string {
 	return ""
 }
 
+// QueryInterestRateCurveRequest is the request type for the Query/InterestRateCurve RPC method.
+type QueryInterestRateCurveRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryInterestRateCurveRequest) Reset()         { *m = QueryInterestRateCurveRequest{} }
+func (m *QueryInterestRateCurveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterestRateCurveRequest) ProtoMessage()    {}
+func (*QueryInterestRateCurveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f0a9c594fd53d2, []int{30}
+}
+func (m *QueryInterestRateCurveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterestRateCurveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterestRateCurveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterestRateCurveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterestRateCurveRequest.Merge(m, src)
+}
+func (m *QueryInterestRateCurveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterestRateCurveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterestRateCurveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterestRateCurveRequest proto.InternalMessageInfo
+
+func (m *QueryInterestRateCurveRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryInterestRateCurveResponse is the response type for the Query/InterestRateCurve RPC method.
+type QueryInterestRateCurveResponse struct {
+	RateModelType RateModelType            `protobuf:"varint,1,opt,name=rate_model_type,json=rateModelType,proto3,enum=aeth.hard.v1beta1.RateModelType" json:"rate_model_type,omitempty"`
+	Points        []InterestRateCurvePoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points"`
+}
+
+func (m *QueryInterestRateCurveResponse) Reset()         { *m = QueryInterestRateCurveResponse{} }
+func (m *QueryInterestRateCurveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterestRateCurveResponse) ProtoMessage()    {}
+func (*QueryInterestRateCurveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f0a9c594fd53d2, []int{31}
+}
+func (m *QueryInterestRateCurveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterestRateCurveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterestRateCurveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterestRateCurveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterestRateCurveResponse.Merge(m, src)
+}
+func (m *QueryInterestRateCurveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterestRateCurveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterestRateCurveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterestRateCurveResponse proto.InternalMessageInfo
+
+func (m *QueryInterestRateCurveResponse) GetRateModelType() RateModelType {
+	if m != nil {
+		return m.RateModelType
+	}
+	return RATE_MODEL_TYPE_JUMP
+}
+
+func (m *QueryInterestRateCurveResponse) GetPoints() []InterestRateCurvePoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// InterestRateCurvePoint is the borrow and supply APY of a money market at a utilization.
+type InterestRateCurvePoint struct {
+	Utilization        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=utilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilization"`
+	BorrowInterestRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=borrow_interest_rate,json=borrowInterestRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"borrow_interest_rate"`
+	SupplyInterestRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=supply_interest_rate,json=supplyInterestRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"supply_interest_rate"`
+}
+
+func (m *InterestRateCurvePoint) Reset()         { *m = InterestRateCurvePoint{} }
+func (m *InterestRateCurvePoint) String() string { return proto.CompactTextString(m) }
+func (*InterestRateCurvePoint) ProtoMessage()    {}
+func (*InterestRateCurvePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f0a9c594fd53d2, []int{32}
+}
+func (m *InterestRateCurvePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterestRateCurvePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterestRateCurvePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterestRateCurvePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterestRateCurvePoint.Merge(m, src)
+}
+func (m *InterestRateCurvePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterestRateCurvePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterestRateCurvePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterestRateCurvePoint proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "aeth.hard.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "aeth.hard.v1beta1.QueryParamsResponse")
@@ -1483,102 +1721,124 @@ func init() {
 	proto.RegisterType((*QueryReservesResponse)(nil), "aeth.hard.v1beta1.QueryReservesResponse")
 	proto.RegisterType((*QueryInterestFactorsRequest)(nil), "aeth.hard.v1beta1.QueryInterestFactorsRequest")
 	proto.RegisterType((*QueryInterestFactorsResponse)(nil), "aeth.hard.v1beta1.QueryInterestFactorsResponse")
+	proto.RegisterType((*QueryCollateralRequest)(nil), "aeth.hard.v1beta1.QueryCollateralRequest")
+	proto.RegisterType((*QueryCollateralResponse)(nil), "aeth.hard.v1beta1.QueryCollateralResponse")
 	proto.RegisterType((*DepositResponse)(nil), "aeth.hard.v1beta1.DepositResponse")
 	proto.RegisterType((*SupplyInterestFactorResponse)(nil), "aeth.hard.v1beta1.SupplyInterestFactorResponse")
 	proto.RegisterType((*BorrowResponse)(nil), "aeth.hard.v1beta1.BorrowResponse")
 	proto.RegisterType((*BorrowInterestFactorResponse)(nil), "aeth.hard.v1beta1.BorrowInterestFactorResponse")
 	proto.RegisterType((*MoneyMarketInterestRate)(nil), "aeth.hard.v1beta1.MoneyMarketInterestRate")
 	proto.RegisterType((*InterestFactor)(nil), "aeth.hard.v1beta1.InterestFactor")
-}
-
-func init() { proto.RegisterFile("aeth/hard/v1beta1/query.proto", fileDescriptor_1eedf429c9bff7da) }
-
-var fileDescriptor_1eedf429c9bff7da = []byte{
-	// 1334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0x24, 0x4d, 0x5f, 0xdb, 0xa4, 0x0c, 0xa6, 0xb5, 0x9d, 0xc4, 0x4d, 0xb7, 0xe4,
-	0x83, 0x24, 0xf6, 0x3a, 0x49, 0x05, 0x57, 0x6a, 0xaa, 0x22, 0x90, 0x82, 0x60, 0x5b, 0x24, 0x84,
-	0x84, 0xa2, 0xb5, 0x77, 0x70, 0x56, 0x71, 0x76, 0xdc, 0x9d, 0x75, 0xda, 0xf0, 0x75, 0xa8, 0xc4,
-	0x85, 0x53, 0xa1, 0x27, 0x04, 0x12, 0x87, 0x22, 0x21, 0x01, 0x47, 0xb8, 0x20, 0x71, 0xe1, 0xd4,
-	0x63, 0x05, 0x17, 0x4e, 0x80, 0x12, 0xfe, 0x02, 0xfe, 0x02, 0xb4, 0x33, 0x6f, 0xd6, 0xde, 0xf5,
-	0xee, 0x3a, 0x48, 0x14, 0xa5, 0xa7, 0x76, 0x67, 0xde, 0xc7, 0xef, 0xfd, 0xde, 0xbc, 0xe7, 0xf7,
-	0x02, 0xb3, 0x3b, 0xd6, 0x9e, 0x65, 0x6c, 0x5b, 0x9e, 0x6d, 0xec, 0xad, 0x35, 0xa8, 0x6f, 0xad,
-	0x19, 0x37, 0xbb, 0xd4, 0xdb, 0xaf, 0x76, 0x3c, 0xe6, 0x33, 0xf2, 0x64, 0x70, 0x5d, 0x0d, 0xae,
-	0xab, 0x78, 0x5d, 0x2a, 0x37, 0x19, 0xdf, 0x65, 0xdc, 0xb0, 0xba, 0xfe, 0x76, 0xa8, 0x13, 0x7c,
-	0x48, 0x95, 0xd2, 0x32, 0xde, 0x37, 0x2c, 0x4e, 0xa5, 0xad, 0x50, 0xaa, 0x63, 0xb5, 0x1c, 0xd7,
-	0xf2, 0x1d, 0xe6, 0xa2, 0x6c, 0xb9, 0x5f, 0x56, 0x49, 0x35, 0x99, 0xa3, 0xee, 0x8b, 0xf2, 0x7e,
-	0x4b, 0x7c, 0x19, 0xf2, 0x03, 0xaf, 0xf2, 0x2d, 0xd6, 0x62, 0xf2, 0x3c, 0xf8, 0x1f, 0x9e, 0xce,
-	0xb4, 0x18, 0x6b, 0xb5, 0xa9, 0x61, 0x75, 0x1c, 0xc3, 0x72, 0x5d, 0xe6, 0x0b, 0x6f, 0x4a, 0x67,
-	0x66, 0x30, 0x58, 0x11, 0x9a, 0xb8, 0xd5, 0xf3, 0x40, 0x5e, 0x0f, 0xe0, 0xbe, 0x66, 0x79, 0xd6,
-	0x2e, 0x37, 0xe9, 0xcd, 0x2e, 0xe5, 0xbe, 0xfe, 0x2a, 0x3c, 0x15, 0x39, 0xe5, 0x1d, 0xe6, 0x72,
-	0x4a, 0x9e, 0x87, 0xf1, 0x8e, 0x38, 0x29, 0x68, 0x73, 0xda, 0xd2, 0xa9, 0xf5, 0x62, 0x75, 0x80,
-	0xa9, 0xaa, 0x54, 0xa9, 0x3f, 0xf1, 0xe0, 0xf7, 0x0b, 0x23, 0x26, 0x8a, 0xeb, 0xe7, 0x20, 0x2f,
-	0xec, 0x5d, 0x69, 0x36, 0x59, 0xd7, 0xf5, 0x43, 0x3f, 0x6f, 0xc3, 0xd3, 0xb1, 0x73, 0xf4, 0x74,
-	0x15, 0x26, 0x2c, 0x3c, 0x2b, 0x68, 0x73, 0xa3, 0x4b, 0xa7, 0xd6, 0xf5, 0x2a, 0x32, 0x21, 0x58,
-	0x57, 0xde, 0x36, 0x99, 0xdd, 0x6d, 0x53, 0x54, 0x47, 0xa7, 0xa1, 0xa6, 0xfe, 0x95, 0x86, 0x7e,
-	0xaf, 0xd2, 0x0e, 0xe3, 0x4e, 0xe8, 0x97, 0xe4, 0x61, 0xcc, 0xa6, 0x2e, 0xdb, 0x15, 0x71, 0x9c,
-	0x34, 0xe5, 0x07, 0xa9, 0xc2, 0x18, 0xbb, 0xe5, 0x52, 0xaf, 0x90, 0x0b, 0x4e, 0xeb, 0x85, 0x5f,
-	0xbe, 0xaf, 0xe4, 0xd1, 0xe9, 0x15, 0xdb, 0xf6, 0x28, 0xe7, 0xd7, 0x7d, 0xcf, 0x71, 0x5b, 0xa6,
-	0x14, 0x23, 0xd7, 0x00, 0x7a, 0xc9, 0x2d, 0x8c, 0x0a, 0x4a, 0x16, 0x14, 0xcc, 0x20, 0xbb, 0x55,
-	0xf9, 0xaa, 0x7a, 0xd4, 0xb4, 0x28, 0x22, 0x30, 0xfb, 0x34, 0xf5, 0x1f, 0x35, 0xa4, 0xa1, 0x07,
-	0x13, 0x69, 0x78, 0x13, 0x26, 0x6c, 0x3c, 0x0b, 0x69, 0x18, 0xa4, 0x1c, 0xd5, 0x94, 0x56, 0xbd,
-	0x10, 0xd0, 0xf0, 0xcd, 0x1f, 0x17, 0xce, 0xc6, 0x2e, 0xb8, 0x19, 0x5a, 0x23, 0x2f, 0x45, 0xb0,
-	0xe7, 0x04, 0xf6, 0xc5, 0xa1, 0xd8, 0xa5, 0x9d, 0x08, 0xf8, 0xef, 0x34, 0x98, 0x11, 0xe0, 0xdf,
-	0x70, 0xf9, 0xbe, 0xdb, 0xa4, 0xf6, 0xf1, 0xe6, 0xfa, 0x67, 0x0d, 0x66, 0x53, 0xe0, 0x3e, 0x3e,
-	0x9c, 0xaf, 0x43, 0x49, 0xc4, 0x70, 0x83, 0xf9, 0x56, 0x1b, 0x1d, 0x52, 0x3b, 0x93, 0x70, 0xfd,
-	0x13, 0x0d, 0xa6, 0x13, 0x95, 0x30, 0x6c, 0x0f, 0x26, 0x79, 0xb7, 0xd3, 0x69, 0x3b, 0xd4, 0xde,
-	0x0a, 0x9a, 0x11, 0x2f, 0xe4, 0x44, 0xf0, 0xc5, 0x08, 0x40, 0x05, 0xed, 0x45, 0xe6, 0xb8, 0xf5,
-	0x1a, 0xc6, 0xbc, 0xd4, 0x72, 0xfc, 0xed, 0x6e, 0xa3, 0xda, 0x64, 0xbb, 0xd8, 0xae, 0xf0, 0x9f,
-	0x0a, 0xb7, 0x77, 0x0c, 0x7f, 0xbf, 0x43, 0xb9, 0x50, 0xe0, 0xe6, 0x19, 0xe5, 0x42, 0x7c, 0xea,
-	0xf7, 0x35, 0xec, 0x33, 0x75, 0xe6, 0x79, 0xec, 0xd6, 0x31, 0x7d, 0x32, 0x3f, 0xa8, 0x2e, 0x12,
-	0xa2, 0x44, 0xca, 0x6e, 0xc0, 0x89, 0x86, 0x3c, 0xc2, 0x87, 0x72, 0x31, 0xe1, 0xa1, 0x48, 0xa5,
-	0xf0, 0x9d, 0x9c, 0x47, 0xce, 0xa6, 0xa2, 0xe7, 0xdc, 0x54, 0xa6, 0xfe, 0xbb, 0x57, 0xf2, 0xad,
-	0xca, 0xb8, 0x7a, 0xea, 0xc7, 0x9a, 0xe5, 0x9f, 0xe2, 0x7d, 0xe4, 0x31, 0x63, 0x7b, 0x0d, 0x8a,
-	0xbd, 0xf2, 0x92, 0xee, 0x86, 0x95, 0xe4, 0x5d, 0xad, 0xbf, 0x8e, 0x7b, 0x3a, 0xbd, 0x8a, 0x6c,
-	0xe0, 0xd9, 0x23, 0xac, 0x48, 0xe5, 0x42, 0x56, 0x64, 0x0d, 0x0a, 0x02, 0xd1, 0xcb, 0xae, 0x4f,
-	0xbd, 0x20, 0x45, 0x96, 0x4f, 0x87, 0x06, 0x51, 0x4c, 0x50, 0xc1, 0x18, 0x38, 0x4c, 0x3a, 0x78,
-	0xbe, 0xe5, 0x59, 0x3e, 0x55, 0xb9, 0x5b, 0x4e, 0xc8, 0xdd, 0x26, 0x73, 0xe9, 0xfe, 0xa6, 0xe5,
-	0xed, 0x50, 0xbf, 0xdf, 0x56, 0x7d, 0x0e, 0x83, 0x2a, 0xa4, 0x08, 0x70, 0xf3, 0x8c, 0xd3, 0xff,
-	0xa9, 0xaf, 0x62, 0xbd, 0x9a, 0x94, 0x53, 0x6f, 0x8f, 0x66, 0x3f, 0x78, 0xfd, 0x7d, 0xfc, 0xf1,
-	0xed, 0x49, 0x23, 0xf6, 0x26, 0x8c, 0x5b, 0xbb, 0xc1, 0x20, 0xf1, 0x28, 0x78, 0x47, 0xd3, 0xfa,
-	0x06, 0xd6, 0xa8, 0x0a, 0xe8, 0x9a, 0xd5, 0xf4, 0x99, 0x37, 0x04, 0xf2, 0x47, 0xaa, 0x56, 0x06,
-	0xb4, 0x10, 0x3a, 0x85, 0xb3, 0x21, 0xed, 0xef, 0xc8, 0xbb, 0x8c, 0xa2, 0x89, 0x5a, 0xe9, 0x15,
-	0x4d, 0xdc, 0xfa, 0x94, 0x13, 0x3d, 0xd0, 0xbf, 0xc8, 0xc1, 0x54, 0xec, 0xf7, 0x8e, 0x3c, 0x07,
-	0x27, 0xf1, 0x07, 0x8f, 0x79, 0x12, 0x75, 0x46, 0x0f, 0xe9, 0x89, 0xfe, 0x2f, 0x6c, 0x93, 0x36,
-	0x8c, 0x39, 0xae, 0x4d, 0x6f, 0x17, 0x46, 0x85, 0x0f, 0x23, 0x81, 0x8c, 0xeb, 0xc1, 0x2f, 0x54,
-	0x8c, 0xd8, 0xb0, 0x9f, 0xcc, 0xa3, 0xe7, 0xd9, 0x2c, 0x29, 0x6e, 0x4a, 0x27, 0xfa, 0x2b, 0x30,
-	0x93, 0x25, 0x97, 0xd2, 0x80, 0xf3, 0x30, 0xb6, 0x67, 0xb5, 0xbb, 0x54, 0x36, 0x60, 0x53, 0x7e,
-	0xe8, 0x9f, 0xe5, 0x60, 0x32, 0xda, 0xc4, 0xc8, 0x65, 0x98, 0xc0, 0xe2, 0x1d, 0x4e, 0x74, 0x28,
-	0x79, 0x6c, 0x78, 0x96, 0xc1, 0x0c, 0xe3, 0x39, 0x4b, 0xaa, 0x9f, 0xe7, 0x2c, 0xb9, 0x7f, 0xc5,
-	0xf3, 0x3d, 0x0d, 0xce, 0xa7, 0xf4, 0x99, 0x14, 0x3b, 0x35, 0xc8, 0x8b, 0xa9, 0x66, 0x7f, 0x2b,
-	0xd2, 0xe9, 0xd0, 0x2c, 0xe1, 0x91, 0x17, 0x20, 0xec, 0xd4, 0x20, 0x2f, 0xd3, 0x11, 0xd3, 0x18,
-	0x95, 0x1a, 0x8d, 0x48, 0x2c, 0x81, 0x86, 0xfe, 0xa9, 0x06, 0x93, 0xd1, 0xe0, 0x52, 0xc0, 0x5c,
-	0x86, 0x73, 0x71, 0xd3, 0xb2, 0xfe, 0x11, 0x4e, 0xbe, 0x91, 0x40, 0x54, 0xa0, 0x15, 0x0f, 0x01,
-	0xb5, 0x24, 0xa4, 0x3c, 0x4f, 0x78, 0xc6, 0xeb, 0x7f, 0x9f, 0x86, 0x31, 0xd1, 0x85, 0xc8, 0xbb,
-	0x30, 0x2e, 0xd7, 0x3e, 0x32, 0x9f, 0x90, 0xe9, 0xc1, 0xfd, 0xb2, 0xb4, 0x30, 0x4c, 0x4c, 0x66,
-	0x4e, 0xbf, 0x78, 0xe7, 0xd7, 0xbf, 0xee, 0xe5, 0xa6, 0x49, 0xd1, 0x18, 0x5c, 0x62, 0xe5, 0x6a,
-	0x49, 0xee, 0x68, 0x30, 0xa1, 0xd6, 0x47, 0xb2, 0x98, 0x66, 0x37, 0xb6, 0x78, 0x96, 0x96, 0x86,
-	0x0b, 0x22, 0x84, 0x4b, 0x02, 0xc2, 0x2c, 0x99, 0x4e, 0x80, 0xa0, 0x16, 0x4d, 0x01, 0x42, 0x2d,
-	0x12, 0xe9, 0x20, 0x62, 0x9b, 0x51, 0x3a, 0x88, 0xf8, 0x4e, 0x92, 0x09, 0x22, 0x5c, 0x2f, 0xee,
-	0x6b, 0x70, 0x36, 0xbe, 0xd5, 0x10, 0x23, 0xcd, 0x47, 0xca, 0xba, 0x56, 0xaa, 0x1d, 0x5d, 0x01,
-	0xc1, 0xad, 0x0a, 0x70, 0x0b, 0xe4, 0x99, 0x04, 0x70, 0x5d, 0x54, 0xaa, 0xf4, 0xa3, 0x9c, 0x8c,
-	0xae, 0x20, 0xa4, 0x92, 0xe6, 0x32, 0x71, 0xbf, 0x29, 0x55, 0x8f, 0x2a, 0x8e, 0xf8, 0xd6, 0x05,
-	0xbe, 0x55, 0xb2, 0x9c, 0x80, 0xcf, 0x0f, 0x54, 0x14, 0x38, 0x6a, 0x1b, 0xef, 0x89, 0x32, 0xfa,
-	0x80, 0x7c, 0x08, 0x27, 0x70, 0xfe, 0x24, 0xa9, 0x6f, 0x35, 0x3a, 0x4e, 0x97, 0x16, 0x87, 0xca,
-	0x21, 0x1e, 0x5d, 0xe0, 0x99, 0x21, 0xa5, 0x04, 0x3c, 0x6a, 0x2c, 0xfd, 0x52, 0x83, 0xa9, 0xd8,
-	0x20, 0x4c, 0xaa, 0xc3, 0x32, 0x13, 0x03, 0x64, 0x1c, 0x59, 0x1e, 0x81, 0xad, 0x08, 0x60, 0xf3,
-	0xe4, 0x52, 0x56, 0x22, 0xfb, 0x10, 0x9e, 0x89, 0xcc, 0xad, 0x64, 0x35, 0x33, 0x2f, 0xb1, 0x91,
-	0xb8, 0x54, 0x39, 0xa2, 0x34, 0x62, 0x5b, 0x13, 0xd8, 0x56, 0xc8, 0xb3, 0xa9, 0x49, 0x54, 0x83,
-	0x6c, 0x98, 0xc3, 0xcf, 0x35, 0x38, 0x1d, 0xe9, 0xbb, 0x2b, 0x69, 0x2e, 0x13, 0xa6, 0xdd, 0xd2,
-	0xea, 0xd1, 0x84, 0x11, 0x5e, 0x4d, 0xc0, 0x5b, 0x26, 0x4b, 0x09, 0xf0, 0x54, 0x4f, 0xad, 0x04,
-	0x4d, 0x3e, 0x44, 0xf7, 0xb1, 0x06, 0x13, 0x6a, 0xe4, 0x4c, 0x6f, 0x19, 0xb1, 0x11, 0x36, 0xbd,
-	0x65, 0xc4, 0xa7, 0xd7, 0xcc, 0x64, 0x7a, 0x28, 0x1c, 0x82, 0xf9, 0x5a, 0x83, 0xf8, 0xb4, 0x97,
-	0xfe, 0xdc, 0x92, 0x47, 0xd5, 0xf4, 0xe7, 0x96, 0x32, 0xa4, 0xea, 0x1b, 0x02, 0x61, 0x85, 0xac,
-	0x64, 0x71, 0x86, 0xd3, 0xab, 0x42, 0x5a, 0x7f, 0xe1, 0xc1, 0x41, 0x59, 0x7b, 0x78, 0x50, 0xd6,
-	0xfe, 0x3c, 0x28, 0x6b, 0x77, 0x0f, 0xcb, 0x23, 0x0f, 0x0f, 0xcb, 0x23, 0xbf, 0x1d, 0x96, 0x47,
-	0xde, 0x5a, 0xe8, 0x9b, 0x52, 0x02, 0x83, 0x95, 0xb6, 0xd5, 0xe0, 0xd2, 0xf4, 0x6d, 0x69, 0x5c,
-	0x4c, 0x2a, 0x8d, 0x71, 0xf1, 0x87, 0xcf, 0x8d, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x21,
-	0xbf, 0x12, 0x05, 0x16, 0x00, 0x00,
+	proto.RegisterType((*QueryInterestRateCurveRequest)(nil), "aeth.hard.v1beta1.QueryInterestRateCurveRequest")
+	proto.RegisterType((*QueryInterestRateCurveResponse)(nil), "aeth.hard.v1beta1.QueryInterestRateCurveResponse")
+	proto.RegisterType((*InterestRateCurvePoint)(nil), "aeth.hard.v1beta1.InterestRateCurvePoint")
+}
+
+func init() { proto.RegisterFile("aeth/hard/v1beta1/query.proto", fileDescriptor_b9f0a9c594fd53d2) }
+
+var fileDescriptor_b9f0a9c594fd53d2 = []byte{
+	// 1609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x13, 0xd7,
+	0x16, 0xcf, 0x38, 0x2f, 0x21, 0x1c, 0x5e, 0x12, 0xb8, 0x38, 0xc1, 0x19, 0x12, 0x13, 0x86, 0x97,
+	0x0f, 0x92, 0xd8, 0xe3, 0x04, 0x1e, 0xdd, 0x74, 0x83, 0x89, 0x80, 0x56, 0x4a, 0x45, 0x07, 0x2a,
+	0x55, 0x95, 0x5a, 0x6b, 0x6c, 0xdf, 0x3a, 0xa3, 0xd8, 0x73, 0xcd, 0xcc, 0x38, 0x10, 0x28, 0x5d,
+	0x20, 0x75, 0xd3, 0x15, 0x85, 0x55, 0xd5, 0x4a, 0x5d, 0x50, 0xa9, 0x52, 0xdb, 0x45, 0xa5, 0xd2,
+	0x0d, 0x52, 0x37, 0x5d, 0xb1, 0x44, 0x74, 0x83, 0xba, 0xa0, 0x15, 0xf4, 0x0f, 0xa9, 0xe6, 0xde,
+	0x33, 0x63, 0xcf, 0x78, 0xc6, 0x63, 0xa4, 0x50, 0x85, 0x55, 0x72, 0xcf, 0x3d, 0x1f, 0xbf, 0xf3,
+	0x71, 0xcf, 0xdc, 0x73, 0x0d, 0x33, 0x3a, 0x75, 0x36, 0xd5, 0x4d, 0xdd, 0xaa, 0xaa, 0xdb, 0xab,
+	0x65, 0xea, 0xe8, 0xab, 0xea, 0xd5, 0x16, 0xb5, 0x76, 0xf2, 0x4d, 0x8b, 0x39, 0x8c, 0x1c, 0x72,
+	0xb7, 0xf3, 0xee, 0x76, 0x1e, 0xb7, 0xe5, 0x6c, 0x85, 0xd9, 0x0d, 0x66, 0xab, 0x7a, 0xcb, 0xd9,
+	0xf4, 0x65, 0xdc, 0x85, 0x10, 0x91, 0x97, 0x70, 0xbf, 0xac, 0xdb, 0x54, 0xe8, 0xf2, 0xb9, 0x9a,
+	0x7a, 0xcd, 0x30, 0x75, 0xc7, 0x60, 0x26, 0xf2, 0x66, 0x3b, 0x79, 0x3d, 0xae, 0x0a, 0x33, 0xbc,
+	0xfd, 0x29, 0xb1, 0x5f, 0xe2, 0x2b, 0x55, 0x2c, 0x70, 0x2b, 0x5d, 0x63, 0x35, 0x26, 0xe8, 0xee,
+	0x7f, 0x48, 0x9d, 0xae, 0x31, 0x56, 0xab, 0x53, 0x55, 0x6f, 0x1a, 0xaa, 0x6e, 0x9a, 0xcc, 0xe1,
+	0xd6, 0x3c, 0x99, 0xe9, 0x6e, 0x67, 0xb9, 0x6b, 0x7c, 0x57, 0x49, 0x03, 0x79, 0xd7, 0x85, 0x7b,
+	0x49, 0xb7, 0xf4, 0x86, 0xad, 0xd1, 0xab, 0x2d, 0x6a, 0x3b, 0xca, 0x3b, 0x70, 0x38, 0x40, 0xb5,
+	0x9b, 0xcc, 0xb4, 0x29, 0x79, 0x03, 0x86, 0x9b, 0x9c, 0x92, 0x91, 0x66, 0xa5, 0xc5, 0x03, 0x6b,
+	0x53, 0xf9, 0xae, 0x48, 0xe5, 0x85, 0x48, 0xf1, 0x3f, 0x8f, 0x9e, 0x1d, 0x1b, 0xd0, 0x90, 0x5d,
+	0x99, 0x84, 0x34, 0xd7, 0x77, 0xb6, 0x52, 0x61, 0x2d, 0xd3, 0xf1, 0xed, 0x7c, 0x08, 0x13, 0x21,
+	0x3a, 0x5a, 0x5a, 0x87, 0x11, 0x1d, 0x69, 0x19, 0x69, 0x76, 0x70, 0xf1, 0xc0, 0x9a, 0x92, 0xc7,
+	0x48, 0xf0, 0xa8, 0x7b, 0xd6, 0x36, 0x58, 0xb5, 0x55, 0xa7, 0x28, 0x8e, 0x46, 0x7d, 0x49, 0xe5,
+	0x5b, 0x09, 0xed, 0xae, 0xd3, 0x26, 0xb3, 0x0d, 0xdf, 0x2e, 0x49, 0xc3, 0x50, 0x95, 0x9a, 0xac,
+	0xc1, 0xfd, 0xd8, 0xaf, 0x89, 0x05, 0xc9, 0xc3, 0x10, 0xbb, 0x66, 0x52, 0x2b, 0x93, 0x72, 0xa9,
+	0xc5, 0xcc, 0x93, 0x07, 0xb9, 0x34, 0x1a, 0x3d, 0x5b, 0xad, 0x5a, 0xd4, 0xb6, 0x2f, 0x3b, 0x96,
+	0x61, 0xd6, 0x34, 0xc1, 0x46, 0xce, 0x03, 0xb4, 0x93, 0x9b, 0x19, 0xe4, 0x21, 0x99, 0xf7, 0x60,
+	0xba, 0xd9, 0xcd, 0x8b, 0xaa, 0x6a, 0x87, 0xa6, 0x46, 0x11, 0x81, 0xd6, 0x21, 0xa9, 0x3c, 0x94,
+	0x60, 0x22, 0x04, 0x13, 0xc3, 0xf0, 0x3e, 0x8c, 0x54, 0x91, 0xe6, 0x87, 0xa1, 0x3b, 0xe4, 0x28,
+	0xe6, 0x49, 0x15, 0x33, 0x6e, 0x18, 0xbe, 0xff, 0xf3, 0xd8, 0xc1, 0xd0, 0x86, 0xad, 0xf9, 0xda,
+	0xc8, 0x85, 0x00, 0xf6, 0x14, 0xc7, 0xbe, 0x90, 0x88, 0x5d, 0xe8, 0x09, 0x80, 0xff, 0x51, 0x82,
+	0x69, 0x0e, 0xfe, 0x3d, 0xd3, 0xde, 0x31, 0x2b, 0xb4, 0xba, 0xb7, 0x63, 0xfd, 0x9b, 0x04, 0x33,
+	0x31, 0x70, 0x5f, 0x9f, 0x98, 0xaf, 0x81, 0xcc, 0x7d, 0xb8, 0xc2, 0x1c, 0xbd, 0x8e, 0x06, 0x69,
+	0xb5, 0x67, 0xc0, 0x95, 0x2f, 0x24, 0x38, 0x1a, 0x29, 0x84, 0x6e, 0x5b, 0x30, 0x66, 0xb7, 0x9a,
+	0xcd, 0xba, 0x41, 0xab, 0x25, 0xb7, 0x19, 0xd9, 0x99, 0x14, 0x77, 0x7e, 0x2a, 0x00, 0xd0, 0x83,
+	0x76, 0x8e, 0x19, 0x66, 0xb1, 0x80, 0x3e, 0x2f, 0xd6, 0x0c, 0x67, 0xb3, 0x55, 0xce, 0x57, 0x58,
+	0x03, 0xdb, 0x15, 0xfe, 0xc9, 0xd9, 0xd5, 0x2d, 0xd5, 0xd9, 0x69, 0x52, 0x9b, 0x0b, 0xd8, 0xda,
+	0xa8, 0x67, 0x82, 0x2f, 0x95, 0xfb, 0x12, 0xf6, 0x99, 0x22, 0xb3, 0x2c, 0x76, 0x6d, 0x8f, 0x96,
+	0xcc, 0x2f, 0x5e, 0x17, 0xf1, 0x51, 0x62, 0xc8, 0xae, 0xc0, 0xbe, 0xb2, 0x20, 0x61, 0xa1, 0x1c,
+	0x8f, 0x28, 0x14, 0x21, 0xe4, 0xd7, 0xc9, 0x11, 0x8c, 0xd9, 0x78, 0x90, 0x6e, 0x6b, 0x9e, 0xaa,
+	0xdd, 0xab, 0x92, 0x1f, 0xbc, 0x8c, 0x7b, 0xa5, 0xbe, 0xa7, 0xa3, 0xfc, 0x6b, 0xb8, 0x8f, 0xbc,
+	0x66, 0xd1, 0x5e, 0x85, 0xa9, 0xf6, 0xf1, 0x12, 0xe6, 0x92, 0x8e, 0xe4, 0x1d, 0x09, 0xe4, 0x28,
+	0x99, 0xf6, 0x89, 0x2c, 0x23, 0xed, 0x15, 0x9e, 0x48, 0xcf, 0x84, 0x38, 0x91, 0x05, 0xc8, 0x70,
+	0x44, 0x6f, 0x99, 0x0e, 0xb5, 0xdc, 0x14, 0xe9, 0x0e, 0x4d, 0x74, 0x62, 0x2a, 0x42, 0x04, 0x7d,
+	0xb0, 0x61, 0xcc, 0x40, 0x7a, 0xc9, 0xd2, 0x1d, 0xea, 0xe5, 0x6e, 0x29, 0x22, 0x77, 0x1b, 0xcc,
+	0xa4, 0x3b, 0x1b, 0xba, 0xb5, 0x45, 0x9d, 0x4e, 0x5d, 0xc5, 0x59, 0x74, 0x2a, 0x13, 0xc3, 0x60,
+	0x6b, 0xa3, 0x46, 0xe7, 0x52, 0x59, 0xc1, 0xf3, 0xaa, 0x51, 0x9b, 0x5a, 0xdb, 0xb4, 0x77, 0xc1,
+	0x2b, 0x9f, 0xc0, 0x44, 0x88, 0x1b, 0xb1, 0x57, 0x60, 0x58, 0x6f, 0xb8, 0x17, 0x89, 0x57, 0x11,
+	0x77, 0x54, 0xad, 0x9c, 0xc2, 0x33, 0xea, 0x39, 0x74, 0x5e, 0xaf, 0x38, 0xcc, 0x4a, 0x80, 0xfc,
+	0x99, 0x77, 0x56, 0xba, 0xa4, 0x10, 0x3a, 0x85, 0x83, 0x7e, 0xd8, 0x3f, 0x16, 0x7b, 0x3d, 0x0e,
+	0x4d, 0x50, 0x4b, 0xfb, 0xd0, 0x84, 0xb5, 0x8f, 0x1b, 0x41, 0x82, 0x72, 0x11, 0x26, 0x39, 0x8c,
+	0x73, 0xac, 0x5e, 0xd7, 0x1d, 0x6a, 0xe9, 0x75, 0x0f, 0xb7, 0xdf, 0x45, 0xa4, 0xbe, 0xba, 0x88,
+	0x7b, 0x05, 0x3a, 0xd2, 0xa5, 0x0a, 0x9d, 0xd9, 0x02, 0xa8, 0xf8, 0xd4, 0x8c, 0xb4, 0xfb, 0xb9,
+	0xe8, 0x50, 0x4f, 0xd6, 0x60, 0xc2, 0x64, 0x66, 0xa9, 0x4d, 0x29, 0xf1, 0x90, 0x8b, 0xb3, 0xb7,
+	0x5f, 0x3b, 0x6c, 0x32, 0xb3, 0x0d, 0x71, 0x9d, 0x6f, 0x29, 0x5f, 0xa7, 0x60, 0x3c, 0xf4, 0xd9,
+	0x27, 0x67, 0x60, 0x3f, 0x7e, 0xf7, 0x59, 0x72, 0x10, 0xda, 0xac, 0xff, 0x4a, 0xd1, 0x91, 0x3a,
+	0x0c, 0x19, 0x66, 0x95, 0x5e, 0xcf, 0x0c, 0x72, 0x1b, 0x6a, 0x44, 0x4d, 0x5c, 0x76, 0x3f, 0xd4,
+	0xa1, 0xfa, 0xf2, 0xdb, 0xea, 0x1c, 0x5a, 0x9e, 0xe9, 0xc5, 0x65, 0x6b, 0xc2, 0x88, 0xf2, 0x36,
+	0x4c, 0xf7, 0xe2, 0x8b, 0xf9, 0x0e, 0xa5, 0x61, 0x68, 0x5b, 0xaf, 0xb7, 0xa8, 0xf8, 0x0e, 0x69,
+	0x62, 0xa1, 0x7c, 0x99, 0x82, 0xb1, 0x60, 0x2f, 0x27, 0xa7, 0x61, 0x04, 0x7b, 0x58, 0x72, 0xa0,
+	0x7d, 0xce, 0x3d, 0x13, 0x67, 0xe1, 0x4c, 0x52, 0x9c, 0x7b, 0x71, 0x75, 0xc6, 0xb9, 0x17, 0xdf,
+	0x4b, 0xc5, 0xf9, 0x9e, 0x04, 0x47, 0x62, 0xda, 0x6d, 0x8c, 0x9e, 0x02, 0xa4, 0xf9, 0xe5, 0x6e,
+	0xa7, 0x14, 0x68, 0xf8, 0xa8, 0x96, 0xd8, 0x81, 0x0a, 0xe0, 0x7a, 0x0a, 0x90, 0x16, 0xe9, 0x08,
+	0x49, 0x0c, 0x0a, 0x89, 0x72, 0xc0, 0x17, 0x57, 0x42, 0xb9, 0x2b, 0xc1, 0x58, 0xd0, 0xb9, 0x18,
+	0x30, 0xa7, 0x61, 0x32, 0xac, 0x5a, 0xb4, 0x41, 0x84, 0x93, 0x2e, 0x47, 0x04, 0xca, 0x95, 0x0a,
+	0xbb, 0x80, 0x52, 0x02, 0x52, 0xda, 0x8e, 0x28, 0x63, 0xe5, 0xff, 0x38, 0x50, 0x74, 0x22, 0x3d,
+	0xd7, 0xb2, 0xb6, 0x13, 0xbe, 0x9b, 0x3f, 0x4b, 0x90, 0x8d, 0x93, 0xc3, 0x84, 0x5d, 0x84, 0x71,
+	0x37, 0x20, 0xa5, 0x06, 0xab, 0xd2, 0x7a, 0xc9, 0x2d, 0x30, 0xae, 0x62, 0x6c, 0x6d, 0x36, 0xa2,
+	0x90, 0x5c, 0xf1, 0x0d, 0x97, 0xf1, 0xca, 0x4e, 0x93, 0x6a, 0xa3, 0x56, 0xe7, 0x92, 0x5c, 0x80,
+	0xe1, 0x26, 0x33, 0xdc, 0x61, 0x5a, 0x54, 0xfb, 0xc9, 0x1e, 0x5f, 0x01, 0x1f, 0xc7, 0x25, 0x66,
+	0xf8, 0x33, 0x35, 0x8a, 0x2b, 0x4f, 0x53, 0x30, 0x19, 0xcd, 0x48, 0x3e, 0x82, 0x03, 0x2d, 0xc7,
+	0xa8, 0x1b, 0x37, 0xc4, 0x55, 0x4a, 0x1c, 0xc5, 0x37, 0x5d, 0xe9, 0x3f, 0x9e, 0x1d, 0x9b, 0xef,
+	0xe3, 0xec, 0xac, 0xd3, 0xca, 0x93, 0x07, 0x39, 0x10, 0x74, 0x77, 0xa5, 0x75, 0x2a, 0x24, 0x66,
+	0x4c, 0xb9, 0xa4, 0x76, 0xc1, 0x50, 0x44, 0xb1, 0xb9, 0xf6, 0x22, 0x0b, 0x7a, 0x70, 0x37, 0xec,
+	0x75, 0x1f, 0x87, 0xb5, 0x87, 0xe3, 0x30, 0xc4, 0x0b, 0x82, 0xdc, 0x80, 0x61, 0xf1, 0x8a, 0x42,
+	0xe6, 0x22, 0xf2, 0xd4, 0xfd, 0x5c, 0x23, 0xcf, 0x27, 0xb1, 0x89, 0x82, 0x52, 0x8e, 0xdf, 0xfe,
+	0xfd, 0xef, 0x7b, 0xa9, 0xa3, 0x64, 0x4a, 0xed, 0x7e, 0x13, 0x12, 0x2f, 0x35, 0xe4, 0xb6, 0x04,
+	0x23, 0xde, 0x6b, 0x0c, 0x59, 0x88, 0xd3, 0x1b, 0x7a, 0xc7, 0x91, 0x17, 0x93, 0x19, 0x11, 0xc2,
+	0x09, 0x0e, 0x61, 0x86, 0x1c, 0x8d, 0x80, 0xe0, 0xbd, 0xdb, 0x70, 0x10, 0xde, 0x5c, 0x1e, 0x0f,
+	0x22, 0xf4, 0xd0, 0x20, 0x2f, 0x26, 0x33, 0xf6, 0x01, 0xc2, 0x9f, 0xd6, 0xef, 0x4b, 0x70, 0x30,
+	0xfc, 0x48, 0x40, 0xd4, 0x38, 0x1b, 0x31, 0xaf, 0x1f, 0x72, 0xa1, 0x7f, 0x01, 0x04, 0xb7, 0xc2,
+	0xc1, 0xcd, 0x93, 0xff, 0x45, 0x80, 0x6b, 0xa1, 0x50, 0xae, 0x13, 0xe5, 0x58, 0x70, 0xa2, 0x27,
+	0xb9, 0x38, 0x93, 0x91, 0xcf, 0x05, 0x72, 0xbe, 0x5f, 0x76, 0xc4, 0xb7, 0xc6, 0xf1, 0xad, 0x90,
+	0xa5, 0x08, 0x7c, 0x8e, 0x2b, 0xe2, 0x81, 0xa3, 0x55, 0xf5, 0x26, 0xef, 0x75, 0xb7, 0xc8, 0xa7,
+	0xb0, 0x0f, 0xc7, 0x39, 0x12, 0x5b, 0xab, 0xc1, 0xe9, 0x54, 0x5e, 0x48, 0xe4, 0x43, 0x3c, 0x0a,
+	0xc7, 0x33, 0x4d, 0xe4, 0x08, 0x3c, 0xde, 0x94, 0xf7, 0x8d, 0x04, 0xe3, 0xa1, 0xb9, 0x92, 0xe4,
+	0x93, 0x32, 0x13, 0x02, 0xa4, 0xf6, 0xcd, 0x8f, 0xc0, 0x96, 0x39, 0xb0, 0x39, 0x72, 0xa2, 0x57,
+	0x22, 0x3b, 0x10, 0x8e, 0x06, 0xc6, 0x40, 0xb2, 0xd2, 0x33, 0x2f, 0xa1, 0x09, 0x53, 0xce, 0xf5,
+	0xc9, 0x8d, 0xd8, 0x56, 0x39, 0xb6, 0x65, 0x72, 0x32, 0x36, 0x89, 0xde, 0x5c, 0xe8, 0xe7, 0xf0,
+	0x2b, 0x09, 0xfe, 0x1b, 0x68, 0x90, 0xcb, 0x71, 0x26, 0x23, 0x86, 0x47, 0x79, 0xa5, 0x3f, 0x66,
+	0x84, 0x57, 0xe0, 0xf0, 0x96, 0xc8, 0x62, 0x04, 0x3c, 0xaf, 0x1b, 0xe7, 0x2c, 0xdd, 0xa1, 0x3e,
+	0xba, 0xcf, 0x25, 0x18, 0xf1, 0x26, 0xb8, 0xf8, 0x96, 0x11, 0x9a, 0x08, 0xe5, 0xc5, 0x64, 0xc6,
+	0x3e, 0x92, 0x69, 0x21, 0xb3, 0x0f, 0xe6, 0x3b, 0x09, 0xc2, 0xc3, 0x53, 0x7c, 0xb9, 0x45, 0x4f,
+	0x7e, 0xb2, 0xda, 0x37, 0x3f, 0x22, 0x3c, 0xc5, 0x11, 0xe6, 0xc8, 0x72, 0xaf, 0x98, 0xe1, 0x30,
+	0xe8, 0x23, 0xbd, 0x2b, 0x01, 0xb4, 0xe7, 0x19, 0x72, 0x32, 0xce, 0x68, 0xd7, 0x84, 0x27, 0x2f,
+	0xf5, 0xc3, 0x8a, 0xd0, 0x72, 0x1c, 0xda, 0x02, 0x99, 0x8b, 0x80, 0xd6, 0x9e, 0xb4, 0xd4, 0x9b,
+	0x7c, 0x16, 0xbc, 0x45, 0x7e, 0x92, 0xe0, 0x50, 0xd7, 0x25, 0x83, 0x14, 0xfa, 0xa9, 0xa0, 0xce,
+	0x8b, 0x97, 0xbc, 0xfa, 0x12, 0x12, 0x88, 0xf4, 0x0c, 0x47, 0x5a, 0x20, 0xf9, 0xa4, 0xc2, 0xcb,
+	0x55, 0x5c, 0x39, 0x2f, 0x8e, 0xc5, 0xf3, 0x8f, 0x9e, 0x67, 0xa5, 0xc7, 0xcf, 0xb3, 0xd2, 0x5f,
+	0xcf, 0xb3, 0xd2, 0x9d, 0x17, 0xd9, 0x81, 0xc7, 0x2f, 0xb2, 0x03, 0x4f, 0x5f, 0x64, 0x07, 0x3e,
+	0x58, 0xe9, 0xb8, 0x20, 0x34, 0xd8, 0x96, 0xe1, 0xe8, 0x26, 0x75, 0xae, 0x31, 0x6b, 0x8b, 0x5b,
+	0xa0, 0x96, 0x7a, 0x5d, 0x58, 0xe1, 0x57, 0x85, 0xf2, 0x30, 0xff, 0x55, 0xe6, 0xd4, 0x3f, 0x03,
+	0x00, 0x4e, 0x33, 0x38, 0xb5, 0xa2, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1615,6 +1875,10 @@ type QueryClient interface {
 	Reserves(ctx context.Context, in *QueryReservesRequest, opts ...grpc.CallOption) (*QueryReservesResponse, error)
 	// InterestFactors queries hard module interest factors.
 	InterestFactors(ctx context.Context, in *QueryInterestFactorsRequest, opts ...grpc.CallOption) (*QueryInterestFactorsResponse, error)
+	// Collateral queries the deposits of an owner that back their borrows.
+	Collateral(ctx context.Context, in *QueryCollateralRequest, opts ...grpc.CallOption) (*QueryCollateralResponse, error)
+	// InterestRateCurve queries the borrow and supply rates of a money market across utilizations.
+	InterestRateCurve(ctx context.Context, in *QueryInterestRateCurveRequest, opts ...grpc.CallOption) (*QueryInterestRateCurveResponse, error)
 }
 
 type queryClient struct {
@@ -1724,6 +1988,24 @@ func (c *queryClient) InterestFactors(ctx context.Context, in *QueryInterestFact
 	return out, nil
 }
 
+func (c *queryClient) Collateral(ctx context.Context, in *QueryCollateralRequest, opts ...grpc.CallOption) (*QueryCollateralResponse, error) {
+	out := new(QueryCollateralResponse)
+	err := c.cc.Invoke(ctx, "/aeth.hard.v1beta1.Query/Collateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterestRateCurve(ctx context.Context, in *QueryInterestRateCurveRequest, opts ...grpc.CallOption) (*QueryInterestRateCurveResponse, error) {
+	out := new(QueryInterestRateCurveResponse)
+	err := c.cc.Invoke(ctx, "/aeth.hard.v1beta1.Query/InterestRateCurve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries module params.
@@ -1748,6 +2030,10 @@ type QueryServer interface {
 	Reserves(context.Context, *QueryReservesRequest) (*QueryReservesResponse, error)
 	// InterestFactors queries hard module interest factors.
 	InterestFactors(context.Context, *QueryInterestFactorsRequest) (*QueryInterestFactorsResponse, error)
+	// Collateral queries the deposits of an owner that back their borrows.
+	Collateral(context.Context, *QueryCollateralRequest) (*QueryCollateralResponse, error)
+	// InterestRateCurve queries the borrow and supply rates of a money market across utilizations.
+	InterestRateCurve(context.Context, *QueryInterestRateCurveRequest) (*QueryInterestRateCurveResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1787,6 +2073,12 @@ func (*UnimplementedQueryServer) Reserves(ctx context.Context, req *QueryReserve
 func (*UnimplementedQueryServer) InterestFactors(ctx context.Context, req *QueryInterestFactorsRequest) (*QueryInterestFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InterestFactors not implemented")
 }
+func (*UnimplementedQueryServer) Collateral(ctx context.Context, req *QueryCollateralRequest) (*QueryCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Collateral not implemented")
+}
+func (*UnimplementedQueryServer) InterestRateCurve(ctx context.Context, req *QueryInterestRateCurveRequest) (*QueryInterestRateCurveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterestRateCurve not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1990,6 +2282,42 @@ func _Query_InterestFactors_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Collateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Collateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.hard.v1beta1.Query/Collateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Collateral(ctx, req.(*QueryCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterestRateCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterestRateCurveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterestRateCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.hard.v1beta1.Query/InterestRateCurve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterestRateCurve(ctx, req.(*QueryInterestRateCurveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "aeth.hard.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2038,6 +2366,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InterestFactors",
 			Handler:    _Query_InterestFactors_Handler,
 		},
+		{
+			MethodName: "Collateral",
+			Handler:    _Query_Collateral_Handler,
+		},
+		{
+			MethodName: "InterestRateCurve",
+			Handler:    _Query_InterestRateCurve_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aeth/hard/v1beta1/query.proto",
@@ -2886,6 +3222,82 @@ func (m *QueryInterestFactorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCollateralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NonCollateralDenoms) > 0 {
+		for iNdEx := len(m.NonCollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NonCollateralDenoms[iNdEx])
+			copy(dAtA[i:], m.NonCollateralDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.NonCollateralDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DepositResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3164,17 +3576,142 @@ func (m *InterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *QueryInterestRateCurveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterestRateCurveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterestRateCurveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterestRateCurveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterestRateCurveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterestRateCurveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RateModelType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RateModelType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterestRateCurvePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterestRateCurvePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterestRateCurvePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SupplyInterestRate.Size()
+		i -= size
+		if _, err := m.SupplyInterestRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BorrowInterestRate.Size()
+		i -= size
+		if _, err := m.BorrowInterestRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Utilization.Size()
+		i -= size
+		if _, err := m.Utilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3519,6 +4056,40 @@ func (m *QueryInterestFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NonCollateralDenoms) > 0 {
+		for _, s := range m.NonCollateralDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DepositResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3645,6 +4216,52 @@ func (m *InterestFactor) Size() (n int) {
 	return n
 }
 
+func (m *QueryInterestRateCurveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterestRateCurveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RateModelType != 0 {
+		n += 1 + sovQuery(uint64(m.RateModelType))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InterestRateCurvePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Utilization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BorrowInterestRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SupplyInterestRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5828,7 +6445,7 @@ func (m *QueryInterestFactorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCollateralRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5851,15 +6468,15 @@ func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollateralRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5887,11 +6504,61 @@ func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5918,16 +6585,16 @@ func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Collateral = append(m.Collateral, types1.Coin{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonCollateralDenoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5937,25 +6604,23 @@ func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index, SupplyInterestFactorResponse{})
-			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NonCollateralDenoms = append(m.NonCollateralDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5978,7 +6643,7 @@ func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SupplyInterestFactorResponse) Unmarshal(dAtA []byte) error {
+func (m *DepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6001,10 +6666,160 @@ func (m *SupplyInterestFactorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyInterestFactorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyInterestFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index, SupplyInterestFactorResponse{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyInterestFactorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyInterestFactorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyInterestFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6648,6 +7463,343 @@ func (m *InterestFactor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInterestRateCurveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterestRateCurveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterestRateCurveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterestRateCurveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterestRateCurveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterestRateCurveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateModelType", wireType)
+			}
+			m.RateModelType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateModelType |= RateModelType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, InterestRateCurvePoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterestRateCurvePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterestRateCurvePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterestRateCurvePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
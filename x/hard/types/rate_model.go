@@ -0,0 +1,237 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const secondsPerYear = 31536000
+
+// RateModel is the interface that must be implemented by an interest rate model.
+type RateModel interface {
+	// GetRateModelType returns the rate model type
+	GetRateModelType() RateModelType
+
+	// BorrowRate returns the borrow APY, expressed as a decimal, at a utilization
+	// ratio in the inclusive range 0.0-1.0.
+	BorrowRate(utilization sdk.Dec) sdk.Dec
+}
+
+var (
+	_ RateModel = InterestRateModel{}
+	_ RateModel = AdaptiveRateCurve{}
+	_ RateModel = FixedRateModel{}
+)
+
+// GetRateModelType returns the rate model type
+func (irm InterestRateModel) GetRateModelType() RateModelType {
+	return RATE_MODEL_TYPE_JUMP
+}
+
+// BorrowRate returns the borrow APY at a utilization, which rises by the base multiplier
+// up to the kink and by the jump multiplier above it.
+func (irm InterestRateModel) BorrowRate(utilization sdk.Dec) sdk.Dec {
+	// Calculate normal borrow rate (under kink)
+	if utilization.LTE(irm.Kink) {
+		return utilization.Mul(irm.BaseMultiplier).Add(irm.BaseRateAPY)
+	}
+
+	// Calculate jump borrow rate (over kink)
+	normalRate := irm.Kink.Mul(irm.BaseMultiplier).Add(irm.BaseRateAPY)
+	excessUtil := utilization.Sub(irm.Kink)
+	return excessUtil.Mul(irm.JumpMultiplier).Add(normalRate)
+}
+
+// NewFixedRateModel returns a new FixedRateModel
+func NewFixedRateModel(borrowRateAPY sdk.Dec) FixedRateModel {
+	return FixedRateModel{
+		BorrowRateAPY: borrowRateAPY,
+	}
+}
+
+// GetRateModelType returns the rate model type
+func (frm FixedRateModel) GetRateModelType() RateModelType {
+	return RATE_MODEL_TYPE_FIXED
+}
+
+// BorrowRate returns the fixed borrow APY, whatever the utilization
+func (frm FixedRateModel) BorrowRate(utilization sdk.Dec) sdk.Dec {
+	return frm.BorrowRateAPY
+}
+
+// Validate FixedRateModel param
+func (frm FixedRateModel) Validate() error {
+	if frm.BorrowRateAPY.IsNil() || frm.BorrowRateAPY.IsNegative() {
+		return fmt.Errorf("fixed borrow rate APY must not be negative")
+	}
+	return nil
+}
+
+// Equal returns a boolean indicating if a FixedRateModel is equal to another FixedRateModel
+func (frm FixedRateModel) Equal(frmCompareTo FixedRateModel) bool {
+	return frm.BorrowRateAPY.Equal(frmCompareTo.BorrowRateAPY)
+}
+
+// NewAdaptiveRateModel returns a new AdaptiveRateModel
+func NewAdaptiveRateModel(targetUtilization, initialRateAtTarget, minRateAtTarget, maxRateAtTarget,
+	adjustmentSpeed, curveSteepness sdk.Dec,
+) AdaptiveRateModel {
+	return AdaptiveRateModel{
+		TargetUtilization:   targetUtilization,
+		InitialRateAtTarget: initialRateAtTarget,
+		MinRateAtTarget:     minRateAtTarget,
+		MaxRateAtTarget:     maxRateAtTarget,
+		AdjustmentSpeed:     adjustmentSpeed,
+		CurveSteepness:      curveSteepness,
+	}
+}
+
+// Validate AdaptiveRateModel param
+func (arm AdaptiveRateModel) Validate() error {
+	for _, d := range []sdk.Dec{
+		arm.TargetUtilization, arm.InitialRateAtTarget, arm.MinRateAtTarget,
+		arm.MaxRateAtTarget, arm.AdjustmentSpeed, arm.CurveSteepness,
+	} {
+		if d.IsNil() {
+			return fmt.Errorf("adaptive rate model parameters cannot be empty")
+		}
+	}
+
+	if !arm.TargetUtilization.IsPositive() || arm.TargetUtilization.GTE(sdk.OneDec()) {
+		return fmt.Errorf("target utilization must be in the exclusive range 0.0-1.0")
+	}
+
+	if arm.MinRateAtTarget.IsNegative() {
+		return fmt.Errorf("min rate at target must not be negative")
+	}
+
+	if arm.InitialRateAtTarget.LT(arm.MinRateAtTarget) || arm.InitialRateAtTarget.GT(arm.MaxRateAtTarget) {
+		return fmt.Errorf("initial rate at target must be between the min and max rates at target")
+	}
+
+	if arm.AdjustmentSpeed.IsNegative() {
+		return fmt.Errorf("adjustment speed must not be negative")
+	}
+
+	if arm.CurveSteepness.LT(sdk.OneDec()) {
+		return fmt.Errorf("curve steepness must be ≥ 1.0")
+	}
+
+	return nil
+}
+
+// Equal returns a boolean indicating if an AdaptiveRateModel is equal to another AdaptiveRateModel
+func (arm AdaptiveRateModel) Equal(armCompareTo AdaptiveRateModel) bool {
+	if !arm.TargetUtilization.Equal(armCompareTo.TargetUtilization) {
+		return false
+	}
+	if !arm.InitialRateAtTarget.Equal(armCompareTo.InitialRateAtTarget) {
+		return false
+	}
+	if !arm.MinRateAtTarget.Equal(armCompareTo.MinRateAtTarget) {
+		return false
+	}
+	if !arm.MaxRateAtTarget.Equal(armCompareTo.MaxRateAtTarget) {
+		return false
+	}
+	if !arm.AdjustmentSpeed.Equal(armCompareTo.AdjustmentSpeed) {
+		return false
+	}
+	if !arm.CurveSteepness.Equal(armCompareTo.CurveSteepness) {
+		return false
+	}
+	return true
+}
+
+// utilizationError returns the distance of utilization from the target, scaled to the inclusive range -1.0-1.0
+func (arm AdaptiveRateModel) utilizationError(utilization sdk.Dec) sdk.Dec {
+	diff := utilization.Sub(arm.TargetUtilization)
+	if diff.IsPositive() {
+		return diff.Quo(sdk.OneDec().Sub(arm.TargetUtilization))
+	}
+	return diff.Quo(arm.TargetUtilization)
+}
+
+// NextRateAtTarget returns the rate at target after utilization has been held for secondsElapsed.
+// The rate moves by the adjustment speed per year, scaled by the distance of utilization from the target,
+// and is kept between the min and max rates at target.
+func (arm AdaptiveRateModel) NextRateAtTarget(rateAtTarget, utilization sdk.Dec, secondsElapsed int64) sdk.Dec {
+	change := arm.AdjustmentSpeed.Mul(arm.utilizationError(utilization)).MulInt64(secondsElapsed).QuoInt64(secondsPerYear)
+	next := rateAtTarget.Mul(sdk.OneDec().Add(change))
+	if next.LT(arm.MinRateAtTarget) {
+		return arm.MinRateAtTarget
+	}
+	if next.GT(arm.MaxRateAtTarget) {
+		return arm.MaxRateAtTarget
+	}
+	return next
+}
+
+// Curve returns the model's rate curve at a rate at target
+func (arm AdaptiveRateModel) Curve(rateAtTarget sdk.Dec) AdaptiveRateCurve {
+	return AdaptiveRateCurve{
+		Model:        arm,
+		RateAtTarget: rateAtTarget,
+	}
+}
+
+// AdaptiveRateCurve is the curve of an adaptive rate model at its current rate at target
+type AdaptiveRateCurve struct {
+	Model        AdaptiveRateModel
+	RateAtTarget sdk.Dec
+}
+
+// GetRateModelType returns the rate model type
+func (arc AdaptiveRateCurve) GetRateModelType() RateModelType {
+	return RATE_MODEL_TYPE_ADAPTIVE
+}
+
+// BorrowRate returns the borrow APY at a utilization. It is the rate at target at the target utilization,
+// falling linearly to the rate at target divided by the curve steepness at 0% utilization, and rising
+// linearly to the rate at target multiplied by the curve steepness at 100% utilization.
+func (arc AdaptiveRateCurve) BorrowRate(utilization sdk.Dec) sdk.Dec {
+	utilizationError := arc.Model.utilizationError(utilization)
+	coefficient := arc.Model.CurveSteepness.Sub(sdk.OneDec())
+	if utilizationError.IsNegative() {
+		coefficient = sdk.OneDec().Sub(sdk.OneDec().Quo(arc.Model.CurveSteepness))
+	}
+	return arc.RateAtTarget.Mul(sdk.OneDec().Add(coefficient.Mul(utilizationError)))
+}
+
+// NewAdaptiveRate returns a new AdaptiveRate
+func NewAdaptiveRate(denom string, rateAtTarget sdk.Dec) AdaptiveRate {
+	return AdaptiveRate{
+		Denom:        denom,
+		RateAtTarget: rateAtTarget,
+	}
+}
+
+// Validate performs validation of AdaptiveRate
+func (ar AdaptiveRate) Validate() error {
+	if err := sdk.ValidateDenom(ar.Denom); err != nil {
+		return err
+	}
+	if ar.RateAtTarget.IsNil() || ar.RateAtTarget.IsNegative() {
+		return fmt.Errorf("rate at target must not be negative, is %s for %s", ar.RateAtTarget, ar.Denom)
+	}
+	return nil
+}
+
+// AdaptiveRates is a slice of AdaptiveRate
+type AdaptiveRates []AdaptiveRate
+
+// Validate performs validation of AdaptiveRates
+func (ars AdaptiveRates) Validate() error {
+	denoms := make(map[string]bool)
+	for _, ar := range ars {
+		if err := ar.Validate(); err != nil {
+			return err
+		}
+		if denoms[ar.Denom] {
+			return fmt.Errorf("duplicate adaptive rate denom: %s", ar.Denom)
+		}
+		denoms[ar.Denom] = true
+	}
+	return nil
+}
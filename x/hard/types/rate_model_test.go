@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mokitanetwork/aether/x/hard/types"
+)
+
+func TestAdaptiveRateCurve_BorrowRate(t *testing.T) {
+	curve := adaptiveRateModel(sdk.MustNewDecFromStr("0.04")).Curve(sdk.MustNewDecFromStr("0.04"))
+
+	testCases := []struct {
+		name        string
+		utilization sdk.Dec
+		expected    sdk.Dec
+	}{
+		{"zero utilization", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.01")},
+		{"half of target", sdk.MustNewDecFromStr("0.45"), sdk.MustNewDecFromStr("0.025")},
+		{"target", sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.04")},
+		{"halfway over target", sdk.MustNewDecFromStr("0.95"), sdk.MustNewDecFromStr("0.1")},
+		{"full utilization", sdk.OneDec(), sdk.MustNewDecFromStr("0.16")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, curve.BorrowRate(tc.utilization))
+		})
+	}
+}
+
+func TestAdaptiveRateModel_NextRateAtTarget(t *testing.T) {
+	model := adaptiveRateModel(sdk.MustNewDecFromStr("0.04"))
+	rateAtTarget := sdk.MustNewDecFromStr("0.04")
+	oneDay := int64(24 * 60 * 60)
+
+	testCases := []struct {
+		name        string
+		utilization sdk.Dec
+		seconds     int64
+		expected    sdk.Dec
+	}{
+		{"at target", sdk.MustNewDecFromStr("0.9"), oneDay, rateAtTarget},
+		{"no time elapsed", sdk.OneDec(), 0, rateAtTarget},
+		// 50 per year x 1/365 years at full utilization
+		{"over target", sdk.OneDec(), oneDay, sdk.MustNewDecFromStr("0.045479452054794521")},
+		{"under target", sdk.ZeroDec(), oneDay, sdk.MustNewDecFromStr("0.034520547945205479")},
+		{"capped at max", sdk.OneDec(), 365 * oneDay, model.MaxRateAtTarget},
+		{"floored at min", sdk.ZeroDec(), 365 * oneDay, model.MinRateAtTarget},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, model.NextRateAtTarget(rateAtTarget, tc.utilization, tc.seconds))
+		})
+	}
+}
+
+func TestFixedRateModel_BorrowRate(t *testing.T) {
+	model := types.NewFixedRateModel(sdk.MustNewDecFromStr("0.03"))
+	require.Equal(t, sdk.MustNewDecFromStr("0.03"), model.BorrowRate(sdk.ZeroDec()))
+	require.Equal(t, sdk.MustNewDecFromStr("0.03"), model.BorrowRate(sdk.OneDec()))
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -41,7 +42,7 @@ func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
 func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgDeposit) ProtoMessage()    {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72cf8eb667c23b8a, []int{0}
+	return fileDescriptor_fd921299669fa075, []int{0}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -92,7 +93,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72cf8eb667c23b8a, []int{1}
+	return fileDescriptor_fd921299669fa075, []int{1}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -131,7 +132,7 @@ func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72cf8eb667c23b8a, []int{2}
+	return fileDescriptor_fd921299669fa075, []int{2}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +183,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72cf8eb667c23b8a, []int{3}
+	return fileDescriptor_fd921299669fa075, []int{3}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -221,7 +222,7 @@ func (m *MsgBorrow) Reset()         { *m = MsgBorrow{} }
 func (m *MsgBorrow) String() string { return proto.CompactTextString(m) }
 func (*MsgBorrow) ProtoMessage()    {}
 func (*MsgBorrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72cf8eb667c23b8a, []int{4}
+	return fileDescriptor_fd921299669fa075, []int{4}
 }
 func (m *MsgBorrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +273,7 @@ func (m *MsgBorrowResponse) Reset()         { *m = MsgBorrowResponse{} }
 func (m *MsgBorrowResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBorrowResponse) ProtoMessage()    {}
 func (*MsgBorrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72cf8eb667c23b8a, []int{5}
+	return fileDescriptor_fd921299669fa075, []int{5}
 }
 func (m *MsgBorrowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -312,7 +313,7 @@ func (m *MsgRepay) Reset()         { *m = MsgRepay{} }
 func (m *MsgRepay) String() string { return proto.CompactTextString(m) }
 func (*MsgRepay) ProtoMessage()    {}
 func (*MsgRepay) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72cf8eb667c23b8a, []int{6}
+	return fileDescriptor_fd921299669fa075, []int{6}
 }
 func (m *MsgRepay) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,7 +371,7 @@ func (m *MsgRepayResponse) Reset()         { *m = MsgRepayResponse{} }
 func (m *MsgRepayResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRepayResponse) ProtoMessage()    {}
 func (*MsgRepayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72cf8eb667c23b8a, []int{7}
+	return fileDescriptor_fd921299669fa075, []int{7}
 }
 func (m *MsgRepayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +410,7 @@ func (m *MsgLiquidate) Reset()         { *m = MsgLiquidate{} }
 func (m *MsgLiquidate) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidate) ProtoMessage()    {}
 func (*MsgLiquidate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72cf8eb667c23b8a, []int{8}
+	return fileDescriptor_fd921299669fa075, []int{8}
 }
 func (m *MsgLiquidate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +461,7 @@ func (m *MsgLiquidateResponse) Reset()         { *m = MsgLiquidateResponse{} }
 func (m *MsgLiquidateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidateResponse) ProtoMessage()    {}
 func (*MsgLiquidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_72cf8eb667c23b8a, []int{9}
+	return fileDescriptor_fd921299669fa075, []int{9}
 }
 func (m *MsgLiquidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,6 +490,331 @@ func (m *MsgLiquidateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLiquidateResponse proto.InternalMessageInfo
 
+// MsgLiquidatePartial defines the Msg/LiquidatePartial request type.
+type MsgLiquidatePartial struct {
+	Keeper   string `protobuf:"bytes,1,opt,name=keeper,proto3" json:"keeper,omitempty"`
+	Borrower string `protobuf:"bytes,2,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	// repay is the largest amount of the borrow to repay, capped by the borrow's close factor
+	Repay types.Coin `protobuf:"bytes,3,opt,name=repay,proto3" json:"repay"`
+	// collateral_denom is the denom of the borrower's deposit to receive
+	CollateralDenom string `protobuf:"bytes,4,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+}
+
+func (m *MsgLiquidatePartial) Reset()         { *m = MsgLiquidatePartial{} }
+func (m *MsgLiquidatePartial) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidatePartial) ProtoMessage()    {}
+func (*MsgLiquidatePartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd921299669fa075, []int{10}
+}
+func (m *MsgLiquidatePartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidatePartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidatePartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiquidatePartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidatePartial.Merge(m, src)
+}
+func (m *MsgLiquidatePartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidatePartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidatePartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiquidatePartial proto.InternalMessageInfo
+
+func (m *MsgLiquidatePartial) GetKeeper() string {
+	if m != nil {
+		return m.Keeper
+	}
+	return ""
+}
+
+func (m *MsgLiquidatePartial) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *MsgLiquidatePartial) GetRepay() types.Coin {
+	if m != nil {
+		return m.Repay
+	}
+	return types.Coin{}
+}
+
+func (m *MsgLiquidatePartial) GetCollateralDenom() string {
+	if m != nil {
+		return m.CollateralDenom
+	}
+	return ""
+}
+
+// MsgLiquidatePartialResponse defines the Msg/LiquidatePartial response type.
+type MsgLiquidatePartialResponse struct {
+	Repaid types.Coin `protobuf:"bytes,1,opt,name=repaid,proto3" json:"repaid"`
+	Seized types.Coin `protobuf:"bytes,2,opt,name=seized,proto3" json:"seized"`
+}
+
+func (m *MsgLiquidatePartialResponse) Reset()         { *m = MsgLiquidatePartialResponse{} }
+func (m *MsgLiquidatePartialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidatePartialResponse) ProtoMessage()    {}
+func (*MsgLiquidatePartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd921299669fa075, []int{11}
+}
+func (m *MsgLiquidatePartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidatePartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidatePartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiquidatePartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidatePartialResponse.Merge(m, src)
+}
+func (m *MsgLiquidatePartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidatePartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidatePartialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiquidatePartialResponse proto.InternalMessageInfo
+
+func (m *MsgLiquidatePartialResponse) GetRepaid() types.Coin {
+	if m != nil {
+		return m.Repaid
+	}
+	return types.Coin{}
+}
+
+func (m *MsgLiquidatePartialResponse) GetSeized() types.Coin {
+	if m != nil {
+		return m.Seized
+	}
+	return types.Coin{}
+}
+
+// MsgFlashLoan defines a message to borrow from a money market for the duration of a list of messages.
+// The loaned amount plus the flash loan fee must be held by the sender once the messages have executed.
+type MsgFlashLoan struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount to borrow
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// msgs are executed in order after lending, and must only be signed by the sender
+	Msgs []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *MsgFlashLoan) Reset()         { *m = MsgFlashLoan{} }
+func (m *MsgFlashLoan) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashLoan) ProtoMessage()    {}
+func (*MsgFlashLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd921299669fa075, []int{12}
+}
+func (m *MsgFlashLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashLoan.Merge(m, src)
+}
+func (m *MsgFlashLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashLoan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashLoan proto.InternalMessageInfo
+
+func (m *MsgFlashLoan) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFlashLoan) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgFlashLoan) GetMsgs() []*types1.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// MsgFlashLoanResponse defines the Msg/FlashLoan response type.
+type MsgFlashLoanResponse struct {
+}
+
+func (m *MsgFlashLoanResponse) Reset()         { *m = MsgFlashLoanResponse{} }
+func (m *MsgFlashLoanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashLoanResponse) ProtoMessage()    {}
+func (*MsgFlashLoanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd921299669fa075, []int{13}
+}
+func (m *MsgFlashLoanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashLoanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashLoanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashLoanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashLoanResponse.Merge(m, src)
+}
+func (m *MsgFlashLoanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashLoanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashLoanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashLoanResponse proto.InternalMessageInfo
+
+// MsgSetCollateral defines the Msg/SetCollateral request type.
+type MsgSetCollateral struct {
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// denoms of the money markets to set
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// enabled uses deposits of the denoms as collateral, otherwise they are kept out of loan-to-value and liquidations
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetCollateral) Reset()         { *m = MsgSetCollateral{} }
+func (m *MsgSetCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCollateral) ProtoMessage()    {}
+func (*MsgSetCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd921299669fa075, []int{14}
+}
+func (m *MsgSetCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCollateral.Merge(m, src)
+}
+func (m *MsgSetCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCollateral proto.InternalMessageInfo
+
+func (m *MsgSetCollateral) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *MsgSetCollateral) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *MsgSetCollateral) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSetCollateralResponse defines the Msg/SetCollateral response type.
+type MsgSetCollateralResponse struct {
+}
+
+func (m *MsgSetCollateralResponse) Reset()         { *m = MsgSetCollateralResponse{} }
+func (m *MsgSetCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCollateralResponse) ProtoMessage()    {}
+func (*MsgSetCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd921299669fa075, []int{15}
+}
+func (m *MsgSetCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCollateralResponse.Merge(m, src)
+}
+func (m *MsgSetCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCollateralResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDeposit)(nil), "aeth.hard.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "aeth.hard.v1beta1.MsgDepositResponse")
@@ -500,46 +826,69 @@ func init() {
 	proto.RegisterType((*MsgRepayResponse)(nil), "aeth.hard.v1beta1.MsgRepayResponse")
 	proto.RegisterType((*MsgLiquidate)(nil), "aeth.hard.v1beta1.MsgLiquidate")
 	proto.RegisterType((*MsgLiquidateResponse)(nil), "aeth.hard.v1beta1.MsgLiquidateResponse")
-}
-
-func init() { proto.RegisterFile("aeth/hard/v1beta1/tx.proto", fileDescriptor_72cf8eb667c23b8a) }
-
-var fileDescriptor_72cf8eb667c23b8a = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x8f, 0xd2, 0x4e,
-	0x18, 0xa6, 0x90, 0xe5, 0x07, 0xef, 0xfe, 0x0e, 0xee, 0x2c, 0x1a, 0xb6, 0x6a, 0xd9, 0xa0, 0xae,
-	0x5c, 0x68, 0x77, 0x57, 0xe3, 0x59, 0xd1, 0x8b, 0xc9, 0x36, 0x26, 0x18, 0x63, 0xe2, 0xc5, 0x4c,
-	0xe9, 0x64, 0x68, 0x90, 0x4e, 0xed, 0x3b, 0xc0, 0xee, 0xb7, 0xf0, 0xec, 0x07, 0x30, 0x71, 0xcf,
-	0x7e, 0x88, 0x3d, 0xae, 0x9e, 0x3c, 0xa9, 0x81, 0x2f, 0x62, 0xda, 0x69, 0x07, 0x8c, 0x04, 0xb8,
-	0x68, 0x3c, 0x31, 0xed, 0xf3, 0x87, 0xe7, 0xc9, 0xbc, 0x33, 0x05, 0x73, 0x40, 0xc7, 0xd4, 0xe9,
-	0xd3, 0xd8, 0x77, 0xc6, 0x47, 0x1e, 0x93, 0xf4, 0xc8, 0x91, 0xa7, 0x76, 0x14, 0x0b, 0x29, 0xc8,
-	0x4e, 0x82, 0xd9, 0x09, 0x66, 0x67, 0x98, 0x69, 0xf5, 0x04, 0x0e, 0x05, 0x3a, 0x1e, 0x45, 0xa6,
-	0x05, 0x3d, 0x11, 0x84, 0x4a, 0x62, 0xee, 0x29, 0xfc, 0x75, 0xfa, 0xe4, 0xa8, 0x87, 0x0c, 0xaa,
-	0x71, 0xc1, 0x85, 0x7a, 0x9f, 0xac, 0xd4, 0xdb, 0xe6, 0x47, 0x03, 0xc0, 0x45, 0xfe, 0x84, 0x45,
-	0x02, 0x03, 0x49, 0x1e, 0x40, 0xd5, 0x57, 0x4b, 0x11, 0xd7, 0x8d, 0x7d, 0xa3, 0x55, 0xed, 0xd4,
-	0xbf, 0x7c, 0x6a, 0xd7, 0x32, 0xa7, 0x47, 0xbe, 0x1f, 0x33, 0xc4, 0xe7, 0x32, 0x0e, 0x42, 0xde,
-	0x9d, 0x53, 0x49, 0x0f, 0xca, 0x74, 0x28, 0x46, 0xa1, 0xac, 0x17, 0xf7, 0x4b, 0xad, 0xed, 0xe3,
-	0x3d, 0x3b, 0x53, 0x24, 0x41, 0xf3, 0xf4, 0xf6, 0x63, 0x11, 0x84, 0x9d, 0xc3, 0x8b, 0x6f, 0x8d,
-	0xc2, 0xf9, 0xf7, 0x46, 0x8b, 0x07, 0xb2, 0x3f, 0xf2, 0xec, 0x9e, 0x18, 0x66, 0x41, 0xb3, 0x9f,
-	0x36, 0xfa, 0x03, 0x47, 0x9e, 0x45, 0x0c, 0x53, 0x01, 0x76, 0x33, 0xeb, 0x66, 0x0d, 0xc8, 0x3c,
-	0x6a, 0x97, 0x61, 0x24, 0x42, 0x64, 0xcd, 0x73, 0x03, 0xb6, 0x5d, 0xe4, 0x2f, 0x03, 0xd9, 0xf7,
-	0x63, 0x3a, 0xf9, 0xb7, 0x2b, 0x5c, 0x85, 0xdd, 0x85, 0xac, 0xba, 0xc3, 0x07, 0x03, 0xaa, 0x2e,
-	0xf2, 0x8e, 0x88, 0x63, 0x31, 0x21, 0xf7, 0xa1, 0xe2, 0xa5, 0x2b, 0xb6, 0xbe, 0x80, 0x66, 0xfe,
-	0x9d, 0xfc, 0xbb, 0xb0, 0xa3, 0x73, 0xea, 0xf4, 0x9f, 0x0d, 0xa8, 0xb8, 0xc8, 0xbb, 0x2c, 0xa2,
-	0x67, 0xe4, 0x10, 0xca, 0xc8, 0x42, 0x7f, 0x83, 0xe8, 0x19, 0x8f, 0xd8, 0xb0, 0x25, 0x26, 0x21,
-	0x8b, 0xeb, 0xc5, 0x35, 0x02, 0x45, 0x5b, 0x28, 0x5a, 0xfa, 0x73, 0x45, 0x09, 0x5c, 0xc9, 0x2b,
-	0xe9, 0x9e, 0x63, 0xf8, 0xdf, 0x45, 0x7e, 0x12, 0xbc, 0x1d, 0x05, 0x3e, 0x95, 0x2c, 0xa9, 0x3a,
-	0x60, 0x2c, 0xda, 0xa4, 0xaa, 0xe2, 0xfd, 0xb2, 0xb3, 0xc5, 0x4d, 0x77, 0xb6, 0x79, 0x0d, 0x6a,
-	0x8b, 0xff, 0x9b, 0xe7, 0x39, 0x7e, 0x5f, 0x82, 0x92, 0x8b, 0x9c, 0x3c, 0x83, 0xff, 0xf2, 0xf3,
-	0x7b, 0xd3, 0xfe, 0xed, 0xce, 0xb0, 0xe7, 0x67, 0xc6, 0xbc, 0xb3, 0x12, 0xce, 0x8d, 0x49, 0x17,
-	0x2a, 0xfa, 0x38, 0x59, 0xcb, 0x25, 0x39, 0x6e, 0x1e, 0xac, 0xc6, 0xb5, 0xe7, 0x09, 0x94, 0xb3,
-	0xf1, 0xbe, 0xb1, 0x5c, 0xa1, 0x50, 0xf3, 0xf6, 0x2a, 0x54, 0xbb, 0x3d, 0x85, 0x2d, 0x35, 0x6e,
-	0xd7, 0x97, 0xd3, 0x53, 0xd0, 0xbc, 0xb5, 0x02, 0xd4, 0x56, 0x2f, 0xa0, 0x3a, 0xdf, 0xd2, 0xc6,
-	0x72, 0x85, 0x26, 0x98, 0x77, 0xd7, 0x10, 0x72, 0xdb, 0xce, 0xc3, 0x8b, 0xa9, 0x65, 0x5c, 0x4e,
-	0x2d, 0xe3, 0xc7, 0xd4, 0x32, 0xde, 0xcd, 0xac, 0xc2, 0xe5, 0xcc, 0x2a, 0x7c, 0x9d, 0x59, 0x85,
-	0x57, 0x07, 0x0b, 0xc3, 0x98, 0x98, 0xb5, 0xdf, 0x50, 0x0f, 0xd3, 0x95, 0x73, 0xaa, 0xbe, 0x04,
-	0xe9, 0x40, 0x7a, 0xe5, 0xf4, 0x86, 0xbe, 0xf7, 0x33, 0x00, 0x00, 0xff, 0xff, 0x91, 0x63, 0x35,
-	0x65, 0x23, 0x06, 0x00, 0x00,
+	proto.RegisterType((*MsgLiquidatePartial)(nil), "aeth.hard.v1beta1.MsgLiquidatePartial")
+	proto.RegisterType((*MsgLiquidatePartialResponse)(nil), "aeth.hard.v1beta1.MsgLiquidatePartialResponse")
+	proto.RegisterType((*MsgFlashLoan)(nil), "aeth.hard.v1beta1.MsgFlashLoan")
+	proto.RegisterType((*MsgFlashLoanResponse)(nil), "aeth.hard.v1beta1.MsgFlashLoanResponse")
+	proto.RegisterType((*MsgSetCollateral)(nil), "aeth.hard.v1beta1.MsgSetCollateral")
+	proto.RegisterType((*MsgSetCollateralResponse)(nil), "aeth.hard.v1beta1.MsgSetCollateralResponse")
+}
+
+func init() { proto.RegisterFile("aeth/hard/v1beta1/tx.proto", fileDescriptor_fd921299669fa075) }
+
+var fileDescriptor_fd921299669fa075 = []byte{
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x13, 0x12, 0x92, 0xc7, 0xae, 0x16, 0x4c, 0x16, 0x19, 0xb3, 0x6b, 0x50, 0x76, 0x0b,
+	0x54, 0x2d, 0x36, 0xd0, 0x52, 0xce, 0x04, 0x84, 0x54, 0x89, 0xa8, 0x95, 0x51, 0x55, 0xa9, 0x17,
+	0x34, 0x89, 0xa7, 0x8e, 0x9b, 0xc4, 0x93, 0x7a, 0x26, 0x84, 0x54, 0xfd, 0x0f, 0xed, 0xaf, 0xa8,
+	0x54, 0x4e, 0x3d, 0xf0, 0x03, 0x7a, 0x44, 0x3d, 0xd1, 0x9e, 0x7a, 0x2a, 0x15, 0xfc, 0x91, 0xca,
+	0x1e, 0x7b, 0x12, 0x4a, 0x48, 0x2c, 0xa4, 0xa2, 0x9e, 0xe2, 0xf1, 0xf7, 0x7d, 0x6f, 0xde, 0x37,
+	0xf3, 0xfc, 0x5e, 0x40, 0x45, 0x98, 0x55, 0x8d, 0x2a, 0xf2, 0x2c, 0x63, 0x7f, 0xa5, 0x8c, 0x19,
+	0x5a, 0x31, 0xd8, 0x81, 0xde, 0xf4, 0x08, 0x23, 0xf2, 0x84, 0x8f, 0xe9, 0x3e, 0xa6, 0x87, 0x98,
+	0xaa, 0x55, 0x08, 0x6d, 0x10, 0x6a, 0x94, 0x11, 0xc5, 0x42, 0x50, 0x21, 0x8e, 0xcb, 0x25, 0xea,
+	0x34, 0xc7, 0xf7, 0x82, 0x95, 0xc1, 0x17, 0x21, 0x94, 0xb7, 0x89, 0x4d, 0xf8, 0x7b, 0xff, 0x29,
+	0x12, 0xd8, 0x84, 0xd8, 0x75, 0x6c, 0x04, 0xab, 0x72, 0xeb, 0xb9, 0x81, 0xdc, 0x0e, 0x87, 0x0a,
+	0xef, 0x25, 0x80, 0x12, 0xb5, 0xb7, 0x70, 0x93, 0x50, 0x87, 0xc9, 0x0f, 0x20, 0x67, 0xf1, 0x47,
+	0xe2, 0x29, 0xd2, 0x9c, 0xb4, 0x98, 0x2b, 0x2a, 0x5f, 0x8e, 0x96, 0xf2, 0xe1, 0x26, 0x1b, 0x96,
+	0xe5, 0x61, 0x4a, 0x77, 0x99, 0xe7, 0xb8, 0xb6, 0xd9, 0xa5, 0xca, 0x15, 0xc8, 0xa0, 0x06, 0x69,
+	0xb9, 0x4c, 0x49, 0xce, 0xa5, 0x16, 0xc7, 0x56, 0xa7, 0xf5, 0x50, 0xe1, 0x7b, 0x88, 0x8c, 0xe9,
+	0x9b, 0xc4, 0x71, 0x8b, 0xcb, 0xc7, 0xdf, 0x66, 0x13, 0x87, 0xa7, 0xb3, 0x8b, 0xb6, 0xc3, 0xaa,
+	0xad, 0xb2, 0x5e, 0x21, 0x8d, 0xd0, 0x43, 0xf8, 0xb3, 0x44, 0xad, 0x9a, 0xc1, 0x3a, 0x4d, 0x4c,
+	0x03, 0x01, 0x35, 0xc3, 0xd0, 0x85, 0x3c, 0xc8, 0xdd, 0x54, 0x4d, 0x4c, 0x9b, 0xc4, 0xa5, 0xb8,
+	0x70, 0x28, 0xc1, 0x58, 0x89, 0xda, 0x4f, 0x1d, 0x56, 0xb5, 0x3c, 0xd4, 0xfe, 0xbd, 0x2d, 0xfc,
+	0x0d, 0x93, 0x3d, 0xb9, 0x0a, 0x0f, 0xef, 0x24, 0xc8, 0x95, 0xa8, 0x5d, 0x24, 0x9e, 0x47, 0xda,
+	0xf2, 0x7d, 0xc8, 0x96, 0x83, 0x27, 0x3c, 0xdc, 0x80, 0x60, 0xde, 0x4c, 0xfe, 0x93, 0x30, 0x21,
+	0xf2, 0x14, 0xd9, 0x7f, 0x96, 0x20, 0x5b, 0xa2, 0xb6, 0x89, 0x9b, 0xa8, 0x23, 0x2f, 0x43, 0x86,
+	0x62, 0xd7, 0x8a, 0x91, 0x7a, 0xc8, 0x93, 0x75, 0x48, 0x93, 0xb6, 0x8b, 0x3d, 0x25, 0x39, 0x44,
+	0xc0, 0x69, 0x3d, 0x46, 0x53, 0xbf, 0xce, 0xa8, 0x0c, 0xe3, 0x91, 0x25, 0xe1, 0x73, 0x1f, 0xfe,
+	0x28, 0x51, 0x7b, 0xc7, 0x79, 0xd9, 0x72, 0x2c, 0xc4, 0xb0, 0x6f, 0xb5, 0x86, 0x71, 0x33, 0x8e,
+	0x55, 0xce, 0xbb, 0x70, 0xb3, 0xc9, 0xb8, 0x37, 0x5b, 0x98, 0x82, 0x7c, 0xef, 0xbe, 0x22, 0x9f,
+	0x53, 0x09, 0x26, 0x7b, 0x81, 0xc7, 0xc8, 0x63, 0x0e, 0xaa, 0xdf, 0x54, 0x5e, 0xf2, 0x1a, 0xa4,
+	0x3d, 0xff, 0x80, 0x94, 0xd4, 0x9c, 0x34, 0xf8, 0x1e, 0x46, 0xfc, 0x7b, 0x30, 0x39, 0x5b, 0xbe,
+	0x0d, 0xe3, 0x15, 0x52, 0xaf, 0x23, 0x86, 0x3d, 0x54, 0xdf, 0xb3, 0xb0, 0x4b, 0x1a, 0xca, 0x88,
+	0xbf, 0xa9, 0xf9, 0x57, 0xf7, 0xfd, 0x96, 0xff, 0xba, 0xf0, 0x46, 0x82, 0x99, 0x3e, 0x0e, 0xa3,
+	0x13, 0x90, 0xd7, 0x21, 0xe3, 0xc7, 0x74, 0x2c, 0x45, 0x8a, 0x97, 0x42, 0x48, 0xf7, 0x85, 0x14,
+	0x3b, 0xaf, 0xb0, 0xa5, 0x24, 0x63, 0x0a, 0x39, 0xbd, 0xf0, 0x41, 0x0a, 0x8a, 0x60, 0xbb, 0x8e,
+	0x68, 0x75, 0x87, 0x20, 0xf7, 0x1a, 0xf5, 0xbe, 0xde, 0xf3, 0xa1, 0xc6, 0xdb, 0x9b, 0xd3, 0xe5,
+	0x35, 0x18, 0x69, 0x50, 0x9b, 0x86, 0x65, 0x9f, 0xd7, 0x79, 0x57, 0xd7, 0xa3, 0xae, 0xae, 0x6f,
+	0xb8, 0x9d, 0xe2, 0xd8, 0xa7, 0xa3, 0xa5, 0x51, 0x6a, 0xd5, 0x74, 0xbf, 0x7a, 0x03, 0x7a, 0x58,
+	0x3e, 0x22, 0x63, 0x51, 0x3e, 0xaf, 0x83, 0x12, 0xdf, 0xc5, 0x6c, 0x53, 0x9c, 0xfa, 0xb5, 0x9b,
+	0xe7, 0x14, 0x64, 0x82, 0x8b, 0xa4, 0x41, 0xf3, 0xc9, 0x99, 0xe1, 0x4a, 0x56, 0x60, 0x14, 0xbb,
+	0xa8, 0x5c, 0xc7, 0x56, 0x50, 0x24, 0x59, 0x33, 0x5a, 0x16, 0x54, 0x50, 0x7e, 0xde, 0x3d, 0xca,
+	0x6c, 0xf5, 0x63, 0x1a, 0x52, 0x25, 0x6a, 0xcb, 0x8f, 0x60, 0x34, 0x1a, 0x4c, 0xff, 0xea, 0x97,
+	0xe6, 0xa4, 0xde, 0x1d, 0x06, 0xea, 0xad, 0x81, 0xb0, 0xa8, 0x17, 0x13, 0xb2, 0x62, 0x4e, 0x68,
+	0xfd, 0x25, 0x11, 0xae, 0xce, 0x0f, 0xc6, 0x45, 0xcc, 0x1d, 0xc8, 0x84, 0x7d, 0xfb, 0x9f, 0xfe,
+	0x0a, 0x8e, 0xaa, 0xff, 0x0f, 0x42, 0x45, 0xb4, 0x87, 0x90, 0xe6, 0x7d, 0x74, 0xa6, 0x3f, 0x3d,
+	0x00, 0xd5, 0xff, 0x06, 0x80, 0x22, 0xd4, 0x13, 0xc8, 0x75, 0x7b, 0xd5, 0x6c, 0x7f, 0x85, 0x20,
+	0xa8, 0x0b, 0x43, 0x08, 0x22, 0xec, 0x0b, 0x18, 0xbf, 0xd4, 0x71, 0xe6, 0x87, 0x88, 0x43, 0x9e,
+	0xaa, 0xc7, 0xe3, 0xf5, 0x5a, 0xe8, 0x7e, 0x69, 0x57, 0x58, 0x10, 0x04, 0x75, 0x61, 0x08, 0x41,
+	0x84, 0x45, 0xf0, 0xe7, 0xc5, 0xb2, 0xbf, 0xe2, 0x3c, 0x2f, 0x90, 0xd4, 0x3b, 0x31, 0x48, 0xd1,
+	0x16, 0xc5, 0xed, 0xe3, 0x33, 0x4d, 0x3a, 0x39, 0xd3, 0xa4, 0xef, 0x67, 0x9a, 0xf4, 0xf6, 0x5c,
+	0x4b, 0x9c, 0x9c, 0x6b, 0x89, 0xaf, 0xe7, 0x5a, 0xe2, 0xd9, 0xdd, 0x9e, 0x59, 0xd4, 0x20, 0x35,
+	0x87, 0x21, 0x17, 0xb3, 0x36, 0xf1, 0x6a, 0x86, 0x1f, 0x1e, 0x7b, 0xc6, 0x01, 0xff, 0xa7, 0x18,
+	0x4c, 0xa5, 0x72, 0x26, 0xf8, 0xba, 0xef, 0xfd, 0x18, 0x00, 0x60, 0x94, 0xe7, 0x23, 0x43, 0x0a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -564,6 +913,14 @@ type MsgClient interface {
 	Repay(ctx context.Context, in *MsgRepay, opts ...grpc.CallOption) (*MsgRepayResponse, error)
 	// Liquidate defines a method for attempting to liquidate a borrower that is over their loan-to-value.
 	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+	// LiquidatePartial defines a method for repaying part of a borrow that is over its loan-to-value in exchange for
+	// discounted deposits of the borrower.
+	LiquidatePartial(ctx context.Context, in *MsgLiquidatePartial, opts ...grpc.CallOption) (*MsgLiquidatePartialResponse, error)
+	// FlashLoan defines a method to borrow from a money market, execute messages with it, and repay it with a fee in the
+	// same message.
+	FlashLoan(ctx context.Context, in *MsgFlashLoan, opts ...grpc.CallOption) (*MsgFlashLoanResponse, error)
+	// SetCollateral defines a method for choosing whether deposits of some denoms back the depositor's borrows.
+	SetCollateral(ctx context.Context, in *MsgSetCollateral, opts ...grpc.CallOption) (*MsgSetCollateralResponse, error)
 }
 
 type msgClient struct {
@@ -619,6 +976,33 @@ func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) LiquidatePartial(ctx context.Context, in *MsgLiquidatePartial, opts ...grpc.CallOption) (*MsgLiquidatePartialResponse, error) {
+	out := new(MsgLiquidatePartialResponse)
+	err := c.cc.Invoke(ctx, "/aeth.hard.v1beta1.Msg/LiquidatePartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FlashLoan(ctx context.Context, in *MsgFlashLoan, opts ...grpc.CallOption) (*MsgFlashLoanResponse, error) {
+	out := new(MsgFlashLoanResponse)
+	err := c.cc.Invoke(ctx, "/aeth.hard.v1beta1.Msg/FlashLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCollateral(ctx context.Context, in *MsgSetCollateral, opts ...grpc.CallOption) (*MsgSetCollateralResponse, error) {
+	out := new(MsgSetCollateralResponse)
+	err := c.cc.Invoke(ctx, "/aeth.hard.v1beta1.Msg/SetCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Deposit defines a method for depositing funds to hard liquidity pool.
@@ -631,6 +1015,14 @@ type MsgServer interface {
 	Repay(context.Context, *MsgRepay) (*MsgRepayResponse, error)
 	// Liquidate defines a method for attempting to liquidate a borrower that is over their loan-to-value.
 	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+	// LiquidatePartial defines a method for repaying part of a borrow that is over its loan-to-value in exchange for
+	// discounted deposits of the borrower.
+	LiquidatePartial(context.Context, *MsgLiquidatePartial) (*MsgLiquidatePartialResponse, error)
+	// FlashLoan defines a method to borrow from a money market, execute messages with it, and repay it with a fee in the
+	// same message.
+	FlashLoan(context.Context, *MsgFlashLoan) (*MsgFlashLoanResponse, error)
+	// SetCollateral defines a method for choosing whether deposits of some denoms back the depositor's borrows.
+	SetCollateral(context.Context, *MsgSetCollateral) (*MsgSetCollateralResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -652,6 +1044,15 @@ func (*UnimplementedMsgServer) Repay(ctx context.Context, req *MsgRepay) (*MsgRe
 func (*UnimplementedMsgServer) Liquidate(ctx context.Context, req *MsgLiquidate) (*MsgLiquidateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
 }
+func (*UnimplementedMsgServer) LiquidatePartial(ctx context.Context, req *MsgLiquidatePartial) (*MsgLiquidatePartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidatePartial not implemented")
+}
+func (*UnimplementedMsgServer) FlashLoan(ctx context.Context, req *MsgFlashLoan) (*MsgFlashLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlashLoan not implemented")
+}
+func (*UnimplementedMsgServer) SetCollateral(ctx context.Context, req *MsgSetCollateral) (*MsgSetCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollateral not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -747,6 +1148,60 @@ func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LiquidatePartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidatePartial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidatePartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.hard.v1beta1.Msg/LiquidatePartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidatePartial(ctx, req.(*MsgLiquidatePartial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FlashLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlashLoan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlashLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.hard.v1beta1.Msg/FlashLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlashLoan(ctx, req.(*MsgFlashLoan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.hard.v1beta1.Msg/SetCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCollateral(ctx, req.(*MsgSetCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "aeth.hard.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -771,6 +1226,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Liquidate",
 			Handler:    _Msg_Liquidate_Handler,
 		},
+		{
+			MethodName: "LiquidatePartial",
+			Handler:    _Msg_LiquidatePartial_Handler,
+		},
+		{
+			MethodName: "FlashLoan",
+			Handler:    _Msg_FlashLoan_Handler,
+		},
+		{
+			MethodName: "SetCollateral",
+			Handler:    _Msg_SetCollateral_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aeth/hard/v1beta1/tx.proto",
@@ -1111,6 +1578,252 @@ func (m *MsgLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgLiquidatePartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidatePartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidatePartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Repay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keeper) > 0 {
+		i -= len(m.Keeper)
+		copy(dAtA[i:], m.Keeper)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Keeper)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidatePartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidatePartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidatePartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Seized.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Repaid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashLoanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashLoanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashLoanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1264,6 +1977,103 @@ func (m *MsgLiquidateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgLiquidatePartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Keeper)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Repay.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiquidatePartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Repaid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Seized.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFlashLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFlashLoanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1280,28 +2090,558 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBorrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1329,9 +2669,9 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1386,7 +2726,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1409,10 +2749,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1436,7 +2776,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1459,15 +2799,15 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1495,13 +2835,13 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Keeper = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1511,25 +2851,23 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1552,7 +2890,7 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1575,10 +2913,10 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1602,7 +2940,7 @@ func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidatePartial) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1625,13 +2963,45 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidatePartial: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidatePartial: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keeper = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
@@ -1663,9 +3033,9 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 			}
 			m.Borrower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1692,11 +3062,42 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1718,7 +3119,7 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidatePartialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1741,12 +3142,78 @@ func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidatePartialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidatePartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Repaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seized", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Seized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1768,7 +3235,7 @@ func (m *MsgBorrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepay) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashLoan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,10 +3258,10 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepay: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashLoan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepay: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashLoan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1831,9 +3298,9 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1843,27 +3310,28 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1890,8 +3358,8 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1916,7 +3384,7 @@ func (m *MsgRepay) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFlashLoanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1939,10 +3407,10 @@ func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFlashLoanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFlashLoanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1966,7 +3434,7 @@ func (m *MsgRepayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1989,15 +3457,15 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keeper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2025,11 +3493,11 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keeper = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2057,8 +3525,28 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2080,7 +3568,7 @@ func (m *MsgLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2103,10 +3591,10 @@ func (m *MsgLiquidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
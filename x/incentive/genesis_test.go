@@ -61,6 +61,9 @@ func (suite *GenesisTestSuite) SetupTest() {
 		hardtypes.DefaultTotalSupplied,
 		hardtypes.DefaultTotalBorrowed,
 		hardtypes.DefaultTotalReserves,
+		hardtypes.DefaultIsolationDebts,
+		hardtypes.DefaultNonCollateralDenoms,
+		hardtypes.DefaultAdaptiveRates,
 	)
 	incentiveGS := types.NewGenesisState(
 		types.NewParams(
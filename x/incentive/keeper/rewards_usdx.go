@@ -58,10 +58,13 @@ func (k Keeper) getUSDXTotalSourceShares(ctx sdk.Context, collateralType string)
 // this function should be called after a cdp is created. If a user previously had a cdp, then closed it, they shouldn't
 // accrue rewards during the period the cdp was closed. By setting the reward factor to the current global reward factor,
 // any unclaimed rewards are preserved, but no new rewards are added.
+// If the owner has other open cdps of the same type, their rewards are synced first as they share the claim's reward index.
 func (k Keeper) InitializeUSDXMintingClaim(ctx sdk.Context, cdp cdptypes.CDP) {
 	claim, found := k.GetUSDXMintingClaim(ctx, cdp.Owner)
 	if !found { // this is the owner's first usdx minting reward claim
 		claim = types.NewUSDXMintingClaim(cdp.Owner, sdk.NewCoin(types.USDXMintingRewardDenom, sdk.ZeroInt()), types.RewardIndexes{})
+	} else if sourceShares := k.getUSDXOwnerSourceShares(ctx, cdp.Owner, cdp.Type, cdp.ID); sourceShares.IsPositive() {
+		claim = k.synchronizeSingleUSDXMintingReward(ctx, claim, cdp.Type, sourceShares)
 	}
 
 	globalRewardFactor, found := k.GetUSDXMintingRewardFactor(ctx, cdp.Type)
@@ -75,6 +78,7 @@ func (k Keeper) InitializeUSDXMintingClaim(ctx sdk.Context, cdp cdptypes.CDP) {
 
 // SynchronizeUSDXMintingReward updates the claim object by adding any accumulated rewards and updating the reward index value.
 // this should be called before a cdp is modified.
+// All the owner's cdps of the same type are synced together, as they share the claim's reward index.
 func (k Keeper) SynchronizeUSDXMintingReward(ctx sdk.Context, cdp cdptypes.CDP) {
 	claim, found := k.GetUSDXMintingClaim(ctx, cdp.Owner)
 	if !found {
@@ -85,12 +89,29 @@ func (k Keeper) SynchronizeUSDXMintingReward(ctx sdk.Context, cdp cdptypes.CDP)
 	if err != nil {
 		panic(fmt.Sprintf("during usdx reward sync, could not get normalized principal for %s: %s", cdp.Owner, err.Error()))
 	}
+	sourceShares = sourceShares.Add(k.getUSDXOwnerSourceShares(ctx, cdp.Owner, cdp.Type, cdp.ID))
 
 	claim = k.synchronizeSingleUSDXMintingReward(ctx, claim, cdp.Type, sourceShares)
 
 	k.SetUSDXMintingClaim(ctx, claim)
 }
 
+// getUSDXOwnerSourceShares sums the normalized principal of an owner's cdps of a collateral type, skipping the cdp with id excludeID.
+func (k Keeper) getUSDXOwnerSourceShares(ctx sdk.Context, owner sdk.AccAddress, collateralType string, excludeID uint64) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, cdp := range k.cdpKeeper.GetCdpsByOwnerAndCollateralType(ctx, owner, collateralType) {
+		if cdp.ID == excludeID {
+			continue
+		}
+		shares, err := cdp.GetNormalizedPrincipal()
+		if err != nil {
+			panic(fmt.Sprintf("during usdx reward sync, could not get normalized principal for %s: %s", cdp.Owner, err.Error()))
+		}
+		total = total.Add(shares)
+	}
+	return total
+}
+
 // synchronizeSingleUSDXMintingReward synchronizes a single rewarded cdp collateral type in a usdx minting claim.
 // It returns the claim without setting in the store.
 // The public methods for accessing and modifying claims are preferred over this one. Direct modification of claims is easy to get wrong.
@@ -154,11 +175,11 @@ func (k Keeper) SimulateUSDXMintingSynchronization(ctx sdk.Context, claim types.
 
 		claim.RewardIndexes[index].RewardFactor = globalRewardFactor
 
-		cdp, found := k.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, claim.GetOwner(), ri.CollateralType)
-		if !found {
-			continue
+		totalPrincipal := sdk.ZeroInt()
+		for _, cdp := range k.cdpKeeper.GetCdpsByOwnerAndCollateralType(ctx, claim.GetOwner(), ri.CollateralType) {
+			totalPrincipal = totalPrincipal.Add(cdp.GetTotalPrincipal().Amount)
 		}
-		newRewardsAmount := rewardsAccumulatedFactor.Mul(cdp.GetTotalPrincipal().Amount.ToDec()).RoundInt()
+		newRewardsAmount := rewardsAccumulatedFactor.Mul(totalPrincipal.ToDec()).RoundInt()
 		if newRewardsAmount.IsZero() {
 			continue
 		}
@@ -173,12 +194,13 @@ func (k Keeper) SimulateUSDXMintingSynchronization(ctx sdk.Context, claim types.
 // Returns the updated claim object
 func (k Keeper) SynchronizeUSDXMintingClaim(ctx sdk.Context, claim types.USDXMintingClaim) (types.USDXMintingClaim, error) {
 	for _, ri := range claim.RewardIndexes {
-		cdp, found := k.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, claim.Owner, ri.CollateralType)
-		if !found {
-			// if the cdp for this collateral type has been closed, no updates are needed
+		cdps := k.cdpKeeper.GetCdpsByOwnerAndCollateralType(ctx, claim.Owner, ri.CollateralType)
+		if len(cdps) == 0 {
+			// if all cdps for this collateral type have been closed, no updates are needed
 			continue
 		}
-		claim = k.synchronizeRewardAndReturnClaim(ctx, cdp)
+		// syncing one cdp syncs all the owner's cdps of the same type
+		claim = k.synchronizeRewardAndReturnClaim(ctx, cdps[0])
 	}
 	return claim, nil
 }
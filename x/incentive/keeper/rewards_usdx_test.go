@@ -149,6 +149,55 @@ func (suite *USDXIntegrationTests) TestSingleUserAccumulatesRewardsWithoutSyncin
 	suite.BalanceInEpsilon(user, cs(c(cdptypes.DefaultStableDenom, 1e8), c(types.USDXMintingRewardDenom, 3*1e6*1e6)), accuracy)
 }
 
+func (suite *USDXIntegrationTests) TestSingleUserWithMultipleCDPsAccumulatesRewardsForAll() {
+	user := suite.addrs[0]
+	initialCollateral := c("bnb", 2e9)
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleModuleAccount(aethdisttypes.ModuleName, cs(c(types.USDXMintingRewardDenom, 1e18))). // Fill aethdist with enough coins to pay out any reward
+		WithSimpleAccount(user, cs(initialCollateral))
+
+	collateralType := "bnb-a"
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithMultipliers(types.MultipliersPerDenoms{{
+			Denom:       types.USDXMintingRewardDenom,
+			Multipliers: types.Multipliers{types.NewMultiplier("large", 12, d("1.0"))}, // keep payout at 1.0 to make maths easier
+		}}).
+		WithSimpleUSDXRewardPeriod(collateralType, c(types.USDXMintingRewardDenom, 1e6))
+
+	suite.SetApp()
+	suite.WithGenesisTime(suite.genesisTime)
+	suite.StartChain(
+		authBuilder.BuildMarshalled(suite.App.AppCodec()),
+		NewPricefeedGenStateMultiFromTime(suite.App.AppCodec(), suite.genesisTime),
+		NewCDPGenStateMulti(suite.App.AppCodec()),
+		incentBuilder.BuildMarshalled(suite.App.AppCodec()),
+	)
+
+	suite.NoError(
+		suite.DeliverMsgCreateCDP(user, c("bnb", 1e9), c("usdx", 1e8), collateralType),
+	)
+	suite.NextBlockAfter(1e6 * time.Second) // about 12 days
+
+	// Opening a second cdp of the same type must not drop the rewards accrued by the first
+	suite.NoError(
+		suite.DeliverMsgCreateCDP(user, c("bnb", 1e9), c("usdx", 1e8), collateralType),
+	)
+	suite.Len(suite.App.GetCDPKeeper().GetCdpsByOwnerAndCollateralType(suite.Ctx, user, collateralType), 2)
+	suite.NextBlockAfter(1e6 * time.Second)
+	suite.NextBlockAfter(1e6 * time.Second)
+
+	msg := types.NewMsgClaimUSDXMintingReward(user.String(), "large")
+	suite.NoError(suite.DeliverIncentiveMsg(&msg))
+
+	// The user has always had 100% of cdp debt across their cdps, so they should receive all rewards for the previous three blocks.
+	// Interest is rounded per cdp but accrued on the total principal, so the user's share is off by a rounding error.
+	accuracy := 1e-8
+	suite.BalanceInEpsilon(user, cs(c(cdptypes.DefaultStableDenom, 2e8), c(types.USDXMintingRewardDenom, 3*1e6*1e6)), accuracy)
+}
+
 func (suite *USDXIntegrationTests) TestReinstatingRewardParamsDoesNotTriggerOverPayments() {
 	userA := suite.addrs[0]
 	userB := suite.addrs[1]
@@ -13,6 +13,13 @@ import (
 // usdxRewardsUnitTester contains common methods for running unit tests for keeper methods related to the USDX minting rewards
 type usdxRewardsUnitTester struct {
 	unitTester
+	cdpKeeper *fakeCDPKeeper
+}
+
+func (suite *usdxRewardsUnitTester) SetupTest() {
+	suite.unitTester.SetupTest()
+	suite.cdpKeeper = newFakeCDPKeeper()
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, suite.cdpKeeper, nil, nil, nil, nil, nil, nil, nil)
 }
 
 func (suite *usdxRewardsUnitTester) storeGlobalUSDXIndexes(indexes types.RewardIndexes) {
@@ -144,6 +151,46 @@ func (suite *SynchronizeUSDXMintingRewardTests) TestRewardIsIncrementedWhenGloba
 	suite.Equal(c(types.USDXMintingRewardDenom, 1e11), syncedClaim.Reward)
 }
 
+func (suite *SynchronizeUSDXMintingRewardTests) TestRewardIncludesOwnersOtherCDPsOfSameType() {
+	collateralType := "bnb-a"
+
+	claim := types.USDXMintingClaim{
+		BaseClaim: types.BaseClaim{
+			Owner:  arbitraryAddress(),
+			Reward: c(types.USDXMintingRewardDenom, 0),
+		},
+		RewardIndexes: types.RewardIndexes{
+			{
+				CollateralType: collateralType,
+				RewardFactor:   d("0.1"),
+			},
+		},
+	}
+	suite.storeClaim(claim)
+
+	globalIndexes := types.RewardIndexes{
+		{
+			CollateralType: collateralType,
+			RewardFactor:   d("0.2"),
+		},
+	}
+	suite.storeGlobalUSDXIndexes(globalIndexes)
+
+	cdp := NewCDPBuilder(claim.Owner, collateralType).WithSourceShares(1e12).Build()
+	cdp.ID = 1
+	otherCDP := NewCDPBuilder(claim.Owner, collateralType).WithSourceShares(3e12).Build()
+	otherCDP.ID = 2
+	otherTypeCDP := NewCDPBuilder(claim.Owner, "busd-b").WithSourceShares(1e12).Build()
+	otherTypeCDP.ID = 3
+	suite.cdpKeeper.addCdp(cdp).addCdp(otherCDP).addCdp(otherTypeCDP)
+
+	suite.keeper.SynchronizeUSDXMintingReward(suite.ctx, cdp)
+
+	syncedClaim, _ := suite.keeper.GetUSDXMintingClaim(suite.ctx, claim.Owner)
+	// reward is ( new index - old index ) * total principal of the owner's cdps of the collateral type
+	suite.Equal(c(types.USDXMintingRewardDenom, 4e11), syncedClaim.Reward)
+}
+
 func (suite *SynchronizeUSDXMintingRewardTests) TestClaimIndexIsUpdatedWhenGlobalIndexIncreased() {
 	claimsRewardIndexes := nonEmptyRewardIndexes
 	collateralType := extractFirstCollateralType(claimsRewardIndexes)
@@ -430,6 +430,7 @@ func (k *fakeStakingKeeper) GetValidatorDelegations(_ sdk.Context, valAddr sdk.V
 type fakeCDPKeeper struct {
 	interestFactor *sdk.Dec
 	totalPrincipal sdk.Int
+	cdps           cdptypes.CDPs
 }
 
 var _ types.CdpKeeper = newFakeCDPKeeper()
@@ -438,6 +439,7 @@ func newFakeCDPKeeper() *fakeCDPKeeper {
 	return &fakeCDPKeeper{
 		interestFactor: nil,
 		totalPrincipal: sdk.ZeroInt(),
+		cdps:           cdptypes.CDPs{},
 	}
 }
 
@@ -451,6 +453,11 @@ func (k *fakeCDPKeeper) addTotalPrincipal(p sdk.Int) *fakeCDPKeeper {
 	return k
 }
 
+func (k *fakeCDPKeeper) addCdp(cdp cdptypes.CDP) *fakeCDPKeeper {
+	k.cdps = append(k.cdps, cdp)
+	return k
+}
+
 func (k *fakeCDPKeeper) GetInterestFactor(_ sdk.Context, collateralType string) (sdk.Dec, bool) {
 	if k.interestFactor != nil {
 		return *k.interestFactor, true
@@ -462,8 +469,14 @@ func (k *fakeCDPKeeper) GetTotalPrincipal(_ sdk.Context, collateralType string,
 	return k.totalPrincipal
 }
 
-func (k *fakeCDPKeeper) GetCdpByOwnerAndCollateralType(_ sdk.Context, owner sdk.AccAddress, collateralType string) (cdptypes.CDP, bool) {
-	return cdptypes.CDP{}, false
+func (k *fakeCDPKeeper) GetCdpsByOwnerAndCollateralType(_ sdk.Context, owner sdk.AccAddress, collateralType string) cdptypes.CDPs {
+	cdps := cdptypes.CDPs{}
+	for _, cdp := range k.cdps {
+		if cdp.Owner.Equals(owner) && cdp.Type == collateralType {
+			cdps = append(cdps, cdp)
+		}
+	}
+	return cdps
 }
 
 func (k *fakeCDPKeeper) GetCollateral(_ sdk.Context, collateralType string) (cdptypes.CollateralParam, bool) {
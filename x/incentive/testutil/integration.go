@@ -285,7 +285,7 @@ func (suite *IntegrationTester) DeliverMsgCreateCDP(owner sdk.AccAddress, collat
 }
 
 func (suite *IntegrationTester) DeliverCDPMsgRepay(owner sdk.AccAddress, collateralType string, payment sdk.Coin) error {
-	msg := cdptypes.NewMsgRepayDebt(owner, collateralType, payment)
+	msg := cdptypes.NewMsgRepayDebt(owner, collateralType, 0, payment)
 	msgServer := cdpkeeper.NewMsgServerImpl(suite.App.GetCDPKeeper())
 
 	_, err := msgServer.RepayDebt(sdk.WrapSDKContext(suite.Ctx), &msg)
@@ -293,7 +293,7 @@ func (suite *IntegrationTester) DeliverCDPMsgRepay(owner sdk.AccAddress, collate
 }
 
 func (suite *IntegrationTester) DeliverCDPMsgBorrow(owner sdk.AccAddress, collateralType string, draw sdk.Coin) error {
-	msg := cdptypes.NewMsgDrawDebt(owner, collateralType, draw)
+	msg := cdptypes.NewMsgDrawDebt(owner, collateralType, 0, draw)
 	msgServer := cdpkeeper.NewMsgServerImpl(suite.App.GetCDPKeeper())
 
 	_, err := msgServer.DrawDebt(sdk.WrapSDKContext(suite.Ctx), &msg)
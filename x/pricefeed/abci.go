@@ -10,8 +10,8 @@ import (
 
 // EndBlocker updates the current pricefeed
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
-	// Update the current price of each asset.
-	for _, market := range k.GetMarkets(ctx) {
+	// Update the current price of each asset. Markets are updated after their source and reference markets.
+	for _, market := range k.GetMarkets(ctx).SortByDependencies() {
 		if !market.Active {
 			continue
 		}
@@ -20,5 +20,17 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
 			panic(err)
 		}
+
+		// Record which oracles missed this price window or posted outliers
+		if err := k.UpdateOracleStats(ctx, market.MarketID); err != nil {
+			panic(err)
+		}
 	}
+
+	// Remove TWAP state of markets that were removed or had TWAP tracking disabled
+	k.ClearDisabledTWAPs(ctx)
+	// Remove stale flags of markets that were removed
+	k.ClearRemovedStaleMarkets(ctx)
+	// Remove stats of oracles that were removed from their market
+	k.ClearRemovedOracleStats(ctx)
 }
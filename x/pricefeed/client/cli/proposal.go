@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/mokitanetwork/aether/x/pricefeed/types"
+)
+
+// GetCmdSubmitClearStaleMarketProposal implements the command to submit a clear stale market proposal
+func GetCmdSubmitClearStaleMarketProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-stale-market [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to clear the stale flag of a pricefeed market",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to clear the stale flag of a pricefeed market along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal clear-stale-market <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Clear BNB:USD",
+  "description": "Oracles have converged on a new BNB price",
+  "market_id": "bnb:usd",
+  "deposit": [
+    {
+      "denom": "uaeth",
+      "amount": "1000000000"
+    }
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseClearStaleMarketProposalJSON(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewClearStaleMarketProposal(proposal.Title, proposal.Description, proposal.MarketID)
+			msg, err := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// ParseClearStaleMarketProposalJSON reads and parses a ClearStaleMarketProposalJSON from a file.
+func ParseClearStaleMarketProposalJSON(cdc codec.JSONCodec, proposalFile string) (types.ClearStaleMarketProposalJSON, error) {
+	proposal := types.ClearStaleMarketProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -25,8 +25,10 @@ func GetQueryCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		GetCmdPrice(),
 		GetCmdQueryPrices(),
+		GetCmdTWAP(),
 		GetCmdRawPrices(),
 		GetCmdOracles(),
+		GetCmdOracleStats(),
 		GetCmdMarkets(),
 		GetCmdQueryParams(),
 	}
@@ -70,6 +72,32 @@ func GetCmdOracles() *cobra.Command {
 	}
 }
 
+// GetCmdOracleStats queries the reliability statistics of the oracles of a market
+func GetCmdOracleStats() *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-stats [marketID]",
+		Short: "get the posts, missed windows and outliers of each oracle for a market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OracleStats(context.Background(), &types.QueryOracleStatsRequest{
+				MarketId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // GetCmdPrice queries the current price of an asset
 func GetCmdPrice() *cobra.Command {
 	return &cobra.Command{
@@ -100,6 +128,32 @@ func GetCmdPrice() *cobra.Command {
 	}
 }
 
+// GetCmdTWAP queries the time-weighted average price of a market
+func GetCmdTWAP() *cobra.Command {
+	return &cobra.Command{
+		Use:   "twap [marketID]",
+		Short: "get the time-weighted average price for the input market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TWAP(context.Background(), &types.QueryTWAPRequest{
+				MarketId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // GetCmdQueryPrices queries the pricefeed module for current prices
 func GetCmdQueryPrices() *cobra.Command {
 	return &cobra.Command{
@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -30,6 +33,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmds := []*cobra.Command{
 		GetCmdPostPrice(),
+		GetCmdPostPrices(),
 	}
 
 	for _, cmd := range cmds {
@@ -60,19 +64,93 @@ func GetCmdPostPrice() *cobra.Command {
 				return err
 			}
 
-			expiryInt, err := strconv.ParseInt(args[2], 10, 64)
+			expiry, err := parseExpiry(args[2])
 			if err != nil {
-				return fmt.Errorf("invalid expiry %s: %w", args[2], err)
+				return err
 			}
 
-			if expiryInt > types.MaxExpiry {
-				return fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+			from := clientCtx.GetFromAddress()
+			msg := types.NewMsgPostPrice(from.String(), args[0], price, expiry)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
 			}
 
-			expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// PriceInputJSON defines a price for a market as read from a postprices JSON file
+type PriceInputJSON struct {
+	MarketID string `json:"market_id"`
+	Price    string `json:"price"`
+	Expiry   string `json:"expiry"`
+}
+
+// GetCmdPostPrices cli command for posting prices for many markets at once.
+func GetCmdPostPrices() *cobra.Command {
+	return &cobra.Command{
+		Use:   "postprices [prices-file]",
+		Short: "post the latest prices for many markets at once from a JSON file, with expiries as UNIX times",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Post the latest prices for many markets at once.
+The prices are posted together: if any of them is rejected none are posted.
+
+Example:
+$ %s tx %s postprices <path/to/prices.json> --from validator
+
+Where prices.json contains:
+
+[
+  {
+    "market_id": "bnb:usd",
+    "price": "25",
+    "expiry": "9999999999"
+  },
+  {
+    "market_id": "btc:usd",
+    "price": "40000",
+    "expiry": "9999999999"
+  }
+]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var inputs []PriceInputJSON
+			if err := json.Unmarshal(contents, &inputs); err != nil {
+				return fmt.Errorf("invalid prices file %s: %w", args[0], err)
+			}
+
+			prices := make(types.PriceInputs, len(inputs))
+			for i, input := range inputs {
+				price, err := sdk.NewDecFromStr(input.Price)
+				if err != nil {
+					return fmt.Errorf("invalid price for market %s: %w", input.MarketID, err)
+				}
+
+				expiry, err := parseExpiry(input.Expiry)
+				if err != nil {
+					return err
+				}
+
+				prices[i] = types.NewPriceInput(input.MarketID, price, expiry)
+			}
 
 			from := clientCtx.GetFromAddress()
-			msg := types.NewMsgPostPrice(from.String(), args[0], price, expiry)
+			msg := types.NewMsgPostPrices(from.String(), prices)
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -81,3 +159,17 @@ func GetCmdPostPrice() *cobra.Command {
 		},
 	}
 }
+
+// parseExpiry parses an expiry given as a UNIX time
+func parseExpiry(s string) (time.Time, error) {
+	expiryInt, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %s: %w", s, err)
+	}
+
+	if expiryInt > types.MaxExpiry {
+		return time.Time{}, fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+	}
+
+	return tmtime.Canonical(time.Unix(expiryInt, 0)), nil
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/mokitanetwork/aether/x/pricefeed/client/cli"
+	"github.com/mokitanetwork/aether/x/pricefeed/client/rest"
+)
+
+// clear stale market proposal handler
+var (
+	ClearStaleMarketProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitClearStaleMarketProposal, rest.ClearStaleMarketProposalRESTHandler)
+)
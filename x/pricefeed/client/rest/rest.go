@@ -4,6 +4,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 )
 
@@ -19,6 +20,30 @@ type PostPriceReq struct {
 	Expiry   string       `json:"expiry"`
 }
 
+// PriceInputReq defines a price for a market in a PostPrices request's body.
+type PriceInputReq struct {
+	MarketID string `json:"market_id"`
+	Price    string `json:"price"`
+	Expiry   string `json:"expiry"`
+}
+
+// PostPricesReq defines the properties of a PostPrices request's body.
+type PostPricesReq struct {
+	BaseReq rest.BaseReq    `json:"base_req"`
+	Prices  []PriceInputReq `json:"prices"`
+}
+
+// ClearStaleMarketProposalReq defines a clear stale market proposal request body.
+type ClearStaleMarketProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	MarketID    string         `json:"market_id" yaml:"market_id"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+}
+
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
@@ -18,6 +18,7 @@ import (
 
 func registerTxRoutes(cliCtx client.Context, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/postprice", types.ModuleName), postPriceHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/postprices", types.ModuleName), postPricesHandlerFn(cliCtx)).Methods("POST")
 }
 
 func postPriceHandlerFn(cliCtx client.Context) http.HandlerFunc {
@@ -46,20 +47,60 @@ func postPriceHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		expiryInt, err := strconv.ParseInt(req.Expiry, 10, 64)
+		expiry, err := parseExpiry(req.Expiry)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid expiry %s: %s", req.Expiry, err))
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		if expiryInt > types.MaxExpiry {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry))
+		msg := types.NewMsgPostPrice(addr.String(), req.MarketID, price, expiry)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
 
-		msg := types.NewMsgPostPrice(addr.String(), req.MarketID, price, expiry)
+func postPricesHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PostPricesReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		prices := make(types.PriceInputs, len(req.Prices))
+		for i, input := range req.Prices {
+			price, err := sdk.NewDecFromStr(input.Price)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			expiry, err := parseExpiry(input.Expiry)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			prices[i] = types.NewPriceInput(input.MarketID, price, expiry)
+		}
+
+		msg := types.NewMsgPostPrices(addr.String(), prices)
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -68,3 +109,17 @@ func postPriceHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
 	}
 }
+
+// parseExpiry parses an expiry given as a UNIX time
+func parseExpiry(s string) (time.Time, error) {
+	expiryInt, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %s: %s", s, err)
+	}
+
+	if expiryInt > types.MaxExpiry {
+		return time.Time{}, fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+	}
+
+	return tmtime.Canonical(time.Unix(expiryInt, 0)), nil
+}
@@ -21,6 +21,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 			}
 		}
 	}
+	// Restore the price history of TWAP markets before any new snapshots are recorded
+	for _, ps := range gs.PriceSnapshots {
+		k.SetPriceSnapshot(ctx, ps)
+	}
+	// Restore the circuit breaker flags so stale markets are not acted on after import
+	for _, sm := range gs.StaleMarkets {
+		k.SetMarketStale(ctx, sm.MarketID, sm.Reason)
+	}
+	for _, stats := range gs.OracleStats {
+		k.SetOracleStats(ctx, stats)
+	}
+
 	params := k.GetParams(ctx)
 
 	// Set the current price (if any) based on what's now in the store
@@ -51,5 +63,5 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return types.NewGenesisState(params, postedPrices)
+	return types.NewGenesisState(params, postedPrices, k.GetAllPriceSnapshots(ctx), k.GetStaleMarkets(ctx), k.GetAllOracleStats(ctx))
 }
@@ -2,6 +2,7 @@ package pricefeed_test
 
 import (
 	"testing"
+	"time"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -11,6 +12,7 @@ import (
 	"github.com/mokitanetwork/aether/app"
 	"github.com/mokitanetwork/aether/x/pricefeed"
 	"github.com/mokitanetwork/aether/x/pricefeed/keeper"
+	"github.com/mokitanetwork/aether/x/pricefeed/types"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -71,6 +73,107 @@ func (suite *GenesisTestSuite) TestParamPricesGenState() {
 	suite.NoError(gs.PostedPrices[0].VerboseEqual(pps[0]), "posted prices should equal init posted prices")
 }
 
+func (suite *GenesisTestSuite) TestInitExportGenState_PriceSnapshots() {
+	now := suite.ctx.BlockTime().UTC()
+	gs := types.NewGenesisState(
+		types.NewParams([]types.Market{
+			{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, TwapWindow: time.Hour, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
+		}),
+		[]types.PostedPrice{},
+		[]types.PriceSnapshot{
+			types.NewPriceSnapshot("btc:usd", sdk.MustNewDecFromStr("8000.00"), now.Add(-time.Hour), sdk.ZeroDec()),
+			types.NewPriceSnapshot("btc:usd", sdk.MustNewDecFromStr("9000.00"), now.Add(-30*time.Minute), sdk.MustNewDecFromStr("8000.00").MulInt64(int64(30*time.Minute))),
+		},
+		[]types.StaleMarket{},
+		[]types.OracleStats{},
+	)
+
+	suite.NotPanics(func() {
+		pricefeed.InitGenesis(suite.ctx, suite.keeper, gs)
+	})
+
+	exportedGs := pricefeed.ExportGenesis(suite.ctx, suite.keeper)
+	suite.NoError(gs.VerboseEqual(exportedGs), "exported genesis should match init genesis")
+}
+
+func (suite *GenesisTestSuite) TestInitGenState_TWAPUsesImportedHistory() {
+	now := suite.ctx.BlockTime().UTC()
+	gs := types.NewGenesisState(
+		types.NewParams([]types.Market{
+			{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, TwapWindow: time.Hour},
+		}),
+		[]types.PostedPrice{
+			types.NewPostedPrice("btc:usd", sdk.AccAddress("oracle1"), sdk.MustNewDecFromStr("9000.00"), now.Add(time.Hour)),
+		},
+		[]types.PriceSnapshot{
+			types.NewPriceSnapshot("btc:usd", sdk.MustNewDecFromStr("8000.00"), now.Add(-time.Hour), sdk.ZeroDec()),
+			types.NewPriceSnapshot("btc:usd", sdk.MustNewDecFromStr("9000.00"), now.Add(-30*time.Minute), sdk.MustNewDecFromStr("8000.00").MulInt64(int64(30*time.Minute))),
+		},
+		[]types.StaleMarket{},
+		[]types.OracleStats{},
+	)
+
+	suite.NotPanics(func() {
+		pricefeed.InitGenesis(suite.ctx, suite.keeper, gs)
+	})
+
+	// the TWAP continues from the imported history rather than restarting at the spot price
+	twap, err := suite.keeper.GetCurrentPrice(suite.ctx, types.TWAPMarketID("btc:usd"))
+	suite.NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("8500.00"), twap.Price)
+}
+
+func (suite *GenesisTestSuite) TestInitExportGenState_StaleMarkets() {
+	gs := types.NewGenesisState(
+		types.NewParams([]types.Market{
+			{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, MaxPriceChange: sdk.MustNewDecFromStr("0.1"), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
+			{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, MaxPriceChange: sdk.ZeroDec(), MinOracles: 2, OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
+		}),
+		[]types.PostedPrice{},
+		[]types.PriceSnapshot{},
+		[]types.StaleMarket{
+			types.NewStaleMarket("btc:usd", types.StaleReasonMaxPriceChange),
+			types.NewStaleMarket("xrp:usd", types.StaleReasonMinOracles),
+		},
+		[]types.OracleStats{},
+	)
+
+	suite.NotPanics(func() {
+		pricefeed.InitGenesis(suite.ctx, suite.keeper, gs)
+	})
+
+	suite.True(suite.keeper.IsMarketStale(suite.ctx, "btc:usd"))
+	suite.True(suite.keeper.IsMarketStale(suite.ctx, "xrp:usd"))
+
+	exportedGs := pricefeed.ExportGenesis(suite.ctx, suite.keeper)
+	suite.NoError(gs.VerboseEqual(exportedGs), "exported genesis should match init genesis")
+}
+
+func (suite *GenesisTestSuite) TestInitExportGenState_OracleStats() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	gs := types.NewGenesisState(
+		types.NewParams([]types.Market{
+			{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.MustNewDecFromStr("0.1"), MaxReferenceDeviation: sdk.ZeroDec()},
+		}),
+		[]types.PostedPrice{
+			types.NewPostedPrice("btc:usd", addrs[0], sdk.MustNewDecFromStr("9000.00"), suite.ctx.BlockTime().Add(time.Hour)),
+		},
+		[]types.PriceSnapshot{},
+		[]types.StaleMarket{},
+		[]types.OracleStats{
+			types.NewOracleStats("btc:usd", addrs[0], 10, 1, 2),
+		},
+	)
+
+	suite.NotPanics(func() {
+		pricefeed.InitGenesis(suite.ctx, suite.keeper, gs)
+	})
+
+	// setting the current price at genesis is not counted as a price window
+	exportedGs := pricefeed.ExportGenesis(suite.ctx, suite.keeper)
+	suite.NoError(gs.VerboseEqual(exportedGs), "exported genesis should match init genesis")
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
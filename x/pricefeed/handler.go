@@ -0,0 +1,22 @@
+package pricefeed
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/mokitanetwork/aether/x/pricefeed/keeper"
+	"github.com/mokitanetwork/aether/x/pricefeed/types"
+)
+
+// NewClearStaleMarketProposalHandler handles proposals that clear the stale flag of a market
+func NewClearStaleMarketProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ClearStaleMarketProposal:
+			return keeper.HandleClearStaleMarketProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized pricefeed proposal content type: %T", c)
+		}
+	}
+}
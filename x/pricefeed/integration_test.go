@@ -13,8 +13,8 @@ func NewPricefeedGen() types.GenesisState {
 	return types.GenesisState{
 		Params: types.Params{
 			Markets: []types.Market{
-				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
+				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
 			},
 		},
 		PostedPrices: []types.PostedPrice{
@@ -43,8 +43,8 @@ func NewPricefeedGenStateWithOracles(addrs []sdk.AccAddress) app.GenesisState {
 	pfGenesis := types.GenesisState{
 		Params: types.Params{
 			Markets: []types.Market{
-				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs, Active: true},
-				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: addrs, Active: true},
+				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
+				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: addrs, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
 			},
 		},
 		PostedPrices: []types.PostedPrice{
@@ -52,6 +52,28 @@ func (s queryServer) Price(c context.Context, req *types.QueryPriceRequest) (*ty
 	}, nil
 }
 
+// TWAP implements the gRPC service handler for querying the time-weighted average price of a market.
+func (s queryServer) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	market, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+	if market.TwapWindow == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "market does not track a TWAP")
+	}
+	twap, sdkErr := s.keeper.GetTWAPPrice(ctx, req.MarketId)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	return &types.QueryTWAPResponse{
+		Price:  types.CurrentPriceResponse(twap),
+		Window: market.TwapWindow,
+	}, nil
+}
+
 func (s queryServer) Prices(c context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -113,10 +135,32 @@ func (s queryServer) Markets(c context.Context, req *types.QueryMarketsRequest)
 
 	var markets types.MarketResponses
 	for _, market := range s.keeper.GetMarkets(ctx) {
-		markets = append(markets, market.ToMarketResponse())
+		resp := market.ToMarketResponse()
+		resp.Stale = s.keeper.IsMarketStale(ctx, market.MarketID)
+		markets = append(markets, resp)
 	}
 
 	return &types.QueryMarketsResponse{
 		Markets: markets,
 	}, nil
 }
+
+// OracleStats implements the gRPC service handler for querying the reliability statistics of a market's oracles.
+func (s queryServer) OracleStats(c context.Context, req *types.QueryOracleStatsRequest) (*types.QueryOracleStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	market, found := s.keeper.GetMarket(ctx, req.MarketId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "invalid market ID")
+	}
+
+	var oracleStats types.OracleStatsResponses
+	for _, oracle := range market.Oracles {
+		stats := s.keeper.GetOracleStats(ctx, market.MarketID, oracle)
+		oracleStats = append(oracleStats, stats.ToOracleStatsResponse())
+	}
+
+	return &types.QueryOracleStatsResponse{
+		OracleStats: oracleStats,
+	}, nil
+}
@@ -58,7 +58,7 @@ func (suite *grpcQueryTestSuite) TestGrpcParams() {
 	}{
 		{"default params", types.DefaultParams(), true},
 		{"test params", types.NewParams([]types.Market{
-			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
 		}), true},
 	}
 
@@ -229,8 +229,8 @@ func (suite *grpcQueryTestSuite) TestGrpcOracles_InvalidMarket() {
 
 func (suite *grpcQueryTestSuite) TestGrpcMarkets() {
 	params := types.NewParams([]types.Market{
-		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-		{MarketID: "btcusd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
+		{MarketID: "btcusd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
 	})
 	suite.keeper.SetParams(suite.ctx, params)
 
@@ -265,6 +265,56 @@ func (suite *grpcQueryTestSuite) setTstPrice() {
 	suite.NoError(err)
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcTWAP() {
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, TwapWindow: time.Hour},
+	}))
+	suite.setTstPrice()
+
+	res, err := suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "tstusd"})
+	suite.NoError(err)
+	suite.Equal(types.QueryTWAPResponse{
+		Price:  types.NewCurrentPriceResponse("tstusd", sdk.MustNewDecFromStr("0.34")),
+		Window: time.Hour,
+	}, *res)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcTWAP_NoWindow() {
+	suite.setTestParams()
+	suite.setTstPrice()
+
+	_, err := suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "tstusd"})
+	suite.Error(err)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcTWAP_InvalidMarket() {
+	suite.setTestParams()
+
+	_, err := suite.queryServer.TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{MarketId: "invalid"})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcOracleStats() {
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: suite.addrs[:2], Active: true},
+	}))
+	suite.keeper.SetOracleStats(suite.ctx, types.NewOracleStats("tstusd", suite.addrs[0], 5, 1, 2))
+
+	res, err := suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{MarketId: "tstusd"})
+	suite.NoError(err)
+	suite.Equal(types.OracleStatsResponses{
+		{MarketID: "tstusd", OracleAddress: suite.strAddrs[0], Posts: 5, MissedWindows: 1, Outliers: 2},
+		{MarketID: "tstusd", OracleAddress: suite.strAddrs[1]},
+	}, res.OracleStats)
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcOracleStats_InvalidMarket() {
+	suite.setTestParams()
+
+	_, err := suite.queryServer.OracleStats(sdk.WrapSDKContext(suite.ctx), &types.QueryOracleStatsRequest{MarketId: "invalid"})
+	suite.Equal("rpc error: code = NotFound desc = invalid market ID", err.Error())
+}
+
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
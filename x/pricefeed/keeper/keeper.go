@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -13,6 +14,7 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/mokitanetwork/aether/x/pricefeed/types"
+	swaptypes "github.com/mokitanetwork/aether/x/swap/types"
 )
 
 // Keeper struct for pricefeed module
@@ -23,11 +25,13 @@ type Keeper struct {
 	cdc codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace paramtypes.Subspace
+	// The reference to the swap keeper to read the reserves of pool markets
+	swapKeeper types.SwapKeeper
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
-	cdc codec.Codec, key sdk.StoreKey, paramstore paramtypes.Subspace,
+	cdc codec.Codec, key sdk.StoreKey, paramstore paramtypes.Subspace, swapKeeper types.SwapKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -37,6 +41,7 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		swapKeeper:    swapKeeper,
 	}
 }
 
@@ -78,9 +83,21 @@ func (k Keeper) SetPrice(
 	return newRawPrice, nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs.
+// Prices outside the market's outlier band of the median are excluded. Markets with a circuit
+// breaker are flagged as stale instead of being updated when too few oracles have posted prices
+// or the median has moved too far from the previous price.
+// Derived markets are updated to the product of their source market prices instead, and are
+// flagged as stale while any source market is stale. Pool markets are updated to the price
+// implied by the reserves of their swap pool.
+// Markets without a valid price use the price of their reference market, and markets with a
+// max reference deviation are flagged as stale when their price deviates too far from it.
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	return k.setCurrentPrices(ctx, marketID, true)
+}
+
+func (k Keeper) setCurrentPrices(ctx sdk.Context, marketID string, checkPriceChange bool) error {
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
 	}
@@ -91,26 +108,63 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 		validPrevPrice = false
 	}
 
-	prices := k.GetRawPrices(ctx, marketID)
+	var (
+		medianPrice sdk.Dec
+		staleReason string
+	)
+	switch {
+	case market.IsDerived():
+		medianPrice, staleReason, err = k.calculateDerivedPrice(ctx, market)
+	case market.IsPoolMarket():
+		medianPrice, err = k.calculatePoolPrice(ctx, market)
+	default:
+		medianPrice, staleReason, err = k.calculatePostedPrice(ctx, market)
+	}
 
-	var notExpiredPrices []types.CurrentPrice
-	// filter out expired prices
-	for _, v := range prices {
-		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+	referencePrice, validReferencePrice := k.getReferencePrice(ctx, market)
+	if errors.Is(err, types.ErrNoValidPrice) && validReferencePrice {
+		// The reference price is used as is, so it is not checked against itself
+		medianPrice, err = referencePrice, nil
+	} else if err == nil && staleReason == "" && market.HasMaxReferenceDeviation() && validReferencePrice {
+		if medianPrice.Sub(referencePrice).Abs().Quo(referencePrice).GT(market.MaxReferenceDeviation) {
+			staleReason = types.StaleReasonReferenceDeviation
 		}
 	}
 
-	if len(notExpiredPrices) == 0 {
+	if errors.Is(err, types.ErrNoValidPrice) {
 		// NOTE: The current price stored will continue storing the most recent (expired)
 		// price if this is not set.
 		// This zero's out the current price stored value for that market and ensures
 		// that CDP methods that GetCurrentPrice will return error.
 		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
-		return types.ErrNoValidPrice
+		// A market without a valid price must not be acted on through its TWAP either.
+		// The history is cleared so no price is held across the gap once prices resume.
+		k.ClearTWAP(ctx, marketID)
+		return err
+	}
+	if err != nil {
+		return err
 	}
 
-	medianPrice := k.CalculateMedianPrice(notExpiredPrices)
+	// The last accepted price is kept while the market is stale, so consumers checking
+	// IsMarketStale refuse to act on it until the oracles converge again.
+	if staleReason != "" {
+		k.SetMarketStale(ctx, marketID, staleReason)
+		return nil
+	}
+
+	if checkPriceChange && market.HasMaxPriceChange() {
+		// A market flagged for a price change without an accepted price has nothing to
+		// converge to, so it remains stale until the flag is cleared by proposal.
+		if reason, found := k.GetMarketStaleReason(ctx, marketID); !validPrevPrice && found && reason == types.StaleReasonMaxPriceChange {
+			return nil
+		}
+		if validPrevPrice && medianPrice.Sub(prevPrice.Price).Abs().Quo(prevPrice.Price).GT(market.MaxPriceChange) {
+			k.SetMarketStale(ctx, marketID, types.StaleReasonMaxPriceChange)
+			return nil
+		}
+	}
+	k.ClearMarketStale(ctx, marketID, types.AttributeValueConverged)
 
 	// check case that market price was not set in genesis
 	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
@@ -127,7 +181,120 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
 	k.setCurrentPrice(ctx, marketID, currentPrice)
 
-	return nil
+	return k.UpdateTWAP(ctx, marketID, medianPrice)
+}
+
+// calculatePostedPrice returns the median of the unexpired prices posted for a market, or the
+// reason the market is stale if the prices cannot be accepted.
+func (k Keeper) calculatePostedPrice(ctx sdk.Context, market types.Market) (sdk.Dec, string, error) {
+	notExpiredPrices := k.getUnexpiredRawPrices(ctx, market.MarketID)
+	if len(notExpiredPrices) == 0 {
+		return sdk.Dec{}, "", types.ErrNoValidPrice
+	}
+
+	includedPrices, _ := k.filterOutliers(market, notExpiredPrices)
+	if len(includedPrices) == 0 {
+		return sdk.Dec{}, types.StaleReasonOutliers, nil
+	}
+	if market.MinOracles > 0 && len(includedPrices) < int(market.MinOracles) {
+		return sdk.Dec{}, types.StaleReasonMinOracles, nil
+	}
+
+	return k.CalculateMedianPrice(toCurrentPrices(includedPrices)), "", nil
+}
+
+// calculateDerivedPrice returns the product of the current prices of a derived market's sources,
+// dividing by the prices of inverted sources, or the reason the market is stale if a source is.
+func (k Keeper) calculateDerivedPrice(ctx sdk.Context, market types.Market) (sdk.Dec, string, error) {
+	price := sdk.OneDec()
+	for _, source := range market.SourceMarkets {
+		sourcePrice, err := k.GetCurrentPrice(ctx, source.MarketID)
+		if err != nil {
+			return sdk.Dec{}, "", err
+		}
+		if k.IsMarketStale(ctx, source.MarketID) {
+			return sdk.Dec{}, types.StaleReasonSourceMarket, nil
+		}
+		if source.Invert {
+			price = price.Quo(sourcePrice.Price)
+		} else {
+			price = price.Mul(sourcePrice.Price)
+		}
+	}
+	if !price.IsPositive() {
+		// the product of valid prices rounds to zero when it is too small to represent
+		return sdk.Dec{}, "", types.ErrNoValidPrice
+	}
+	return price, "", nil
+}
+
+// calculatePoolPrice returns the price implied by the reserves of a pool market's swap pool.
+// Pools that do not exist or hold less than the minimum liquidity have no valid price.
+func (k Keeper) calculatePoolPrice(ctx sdk.Context, market types.Market) (sdk.Dec, error) {
+	source := market.PoolPriceSource
+	pool, found := k.swapKeeper.GetPool(ctx, swaptypes.PoolID(source.BaseDenom, source.QuoteDenom))
+	if !found {
+		return sdk.Dec{}, types.ErrNoValidPrice
+	}
+	reserves := pool.Reserves()
+	price, ok := source.Price(reserves.AmountOf(source.BaseDenom), reserves.AmountOf(source.QuoteDenom))
+	if !ok {
+		return sdk.Dec{}, types.ErrNoValidPrice
+	}
+	return price, nil
+}
+
+// getReferencePrice returns the current price of a market's reference market, and false if the
+// market has no reference market or the reference market has no valid price or is stale.
+func (k Keeper) getReferencePrice(ctx sdk.Context, market types.Market) (sdk.Dec, bool) {
+	if market.ReferenceMarketID == "" {
+		return sdk.Dec{}, false
+	}
+	price, err := k.GetCurrentPrice(ctx, market.ReferenceMarketID)
+	if err != nil || k.IsMarketStale(ctx, market.ReferenceMarketID) {
+		return sdk.Dec{}, false
+	}
+	return price.Price, true
+}
+
+// getUnexpiredRawPrices returns the prices posted for a market that have not expired
+func (k Keeper) getUnexpiredRawPrices(ctx sdk.Context, marketID string) types.PostedPrices {
+	var notExpiredPrices types.PostedPrices
+	// filter out expired prices
+	for _, v := range k.GetRawPrices(ctx, marketID) {
+		if v.Expiry.After(ctx.BlockTime()) {
+			notExpiredPrices = append(notExpiredPrices, v)
+		}
+	}
+	return notExpiredPrices
+}
+
+// filterOutliers splits prices into those within the market's outlier band of their median and
+// those outside it. All prices are included if the market has no outlier band.
+func (k Keeper) filterOutliers(market types.Market, prices types.PostedPrices) (included, excluded types.PostedPrices) {
+	if !market.HasOutlierBand() {
+		return prices, nil
+	}
+	median := k.CalculateMedianPrice(toCurrentPrices(prices))
+	if median.IsZero() {
+		return prices, nil
+	}
+	for _, pp := range prices {
+		if pp.Price.Sub(median).Abs().Quo(median).GT(market.OutlierBand) {
+			excluded = append(excluded, pp)
+		} else {
+			included = append(included, pp)
+		}
+	}
+	return included, excluded
+}
+
+func toCurrentPrices(prices types.PostedPrices) []types.CurrentPrice {
+	currentPrices := make([]types.CurrentPrice, len(prices))
+	for i, pp := range prices {
+		currentPrices[i] = types.NewCurrentPrice(pp.MarketID, pp.Price)
+	}
+	return currentPrices
 }
 
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
@@ -162,8 +329,18 @@ func (k Keeper) calculateMeanPrice(priceA, priceB types.CurrentPrice) sdk.Dec {
 	return mean
 }
 
-// GetCurrentPrice fetches the current median price of all oracles for a specific market
+// GetCurrentPrice fetches the current median price of all oracles for a specific market.
+// Market IDs ending in types.TWAPMarketIDSuffix return the time-weighted average price
+// of the underlying market.
 func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	if twapMarketID, ok := types.ParseTWAPMarketID(marketID); ok {
+		price, err := k.GetTWAPPrice(ctx, twapMarketID)
+		if err != nil {
+			return types.CurrentPrice{}, err
+		}
+		return types.NewCurrentPrice(marketID, price.Price), nil
+	}
+
 	store := ctx.KVStore(k.key)
 	bz := store.Get(types.CurrentPriceKey(marketID))
 
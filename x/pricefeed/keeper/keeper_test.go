@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/mokitanetwork/aether/app"
 	"github.com/mokitanetwork/aether/x/pricefeed/types"
+	swaptypes "github.com/mokitanetwork/aether/x/swap/types"
 )
 
 // TestKeeper_SetGetMarket tests adding markets to the pricefeed, getting markets from the store
@@ -236,3 +238,169 @@ func TestKeeper_ExpiredSetCurrentPrices(t *testing.T) {
 	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
 	require.ErrorIs(t, types.ErrNoValidPrice, err, "current prices should be invalid")
 }
+
+func TestKeeper_DerivedSetCurrentPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	atomUSDX := types.NewMarket("atom:usdx", "atom", "usdx", nil, true)
+	atomUSDX.SourceMarkets = types.SourceMarkets{
+		types.NewSourceMarket("atom:usd", false),
+		types.NewSourceMarket("usdx:usd", true),
+	}
+	atomUSD := types.NewMarket("atom:usd", "atom", "usd", addrs, true)
+	atomUSD.MaxPriceChange = sdk.MustNewDecFromStr("0.5")
+	keeper.SetParams(ctx, types.NewParams([]types.Market{
+		atomUSDX,
+		atomUSD,
+		types.NewMarket("usdx:usd", "usdx", "usd", addrs, true),
+	}))
+
+	// update the markets in the same order as the end blocker
+	setCurrentPrices := func() {
+		for _, market := range keeper.GetMarkets(ctx).SortByDependencies() {
+			err := keeper.SetCurrentPrices(ctx, market.MarketID)
+			if !errors.Is(err, types.ErrNoValidPrice) {
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "atom:usd", sdk.MustNewDecFromStr("10"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	// the derived market has no price while one of its sources has none
+	setCurrentPrices()
+	_, err = keeper.GetCurrentPrice(ctx, "atom:usdx")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	_, err = keeper.SetPrice(ctx, addrs[0], "usdx:usd", sdk.MustNewDecFromStr("0.8"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	setCurrentPrices()
+
+	price, err := keeper.GetCurrentPrice(ctx, "atom:usdx")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("12.5"), price.Price)
+
+	// oracles cannot post prices for derived markets
+	_, err = keeper.GetOracle(ctx, "atom:usdx", addrs[0])
+	require.ErrorIs(t, err, types.ErrInvalidOracle)
+
+	// the derived market is stale with its last price while a source is stale
+	_, err = keeper.SetPrice(ctx, addrs[0], "atom:usd", sdk.MustNewDecFromStr("20"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	setCurrentPrices()
+	require.True(t, keeper.IsMarketStale(ctx, "atom:usdx"))
+	reason, _ := keeper.GetMarketStaleReason(ctx, "atom:usdx")
+	require.Equal(t, types.StaleReasonSourceMarket, reason)
+	price, err = keeper.GetCurrentPrice(ctx, "atom:usdx")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("12.5"), price.Price)
+
+	// and is updated once the source converges
+	_, err = keeper.SetPrice(ctx, addrs[0], "atom:usd", sdk.MustNewDecFromStr("12"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	setCurrentPrices()
+	require.False(t, keeper.IsMarketStale(ctx, "atom:usdx"))
+	price, err = keeper.GetCurrentPrice(ctx, "atom:usdx")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("15"), price.Price)
+	// and has no price once its sources expire
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	setCurrentPrices()
+	_, err = keeper.GetCurrentPrice(ctx, "atom:usdx")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+}
+
+func TestKeeper_PoolSetCurrentPrices(t *testing.T) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	swpUSDX := types.NewMarket("swp:usdx", "swp", "usdx", nil, true)
+	swpUSDX.PoolPriceSource = types.NewPoolPriceSource("uswp", "usdx", sdk.OneDec(), sdk.NewInt(1000e6))
+	keeper.SetParams(ctx, types.NewParams([]types.Market{swpUSDX}))
+
+	// the market has no price without a pool
+	err := keeper.SetCurrentPrices(ctx, "swp:usdx")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	tApp.GetSwapKeeper().SetPool_Raw(ctx, swaptypes.NewPoolRecord(
+		sdk.NewCoins(sdk.NewCoin("uswp", sdk.NewInt(2000e6)), sdk.NewCoin("usdx", sdk.NewInt(500e6))),
+		sdk.NewInt(1000e6),
+	))
+
+	// or while the pool holds less than the min liquidity
+	err = keeper.SetCurrentPrices(ctx, "swp:usdx")
+	require.ErrorIs(t, err, types.ErrNoValidPrice)
+
+	tApp.GetSwapKeeper().SetPool_Raw(ctx, swaptypes.NewPoolRecord(
+		sdk.NewCoins(sdk.NewCoin("uswp", sdk.NewInt(4000e6)), sdk.NewCoin("usdx", sdk.NewInt(1000e6))),
+		sdk.NewInt(2000e6),
+	))
+
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "swp:usdx"))
+	price, err := keeper.GetCurrentPrice(ctx, "swp:usdx")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.25"), price.Price)
+}
+
+func TestKeeper_ReferenceSetCurrentPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	keeper := tApp.GetPriceFeedKeeper()
+
+	swpUSDX := types.NewMarket("swp:usdx", "swp", "usdx", nil, true)
+	swpUSDX.PoolPriceSource = types.NewPoolPriceSource("uswp", "usdx", sdk.OneDec(), sdk.ZeroInt())
+	swpUSDX.TwapWindow = time.Hour
+	swpUSD := types.NewMarket("swp:usd", "swp", "usd", addrs, true)
+	swpUSD.ReferenceMarketID = "swp:usdx:twap"
+	swpUSD.MaxReferenceDeviation = sdk.MustNewDecFromStr("0.1")
+	keeper.SetParams(ctx, types.NewParams([]types.Market{swpUSD, swpUSDX}))
+
+	tApp.GetSwapKeeper().SetPool_Raw(ctx, swaptypes.NewPoolRecord(
+		sdk.NewCoins(sdk.NewCoin("uswp", sdk.NewInt(4000e6)), sdk.NewCoin("usdx", sdk.NewInt(1000e6))),
+		sdk.NewInt(2000e6),
+	))
+
+	// update the markets in the same order as the end blocker
+	setCurrentPrices := func() {
+		for _, market := range keeper.GetMarkets(ctx).SortByDependencies() {
+			err := keeper.SetCurrentPrices(ctx, market.MarketID)
+			if !errors.Is(err, types.ErrNoValidPrice) {
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	// the market uses the reference price without oracle prices
+	setCurrentPrices()
+	price, err := keeper.GetCurrentPrice(ctx, "swp:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.25"), price.Price)
+
+	// oracle prices within the max reference deviation are accepted
+	_, err = keeper.SetPrice(ctx, addrs[0], "swp:usd", sdk.MustNewDecFromStr("0.26"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	setCurrentPrices()
+	price, err = keeper.GetCurrentPrice(ctx, "swp:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.26"), price.Price)
+
+	// and prices deviating further flag the market as stale
+	_, err = keeper.SetPrice(ctx, addrs[0], "swp:usd", sdk.MustNewDecFromStr("0.3"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	setCurrentPrices()
+	require.True(t, keeper.IsMarketStale(ctx, "swp:usd"))
+	reason, _ := keeper.GetMarketStaleReason(ctx, "swp:usd")
+	require.Equal(t, types.StaleReasonReferenceDeviation, reason)
+	price, err = keeper.GetCurrentPrice(ctx, "swp:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.26"), price.Price)
+}
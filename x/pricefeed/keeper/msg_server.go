@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/mokitanetwork/aether/x/pricefeed/types"
 )
@@ -37,6 +38,7 @@ func (k msgServer) PostPrice(goCtx context.Context, msg *types.MsgPostPrice) (*t
 	if err != nil {
 		return nil, err
 	}
+	k.keeper.RecordOraclePost(ctx, msg.MarketID, from)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -48,3 +50,41 @@ func (k msgServer) PostPrice(goCtx context.Context, msg *types.MsgPostPrice) (*t
 
 	return &types.MsgPostPriceResponse{}, nil
 }
+
+func (k msgServer) PostPrices(goCtx context.Context, msg *types.MsgPostPrices) (*types.MsgPostPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check every price before posting any so the prices are posted together or not at all
+	for _, pi := range msg.Prices {
+		_, err = k.keeper.GetOracle(ctx, pi.MarketID, from)
+		if err != nil {
+			return nil, err
+		}
+		if !pi.Expiry.After(ctx.BlockTime()) {
+			return nil, sdkerrors.Wrapf(types.ErrExpired, "market %s", pi.MarketID)
+		}
+	}
+
+	for _, pi := range msg.Prices {
+		_, err = k.keeper.SetPrice(ctx, from, pi.MarketID, pi.Price, pi.Expiry)
+		if err != nil {
+			return nil, err
+		}
+		k.keeper.RecordOraclePost(ctx, pi.MarketID, from)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From),
+		),
+	)
+
+	return &types.MsgPostPricesResponse{}, nil
+}
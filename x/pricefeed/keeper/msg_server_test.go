@@ -61,3 +61,97 @@ func TestKeeper_PostPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_PostPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	k := tApp.GetPriceFeedKeeper()
+	msgSrv := keeper.NewMsgServerImpl(k)
+
+	oracle := addrs[0]
+
+	mp := types.Params{
+		Markets: []types.Market{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs[:1], Active: true},
+			{MarketID: "tst2usd", BaseAsset: "tst2", QuoteAsset: "usd", Oracles: addrs[:1], Active: true},
+			{MarketID: "tst3usd", BaseAsset: "tst3", QuoteAsset: "usd", Oracles: addrs[1:], Active: true},
+		},
+	}
+	k.SetParams(ctx, mp)
+
+	now := time.Now().UTC()
+	price := sdk.MustNewDecFromStr("0.5")
+
+	tests := []struct {
+		giveMsg      string
+		givePrices   types.PriceInputs
+		wantAccepted bool
+		errorKind    error
+	}{
+		{
+			"authorized",
+			types.PriceInputs{
+				types.NewPriceInput("tstusd", price, now.Add(time.Hour*1)),
+				types.NewPriceInput("tst2usd", price, now.Add(time.Hour*1)),
+			},
+			true,
+			nil,
+		},
+		{
+			"expired",
+			types.PriceInputs{
+				types.NewPriceInput("tstusd", price, now.Add(time.Hour*1)),
+				types.NewPriceInput("tst2usd", price, now.Add(-time.Hour*1)),
+			},
+			false,
+			types.ErrExpired,
+		},
+		{
+			"invalid",
+			types.PriceInputs{
+				types.NewPriceInput("tstusd", price, now.Add(time.Hour*1)),
+				types.NewPriceInput("invalid", price, now.Add(time.Hour*1)),
+			},
+			false,
+			types.ErrInvalidMarket,
+		},
+		{
+			"unauthorized",
+			types.PriceInputs{
+				types.NewPriceInput("tstusd", price, now.Add(time.Hour*1)),
+				types.NewPriceInput("tst3usd", price, now.Add(time.Hour*1)),
+			},
+			false,
+			types.ErrInvalidOracle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.giveMsg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			msg := types.NewMsgPostPrices(oracle.String(), tt.givePrices)
+			_, err := msgSrv.PostPrices(sdk.WrapSDKContext(ctx), msg)
+
+			if tt.wantAccepted {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.errorKind)
+			}
+
+			// the prices are posted together or not at all
+			for _, pi := range tt.givePrices {
+				rawPrices := k.GetRawPrices(ctx, pi.MarketID)
+				if tt.wantAccepted {
+					require.Equal(t, types.PostedPrices{types.NewPostedPrice(pi.MarketID, oracle, pi.Price, pi.Expiry)}, rawPrices)
+					require.Equal(t, uint64(1), k.GetOracleStats(ctx, pi.MarketID, oracle).Posts)
+				} else {
+					require.Empty(t, rawPrices)
+				}
+			}
+		})
+	}
+}
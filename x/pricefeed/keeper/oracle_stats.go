@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/pricefeed/types"
+)
+
+// SetOracleStats stores the reliability statistics of an oracle for a market
+func (k Keeper) SetOracleStats(ctx sdk.Context, stats types.OracleStats) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleStatsKey(stats.MarketID, stats.OracleAddress), k.cdc.MustMarshal(&stats))
+}
+
+// GetOracleStats returns the reliability statistics of an oracle for a market, or empty stats
+// if the oracle has none recorded
+func (k Keeper) GetOracleStats(ctx sdk.Context, marketID string, oracle sdk.AccAddress) types.OracleStats {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleStatsKey(marketID, oracle))
+	if bz == nil {
+		return types.NewOracleStats(marketID, oracle, 0, 0, 0)
+	}
+	var stats types.OracleStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// IterateOracleStatsByMarket iterates over the oracle stats of a market and performs a callback function
+func (k Keeper) IterateOracleStatsByMarket(ctx sdk.Context, marketID string, cb func(stats types.OracleStats) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleStatsIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// IterateAllOracleStats iterates over the oracle stats of all markets and performs a callback function
+func (k Keeper) IterateAllOracleStats(ctx sdk.Context, cb func(stats types.OracleStats) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleStatsPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetAllOracleStats returns the oracle stats of all markets
+func (k Keeper) GetAllOracleStats(ctx sdk.Context) types.OracleStatsList {
+	statsList := types.OracleStatsList{}
+	k.IterateAllOracleStats(ctx, func(stats types.OracleStats) (stop bool) {
+		statsList = append(statsList, stats)
+		return false
+	})
+	return statsList
+}
+
+// RecordOraclePost increments the number of prices posted by an oracle for a market
+func (k Keeper) RecordOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	stats := k.GetOracleStats(ctx, marketID, oracle)
+	stats.Posts++
+	k.SetOracleStats(ctx, stats)
+}
+
+// UpdateOracleStats records the oracles of a market without an unexpired price as having missed
+// the current price window, and the oracles with a price outside the market's outlier band as
+// outliers. It is called each time the market's price is calculated in the end blocker.
+func (k Keeper) UpdateOracleStats(ctx sdk.Context, marketID string) error {
+	market, ok := k.GetMarket(ctx, marketID)
+	if !ok {
+		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	prices := k.getUnexpiredRawPrices(ctx, marketID)
+	k.recordMissedWindows(ctx, market, prices)
+	if len(prices) == 0 {
+		return nil
+	}
+	_, excluded := k.filterOutliers(market, prices)
+	k.recordOutliers(ctx, market, excluded)
+	return nil
+}
+
+// recordMissedWindows increments the missed windows of each oracle of a market without an unexpired price
+func (k Keeper) recordMissedWindows(ctx sdk.Context, market types.Market, prices types.PostedPrices) {
+	posted := make(map[string]bool)
+	for _, pp := range prices {
+		posted[pp.OracleAddress.String()] = true
+	}
+	for _, oracle := range market.Oracles {
+		if posted[oracle.String()] {
+			continue
+		}
+		stats := k.GetOracleStats(ctx, market.MarketID, oracle)
+		stats.MissedWindows++
+		k.SetOracleStats(ctx, stats)
+	}
+}
+
+// recordOutliers increments the outliers of each oracle of a market with an excluded price and
+// emits an event for each excluded price
+func (k Keeper) recordOutliers(ctx sdk.Context, market types.Market, excluded types.PostedPrices) {
+	for _, pp := range excluded {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOraclePriceExcluded,
+				sdk.NewAttribute(types.AttributeMarketID, pp.MarketID),
+				sdk.NewAttribute(types.AttributeOracle, pp.OracleAddress.String()),
+				sdk.NewAttribute(types.AttributeMarketPrice, pp.Price.String()),
+			),
+		)
+
+		// prices can remain from oracles that were removed from the market, which have no stats
+		if !market.HasOracle(pp.OracleAddress) {
+			continue
+		}
+		stats := k.GetOracleStats(ctx, pp.MarketID, pp.OracleAddress)
+		stats.Outliers++
+		k.SetOracleStats(ctx, stats)
+	}
+}
+
+// ClearRemovedOracleStats deletes the stats of oracles that have been removed from their
+// market and of markets that have been removed from params
+func (k Keeper) ClearRemovedOracleStats(ctx sdk.Context) {
+	markets := make(map[string]types.Market)
+	for _, market := range k.GetMarkets(ctx) {
+		markets[market.MarketID] = market
+	}
+	for _, stats := range k.GetAllOracleStats(ctx) {
+		market, found := markets[stats.MarketID]
+		if !found || !market.HasOracle(stats.OracleAddress) {
+			ctx.KVStore(k.key).Delete(types.OracleStatsKey(stats.MarketID, stats.OracleAddress))
+		}
+	}
+}
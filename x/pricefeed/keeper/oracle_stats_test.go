@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/pricefeed/keeper"
+	"github.com/mokitanetwork/aether/x/pricefeed/types"
+)
+
+type oracleStatsTestSuite struct {
+	suite.Suite
+
+	tApp   app.TestApp
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	addrs  []sdk.AccAddress
+}
+
+func (suite *oracleStatsTestSuite) SetupTest() {
+	suite.tApp = app.NewTestApp()
+	suite.ctx = suite.tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.keeper = suite.tApp.GetPriceFeedKeeper()
+	_, suite.addrs = app.GeneratePrivKeyAddressPairs(3)
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: suite.addrs, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.MustNewDecFromStr("0.1")},
+	}))
+}
+
+// postPrices posts a price from each oracle and updates the current price and oracle stats
+func (suite *oracleStatsTestSuite) postPrices(prices ...string) {
+	for i, price := range prices {
+		_, err := suite.keeper.SetPrice(suite.ctx, suite.addrs[i], "tstusd", sdk.MustNewDecFromStr(price), suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+	}
+	err := suite.keeper.SetCurrentPrices(suite.ctx, "tstusd")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.UpdateOracleStats(suite.ctx, "tstusd"))
+}
+
+func (suite *oracleStatsTestSuite) requireStats(oracle sdk.AccAddress, posts, missedWindows, outliers uint64) {
+	suite.Require().Equal(
+		types.NewOracleStats("tstusd", oracle, posts, missedWindows, outliers),
+		suite.keeper.GetOracleStats(suite.ctx, "tstusd", oracle),
+	)
+}
+
+func (suite *oracleStatsTestSuite) TestOutlierExcluded() {
+	suite.postPrices("1.00", "1.02", "2.00")
+
+	// the median of all prices is 1.02, so 2.00 is excluded and the median of the rest is used
+	price, err := suite.keeper.GetCurrentPrice(suite.ctx, "tstusd")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.01"), price.Price)
+
+	suite.requireStats(suite.addrs[0], 0, 0, 0)
+	suite.requireStats(suite.addrs[1], 0, 0, 0)
+	suite.requireStats(suite.addrs[2], 0, 0, 1)
+	suite.Require().Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeOraclePriceExcluded,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeOracle, suite.addrs[2].String()),
+		sdk.NewAttribute(types.AttributeMarketPrice, sdk.MustNewDecFromStr("2.00").String()),
+	))
+}
+
+func (suite *oracleStatsTestSuite) TestNoPricesWithinBand() {
+	// both prices are outside the band of their median, leaving no price to accept
+	suite.postPrices("1.00", "2.00")
+
+	suite.Require().True(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+	reason, _ := suite.keeper.GetMarketStaleReason(suite.ctx, "tstusd")
+	suite.Require().Equal(types.StaleReasonOutliers, reason)
+	suite.requireStats(suite.addrs[0], 0, 0, 1)
+	suite.requireStats(suite.addrs[1], 0, 0, 1)
+}
+
+func (suite *oracleStatsTestSuite) TestMissedWindows() {
+	suite.postPrices("1.00", "1.00")
+	suite.requireStats(suite.addrs[0], 0, 0, 0)
+	suite.requireStats(suite.addrs[2], 0, 1, 0)
+
+	// once the posted prices expire every oracle misses the window
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
+	err := suite.keeper.SetCurrentPrices(suite.ctx, "tstusd")
+	suite.Require().ErrorIs(err, types.ErrNoValidPrice)
+	suite.Require().NoError(suite.keeper.UpdateOracleStats(suite.ctx, "tstusd"))
+	suite.requireStats(suite.addrs[0], 0, 1, 0)
+	suite.requireStats(suite.addrs[1], 0, 1, 0)
+	suite.requireStats(suite.addrs[2], 0, 2, 0)
+}
+
+func (suite *oracleStatsTestSuite) TestPosts() {
+	msgSrv := keeper.NewMsgServerImpl(suite.keeper)
+	for i := 0; i < 2; i++ {
+		msg := types.NewMsgPostPrice(suite.addrs[0].String(), "tstusd", sdk.OneDec(), suite.ctx.BlockTime().Add(time.Hour))
+		_, err := msgSrv.PostPrice(sdk.WrapSDKContext(suite.ctx), msg)
+		suite.Require().NoError(err)
+	}
+	suite.requireStats(suite.addrs[0], 2, 0, 0)
+	suite.requireStats(suite.addrs[1], 0, 0, 0)
+}
+
+func (suite *oracleStatsTestSuite) TestOracleRemoved() {
+	suite.postPrices("1.00")
+	suite.Require().Len(suite.keeper.GetAllOracleStats(suite.ctx), 2)
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: suite.addrs[:2], Active: true},
+	}))
+	suite.keeper.ClearRemovedOracleStats(suite.ctx)
+	suite.Require().Equal(types.OracleStatsList{
+		types.NewOracleStats("tstusd", suite.addrs[1], 0, 1, 0),
+	}, suite.keeper.GetAllOracleStats(suite.ctx))
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{}))
+	suite.keeper.ClearRemovedOracleStats(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetAllOracleStats(suite.ctx))
+}
+
+func TestOracleStatsTestSuite(t *testing.T) {
+	suite.Run(t, new(oracleStatsTestSuite))
+}
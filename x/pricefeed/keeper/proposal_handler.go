@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/pricefeed/types"
+)
+
+// HandleClearStaleMarketProposal clears the stale flag of a market and accepts the current
+// median of its oracle prices, regardless of how far it has moved from the last accepted price.
+func HandleClearStaleMarketProposal(ctx sdk.Context, k Keeper, p *types.ClearStaleMarketProposal) error {
+	if _, found := k.GetMarket(ctx, p.MarketID); !found {
+		return sdkerrors.Wrap(types.ErrInvalidMarket, p.MarketID)
+	}
+	if !k.IsMarketStale(ctx, p.MarketID) {
+		return sdkerrors.Wrap(types.ErrMarketNotStale, p.MarketID)
+	}
+	k.ClearMarketStale(ctx, p.MarketID, types.AttributeValueProposal)
+
+	err := k.setCurrentPrices(ctx, p.MarketID, false)
+	if err != nil && !sdkerrors.IsOf(err, types.ErrNoValidPrice) {
+		return err
+	}
+	return nil
+}
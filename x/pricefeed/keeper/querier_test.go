@@ -16,8 +16,8 @@ func (suite *KeeperTestSuite) TestQuerierGetParams() {
 
 	expParams := types.Params{
 		Markets: []types.Market{
-			{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-			{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
+			{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, MaxPriceChange: sdk.ZeroDec(), OutlierBand: sdk.ZeroDec(), MaxReferenceDeviation: sdk.ZeroDec()},
 		},
 	}
 	var p types.Params
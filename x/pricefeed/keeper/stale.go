@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/mokitanetwork/aether/x/pricefeed/types"
+)
+
+// SetMarketStale flags a market as stale for a reason, emitting an event if it was not
+// already stale for that reason
+func (k Keeper) SetMarketStale(ctx sdk.Context, marketID, reason string) {
+	if currentReason, found := k.GetMarketStaleReason(ctx, marketID); found && currentReason == reason {
+		return
+	}
+	store := ctx.KVStore(k.key)
+	store.Set(types.StaleMarketKey(marketID), []byte(reason))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketStale,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeReason, reason),
+		),
+	)
+}
+
+// IsMarketStale returns true if a market has been flagged as stale by the circuit breaker.
+// A TWAP market ID is stale when its underlying market is stale.
+func (k Keeper) IsMarketStale(ctx sdk.Context, marketID string) bool {
+	if twapMarketID, ok := types.ParseTWAPMarketID(marketID); ok {
+		marketID = twapMarketID
+	}
+	store := ctx.KVStore(k.key)
+	return store.Has(types.StaleMarketKey(marketID))
+}
+
+// GetMarketStaleReason returns the reason a market was flagged as stale and whether it is stale
+func (k Keeper) GetMarketStaleReason(ctx sdk.Context, marketID string) (string, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.StaleMarketKey(marketID))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// ClearMarketStale removes the stale flag of a market, emitting an event with the reason it was cleared
+func (k Keeper) ClearMarketStale(ctx sdk.Context, marketID, reason string) {
+	if !k.IsMarketStale(ctx, marketID) {
+		return
+	}
+	store := ctx.KVStore(k.key)
+	store.Delete(types.StaleMarketKey(marketID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketStaleCleared,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeReason, reason),
+		),
+	)
+}
+
+// GetStaleMarkets returns all markets flagged as stale and the reasons they were flagged
+func (k Keeper) GetStaleMarkets(ctx sdk.Context) types.StaleMarkets {
+	staleMarkets := types.StaleMarkets{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.StaleMarketPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		marketID := string(iterator.Key()[len(types.StaleMarketPrefix):])
+		staleMarkets = append(staleMarkets, types.NewStaleMarket(marketID, string(iterator.Value())))
+	}
+	return staleMarkets
+}
+
+// ClearRemovedStaleMarkets removes the stale flags of markets that have been removed from params
+func (k Keeper) ClearRemovedStaleMarkets(ctx sdk.Context) {
+	for _, sm := range k.GetStaleMarkets(ctx) {
+		if _, found := k.GetMarket(ctx, sm.MarketID); !found {
+			ctx.KVStore(k.key).Delete(types.StaleMarketKey(sm.MarketID))
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/pricefeed/keeper"
+	"github.com/mokitanetwork/aether/x/pricefeed/types"
+)
+
+type staleTestSuite struct {
+	suite.Suite
+
+	tApp   app.TestApp
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	addrs  []sdk.AccAddress
+}
+
+func (suite *staleTestSuite) SetupTest() {
+	suite.tApp = app.NewTestApp()
+	suite.ctx = suite.tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	suite.keeper = suite.tApp.GetPriceFeedKeeper()
+	_, suite.addrs = app.GeneratePrivKeyAddressPairs(3)
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, TwapWindow: time.Hour, MaxPriceChange: sdk.MustNewDecFromStr("0.1"), MinOracles: 2},
+	}))
+}
+
+// postPrices posts a price from each of the first n oracles and updates the current price
+func (suite *staleTestSuite) postPrices(n int, price string) {
+	for _, oracle := range suite.addrs[:n] {
+		_, err := suite.keeper.SetPrice(suite.ctx, oracle, "tstusd", sdk.MustNewDecFromStr(price), suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+	}
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+}
+
+func (suite *staleTestSuite) requirePrice(expected string) {
+	price, err := suite.keeper.GetCurrentPrice(suite.ctx, "tstusd")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr(expected), price.Price)
+}
+
+func (suite *staleTestSuite) requireStaleEvent(reason string) {
+	suite.Require().Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMarketStale,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeReason, reason),
+	))
+}
+
+func (suite *staleTestSuite) TestMaxPriceChange() {
+	suite.postPrices(2, "1.00")
+	suite.requirePrice("1.00")
+	suite.Require().False(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+
+	// a 50% move flags the market and the last accepted price is kept
+	suite.postPrices(2, "1.50")
+	suite.Require().True(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+	suite.Require().True(suite.keeper.IsMarketStale(suite.ctx, "tstusd:twap"))
+	suite.requireStaleEvent(types.StaleReasonMaxPriceChange)
+	suite.requirePrice("1.00")
+
+	// prices converging within 10% of the last accepted price clear the flag
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.postPrices(2, "1.05")
+	suite.Require().False(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+	suite.requirePrice("1.05")
+	suite.Require().Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMarketStaleCleared,
+		sdk.NewAttribute(types.AttributeMarketID, "tstusd"),
+		sdk.NewAttribute(types.AttributeReason, types.AttributeValueConverged),
+	))
+}
+
+func (suite *staleTestSuite) TestMinOracles() {
+	suite.postPrices(1, "1.00")
+	suite.Require().True(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+	suite.requireStaleEvent(types.StaleReasonMinOracles)
+	_, err := suite.keeper.GetCurrentPrice(suite.ctx, "tstusd")
+	suite.Require().ErrorIs(err, types.ErrNoValidPrice)
+
+	suite.postPrices(2, "1.00")
+	suite.Require().False(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+	suite.requirePrice("1.00")
+}
+
+func (suite *staleTestSuite) TestClearStaleMarketProposal() {
+	suite.postPrices(2, "1.00")
+
+	err := keeper.HandleClearStaleMarketProposal(suite.ctx, suite.keeper, types.NewClearStaleMarketProposal("title", "description", "tstusd"))
+	suite.Require().ErrorIs(err, types.ErrMarketNotStale)
+	err = keeper.HandleClearStaleMarketProposal(suite.ctx, suite.keeper, types.NewClearStaleMarketProposal("title", "description", "invalid"))
+	suite.Require().ErrorIs(err, types.ErrInvalidMarket)
+
+	// oracles agree on a new price outside of the allowed change
+	suite.postPrices(2, "2.00")
+	suite.Require().True(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+	suite.postPrices(2, "2.00")
+	suite.Require().True(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+
+	// clearing the flag accepts the new price
+	err = keeper.HandleClearStaleMarketProposal(suite.ctx, suite.keeper, types.NewClearStaleMarketProposal("title", "description", "tstusd"))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+	suite.requirePrice("2.00")
+
+	suite.postPrices(2, "2.00")
+	suite.Require().False(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+}
+
+func (suite *staleTestSuite) TestMarketRemoved() {
+	suite.keeper.SetMarketStale(suite.ctx, "tstusd", types.StaleReasonMaxPriceChange)
+
+	suite.keeper.ClearRemovedStaleMarkets(suite.ctx)
+	suite.Require().True(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{}))
+	suite.keeper.ClearRemovedStaleMarkets(suite.ctx)
+	suite.Require().False(suite.keeper.IsMarketStale(suite.ctx, "tstusd"))
+	suite.Require().Empty(suite.keeper.GetStaleMarkets(suite.ctx))
+}
+
+func TestStaleTestSuite(t *testing.T) {
+	suite.Run(t, new(staleTestSuite))
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/mokitanetwork/aether/x/pricefeed/types"
+)
+
+// SetPriceSnapshot stores the price of a market at a given block time
+func (k Keeper) SetPriceSnapshot(ctx sdk.Context, snapshot types.PriceSnapshot) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceSnapshotKey(snapshot.MarketID, snapshot.Time), k.cdc.MustMarshal(&snapshot))
+}
+
+// IteratePriceSnapshots iterates over the price history of a market in ascending time order
+func (k Keeper) IteratePriceSnapshots(ctx sdk.Context, marketID string, cb func(snapshot types.PriceSnapshot) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// IterateAllPriceSnapshots iterates over the price history of all markets
+func (k Keeper) IterateAllPriceSnapshots(ctx sdk.Context, cb func(snapshot types.PriceSnapshot) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceSnapshotPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetPriceSnapshots returns the price history of a market in ascending time order
+func (k Keeper) GetPriceSnapshots(ctx sdk.Context, marketID string) types.PriceSnapshots {
+	var snapshots types.PriceSnapshots
+	k.IteratePriceSnapshots(ctx, marketID, func(snapshot types.PriceSnapshot) (stop bool) {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return snapshots
+}
+
+// GetAllPriceSnapshots returns the price history of all markets
+func (k Keeper) GetAllPriceSnapshots(ctx sdk.Context) types.PriceSnapshots {
+	var snapshots types.PriceSnapshots
+	k.IterateAllPriceSnapshots(ctx, func(snapshot types.PriceSnapshot) (stop bool) {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+	return snapshots
+}
+
+// getPriceSnapshotAtOrBefore returns the most recent snapshot of a market recorded at or before the input time
+func (k Keeper) getPriceSnapshotAtOrBefore(ctx sdk.Context, marketID string, t time.Time) (types.PriceSnapshot, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+	iterator := store.ReverseIterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(t)))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceSnapshot{}, false
+	}
+	var snapshot types.PriceSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return snapshot, true
+}
+
+// getEarliestPriceSnapshot returns the oldest snapshot of a market
+func (k Keeper) getEarliestPriceSnapshot(ctx sdk.Context, marketID string) (types.PriceSnapshot, bool) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceSnapshot{}, false
+	}
+	var snapshot types.PriceSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return snapshot, true
+}
+
+// GetTWAPPrice returns the time-weighted average price of a market. Markets
+// that no longer exist or no longer track a TWAP have no valid TWAP.
+func (k Keeper) GetTWAPPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found || market.TwapWindow == 0 {
+		return types.CurrentPrice{}, types.ErrNoValidPrice
+	}
+
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.TWAPPriceKey(marketID))
+
+	if bz == nil {
+		return types.CurrentPrice{}, types.ErrNoValidPrice
+	}
+	var price types.CurrentPrice
+	if err := k.cdc.Unmarshal(bz, &price); err != nil {
+		return types.CurrentPrice{}, err
+	}
+	if price.Price.Equal(sdk.ZeroDec()) {
+		return types.CurrentPrice{}, types.ErrNoValidPrice
+	}
+	return price, nil
+}
+
+func (k Keeper) setTWAPPrice(ctx sdk.Context, marketID string, price types.CurrentPrice) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.TWAPPriceKey(marketID), k.cdc.MustMarshal(&price))
+}
+
+// ClearTWAP deletes the stored TWAP and the price history of a market
+func (k Keeper) ClearTWAP(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.TWAPPriceKey(marketID))
+
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, types.PriceSnapshotIteratorKey(marketID))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ClearDisabledTWAPs deletes the TWAP state of markets that have been removed
+// from params or no longer have a TWAP window.
+func (k Keeper) ClearDisabledTWAPs(ctx sdk.Context) {
+	var marketIDs []string
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.TWAPPricePrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		marketIDs = append(marketIDs, string(iterator.Key()[len(types.TWAPPricePrefix):]))
+	}
+	iterator.Close()
+
+	for _, marketID := range marketIDs {
+		market, found := k.GetMarket(ctx, marketID)
+		if !found || market.TwapWindow == 0 {
+			k.ClearTWAP(ctx, marketID)
+		}
+	}
+}
+
+// UpdateTWAP records the current price of a market in its price history, prunes
+// snapshots that fall outside the market's TWAP window and stores the resulting
+// time-weighted average price. Markets without a TWAP window have their TWAP
+// state cleared.
+func (k Keeper) UpdateTWAP(ctx sdk.Context, marketID string, price sdk.Dec) error {
+	market, ok := k.GetMarket(ctx, marketID)
+	if !ok {
+		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if market.TwapWindow == 0 {
+		k.ClearTWAP(ctx, marketID)
+		return nil
+	}
+
+	now := ctx.BlockTime()
+	cumulativePrice := sdk.ZeroDec()
+	if last, found := k.getPriceSnapshotAtOrBefore(ctx, marketID, now); found {
+		cumulativePrice = last.CumulativePrice.Add(last.Price.MulInt64(int64(now.Sub(last.Time))))
+	}
+	current := types.NewPriceSnapshot(marketID, price, now, cumulativePrice)
+	k.SetPriceSnapshot(ctx, current)
+
+	windowStart := now.Add(-market.TwapWindow)
+	k.prunePriceSnapshots(ctx, marketID, windowStart)
+
+	// The TWAP is the difference in cumulative price over the window divided by
+	// its length. When the history is shorter than the window, the average is
+	// taken from the oldest snapshot.
+	startCumulativePrice := sdk.ZeroDec()
+	var elapsed time.Duration
+	if start, found := k.getPriceSnapshotAtOrBefore(ctx, marketID, windowStart); found {
+		startCumulativePrice = start.CumulativePrice.Add(start.Price.MulInt64(int64(windowStart.Sub(start.Time))))
+		elapsed = market.TwapWindow
+	} else {
+		earliest, _ := k.getEarliestPriceSnapshot(ctx, marketID)
+		startCumulativePrice = earliest.CumulativePrice
+		elapsed = now.Sub(earliest.Time)
+	}
+
+	twap := price
+	if elapsed > 0 {
+		twap = current.CumulativePrice.Sub(startCumulativePrice).QuoInt64(int64(elapsed))
+	}
+	k.setTWAPPrice(ctx, marketID, types.NewCurrentPrice(marketID, twap))
+	return nil
+}
+
+// prunePriceSnapshots deletes the snapshots of a market that are no longer
+// needed to calculate the TWAP of a window starting at windowStart. The most
+// recent snapshot at or before the window start is kept, as it defines the
+// price at the beginning of the window.
+func (k Keeper) prunePriceSnapshots(ctx sdk.Context, marketID string, windowStart time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceSnapshotIteratorKey(marketID))
+
+	var keys [][]byte
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(windowStart)))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i := 0; i+1 < len(keys); i++ {
+		store.Delete(keys[i])
+	}
+}
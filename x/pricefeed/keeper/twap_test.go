@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/mokitanetwork/aether/app"
+	"github.com/mokitanetwork/aether/x/pricefeed/keeper"
+	"github.com/mokitanetwork/aether/x/pricefeed/types"
+)
+
+type twapTestSuite struct {
+	suite.Suite
+
+	tApp   app.TestApp
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	addrs  []sdk.AccAddress
+	start  time.Time
+}
+
+func (suite *twapTestSuite) SetupTest() {
+	suite.tApp = app.NewTestApp()
+	suite.start = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.tApp.NewContext(true, tmprototypes.Header{}).WithBlockTime(suite.start)
+	suite.keeper = suite.tApp.GetPriceFeedKeeper()
+	_, suite.addrs = app.GeneratePrivKeyAddressPairs(1)
+
+	suite.setTWAPWindow(time.Hour)
+}
+
+func (suite *twapTestSuite) setTWAPWindow(window time.Duration) {
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{
+		{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, TwapWindow: window},
+	}))
+}
+
+// postAndUpdate advances the block time to the input offset from the start, posts a price and updates the current price
+func (suite *twapTestSuite) postAndUpdate(offset time.Duration, price string) {
+	suite.ctx = suite.ctx.WithBlockTime(suite.start.Add(offset))
+	_, err := suite.keeper.SetPrice(suite.ctx, suite.addrs[0], "tstusd", sdk.MustNewDecFromStr(price), suite.ctx.BlockTime().Add(time.Hour*24))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"))
+}
+
+func (suite *twapTestSuite) requireTWAP(expected string) {
+	twap, err := suite.keeper.GetTWAPPrice(suite.ctx, "tstusd")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr(expected), twap.Price)
+}
+
+func (suite *twapTestSuite) TestUpdateTWAP() {
+	// a single snapshot covers no time, so the TWAP is the current price
+	suite.postAndUpdate(0, "1.00")
+	suite.requireTWAP("1.00")
+
+	// price of 1.00 held for 30 minutes
+	suite.postAndUpdate(30*time.Minute, "4.00")
+	suite.requireTWAP("1.00")
+
+	// 1.00 for 30 minutes, 4.00 for 30 minutes
+	suite.postAndUpdate(time.Hour, "4.00")
+	suite.requireTWAP("2.50")
+
+	// window now starts at 0:45, so 4.00 for the whole hour and the first snapshot is pruned
+	suite.postAndUpdate(105*time.Minute, "4.00")
+	suite.requireTWAP("4.00")
+	suite.Require().Len(suite.keeper.GetPriceSnapshots(suite.ctx, "tstusd"), 3)
+
+	// the TWAP can be referenced as a market through GetCurrentPrice
+	price, err := suite.keeper.GetCurrentPrice(suite.ctx, types.TWAPMarketID("tstusd"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewCurrentPrice("tstusd:twap", sdk.MustNewDecFromStr("4.00")), price)
+}
+
+func (suite *twapTestSuite) TestUpdateTWAP_WindowStartBetweenSnapshots() {
+	suite.postAndUpdate(0, "10.00")
+	suite.postAndUpdate(75*time.Minute, "2.00")
+	suite.postAndUpdate(90*time.Minute, "2.00")
+
+	// window starts at 0:30, 10.00 is held for 45 minutes and 2.00 for 15 minutes
+	suite.requireTWAP("8.00")
+}
+
+func (suite *twapTestSuite) TestUpdateTWAP_SubSecondBlocks() {
+	suite.postAndUpdate(0, "1.00")
+
+	// a price posted in a sub-second block does not immediately become the TWAP
+	suite.postAndUpdate(400*time.Millisecond, "5.00")
+	suite.requireTWAP("1.00")
+
+	// 1.00 for 0.4s, 5.00 for 1.1s
+	suite.postAndUpdate(1500*time.Millisecond, "5.00")
+	suite.requireTWAP("3.933333333333333333")
+}
+
+func (suite *twapTestSuite) TestUpdateTWAP_PriceGap() {
+	suite.postAndUpdate(0, "1.00")
+	suite.postAndUpdate(time.Minute, "1.00")
+
+	// all prices expire
+	suite.ctx = suite.ctx.WithBlockTime(suite.start.Add(time.Hour * 25))
+	suite.Require().ErrorIs(suite.keeper.SetCurrentPrices(suite.ctx, "tstusd"), types.ErrNoValidPrice)
+	_, err := suite.keeper.GetCurrentPrice(suite.ctx, "tstusd:twap")
+	suite.Require().ErrorIs(err, types.ErrNoValidPrice)
+	suite.Require().Empty(suite.keeper.GetPriceSnapshots(suite.ctx, "tstusd"))
+
+	// once prices resume the pre-gap price is not held across the gap
+	suite.postAndUpdate(time.Hour*26, "3.00")
+	suite.requireTWAP("3.00")
+	suite.postAndUpdate(time.Hour*26+time.Minute, "3.00")
+	suite.requireTWAP("3.00")
+}
+
+func (suite *twapTestSuite) TestTWAPDisabled() {
+	suite.postAndUpdate(0, "1.00")
+	suite.postAndUpdate(time.Minute, "2.00")
+
+	suite.setTWAPWindow(0)
+	_, err := suite.keeper.GetCurrentPrice(suite.ctx, "tstusd:twap")
+	suite.Require().ErrorIs(err, types.ErrNoValidPrice)
+
+	suite.keeper.ClearDisabledTWAPs(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetPriceSnapshots(suite.ctx, "tstusd"))
+
+	// re-enabling the window starts a new history
+	suite.setTWAPWindow(time.Hour)
+	_, err = suite.keeper.GetTWAPPrice(suite.ctx, "tstusd")
+	suite.Require().ErrorIs(err, types.ErrNoValidPrice)
+	suite.postAndUpdate(2*time.Minute, "5.00")
+	suite.requireTWAP("5.00")
+}
+
+func (suite *twapTestSuite) TestTWAPMarketRemoved() {
+	suite.postAndUpdate(0, "1.00")
+	suite.postAndUpdate(time.Minute, "2.00")
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.Market{}))
+	_, err := suite.keeper.GetCurrentPrice(suite.ctx, "tstusd:twap")
+	suite.Require().ErrorIs(err, types.ErrNoValidPrice)
+
+	suite.keeper.ClearDisabledTWAPs(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetPriceSnapshots(suite.ctx, "tstusd"))
+}
+
+func TestTWAPTestSuite(t *testing.T) {
+	suite.Run(t, new(twapTestSuite))
+}
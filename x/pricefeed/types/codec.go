@@ -8,17 +8,26 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers all the necessary types and interfaces for the
 // governance module.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPostPrice{}, "pricefeed/MsgPostPrice", nil)
+	cdc.RegisterConcrete(&MsgPostPrices{}, "pricefeed/MsgPostPrices", nil)
+	cdc.RegisterConcrete(&ClearStaleMarketProposal{}, "pricefeed/ClearStaleMarketProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPostPrice{},
+		&MsgPostPrices{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ClearStaleMarketProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -19,4 +19,6 @@ var (
 	ErrInvalidOracle = sdkerrors.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = sdkerrors.Register(ModuleName, 7, "asset not found")
+	// ErrMarketNotStale error for clearing the stale flag of a market that is not stale
+	ErrMarketNotStale = sdkerrors.Register(ModuleName, 8, "market is not stale")
 )
@@ -2,13 +2,20 @@ package types
 
 // Pricefeed module event types
 const (
-	EventTypeMarketPriceUpdated = "market_price_updated"
-	EventTypeOracleUpdatedPrice = "oracle_updated_price"
-	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketPriceUpdated  = "market_price_updated"
+	EventTypeOracleUpdatedPrice  = "oracle_updated_price"
+	EventTypeNoValidPrices       = "no_valid_prices"
+	EventTypeMarketStale         = "market_stale"
+	EventTypeMarketStaleCleared  = "market_stale_cleared"
+	EventTypeOraclePriceExcluded = "oracle_price_excluded"
 
 	AttributeValueCategory = ModuleName
 	AttributeMarketID      = "market_id"
 	AttributeMarketPrice   = "market_price"
 	AttributeOracle        = "oracle"
 	AttributeExpiry        = "expiry"
+	AttributeReason        = "reason"
+
+	AttributeValueConverged = "converged"
+	AttributeValueProposal  = "proposal"
 )
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swaptypes "github.com/mokitanetwork/aether/x/swap/types"
+)
+
+// SwapKeeper defines the expected swap keeper for pool markets
+type SwapKeeper interface {
+	GetPool(ctx sdk.Context, poolID string) (swaptypes.PoolRecord, bool)
+}
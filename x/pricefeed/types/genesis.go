@@ -1,10 +1,15 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, ps []PriceSnapshot, sms []StaleMarket, osl []OracleStats) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:         p,
+		PostedPrices:   pp,
+		PriceSnapshots: ps,
+		StaleMarkets:   sms,
+		OracleStats:    osl,
 	}
 }
 
@@ -13,6 +18,9 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		[]PriceSnapshot{},
+		[]StaleMarket{},
+		[]OracleStats{},
 	)
 }
 
@@ -23,5 +31,30 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.PriceSnapshots.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.StaleMarkets.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.OracleStats.Validate(); err != nil {
+		return err
+	}
+
+	markets := make(map[string]bool)
+	for _, market := range gs.Params.Markets {
+		markets[market.MarketID] = true
+	}
+	for _, sm := range gs.StaleMarkets {
+		if !markets[sm.MarketID] {
+			return fmt.Errorf("stale market %s not found in params", sm.MarketID)
+		}
+	}
+	return nil
 }
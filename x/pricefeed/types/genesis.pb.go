@@ -28,13 +28,19 @@ type GenesisState struct {
 	// params defines all the paramaters of the module.
 	Params       Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	PostedPrices PostedPrices `protobuf:"bytes,2,rep,name=posted_prices,json=postedPrices,proto3,castrepeated=PostedPrices" json:"posted_prices"`
+	// price_snapshots is the price history of markets that track a TWAP.
+	PriceSnapshots PriceSnapshots `protobuf:"bytes,3,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	// stale_markets are the markets flagged as stale by the circuit breaker.
+	StaleMarkets StaleMarkets `protobuf:"bytes,4,rep,name=stale_markets,json=staleMarkets,proto3,castrepeated=StaleMarkets" json:"stale_markets"`
+	// oracle_stats are the reliability statistics of each market's oracles.
+	OracleStats OracleStatsList `protobuf:"bytes,5,rep,name=oracle_stats,json=oracleStats,proto3,castrepeated=OracleStatsList" json:"oracle_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fffec798191784d2, []int{0}
+	return fileDescriptor_f87312eb099745fe, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,33 +83,61 @@ func (m *GenesisState) GetPostedPrices() PostedPrices {
 	return nil
 }
 
+func (m *GenesisState) GetPriceSnapshots() PriceSnapshots {
+	if m != nil {
+		return m.PriceSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStaleMarkets() StaleMarkets {
+	if m != nil {
+		return m.StaleMarkets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleStats() OracleStatsList {
+	if m != nil {
+		return m.OracleStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "aeth.pricefeed.v1beta1.GenesisState")
 }
 
 func init() {
-	proto.RegisterFile("aeth/pricefeed/v1beta1/genesis.proto", fileDescriptor_fffec798191784d2)
+	proto.RegisterFile("aeth/pricefeed/v1beta1/genesis.proto", fileDescriptor_f87312eb099745fe)
 }
 
-var fileDescriptor_fffec798191784d2 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x2f, 0x28, 0xca, 0x4c, 0x4e, 0x4d, 0x4b, 0x4d, 0x4d, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x03, 0xa9, 0xd2, 0x83, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x94, 0x70, 0x98, 0x59, 0x5c, 0x92, 0x5f, 0x94,
-	0x0a, 0x51, 0xa3, 0xb4, 0x86, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x0d, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x9c, 0x1e, 0x76, 0x3b, 0xf5, 0x02, 0xc0, 0xaa, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0xea, 0x11, 0x8a, 0xe3, 0xe2, 0x2d, 0xc8, 0x2f, 0x2e, 0x49, 0x4d, 0x89, 0x07, 0x6b, 0x28,
-	0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0x69, 0x08, 0x58, 0x71, 0x00, 0x48, 0xdc,
-	0x49, 0x04, 0x64, 0xd2, 0xaa, 0xfb, 0xf2, 0x3c, 0x48, 0x82, 0xc5, 0x41, 0x3c, 0x05, 0x48, 0x3c,
-	0x27, 0xdf, 0x07, 0x0f, 0xe5, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0x90, 0xa5, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x60, 0x96, 0x7e, 0x05, 0x52, 0x58, 0x94, 0x54,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xf3,
-	0x1e, 0xef, 0x7e, 0x01, 0x00, 0x00,
+var fileDescriptor_f87312eb099745fe = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x0e, 0xd2, 0x40,
+	0x10, 0xc6, 0x5b, 0x41, 0x0e, 0xa5, 0x42, 0xd2, 0x10, 0x6c, 0x38, 0x2c, 0x04, 0x35, 0xe1, 0xd4,
+	0x06, 0xbc, 0x7a, 0xea, 0xc5, 0x8b, 0x46, 0xd2, 0xde, 0x3c, 0xd8, 0x2c, 0x30, 0x94, 0x06, 0xca,
+	0x6e, 0x76, 0xc6, 0x7f, 0x6f, 0xe1, 0x63, 0x18, 0x9f, 0x84, 0x23, 0x17, 0x13, 0x4f, 0x8a, 0xe5,
+	0x45, 0xcc, 0x2e, 0x8d, 0x96, 0x44, 0xb8, 0x75, 0xbe, 0xfc, 0xe6, 0xfb, 0xfa, 0x65, 0xc7, 0x79,
+	0xca, 0x81, 0x36, 0xa1, 0x54, 0xf9, 0x12, 0xd6, 0x00, 0xab, 0xf0, 0xc3, 0x74, 0x01, 0xc4, 0xa7,
+	0x61, 0x06, 0x7b, 0xc0, 0x1c, 0x03, 0xa9, 0x04, 0x09, 0xaf, 0xaf, 0xa9, 0xe0, 0x2f, 0x15, 0x54,
+	0xd4, 0xa0, 0x97, 0x89, 0x4c, 0x18, 0x24, 0xd4, 0x5f, 0x17, 0x7a, 0x30, 0xbe, 0xe1, 0x89, 0x24,
+	0x14, 0x5c, 0x98, 0xf1, 0xf7, 0x86, 0xe3, 0xbe, 0xbc, 0x64, 0x24, 0xc4, 0x09, 0xbc, 0x17, 0x4e,
+	0x4b, 0x72, 0xc5, 0x0b, 0xf4, 0xed, 0x91, 0x3d, 0x69, 0xcf, 0x58, 0xf0, 0xff, 0xcc, 0x60, 0x6e,
+	0xa8, 0xa8, 0x79, 0xf8, 0x39, 0xb4, 0xe2, 0x6a, 0xc7, 0x7b, 0xe7, 0x3c, 0x92, 0x02, 0x09, 0x56,
+	0xa9, 0x59, 0x40, 0xff, 0xc1, 0xa8, 0x31, 0x69, 0xcf, 0x9e, 0xdc, 0x34, 0x31, 0xf0, 0x5c, 0xeb,
+	0x51, 0x4f, 0x3b, 0x7d, 0xfb, 0x35, 0x74, 0x6b, 0x22, 0xc6, 0xae, 0xac, 0x4d, 0xde, 0xda, 0xe9,
+	0x1a, 0x93, 0x14, 0xf7, 0x5c, 0xe2, 0x46, 0x10, 0xfa, 0x0d, 0x93, 0xf0, 0xec, 0x66, 0x82, 0x56,
+	0x92, 0x8a, 0x8e, 0xfa, 0x55, 0x46, 0xe7, 0x4a, 0xc6, 0xb8, 0x23, 0xaf, 0x66, 0xdd, 0x03, 0x89,
+	0xef, 0x20, 0x2d, 0xb8, 0xda, 0x02, 0xa1, 0xdf, 0xbc, 0xdf, 0x23, 0xd1, 0xf0, 0x6b, 0xc3, 0xfe,
+	0xeb, 0x51, 0x13, 0x31, 0x76, 0xb1, 0x36, 0x79, 0xa9, 0xe3, 0x0a, 0xc5, 0x97, 0x3b, 0x48, 0x91,
+	0x38, 0xa1, 0xff, 0xf0, 0xbe, 0xfd, 0x1b, 0xc3, 0xea, 0x07, 0xc2, 0xe8, 0x71, 0x65, 0xdf, 0xad,
+	0x89, 0xaf, 0x72, 0xa4, 0xb8, 0x2d, 0x6a, 0x54, 0x72, 0xfa, 0xcd, 0xec, 0xaf, 0x25, 0xb3, 0x0f,
+	0x25, 0xb3, 0x8f, 0x25, 0xb3, 0x4f, 0x25, 0xb3, 0xbf, 0x9c, 0x99, 0x75, 0x3c, 0x33, 0xeb, 0xc7,
+	0x99, 0x59, 0x6f, 0xa7, 0x59, 0x4e, 0x9b, 0xf7, 0x8b, 0x60, 0x29, 0x8a, 0xb0, 0x10, 0xdb, 0x9c,
+	0xf8, 0x1e, 0xe8, 0xa3, 0x50, 0xdb, 0x50, 0xff, 0x04, 0xa8, 0xf0, 0x53, 0xed, 0x74, 0xe8, 0xb3,
+	0x04, 0x5c, 0xb4, 0xcc, 0xcd, 0x3c, 0xff, 0x33, 0x00, 0xcc, 0xc5, 0x38, 0xbb, 0xad, 0x02, 0x00,
+	0x00,
 }
 
 func (this *GenesisState) VerboseEqual(that interface{}) error {
@@ -142,6 +176,30 @@ func (this *GenesisState) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("PostedPrices this[%v](%v) Not Equal that[%v](%v)", i, this.PostedPrices[i], i, that1.PostedPrices[i])
 		}
 	}
+	if len(this.PriceSnapshots) != len(that1.PriceSnapshots) {
+		return fmt.Errorf("PriceSnapshots this(%v) Not Equal that(%v)", len(this.PriceSnapshots), len(that1.PriceSnapshots))
+	}
+	for i := range this.PriceSnapshots {
+		if !this.PriceSnapshots[i].Equal(&that1.PriceSnapshots[i]) {
+			return fmt.Errorf("PriceSnapshots this[%v](%v) Not Equal that[%v](%v)", i, this.PriceSnapshots[i], i, that1.PriceSnapshots[i])
+		}
+	}
+	if len(this.StaleMarkets) != len(that1.StaleMarkets) {
+		return fmt.Errorf("StaleMarkets this(%v) Not Equal that(%v)", len(this.StaleMarkets), len(that1.StaleMarkets))
+	}
+	for i := range this.StaleMarkets {
+		if !this.StaleMarkets[i].Equal(&that1.StaleMarkets[i]) {
+			return fmt.Errorf("StaleMarkets this[%v](%v) Not Equal that[%v](%v)", i, this.StaleMarkets[i], i, that1.StaleMarkets[i])
+		}
+	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return fmt.Errorf("OracleStats this(%v) Not Equal that(%v)", len(this.OracleStats), len(that1.OracleStats))
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return fmt.Errorf("OracleStats this[%v](%v) Not Equal that[%v](%v)", i, this.OracleStats[i], i, that1.OracleStats[i])
+		}
+	}
 	return nil
 }
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -174,6 +232,30 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.PriceSnapshots) != len(that1.PriceSnapshots) {
+		return false
+	}
+	for i := range this.PriceSnapshots {
+		if !this.PriceSnapshots[i].Equal(&that1.PriceSnapshots[i]) {
+			return false
+		}
+	}
+	if len(this.StaleMarkets) != len(that1.StaleMarkets) {
+		return false
+	}
+	for i := range this.StaleMarkets {
+		if !this.StaleMarkets[i].Equal(&that1.StaleMarkets[i]) {
+			return false
+		}
+	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return false
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +278,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleStats) > 0 {
+		for iNdEx := len(m.OracleStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.StaleMarkets) > 0 {
+		for iNdEx := len(m.StaleMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StaleMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PriceSnapshots) > 0 {
+		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PostedPrices) > 0 {
 		for iNdEx := len(m.PostedPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -248,6 +372,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PriceSnapshots) > 0 {
+		for _, e := range m.PriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StaleMarkets) > 0 {
+		for _, e := range m.StaleMarkets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleStats) > 0 {
+		for _, e := range m.OracleStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -353,6 +495,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshots = append(m.PriceSnapshots, PriceSnapshot{})
+			if err := m.PriceSnapshots[len(m.PriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StaleMarkets = append(m.StaleMarkets, StaleMarket{})
+			if err := m.StaleMarkets[len(m.StaleMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleStats = append(m.OracleStats, OracleStats{})
+			if err := m.OracleStats[len(m.OracleStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
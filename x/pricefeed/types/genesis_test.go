@@ -32,9 +32,12 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, sdk.ZeroDec(), 0, sdk.ZeroDec(), nil, nil, "", sdk.ZeroDec()},
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]PriceSnapshot{},
+				[]StaleMarket{},
+				[]OracleStats{},
 			),
 			expPass: true,
 		},
@@ -42,9 +45,12 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, sdk.ZeroDec(), 0, sdk.ZeroDec(), nil, nil, "", sdk.ZeroDec()},
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]PriceSnapshot{},
+				[]StaleMarket{},
+				[]OracleStats{},
 			),
 			expPass: false,
 		},
@@ -52,10 +58,13 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams([]Market{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, sdk.ZeroDec(), 0, sdk.ZeroDec(), nil, nil, "", sdk.ZeroDec()},
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, sdk.ZeroDec(), 0, sdk.ZeroDec(), nil, nil, "", sdk.ZeroDec()},
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				[]PriceSnapshot{},
+				[]StaleMarket{},
+				[]OracleStats{},
 			),
 			expPass: false,
 		},
@@ -64,6 +73,9 @@ func TestGenesisStateValidate(t *testing.T) {
 			genesisState: NewGenesisState(
 				NewParams([]Market{}),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				[]PriceSnapshot{},
+				[]StaleMarket{},
+				[]OracleStats{},
 			),
 			expPass: false,
 		},
@@ -75,6 +87,131 @@ func TestGenesisStateValidate(t *testing.T) {
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				[]PriceSnapshot{},
+				[]StaleMarket{},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid price snapshots",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceSnapshot{
+					NewPriceSnapshot("xrp", sdk.OneDec(), now, sdk.ZeroDec()),
+					NewPriceSnapshot("xrp", sdk.OneDec(), now.Add(time.Minute), sdk.NewDec(60e9)),
+				},
+				[]StaleMarket{},
+				[]OracleStats{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid price snapshot",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceSnapshot{NewPriceSnapshot("xrp", sdk.OneDec(), now, sdk.NewDec(-1))},
+				[]StaleMarket{},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated price snapshot",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceSnapshot{
+					NewPriceSnapshot("xrp", sdk.OneDec(), now, sdk.ZeroDec()),
+					NewPriceSnapshot("xrp", sdk.NewDec(2), now, sdk.ZeroDec()),
+				},
+				[]StaleMarket{},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid stale market",
+			genesisState: NewGenesisState(
+				NewParams([]Market{
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, sdk.MustNewDecFromStr("0.1"), 2, sdk.ZeroDec(), nil, nil, "", sdk.ZeroDec()},
+				}),
+				[]PostedPrice{},
+				[]PriceSnapshot{},
+				[]StaleMarket{NewStaleMarket("market", StaleReasonMaxPriceChange)},
+				[]OracleStats{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "stale market not in params",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceSnapshot{},
+				[]StaleMarket{NewStaleMarket("market", StaleReasonMaxPriceChange)},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid stale market reason",
+			genesisState: NewGenesisState(
+				NewParams([]Market{
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, sdk.MustNewDecFromStr("0.1"), 2, sdk.ZeroDec(), nil, nil, "", sdk.ZeroDec()},
+				}),
+				[]PostedPrice{},
+				[]PriceSnapshot{},
+				[]StaleMarket{NewStaleMarket("market", "")},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated stale market",
+			genesisState: NewGenesisState(
+				NewParams([]Market{
+					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true, 0, sdk.MustNewDecFromStr("0.1"), 2, sdk.ZeroDec(), nil, nil, "", sdk.ZeroDec()},
+				}),
+				[]PostedPrice{},
+				[]PriceSnapshot{},
+				[]StaleMarket{NewStaleMarket("market", StaleReasonMaxPriceChange), NewStaleMarket("market", StaleReasonMinOracles)},
+				[]OracleStats{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceSnapshot{},
+				[]StaleMarket{},
+				[]OracleStats{NewOracleStats("market", addr, 3, 2, 1)},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceSnapshot{},
+				[]StaleMarket{},
+				[]OracleStats{NewOracleStats("market", sdk.AccAddress{}, 3, 2, 1)},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated oracle stats",
+			genesisState: NewGenesisState(
+				NewParams([]Market{}),
+				[]PostedPrice{},
+				[]PriceSnapshot{},
+				[]StaleMarket{},
+				[]OracleStats{NewOracleStats("market", addr, 3, 2, 1), NewOracleStats("market", addr, 0, 0, 0)},
 			),
 			expPass: false,
 		},
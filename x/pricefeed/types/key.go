@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName The name that will be used throughout the module
@@ -25,13 +30,82 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// PriceSnapshotPrefix prefix for the price history of a market
+	PriceSnapshotPrefix = []byte{0x02}
+
+	// TWAPPricePrefix prefix for the time-weighted average price of a market
+	TWAPPricePrefix = []byte{0x03}
+
+	// StaleMarketPrefix prefix for markets flagged as stale by the circuit breaker
+	StaleMarketPrefix = []byte{0x04}
+
+	// OracleStatsPrefix prefix for the reliability statistics of a market's oracles
+	OracleStatsPrefix = []byte{0x05}
 )
 
+// TWAPMarketIDSuffix is appended to a market ID to reference the time-weighted
+// average price of that market instead of its current median price.
+const TWAPMarketIDSuffix = ":twap"
+
+// TWAPMarketID returns the market ID that references the TWAP of a market
+func TWAPMarketID(marketID string) string {
+	return marketID + TWAPMarketIDSuffix
+}
+
+// ParseTWAPMarketID returns the underlying market ID of a TWAP market ID, and
+// false if the input does not reference a TWAP
+func ParseTWAPMarketID(marketID string) (string, bool) {
+	if !strings.HasSuffix(marketID, TWAPMarketIDSuffix) {
+		return "", false
+	}
+	return strings.TrimSuffix(marketID, TWAPMarketIDSuffix), true
+}
+
 // CurrentPriceKey returns the prefix for the current price
 func CurrentPriceKey(marketID string) []byte {
 	return append(CurrentPricePrefix, []byte(marketID)...)
 }
 
+// PriceSnapshotIteratorKey returns the prefix for the price history of a single market
+func PriceSnapshotIteratorKey(marketID string) []byte {
+	return append(
+		PriceSnapshotPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// PriceSnapshotKey returns the key for a price snapshot of a market at a given time
+func PriceSnapshotKey(marketID string, t time.Time) []byte {
+	return append(PriceSnapshotIteratorKey(marketID), sdk.FormatTimeBytes(t)...)
+}
+
+// TWAPPriceKey returns the key for the time-weighted average price of a market
+func TWAPPriceKey(marketID string) []byte {
+	return append(TWAPPricePrefix, []byte(marketID)...)
+}
+
+// StaleMarketKey returns the key for the stale flag of a market
+func StaleMarketKey(marketID string) []byte {
+	return append(StaleMarketPrefix, []byte(marketID)...)
+}
+
+// OracleStatsIteratorKey returns the prefix for the oracle stats of a single market
+func OracleStatsIteratorKey(marketID string) []byte {
+	return append(
+		OracleStatsPrefix,
+		lengthPrefixWithByte([]byte(marketID))...,
+	)
+}
+
+// OracleStatsKey returns the key for the stats of an oracle for a market
+func OracleStatsKey(marketID string, oracleAddr sdk.AccAddress) []byte {
+	return append(
+		OracleStatsIteratorKey(marketID),
+		lengthPrefixWithByte(oracleAddr)...,
+	)
+}
+
 // RawPriceIteratorKey returns the prefix for the raw price for a single market
 func RawPriceIteratorKey(marketID string) []byte {
 	return append(
@@ -12,11 +12,14 @@ import (
 // NewMarket returns a new Market
 func NewMarket(id, base, quote string, oracles []sdk.AccAddress, active bool) Market {
 	return Market{
-		MarketID:   id,
-		BaseAsset:  base,
-		QuoteAsset: quote,
-		Oracles:    oracles,
-		Active:     active,
+		MarketID:              id,
+		BaseAsset:             base,
+		QuoteAsset:            quote,
+		Oracles:               oracles,
+		Active:                active,
+		MaxPriceChange:        sdk.ZeroDec(),
+		OutlierBand:           sdk.ZeroDec(),
+		MaxReferenceDeviation: sdk.ZeroDec(),
 	}
 }
 
@@ -31,6 +34,72 @@ func (m Market) Validate() error {
 	if err := sdk.ValidateDenom(m.QuoteAsset); err != nil {
 		return fmt.Errorf("invalid quote asset: %w", err)
 	}
+	if _, ok := ParseTWAPMarketID(m.MarketID); ok {
+		return fmt.Errorf("market id cannot end with %s", TWAPMarketIDSuffix)
+	}
+	if m.TwapWindow < 0 {
+		return fmt.Errorf("twap window cannot be negative %s", m.TwapWindow)
+	}
+	if !m.MaxPriceChange.IsNil() && m.MaxPriceChange.IsNegative() {
+		return fmt.Errorf("max price change cannot be negative %s", m.MaxPriceChange)
+	}
+	if !m.OutlierBand.IsNil() && m.OutlierBand.IsNegative() {
+		return fmt.Errorf("outlier band cannot be negative %s", m.OutlierBand)
+	}
+	if m.IsDerived() && m.IsPoolMarket() {
+		return fmt.Errorf("market %s cannot be both derived and a pool market", m.MarketID)
+	}
+	if m.IsPoolMarket() {
+		if err := m.PoolPriceSource.Validate(); err != nil {
+			return err
+		}
+		// pool prices are not posted, so the checks on posted prices do not apply
+		if len(m.Oracles) > 0 {
+			return fmt.Errorf("pool market %s cannot have oracles", m.MarketID)
+		}
+		if m.MinOracles > 0 {
+			return fmt.Errorf("pool market %s cannot have min oracles", m.MarketID)
+		}
+		if m.HasOutlierBand() {
+			return fmt.Errorf("pool market %s cannot have an outlier band", m.MarketID)
+		}
+	}
+	if m.ReferenceMarketID != "" {
+		referenceMarketID := m.ReferenceMarketID
+		if twapMarketID, ok := ParseTWAPMarketID(referenceMarketID); ok {
+			referenceMarketID = twapMarketID
+		}
+		if strings.TrimSpace(referenceMarketID) == "" {
+			return errors.New("reference market id cannot be blank")
+		}
+		if referenceMarketID == m.MarketID {
+			return fmt.Errorf("market %s cannot reference itself", m.MarketID)
+		}
+	}
+	if !m.MaxReferenceDeviation.IsNil() && m.MaxReferenceDeviation.IsNegative() {
+		return fmt.Errorf("max reference deviation cannot be negative %s", m.MaxReferenceDeviation)
+	}
+	if m.HasMaxReferenceDeviation() && m.ReferenceMarketID == "" {
+		return fmt.Errorf("market %s with a max reference deviation must have a reference market", m.MarketID)
+	}
+	if m.IsDerived() {
+		if len(m.SourceMarkets) < 2 {
+			return fmt.Errorf("derived market %s must have at least 2 source markets", m.MarketID)
+		}
+		if err := m.SourceMarkets.Validate(); err != nil {
+			return err
+		}
+		// derived prices are not posted, so the checks on posted prices do not apply
+		if len(m.Oracles) > 0 {
+			return fmt.Errorf("derived market %s cannot have oracles", m.MarketID)
+		}
+		if m.MinOracles > 0 {
+			return fmt.Errorf("derived market %s cannot have min oracles", m.MarketID)
+		}
+		if m.HasOutlierBand() {
+			return fmt.Errorf("derived market %s cannot have an outlier band", m.MarketID)
+		}
+	}
 	seenOracles := make(map[string]bool)
 	for i, oracle := range m.Oracles {
 		if len(oracle) == 0 {
@@ -46,7 +115,51 @@ func (m Market) Validate() error {
 
 // ToMarketResponse returns a new MarketResponse from a Market
 func (m Market) ToMarketResponse() MarketResponse {
-	return NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	resp := NewMarketResponse(m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	resp.TwapWindow = m.TwapWindow
+	resp.MaxPriceChange = m.MaxPriceChange
+	resp.MinOracles = m.MinOracles
+	resp.OutlierBand = m.OutlierBand
+	resp.SourceMarkets = m.SourceMarkets
+	resp.PoolPriceSource = m.PoolPriceSource
+	resp.ReferenceMarketID = m.ReferenceMarketID
+	resp.MaxReferenceDeviation = m.MaxReferenceDeviation
+	return resp
+}
+
+// HasMaxPriceChange returns true if the market limits the change in its price per block
+func (m Market) HasMaxPriceChange() bool {
+	return !m.MaxPriceChange.IsNil() && m.MaxPriceChange.IsPositive()
+}
+
+// HasOutlierBand returns true if the market excludes prices that deviate too far from the median
+func (m Market) HasOutlierBand() bool {
+	return !m.OutlierBand.IsNil() && m.OutlierBand.IsPositive()
+}
+
+// IsDerived returns true if the market's price is computed from other markets instead of posted by oracles
+func (m Market) IsDerived() bool {
+	return len(m.SourceMarkets) > 0
+}
+
+// IsPoolMarket returns true if the market's price is computed from a swap pool instead of posted by oracles
+func (m Market) IsPoolMarket() bool {
+	return m.PoolPriceSource != nil
+}
+
+// HasMaxReferenceDeviation returns true if the market limits the deviation of its price from its reference market
+func (m Market) HasMaxReferenceDeviation() bool {
+	return !m.MaxReferenceDeviation.IsNil() && m.MaxReferenceDeviation.IsPositive()
+}
+
+// HasOracle returns true if the address is one of the market's oracles
+func (m Market) HasOracle(address sdk.AccAddress) bool {
+	for _, oracle := range m.Oracles {
+		if oracle.Equals(address) {
+			return true
+		}
+	}
+	return false
 }
 
 // Markets is a slice of Market
@@ -65,6 +178,180 @@ func (ms Markets) Validate() error {
 		}
 		seenMarkets[m.MarketID] = true
 	}
+	return ms.validateSourceMarkets()
+}
+
+// validateSourceMarkets checks that the sources of derived markets and the reference markets
+// are markets in the list, that active markets have active sources and references, and that no
+// market depends on itself.
+func (ms Markets) validateSourceMarkets() error {
+	markets := make(map[string]Market, len(ms))
+	for _, m := range ms {
+		markets[m.MarketID] = m
+	}
+	for _, m := range ms {
+		for _, source := range m.SourceMarkets {
+			sourceMarket, found := markets[source.MarketID]
+			if !found {
+				return fmt.Errorf("source market %s of %s not found", source.MarketID, m.MarketID)
+			}
+			if m.Active && !sourceMarket.Active {
+				return fmt.Errorf("source market %s of active market %s is inactive", source.MarketID, m.MarketID)
+			}
+		}
+		if m.ReferenceMarketID == "" {
+			continue
+		}
+		referenceMarketID, isTWAP := ParseTWAPMarketID(m.ReferenceMarketID)
+		if !isTWAP {
+			referenceMarketID = m.ReferenceMarketID
+		}
+		referenceMarket, found := markets[referenceMarketID]
+		if !found {
+			return fmt.Errorf("reference market %s of %s not found", m.ReferenceMarketID, m.MarketID)
+		}
+		if isTWAP && referenceMarket.TwapWindow == 0 {
+			return fmt.Errorf("reference market %s of %s does not track a TWAP", referenceMarketID, m.MarketID)
+		}
+		if m.Active && !referenceMarket.Active {
+			return fmt.Errorf("reference market %s of active market %s is inactive", referenceMarketID, m.MarketID)
+		}
+	}
+	_, err := ms.sortByDependencies()
+	return err
+}
+
+// SortByDependencies returns the markets ordered so that each market follows all of its source
+// and reference markets. Markets must be valid.
+func (ms Markets) SortByDependencies() Markets {
+	sorted, err := ms.sortByDependencies()
+	if err != nil {
+		panic(err)
+	}
+	return sorted
+}
+
+// dependencies returns the ids of the markets a market's price is computed from
+func (m Market) dependencies() []string {
+	var ids []string
+	for _, source := range m.SourceMarkets {
+		ids = append(ids, source.MarketID)
+	}
+	if m.ReferenceMarketID != "" {
+		referenceMarketID, isTWAP := ParseTWAPMarketID(m.ReferenceMarketID)
+		if !isTWAP {
+			referenceMarketID = m.ReferenceMarketID
+		}
+		ids = append(ids, referenceMarketID)
+	}
+	return ids
+}
+
+func (ms Markets) sortByDependencies() (Markets, error) {
+	markets := make(map[string]Market, len(ms))
+	for _, m := range ms {
+		markets[m.MarketID] = m
+	}
+
+	sorted := make(Markets, 0, len(ms))
+	// markets are visiting while their sources are being sorted, and visited once added
+	visiting := make(map[string]bool)
+	visited := make(map[string]bool)
+	var visit func(m Market) error
+	visit = func(m Market) error {
+		if visited[m.MarketID] {
+			return nil
+		}
+		if visiting[m.MarketID] {
+			return fmt.Errorf("market %s depends on itself", m.MarketID)
+		}
+		visiting[m.MarketID] = true
+		for _, id := range m.dependencies() {
+			dependency, found := markets[id]
+			if !found {
+				return fmt.Errorf("market %s of %s not found", id, m.MarketID)
+			}
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		visiting[m.MarketID] = false
+		visited[m.MarketID] = true
+		sorted = append(sorted, m)
+		return nil
+	}
+
+	for _, m := range ms {
+		if err := visit(m); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// NewPoolPriceSource returns a new PoolPriceSource
+func NewPoolPriceSource(baseDenom, quoteDenom string, conversionFactor sdk.Dec, minLiquidity sdk.Int) *PoolPriceSource {
+	return &PoolPriceSource{
+		BaseDenom:        baseDenom,
+		QuoteDenom:       quoteDenom,
+		ConversionFactor: conversionFactor,
+		MinLiquidity:     minLiquidity,
+	}
+}
+
+// Validate performs a basic validation of the pool price source
+func (ps PoolPriceSource) Validate() error {
+	if err := sdk.ValidateDenom(ps.BaseDenom); err != nil {
+		return fmt.Errorf("invalid pool base denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(ps.QuoteDenom); err != nil {
+		return fmt.Errorf("invalid pool quote denom: %w", err)
+	}
+	if ps.BaseDenom == ps.QuoteDenom {
+		return fmt.Errorf("pool base and quote denoms cannot be the same %s", ps.BaseDenom)
+	}
+	if ps.ConversionFactor.IsNil() || !ps.ConversionFactor.IsPositive() {
+		return fmt.Errorf("pool conversion factor must be positive %s", ps.ConversionFactor)
+	}
+	if ps.MinLiquidity.IsNil() || ps.MinLiquidity.IsNegative() {
+		return fmt.Errorf("pool min liquidity cannot be negative %s", ps.MinLiquidity)
+	}
+	return nil
+}
+
+// Price returns the price implied by the reserves of a pool, and false if the pool holds less than
+// the minimum liquidity
+func (ps PoolPriceSource) Price(baseReserves, quoteReserves sdk.Int) (sdk.Dec, bool) {
+	if !baseReserves.IsPositive() || !quoteReserves.IsPositive() || quoteReserves.LT(ps.MinLiquidity) {
+		return sdk.Dec{}, false
+	}
+	price := quoteReserves.ToDec().Quo(baseReserves.ToDec()).Mul(ps.ConversionFactor)
+	return price, price.IsPositive()
+}
+
+// NewSourceMarket returns a new SourceMarket
+func NewSourceMarket(marketID string, invert bool) SourceMarket {
+	return SourceMarket{
+		MarketID: marketID,
+		Invert:   invert,
+	}
+}
+
+// SourceMarkets is a slice of SourceMarket
+type SourceMarkets []SourceMarket
+
+// Validate checks that the source market ids are set and there are no duplicated entries.
+func (sms SourceMarkets) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, sm := range sms {
+		if strings.TrimSpace(sm.MarketID) == "" {
+			return errors.New("source market id cannot be blank")
+		}
+		if seenMarkets[sm.MarketID] {
+			return fmt.Errorf("duplicated source market %s", sm.MarketID)
+		}
+		seenMarkets[sm.MarketID] = true
+	}
 	return nil
 }
 
@@ -164,9 +451,161 @@ func NewPostedPriceResponse(marketID string, oracle sdk.AccAddress, price sdk.De
 // PostedPriceResponses is a slice of PostedPriceResponse
 type PostedPriceResponses []PostedPriceResponse
 
+// NewPriceSnapshot returns a new PriceSnapshot
+func NewPriceSnapshot(marketID string, price sdk.Dec, t time.Time, cumulativePrice sdk.Dec) PriceSnapshot {
+	return PriceSnapshot{
+		MarketID:        marketID,
+		Price:           price,
+		Time:            t,
+		CumulativePrice: cumulativePrice,
+	}
+}
+
+// Validate performs a basic check of a PriceSnapshot params.
+func (ps PriceSnapshot) Validate() error {
+	if strings.TrimSpace(ps.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if ps.Price.IsNil() || ps.Price.IsNegative() {
+		return fmt.Errorf("snapshot price cannot be negative %s", ps.Price)
+	}
+	if ps.CumulativePrice.IsNil() || ps.CumulativePrice.IsNegative() {
+		return fmt.Errorf("snapshot cumulative price cannot be negative %s", ps.CumulativePrice)
+	}
+	if ps.Time.Unix() <= 0 {
+		return errors.New("snapshot time cannot be zero")
+	}
+	return nil
+}
+
+// PriceSnapshots is a slice of PriceSnapshot
+type PriceSnapshots []PriceSnapshot
+
+// Validate checks if all the price snapshots are valid and there are no
+// duplicated entries.
+func (pss PriceSnapshots) Validate() error {
+	seenSnapshots := make(map[string]bool)
+	for _, ps := range pss {
+		if err := ps.Validate(); err != nil {
+			return err
+		}
+		key := ps.MarketID + string(sdk.FormatTimeBytes(ps.Time))
+		if seenSnapshots[key] {
+			return fmt.Errorf("duplicated price snapshot for market id %s at %s", ps.MarketID, ps.Time)
+		}
+		seenSnapshots[key] = true
+	}
+	return nil
+}
+
 // SortDecs provides the interface needed to sort sdk.Dec slices
 type SortDecs []sdk.Dec
 
 func (a SortDecs) Len() int           { return len(a) }
 func (a SortDecs) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a SortDecs) Less(i, j int) bool { return a[i].LT(a[j]) }
+
+// Reasons a market is flagged as stale by the circuit breaker
+const (
+	StaleReasonMaxPriceChange     = "max_price_change"
+	StaleReasonMinOracles         = "min_oracles"
+	StaleReasonOutliers           = "outliers"
+	StaleReasonSourceMarket       = "source_market"
+	StaleReasonReferenceDeviation = "reference_deviation"
+)
+
+// NewStaleMarket returns a new StaleMarket
+func NewStaleMarket(marketID, reason string) StaleMarket {
+	return StaleMarket{
+		MarketID: marketID,
+		Reason:   reason,
+	}
+}
+
+// Validate performs a basic check of a StaleMarket params.
+func (sm StaleMarket) Validate() error {
+	if strings.TrimSpace(sm.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	switch sm.Reason {
+	case StaleReasonMaxPriceChange, StaleReasonMinOracles, StaleReasonOutliers, StaleReasonSourceMarket, StaleReasonReferenceDeviation:
+		return nil
+	default:
+		return fmt.Errorf("invalid stale reason %s", sm.Reason)
+	}
+}
+
+// StaleMarkets is a slice of StaleMarket
+type StaleMarkets []StaleMarket
+
+// Validate checks if all the stale markets are valid and there are no duplicated
+// entries.
+func (sms StaleMarkets) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, sm := range sms {
+		if seenMarkets[sm.MarketID] {
+			return fmt.Errorf("duplicated stale market %s", sm.MarketID)
+		}
+		if err := sm.Validate(); err != nil {
+			return err
+		}
+		seenMarkets[sm.MarketID] = true
+	}
+	return nil
+}
+
+// NewOracleStats returns a new OracleStats
+func NewOracleStats(marketID string, oracle sdk.AccAddress, posts, missedWindows, outliers uint64) OracleStats {
+	return OracleStats{
+		MarketID:      marketID,
+		OracleAddress: oracle,
+		Posts:         posts,
+		MissedWindows: missedWindows,
+		Outliers:      outliers,
+	}
+}
+
+// Validate performs a basic check of an OracleStats params.
+func (stats OracleStats) Validate() error {
+	if strings.TrimSpace(stats.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(stats.OracleAddress) == 0 {
+		return errors.New("oracle address cannot be empty")
+	}
+	return nil
+}
+
+// ToOracleStatsResponse returns a new OracleStatsResponse from an OracleStats
+func (stats OracleStats) ToOracleStatsResponse() OracleStatsResponse {
+	return OracleStatsResponse{
+		MarketID:      stats.MarketID,
+		OracleAddress: stats.OracleAddress.String(),
+		Posts:         stats.Posts,
+		MissedWindows: stats.MissedWindows,
+		Outliers:      stats.Outliers,
+	}
+}
+
+// OracleStatsList is a slice of OracleStats
+type OracleStatsList []OracleStats
+
+// Validate checks if all the oracle stats are valid and there are no
+// duplicated entries.
+func (osl OracleStatsList) Validate() error {
+	seenStats := make(map[string]bool)
+	for _, stats := range osl {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+		key := stats.MarketID + stats.OracleAddress.String()
+		if seenStats[key] {
+			return fmt.Errorf("duplicated oracle stats for market id %s and oracle address %s", stats.MarketID, stats.OracleAddress)
+		}
+		seenStats[key] = true
+	}
+	return nil
+}
+
+// OracleStatsResponses is a slice of OracleStatsResponse
+type OracleStatsResponses []OracleStatsResponse
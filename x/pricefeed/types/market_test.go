@@ -33,6 +33,18 @@ func TestMarketValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid market with twap",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Active:     true,
+				TwapWindow: time.Hour,
+			},
+			true,
+		},
 		{
 			"invalid id",
 			Market{
@@ -40,6 +52,70 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid twap id",
+			Market{
+				MarketID:   "market:twap",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+			},
+			false,
+		},
+		{
+			"negative twap window",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				TwapWindow: -time.Hour,
+			},
+			false,
+		},
+		{
+			"valid market with circuit breaker",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				Oracles:        []sdk.AccAddress{addr},
+				Active:         true,
+				MaxPriceChange: sdk.MustNewDecFromStr("0.1"),
+				MinOracles:     1,
+			},
+			true,
+		},
+		{
+			"negative max price change",
+			Market{
+				MarketID:       "market",
+				BaseAsset:      "xrp",
+				QuoteAsset:     "bnb",
+				MaxPriceChange: sdk.MustNewDecFromStr("-0.1"),
+			},
+			false,
+		},
+		{
+			"valid market with outlier band",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				Oracles:     []sdk.AccAddress{addr},
+				Active:      true,
+				OutlierBand: sdk.MustNewDecFromStr("0.05"),
+			},
+			true,
+		},
+		{
+			"negative outlier band",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				OutlierBand: sdk.MustNewDecFromStr("-0.05"),
+			},
+			false,
+		},
 		{
 			"invalid base asset",
 			Market{
@@ -78,6 +154,186 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid derived market",
+			Market{
+				MarketID:      "atom:usdx",
+				BaseAsset:     "atom",
+				QuoteAsset:    "usdx",
+				Active:        true,
+				SourceMarkets: SourceMarkets{NewSourceMarket("atom:usd", false), NewSourceMarket("usdx:usd", true)},
+			},
+			true,
+		},
+		{
+			"derived market with one source",
+			Market{
+				MarketID:      "atom:usdx",
+				BaseAsset:     "atom",
+				QuoteAsset:    "usdx",
+				SourceMarkets: SourceMarkets{NewSourceMarket("atom:usd", false)},
+			},
+			false,
+		},
+		{
+			"derived market with duplicated source",
+			Market{
+				MarketID:      "atom:usdx",
+				BaseAsset:     "atom",
+				QuoteAsset:    "usdx",
+				SourceMarkets: SourceMarkets{NewSourceMarket("atom:usd", false), NewSourceMarket("atom:usd", true)},
+			},
+			false,
+		},
+		{
+			"derived market with blank source",
+			Market{
+				MarketID:      "atom:usdx",
+				BaseAsset:     "atom",
+				QuoteAsset:    "usdx",
+				SourceMarkets: SourceMarkets{NewSourceMarket("atom:usd", false), NewSourceMarket(" ", true)},
+			},
+			false,
+		},
+		{
+			"derived market with oracles",
+			Market{
+				MarketID:      "atom:usdx",
+				BaseAsset:     "atom",
+				QuoteAsset:    "usdx",
+				Oracles:       []sdk.AccAddress{addr},
+				SourceMarkets: SourceMarkets{NewSourceMarket("atom:usd", false), NewSourceMarket("usdx:usd", true)},
+			},
+			false,
+		},
+		{
+			"derived market with min oracles",
+			Market{
+				MarketID:      "atom:usdx",
+				BaseAsset:     "atom",
+				QuoteAsset:    "usdx",
+				MinOracles:    1,
+				SourceMarkets: SourceMarkets{NewSourceMarket("atom:usd", false), NewSourceMarket("usdx:usd", true)},
+			},
+			false,
+		},
+		{
+			"derived market with outlier band",
+			Market{
+				MarketID:      "atom:usdx",
+				BaseAsset:     "atom",
+				QuoteAsset:    "usdx",
+				OutlierBand:   sdk.MustNewDecFromStr("0.1"),
+				SourceMarkets: SourceMarkets{NewSourceMarket("atom:usd", false), NewSourceMarket("usdx:usd", true)},
+			},
+			false,
+		},
+		{
+			"valid pool market",
+			Market{
+				MarketID:        "swp:usdx",
+				BaseAsset:       "swp",
+				QuoteAsset:      "usdx",
+				Active:          true,
+				PoolPriceSource: NewPoolPriceSource("swp", "usdx", sdk.OneDec(), sdk.NewInt(1e6)),
+			},
+			true,
+		},
+		{
+			"pool market with oracles",
+			Market{
+				MarketID:        "swp:usdx",
+				BaseAsset:       "swp",
+				QuoteAsset:      "usdx",
+				Oracles:         []sdk.AccAddress{addr},
+				PoolPriceSource: NewPoolPriceSource("swp", "usdx", sdk.OneDec(), sdk.NewInt(1e6)),
+			},
+			false,
+		},
+		{
+			"derived pool market",
+			Market{
+				MarketID:        "swp:usdx",
+				BaseAsset:       "swp",
+				QuoteAsset:      "usdx",
+				SourceMarkets:   SourceMarkets{NewSourceMarket("swp:usd", false), NewSourceMarket("usdx:usd", true)},
+				PoolPriceSource: NewPoolPriceSource("swp", "usdx", sdk.OneDec(), sdk.NewInt(1e6)),
+			},
+			false,
+		},
+		{
+			"pool market with same denoms",
+			Market{
+				MarketID:        "swp:usdx",
+				BaseAsset:       "swp",
+				QuoteAsset:      "usdx",
+				PoolPriceSource: NewPoolPriceSource("swp", "swp", sdk.OneDec(), sdk.NewInt(1e6)),
+			},
+			false,
+		},
+		{
+			"pool market with zero conversion factor",
+			Market{
+				MarketID:        "swp:usdx",
+				BaseAsset:       "swp",
+				QuoteAsset:      "usdx",
+				PoolPriceSource: NewPoolPriceSource("swp", "usdx", sdk.ZeroDec(), sdk.NewInt(1e6)),
+			},
+			false,
+		},
+		{
+			"pool market with negative min liquidity",
+			Market{
+				MarketID:        "swp:usdx",
+				BaseAsset:       "swp",
+				QuoteAsset:      "usdx",
+				PoolPriceSource: NewPoolPriceSource("swp", "usdx", sdk.OneDec(), sdk.NewInt(-1)),
+			},
+			false,
+		},
+		{
+			"valid market with reference",
+			Market{
+				MarketID:              "swp:usd",
+				BaseAsset:             "swp",
+				QuoteAsset:            "usd",
+				Oracles:               []sdk.AccAddress{addr},
+				ReferenceMarketID:     "swp:usdx:twap",
+				MaxReferenceDeviation: sdk.MustNewDecFromStr("0.1"),
+			},
+			true,
+		},
+		{
+			"market referencing itself",
+			Market{
+				MarketID:          "swp:usd",
+				BaseAsset:         "swp",
+				QuoteAsset:        "usd",
+				ReferenceMarketID: "swp:usd:twap",
+			},
+			false,
+		},
+		{
+			"negative max reference deviation",
+			Market{
+				MarketID:              "swp:usd",
+				BaseAsset:             "swp",
+				QuoteAsset:            "usd",
+				ReferenceMarketID:     "swp:usdx",
+				MaxReferenceDeviation: sdk.MustNewDecFromStr("-0.1"),
+			},
+			false,
+		},
+		{
+			"max reference deviation without reference",
+			Market{
+				MarketID:              "swp:usd",
+				BaseAsset:             "swp",
+				QuoteAsset:            "usd",
+				MaxReferenceDeviation: sdk.MustNewDecFromStr("0.1"),
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -92,6 +348,128 @@ func TestMarketValidate(t *testing.T) {
 	}
 }
 
+func TestMarketsValidate(t *testing.T) {
+	atomUSD := NewMarket("atom:usd", "atom", "usd", nil, true)
+	usdxUSD := NewMarket("usdx:usd", "usdx", "usd", nil, true)
+	derivedMarket := func(id string, active bool, sources ...string) Market {
+		m := NewMarket(id, "base", "quote", nil, active)
+		for _, source := range sources {
+			m.SourceMarkets = append(m.SourceMarkets, NewSourceMarket(source, false))
+		}
+		return m
+	}
+	referencingMarket := func(id string, active bool, referenceID string) Market {
+		m := NewMarket(id, "base", "quote", nil, active)
+		m.TwapWindow = time.Hour
+		m.ReferenceMarketID = referenceID
+		return m
+	}
+
+	testCases := []struct {
+		msg     string
+		markets Markets
+		expPass bool
+	}{
+		{
+			"valid derived market",
+			Markets{atomUSD, usdxUSD, derivedMarket("atom:usdx", true, "atom:usd", "usdx:usd")},
+			true,
+		},
+		{
+			"valid market derived from a derived market",
+			Markets{derivedMarket("a", true, "atom:usdx", "usdx:usd"), atomUSD, usdxUSD, derivedMarket("atom:usdx", true, "atom:usd", "usdx:usd")},
+			true,
+		},
+		{
+			"inactive derived market with inactive source",
+			Markets{atomUSD, NewMarket("usdx:usd", "usdx", "usd", nil, false), derivedMarket("atom:usdx", false, "atom:usd", "usdx:usd")},
+			true,
+		},
+		{
+			"missing source market",
+			Markets{atomUSD, derivedMarket("atom:usdx", true, "atom:usd", "usdx:usd")},
+			false,
+		},
+		{
+			"inactive source market",
+			Markets{atomUSD, NewMarket("usdx:usd", "usdx", "usd", nil, false), derivedMarket("atom:usdx", true, "atom:usd", "usdx:usd")},
+			false,
+		},
+		{
+			"market derived from itself",
+			Markets{atomUSD, derivedMarket("a", true, "atom:usd", "a")},
+			false,
+		},
+		{
+			"cycle of derived markets",
+			Markets{atomUSD, derivedMarket("a", true, "atom:usd", "b"), derivedMarket("b", true, "atom:usd", "a")},
+			false,
+		},
+		{
+			"valid reference market",
+			Markets{referencingMarket("atom:usd", true, "usdx:usd"), usdxUSD},
+			true,
+		},
+		{
+			"missing reference market",
+			Markets{referencingMarket("atom:usd", true, "usdx:usd")},
+			false,
+		},
+		{
+			"inactive reference market",
+			Markets{referencingMarket("atom:usd", true, "usdx:usd"), NewMarket("usdx:usd", "usdx", "usd", nil, false)},
+			false,
+		},
+		{
+			"reference TWAP market without TWAP",
+			Markets{referencingMarket("atom:usd", true, "usdx:usd:twap"), usdxUSD},
+			false,
+		},
+		{
+			"cycle of reference markets",
+			Markets{referencingMarket("atom:usd", true, "usdx:usd"), referencingMarket("usdx:usd", true, "atom:usd:twap")},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := tc.markets.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMarketsSortByDependencies(t *testing.T) {
+	atomUSD := NewMarket("atom:usd", "atom", "usd", nil, true)
+	usdxUSD := NewMarket("usdx:usd", "usdx", "usd", nil, true)
+	atomUSDX := NewMarket("atom:usdx", "atom", "usdx", nil, true)
+	atomUSDX.SourceMarkets = SourceMarkets{NewSourceMarket("atom:usd", false), NewSourceMarket("usdx:usd", true)}
+	usdxATOM := NewMarket("usdx:atom", "usdx", "atom", nil, true)
+	usdxATOM.SourceMarkets = SourceMarkets{NewSourceMarket("atom:usdx", true), NewSourceMarket("usdx:usd", false)}
+
+	sorted := Markets{usdxATOM, atomUSDX, atomUSD, usdxUSD}.SortByDependencies()
+	require.Equal(t, Markets{atomUSD, usdxUSD, atomUSDX, usdxATOM}, sorted)
+}
+
+func TestPoolPriceSourcePrice(t *testing.T) {
+	source := NewPoolPriceSource("ubtc", "usdx", sdk.NewDec(100), sdk.NewInt(1000))
+
+	price, ok := source.Price(sdk.NewInt(100), sdk.NewInt(2000))
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDec(2000), price)
+
+	// pools with less than the min liquidity of the quote denom are not trusted
+	_, ok = source.Price(sdk.NewInt(100), sdk.NewInt(999))
+	require.False(t, ok)
+	_, ok = source.Price(sdk.ZeroInt(), sdk.NewInt(2000))
+	require.False(t, ok)
+}
+
 func TestPostedPriceValidate(t *testing.T) {
 	now := time.Now()
 	mockPrivKey := tmtypes.NewMockPV()
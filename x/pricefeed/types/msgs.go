@@ -13,13 +13,18 @@ import (
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgPostPrices type of PostPrices msg
+	TypeMsgPostPrices = "post_prices"
 
 	// MaxExpiry defines the max expiry time defined as UNIX time (9999-12-31 23:59:59 +0000 UTC)
 	MaxExpiry = 253402300799
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgPostPrices{}
+)
 
 // NewMsgPostPrice returns a new MsgPostPrice
 func NewMsgPostPrice(from string, marketID string, price sdk.Dec, expiry time.Time) *MsgPostPrice {
@@ -68,3 +73,85 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewPriceInput returns a new PriceInput
+func NewPriceInput(marketID string, price sdk.Dec, expiry time.Time) PriceInput {
+	return PriceInput{
+		MarketID: marketID,
+		Price:    price,
+		Expiry:   expiry,
+	}
+}
+
+// Validate performs a basic check of a PriceInput params.
+func (pi PriceInput) Validate() error {
+	if strings.TrimSpace(pi.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if pi.Price.IsNil() || pi.Price.IsNegative() {
+		return fmt.Errorf("price cannot be negative: %s", pi.Price)
+	}
+	if pi.Expiry.Unix() <= 0 {
+		return errors.New("must set an expiration time")
+	}
+	return nil
+}
+
+// PriceInputs is a slice of PriceInput
+type PriceInputs []PriceInput
+
+// Validate checks if all the price inputs are valid and there are no
+// duplicated markets.
+func (pis PriceInputs) Validate() error {
+	seenMarkets := make(map[string]bool)
+	for _, pi := range pis {
+		if err := pi.Validate(); err != nil {
+			return err
+		}
+		if seenMarkets[pi.MarketID] {
+			return fmt.Errorf("duplicated price for market %s", pi.MarketID)
+		}
+		seenMarkets[pi.MarketID] = true
+	}
+	return nil
+}
+
+// NewMsgPostPrices returns a new MsgPostPrices
+func NewMsgPostPrices(from string, prices PriceInputs) *MsgPostPrices {
+	return &MsgPostPrices{
+		From:   from,
+		Prices: prices,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPostPrices) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgPostPrices) Type() string { return TypeMsgPostPrices }
+
+// GetSignBytes Implements Msg.
+func (msg MsgPostPrices) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPostPrices) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPostPrices) ValidateBasic() error {
+	if len(msg.From) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.Prices) == 0 {
+		return errors.New("prices cannot be empty")
+	}
+	return msg.Prices.Validate()
+}
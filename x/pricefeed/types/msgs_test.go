@@ -36,3 +36,32 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPostPrices_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	price, _ := sdk.NewDecFromStr("0.3005")
+	expiry := tmtime.Now()
+	negativePrice, _ := sdk.NewDecFromStr("-3.05")
+
+	tests := []struct {
+		name       string
+		msg        MsgPostPrices
+		expectPass bool
+	}{
+		{"normal", MsgPostPrices{addr.String(), PriceInputs{{"xrp", price, expiry}, {"bnb", price, expiry}}}, true},
+		{"emptyAddr", MsgPostPrices{"", PriceInputs{{"xrp", price, expiry}}}, false},
+		{"emptyPrices", MsgPostPrices{addr.String(), PriceInputs{}}, false},
+		{"emptyAsset", MsgPostPrices{addr.String(), PriceInputs{{"xrp", price, expiry}, {"", price, expiry}}}, false},
+		{"negativePrice", MsgPostPrices{addr.String(), PriceInputs{{"xrp", negativePrice, expiry}}}, false},
+		{"duplicateMarket", MsgPostPrices{addr.String(), PriceInputs{{"xrp", price, expiry}, {"xrp", price, expiry}}}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
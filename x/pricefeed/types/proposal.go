@@ -0,0 +1,63 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeClearStaleMarket defines the type for a ClearStaleMarketProposal
+	ProposalTypeClearStaleMarket = "ClearStaleMarket"
+)
+
+// Assert ClearStaleMarketProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = ClearStaleMarketProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeClearStaleMarket)
+	govtypes.RegisterProposalTypeCodec(ClearStaleMarketProposal{}, "pricefeed/ClearStaleMarketProposal")
+}
+
+// NewClearStaleMarketProposal creates a new clear stale market proposal.
+func NewClearStaleMarketProposal(title, description, marketID string) *ClearStaleMarketProposal {
+	return &ClearStaleMarketProposal{
+		Title:       title,
+		Description: description,
+		MarketID:    marketID,
+	}
+}
+
+// GetTitle returns the title of a clear stale market proposal.
+func (p ClearStaleMarketProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a clear stale market proposal.
+func (p ClearStaleMarketProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a clear stale market proposal.
+func (p ClearStaleMarketProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a clear stale market proposal.
+func (p ClearStaleMarketProposal) ProposalType() string { return ProposalTypeClearStaleMarket }
+
+// ValidateBasic stateless validation of a clear stale market proposal.
+func (p ClearStaleMarketProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p ClearStaleMarketProposal) String() string {
+	return fmt.Sprintf(`Clear Stale Market Proposal:
+  Title:       %s
+  Description: %s
+  Market ID:   %s
+`, p.Title, p.Description, p.MarketID)
+}
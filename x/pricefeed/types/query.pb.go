@@ -15,6 +15,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -43,7 +44,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{0}
+	return fileDescriptor_f07f957c9b8ed104, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -82,7 +83,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{1}
+	return fileDescriptor_f07f957c9b8ed104, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,7 +121,7 @@ func (m *QueryPriceRequest) Reset()         { *m = QueryPriceRequest{} }
 func (m *QueryPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPriceRequest) ProtoMessage()    {}
 func (*QueryPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{2}
+	return fileDescriptor_f07f957c9b8ed104, []int{2}
 }
 func (m *QueryPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +159,7 @@ func (m *QueryPriceResponse) Reset()         { *m = QueryPriceResponse{} }
 func (m *QueryPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPriceResponse) ProtoMessage()    {}
 func (*QueryPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{3}
+	return fileDescriptor_f07f957c9b8ed104, []int{3}
 }
 func (m *QueryPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +196,7 @@ func (m *QueryPricesRequest) Reset()         { *m = QueryPricesRequest{} }
 func (m *QueryPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPricesRequest) ProtoMessage()    {}
 func (*QueryPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{4}
+	return fileDescriptor_f07f957c9b8ed104, []int{4}
 }
 func (m *QueryPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,7 +234,7 @@ func (m *QueryPricesResponse) Reset()         { *m = QueryPricesResponse{} }
 func (m *QueryPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPricesResponse) ProtoMessage()    {}
 func (*QueryPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{5}
+	return fileDescriptor_f07f957c9b8ed104, []int{5}
 }
 func (m *QueryPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,6 +263,83 @@ func (m *QueryPricesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPricesResponse proto.InternalMessageInfo
 
+// QueryTWAPRequest is the request type for the Query/TWAP RPC method.
+type QueryTWAPRequest struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *QueryTWAPRequest) Reset()         { *m = QueryTWAPRequest{} }
+func (m *QueryTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPRequest) ProtoMessage()    {}
+func (*QueryTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f07f957c9b8ed104, []int{6}
+}
+func (m *QueryTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPRequest.Merge(m, src)
+}
+func (m *QueryTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPRequest proto.InternalMessageInfo
+
+// QueryTWAPResponse is the response type for the Query/TWAP RPC method.
+type QueryTWAPResponse struct {
+	Price  CurrentPriceResponse `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	Window time.Duration        `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *QueryTWAPResponse) Reset()         { *m = QueryTWAPResponse{} }
+func (m *QueryTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTWAPResponse) ProtoMessage()    {}
+func (*QueryTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f07f957c9b8ed104, []int{7}
+}
+func (m *QueryTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTWAPResponse.Merge(m, src)
+}
+func (m *QueryTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTWAPResponse proto.InternalMessageInfo
+
 // QueryRawPricesRequest is the request type for the Query/RawPrices RPC method.
 type QueryRawPricesRequest struct {
 	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -271,7 +349,7 @@ func (m *QueryRawPricesRequest) Reset()         { *m = QueryRawPricesRequest{} }
 func (m *QueryRawPricesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRawPricesRequest) ProtoMessage()    {}
 func (*QueryRawPricesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{6}
+	return fileDescriptor_f07f957c9b8ed104, []int{8}
 }
 func (m *QueryRawPricesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -310,7 +388,7 @@ func (m *QueryRawPricesResponse) Reset()         { *m = QueryRawPricesResponse{}
 func (m *QueryRawPricesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRawPricesResponse) ProtoMessage()    {}
 func (*QueryRawPricesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{7}
+	return fileDescriptor_f07f957c9b8ed104, []int{9}
 }
 func (m *QueryRawPricesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +426,7 @@ func (m *QueryOraclesRequest) Reset()         { *m = QueryOraclesRequest{} }
 func (m *QueryOraclesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryOraclesRequest) ProtoMessage()    {}
 func (*QueryOraclesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{8}
+	return fileDescriptor_f07f957c9b8ed104, []int{10}
 }
 func (m *QueryOraclesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +465,7 @@ func (m *QueryOraclesResponse) Reset()         { *m = QueryOraclesResponse{} }
 func (m *QueryOraclesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryOraclesResponse) ProtoMessage()    {}
 func (*QueryOraclesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{9}
+	return fileDescriptor_f07f957c9b8ed104, []int{11}
 }
 func (m *QueryOraclesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,7 +502,7 @@ func (m *QueryMarketsRequest) Reset()         { *m = QueryMarketsRequest{} }
 func (m *QueryMarketsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketsRequest) ProtoMessage()    {}
 func (*QueryMarketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{10}
+	return fileDescriptor_f07f957c9b8ed104, []int{12}
 }
 func (m *QueryMarketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,7 +541,7 @@ func (m *QueryMarketsResponse) Reset()         { *m = QueryMarketsResponse{} }
 func (m *QueryMarketsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketsResponse) ProtoMessage()    {}
 func (*QueryMarketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{11}
+	return fileDescriptor_f07f957c9b8ed104, []int{13}
 }
 func (m *QueryMarketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,6 +570,84 @@ func (m *QueryMarketsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryMarketsResponse proto.InternalMessageInfo
 
+// QueryOracleStatsRequest is the request type for the Query/OracleStats RPC
+// method.
+type QueryOracleStatsRequest struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *QueryOracleStatsRequest) Reset()         { *m = QueryOracleStatsRequest{} }
+func (m *QueryOracleStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleStatsRequest) ProtoMessage()    {}
+func (*QueryOracleStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f07f957c9b8ed104, []int{14}
+}
+func (m *QueryOracleStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleStatsRequest.Merge(m, src)
+}
+func (m *QueryOracleStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleStatsRequest proto.InternalMessageInfo
+
+// QueryOracleStatsResponse is the response type for the Query/OracleStats RPC
+// method.
+type QueryOracleStatsResponse struct {
+	OracleStats OracleStatsResponses `protobuf:"bytes,1,rep,name=oracle_stats,json=oracleStats,proto3,castrepeated=OracleStatsResponses" json:"oracle_stats"`
+}
+
+func (m *QueryOracleStatsResponse) Reset()         { *m = QueryOracleStatsResponse{} }
+func (m *QueryOracleStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleStatsResponse) ProtoMessage()    {}
+func (*QueryOracleStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f07f957c9b8ed104, []int{15}
+}
+func (m *QueryOracleStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleStatsResponse.Merge(m, src)
+}
+func (m *QueryOracleStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleStatsResponse proto.InternalMessageInfo
+
 // PostedPriceResponse defines a price for market posted by a specific oracle.
 type PostedPriceResponse struct {
 	MarketID      string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -504,7 +660,7 @@ func (m *PostedPriceResponse) Reset()         { *m = PostedPriceResponse{} }
 func (m *PostedPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*PostedPriceResponse) ProtoMessage()    {}
 func (*PostedPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{12}
+	return fileDescriptor_f07f957c9b8ed104, []int{16}
 }
 func (m *PostedPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +721,7 @@ func (m *CurrentPriceResponse) Reset()         { *m = CurrentPriceResponse{} }
 func (m *CurrentPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*CurrentPriceResponse) ProtoMessage()    {}
 func (*CurrentPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{13}
+	return fileDescriptor_f07f957c9b8ed104, []int{17}
 }
 func (m *CurrentPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,18 +759,28 @@ func (m *CurrentPriceResponse) GetMarketID() string {
 
 // MarketResponse defines an asset in the pricefeed.
 type MarketResponse struct {
-	MarketID   string   `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	BaseAsset  string   `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
-	QuoteAsset string   `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
-	Oracles    []string `protobuf:"bytes,4,rep,name=oracles,proto3" json:"oracles,omitempty"`
-	Active     bool     `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	MarketID       string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BaseAsset      string                                 `protobuf:"bytes,2,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	QuoteAsset     string                                 `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
+	Oracles        []string                               `protobuf:"bytes,4,rep,name=oracles,proto3" json:"oracles,omitempty"`
+	Active         bool                                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	TwapWindow     time.Duration                          `protobuf:"bytes,6,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window"`
+	MaxPriceChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_price_change,json=maxPriceChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_change"`
+	MinOracles     uint32                                 `protobuf:"varint,8,opt,name=min_oracles,json=minOracles,proto3" json:"min_oracles,omitempty"`
+	// stale is true when the market has been flagged by the circuit breaker
+	Stale                 bool                                   `protobuf:"varint,9,opt,name=stale,proto3" json:"stale,omitempty"`
+	OutlierBand           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=outlier_band,json=outlierBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"outlier_band"`
+	SourceMarkets         SourceMarkets                          `protobuf:"bytes,11,rep,name=source_markets,json=sourceMarkets,proto3,castrepeated=SourceMarkets" json:"source_markets"`
+	PoolPriceSource       *PoolPriceSource                       `protobuf:"bytes,12,opt,name=pool_price_source,json=poolPriceSource,proto3" json:"pool_price_source,omitempty"`
+	ReferenceMarketID     string                                 `protobuf:"bytes,13,opt,name=reference_market_id,json=referenceMarketId,proto3" json:"reference_market_id,omitempty"`
+	MaxReferenceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=max_reference_deviation,json=maxReferenceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reference_deviation"`
 }
 
 func (m *MarketResponse) Reset()         { *m = MarketResponse{} }
 func (m *MarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MarketResponse) ProtoMessage()    {}
 func (*MarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_84567be3085e4c6c, []int{14}
+	return fileDescriptor_f07f957c9b8ed104, []int{18}
 }
 func (m *MarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,6 +844,126 @@ func (m *MarketResponse) GetActive() bool {
 	return false
 }
 
+func (m *MarketResponse) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetMinOracles() uint32 {
+	if m != nil {
+		return m.MinOracles
+	}
+	return 0
+}
+
+func (m *MarketResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *MarketResponse) GetSourceMarkets() SourceMarkets {
+	if m != nil {
+		return m.SourceMarkets
+	}
+	return nil
+}
+
+func (m *MarketResponse) GetPoolPriceSource() *PoolPriceSource {
+	if m != nil {
+		return m.PoolPriceSource
+	}
+	return nil
+}
+
+func (m *MarketResponse) GetReferenceMarketID() string {
+	if m != nil {
+		return m.ReferenceMarketID
+	}
+	return ""
+}
+
+// OracleStatsResponse defines the reliability statistics of an oracle for a
+// market.
+type OracleStatsResponse struct {
+	MarketID      string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress string `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3" json:"oracle_address,omitempty"`
+	Posts         uint64 `protobuf:"varint,3,opt,name=posts,proto3" json:"posts,omitempty"`
+	MissedWindows uint64 `protobuf:"varint,4,opt,name=missed_windows,json=missedWindows,proto3" json:"missed_windows,omitempty"`
+	Outliers      uint64 `protobuf:"varint,5,opt,name=outliers,proto3" json:"outliers,omitempty"`
+}
+
+func (m *OracleStatsResponse) Reset()         { *m = OracleStatsResponse{} }
+func (m *OracleStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*OracleStatsResponse) ProtoMessage()    {}
+func (*OracleStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f07f957c9b8ed104, []int{19}
+}
+func (m *OracleStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleStatsResponse.Merge(m, src)
+}
+func (m *OracleStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleStatsResponse proto.InternalMessageInfo
+
+func (m *OracleStatsResponse) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *OracleStatsResponse) GetOracleAddress() string {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return ""
+}
+
+func (m *OracleStatsResponse) GetPosts() uint64 {
+	if m != nil {
+		return m.Posts
+	}
+	return 0
+}
+
+func (m *OracleStatsResponse) GetMissedWindows() uint64 {
+	if m != nil {
+		return m.MissedWindows
+	}
+	return 0
+}
+
+func (m *OracleStatsResponse) GetOutliers() uint64 {
+	if m != nil {
+		return m.Outliers
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "aeth.pricefeed.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "aeth.pricefeed.v1beta1.QueryParamsResponse")
@@ -685,79 +971,111 @@ func init() {
 	proto.RegisterType((*QueryPriceResponse)(nil), "aeth.pricefeed.v1beta1.QueryPriceResponse")
 	proto.RegisterType((*QueryPricesRequest)(nil), "aeth.pricefeed.v1beta1.QueryPricesRequest")
 	proto.RegisterType((*QueryPricesResponse)(nil), "aeth.pricefeed.v1beta1.QueryPricesResponse")
+	proto.RegisterType((*QueryTWAPRequest)(nil), "aeth.pricefeed.v1beta1.QueryTWAPRequest")
+	proto.RegisterType((*QueryTWAPResponse)(nil), "aeth.pricefeed.v1beta1.QueryTWAPResponse")
 	proto.RegisterType((*QueryRawPricesRequest)(nil), "aeth.pricefeed.v1beta1.QueryRawPricesRequest")
 	proto.RegisterType((*QueryRawPricesResponse)(nil), "aeth.pricefeed.v1beta1.QueryRawPricesResponse")
 	proto.RegisterType((*QueryOraclesRequest)(nil), "aeth.pricefeed.v1beta1.QueryOraclesRequest")
 	proto.RegisterType((*QueryOraclesResponse)(nil), "aeth.pricefeed.v1beta1.QueryOraclesResponse")
 	proto.RegisterType((*QueryMarketsRequest)(nil), "aeth.pricefeed.v1beta1.QueryMarketsRequest")
 	proto.RegisterType((*QueryMarketsResponse)(nil), "aeth.pricefeed.v1beta1.QueryMarketsResponse")
+	proto.RegisterType((*QueryOracleStatsRequest)(nil), "aeth.pricefeed.v1beta1.QueryOracleStatsRequest")
+	proto.RegisterType((*QueryOracleStatsResponse)(nil), "aeth.pricefeed.v1beta1.QueryOracleStatsResponse")
 	proto.RegisterType((*PostedPriceResponse)(nil), "aeth.pricefeed.v1beta1.PostedPriceResponse")
 	proto.RegisterType((*CurrentPriceResponse)(nil), "aeth.pricefeed.v1beta1.CurrentPriceResponse")
 	proto.RegisterType((*MarketResponse)(nil), "aeth.pricefeed.v1beta1.MarketResponse")
+	proto.RegisterType((*OracleStatsResponse)(nil), "aeth.pricefeed.v1beta1.OracleStatsResponse")
 }
 
 func init() {
-	proto.RegisterFile("aeth/pricefeed/v1beta1/query.proto", fileDescriptor_84567be3085e4c6c)
-}
-
-var fileDescriptor_84567be3085e4c6c = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0xa9, 0x7f, 0x4e, 0xa1, 0x88, 0xa9, 0x13, 0x2c, 0xd3, 0xee, 0x86, 0x95, 0x08,
-	0x6d, 0x1c, 0xef, 0xaa, 0xa9, 0xa8, 0x50, 0xc5, 0xa5, 0x26, 0x07, 0x7a, 0xa8, 0x80, 0x15, 0x97,
-	0x72, 0xb1, 0xc6, 0xde, 0xa9, 0xbb, 0x4a, 0xec, 0xd9, 0xec, 0x8c, 0xe3, 0x46, 0x08, 0x09, 0x21,
-	0x24, 0xca, 0x01, 0x29, 0x82, 0x13, 0x37, 0xb8, 0x21, 0x24, 0xfe, 0x8f, 0x1c, 0x23, 0x71, 0x41,
-	0x1c, 0x92, 0xe0, 0x70, 0xe3, 0x9f, 0x40, 0x3b, 0xf3, 0x76, 0xf1, 0x26, 0xde, 0x64, 0x2d, 0x4e,
-	0xc9, 0xbe, 0x7d, 0x3f, 0x3e, 0xef, 0xbb, 0x33, 0x5f, 0x63, 0x6b, 0x9b, 0xee, 0x51, 0x27, 0x08,
-	0xfd, 0x3e, 0x7b, 0xc6, 0x98, 0xe7, 0xec, 0xdd, 0xeb, 0x31, 0x49, 0xef, 0x39, 0xbb, 0x63, 0x16,
-	0xee, 0xdb, 0x41, 0xc8, 0x25, 0x27, 0x2b, 0x51, 0x8e, 0x9d, 0xe4, 0xd8, 0x90, 0xd3, 0xac, 0x0f,
-	0xf8, 0x80, 0xab, 0x14, 0x27, 0xfa, 0x4f, 0x67, 0x37, 0x6f, 0x0d, 0x38, 0x1f, 0xec, 0x30, 0x87,
-	0x06, 0xbe, 0x43, 0x47, 0x23, 0x2e, 0xa9, 0xf4, 0xf9, 0x48, 0xc0, 0x5b, 0x13, 0xde, 0xaa, 0xa7,
-	0xde, 0xf8, 0x99, 0x23, 0xfd, 0x21, 0x13, 0x92, 0x0e, 0x03, 0x48, 0xc8, 0x02, 0x12, 0x92, 0x87,
-	0x4c, 0xe7, 0x58, 0x75, 0x4c, 0x3e, 0x89, 0xf8, 0x3e, 0xa6, 0x21, 0x1d, 0x0a, 0x97, 0xed, 0x8e,
-	0x99, 0x90, 0xd6, 0x53, 0x7c, 0x33, 0x15, 0x15, 0x01, 0x1f, 0x09, 0x46, 0xde, 0xc7, 0xe5, 0x40,
-	0x45, 0x1a, 0x68, 0x15, 0xdd, 0xb9, 0xbe, 0x69, 0xd8, 0xf3, 0xd7, 0xb1, 0x75, 0x5d, 0xa7, 0x78,
-	0x78, 0x6c, 0x16, 0x5c, 0xa8, 0x79, 0x58, 0x7c, 0xf9, 0x93, 0x59, 0xb0, 0x1e, 0xe0, 0xd7, 0x75,
-	0xeb, 0xa8, 0x08, 0xe6, 0x91, 0x37, 0x71, 0x6d, 0x48, 0xc3, 0x6d, 0x26, 0xbb, 0xbe, 0xa7, 0x7a,
-	0xd7, 0xdc, 0xaa, 0x0e, 0x3c, 0xf6, 0xa0, 0xce, 0x8b, 0x41, 0x75, 0x1d, 0x10, 0x7d, 0x88, 0x4b,
-	0x6a, 0x3a, 0x00, 0x6d, 0x64, 0x01, 0x7d, 0x30, 0x0e, 0x43, 0x36, 0x92, 0xa9, 0x62, 0xc0, 0xd3,
-	0x0d, 0x60, 0x4a, 0x7d, 0x76, 0x4a, 0x22, 0xc7, 0x97, 0x28, 0xd6, 0x03, 0xc2, 0x30, 0xbd, 0x8f,
-	0xcb, 0xaa, 0x38, 0xd2, 0xe3, 0xda, 0xc2, 0xe3, 0x6f, 0x47, 0xe3, 0x7f, 0x3d, 0x31, 0x97, 0xe7,
-	0xbd, 0x15, 0x2e, 0xb4, 0x06, 0xb0, 0x87, 0x78, 0x59, 0x11, 0xb8, 0x74, 0x92, 0x62, 0xcb, 0x23,
-	0xdd, 0x4b, 0x84, 0x57, 0xce, 0x17, 0xc3, 0x06, 0xcf, 0x31, 0x0e, 0xe9, 0xa4, 0x9b, 0xda, 0xa2,
-	0x95, 0xf9, 0x55, 0xb9, 0x90, 0xcc, 0x4b, 0x2f, 0x71, 0x0b, 0x96, 0xa8, 0xcf, 0x79, 0x29, 0xdc,
-	0x5a, 0x18, 0x4f, 0x04, 0x94, 0xf7, 0x40, 0xc8, 0x8f, 0x42, 0xda, 0xdf, 0x59, 0x68, 0x89, 0x07,
-	0xb8, 0x9e, 0xae, 0x84, 0x0d, 0x1a, 0xb8, 0xc2, 0x75, 0x48, 0xe1, 0xd7, 0xdc, 0xf8, 0x11, 0xea,
-	0x96, 0x61, 0xe2, 0x13, 0xd5, 0x2e, 0xf9, 0xa4, 0x13, 0x68, 0x97, 0x84, 0xa1, 0xdd, 0x53, 0x5c,
-	0xd1, 0x83, 0x63, 0x35, 0xd6, 0xb2, 0xd4, 0xd0, 0x95, 0x89, 0x10, 0x6f, 0x80, 0x10, 0xaf, 0xa5,
-	0xe3, 0xc2, 0x8d, 0xfb, 0x01, 0xcf, 0x3f, 0x08, 0xdf, 0x9c, 0xa3, 0x15, 0xb9, 0x7b, 0x41, 0x82,
-	0xce, 0x2b, 0xd3, 0x63, 0xb3, 0xaa, 0xdb, 0x3d, 0xde, 0xfa, 0x4f, 0x10, 0xf2, 0x36, 0xbe, 0xa1,
-	0x77, 0xec, 0x52, 0xcf, 0x0b, 0x99, 0x10, 0x8d, 0x25, 0x25, 0xd9, 0xab, 0x3a, 0xfa, 0x48, 0x07,
-	0xc9, 0x56, 0x7c, 0x37, 0xae, 0xa9, 0x6e, 0x76, 0x04, 0xf8, 0xe7, 0xb1, 0xb9, 0x36, 0xf0, 0xe5,
-	0xf3, 0x71, 0xcf, 0xee, 0xf3, 0xa1, 0xd3, 0xe7, 0x62, 0xc8, 0x05, 0xfc, 0x69, 0x0b, 0x6f, 0xdb,
-	0x91, 0xfb, 0x01, 0x13, 0xf6, 0x16, 0xeb, 0xc3, 0xbd, 0x88, 0xee, 0x3c, 0x7b, 0x11, 0xf8, 0xe1,
-	0x7e, 0xa3, 0xa8, 0xae, 0x58, 0xd3, 0xd6, 0xb6, 0x63, 0xc7, 0xb6, 0x63, 0x7f, 0x1a, 0xdb, 0x4e,
-	0xa7, 0x1a, 0x8d, 0x38, 0x38, 0x31, 0x91, 0x0b, 0x35, 0xd6, 0x37, 0x08, 0xd7, 0xe7, 0x1d, 0xef,
-	0x45, 0xd6, 0x4d, 0xf6, 0x58, 0xfa, 0x1f, 0x7b, 0x58, 0xbf, 0x21, 0x7c, 0x23, 0xfd, 0x69, 0x16,
-	0x61, 0xb8, 0x8d, 0x71, 0x8f, 0x0a, 0xd6, 0xa5, 0x42, 0x30, 0x09, 0x72, 0xd7, 0xa2, 0xc8, 0xa3,
-	0x28, 0x40, 0x4c, 0x7c, 0x7d, 0x77, 0xcc, 0x65, 0xfc, 0x5e, 0x09, 0xee, 0x62, 0x15, 0xd2, 0x09,
-	0x33, 0xa7, 0xb4, 0x98, 0x3a, 0xa5, 0x64, 0x05, 0x97, 0x69, 0x5f, 0xfa, 0x7b, 0xac, 0x51, 0x5a,
-	0x45, 0x77, 0xaa, 0x2e, 0x3c, 0x6d, 0x7e, 0x5d, 0xc1, 0x25, 0x75, 0x42, 0xc9, 0xb7, 0x08, 0x97,
-	0xb5, 0xa1, 0x92, 0xf5, 0xac, 0xc3, 0x78, 0xd1, 0xc3, 0x9b, 0xad, 0x5c, 0xb9, 0x5a, 0x0a, 0x6b,
-	0xed, 0xab, 0xdf, 0xff, 0xfe, 0x61, 0x69, 0x95, 0x18, 0x4e, 0xc6, 0x6f, 0x86, 0xf6, 0x70, 0xf2,
-	0x3d, 0xc2, 0x25, 0xf5, 0x21, 0xc9, 0xdd, 0xcb, 0xdb, 0xcf, 0xb8, 0x7b, 0x73, 0x3d, 0x4f, 0x2a,
-	0x80, 0x6c, 0x2a, 0x90, 0x0d, 0xb2, 0x9e, 0x09, 0xa2, 0xec, 0xc4, 0xf9, 0x3c, 0xf9, 0x72, 0x5f,
-	0x68, 0x81, 0x54, 0x98, 0xe4, 0x18, 0x95, 0x57, 0xa0, 0x94, 0x51, 0xe6, 0x10, 0x48, 0x03, 0xfc,
-	0x8c, 0x70, 0x2d, 0xb1, 0x59, 0xd2, 0xbe, 0x74, 0xc4, 0x79, 0x2f, 0x6f, 0xda, 0x79, 0xd3, 0x01,
-	0xea, 0x5d, 0x05, 0xe5, 0x90, 0x76, 0x16, 0x54, 0x48, 0x27, 0x73, 0xf4, 0xfa, 0x11, 0xe1, 0x0a,
-	0xd8, 0x28, 0xb9, 0x5c, 0x84, 0xb4, 0x4d, 0x37, 0x37, 0xf2, 0x25, 0x03, 0xdd, 0x7d, 0x45, 0xd7,
-	0x26, 0xad, 0x2c, 0x3a, 0xb8, 0x02, 0x29, 0xb6, 0xef, 0x10, 0xae, 0x80, 0x27, 0x5f, 0xc1, 0x96,
-	0x36, 0xf4, 0x2b, 0xd8, 0xce, 0xd9, 0xbc, 0xf5, 0x8e, 0x62, 0x7b, 0x8b, 0x98, 0x59, 0x6c, 0x60,
-	0xda, 0x9d, 0x27, 0xa7, 0x7f, 0x19, 0xe8, 0x97, 0xa9, 0x81, 0x0e, 0xa7, 0x06, 0x3a, 0x9a, 0x1a,
-	0xe8, 0x74, 0x6a, 0xa0, 0x83, 0x33, 0xa3, 0x70, 0x74, 0x66, 0x14, 0xfe, 0x38, 0x33, 0x0a, 0x9f,
-	0xb5, 0x66, 0x7c, 0x28, 0x6a, 0xd6, 0xde, 0xa1, 0x3d, 0xa1, 0xdb, 0xbe, 0x98, 0x69, 0xac, 0x0c,
-	0xa9, 0x57, 0x56, 0xae, 0x79, 0xff, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x99, 0x27, 0xa2,
-	0x2c, 0x0a, 0x00, 0x00,
+	proto.RegisterFile("aeth/pricefeed/v1beta1/query.proto", fileDescriptor_f07f957c9b8ed104)
+}
+
+var fileDescriptor_f07f957c9b8ed104 = []byte{
+	// 1321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0x82, 0xe3, 0xc4, 0xcf, 0x49, 0x20, 0x13, 0x07, 0xfc, 0xf5, 0x17, 0xec, 0xd4, 0x6a,
+	0x21, 0x84, 0xc4, 0x4b, 0x40, 0xd0, 0x8a, 0x72, 0x21, 0xa4, 0x52, 0x39, 0x54, 0x85, 0x0d, 0x12,
+	0xa5, 0x17, 0x6b, 0xec, 0x9d, 0x24, 0xab, 0x78, 0x77, 0xcc, 0xce, 0x18, 0x07, 0x55, 0x95, 0xaa,
+	0x5e, 0x4a, 0x0f, 0x54, 0xa8, 0xbd, 0xb4, 0x37, 0x7a, 0x6b, 0xfb, 0x4f, 0x94, 0x23, 0x47, 0xa4,
+	0x5e, 0xaa, 0x1e, 0x02, 0x0d, 0xbd, 0xf5, 0x9f, 0xa8, 0x76, 0xe6, 0xed, 0x66, 0x37, 0xf1, 0xa6,
+	0x9b, 0x94, 0x53, 0xb2, 0x6f, 0xde, 0x8f, 0xcf, 0xfb, 0xbc, 0x1f, 0x7e, 0x50, 0xa7, 0x4c, 0xae,
+	0x9b, 0x5d, 0xdf, 0x69, 0xb3, 0x55, 0xc6, 0x6c, 0xf3, 0xc1, 0x62, 0x8b, 0x49, 0xba, 0x68, 0xde,
+	0xef, 0x31, 0xff, 0x61, 0xa3, 0xeb, 0x73, 0xc9, 0xc9, 0x89, 0x40, 0xa7, 0x11, 0xe9, 0x34, 0x50,
+	0xa7, 0x52, 0x5a, 0xe3, 0x6b, 0x5c, 0xa9, 0x98, 0xc1, 0x7f, 0x5a, 0xbb, 0x72, 0x6a, 0x8d, 0xf3,
+	0xb5, 0x0e, 0x33, 0x69, 0xd7, 0x31, 0xa9, 0xe7, 0x71, 0x49, 0xa5, 0xc3, 0x3d, 0x81, 0xaf, 0x55,
+	0x7c, 0x55, 0x5f, 0xad, 0xde, 0xaa, 0x69, 0xf7, 0x7c, 0xa5, 0x80, 0xef, 0xb5, 0xdd, 0xef, 0xd2,
+	0x71, 0x99, 0x90, 0xd4, 0xed, 0xa2, 0x42, 0x1a, 0x60, 0x21, 0xb9, 0xcf, 0xb4, 0x4e, 0xbd, 0x04,
+	0xe4, 0x76, 0x80, 0xff, 0x16, 0xf5, 0xa9, 0x2b, 0x2c, 0x76, 0xbf, 0xc7, 0x84, 0xac, 0xdf, 0x83,
+	0xa9, 0x84, 0x54, 0x74, 0xb9, 0x27, 0x18, 0xb9, 0x06, 0xf9, 0xae, 0x92, 0x94, 0x8d, 0x19, 0x63,
+	0xb6, 0x78, 0xb1, 0xda, 0x18, 0x9c, 0x6e, 0x43, 0xdb, 0x2d, 0xe5, 0x9e, 0x6f, 0xd5, 0x86, 0x2c,
+	0xb4, 0xb9, 0x9a, 0x7b, 0xf4, 0xb4, 0x36, 0x54, 0xbf, 0x02, 0x93, 0xda, 0x75, 0x60, 0x84, 0xf1,
+	0xc8, 0xff, 0xa1, 0xe0, 0x52, 0x7f, 0x83, 0xc9, 0xa6, 0x63, 0x2b, 0xdf, 0x05, 0x6b, 0x54, 0x0b,
+	0x6e, 0xda, 0x68, 0x67, 0x03, 0x89, 0xdb, 0x21, 0xa2, 0x0f, 0x61, 0x58, 0x45, 0x47, 0x40, 0xf3,
+	0x69, 0x80, 0x6e, 0xf4, 0x7c, 0x9f, 0x79, 0x32, 0x61, 0x8c, 0xf0, 0xb4, 0x03, 0x8c, 0x52, 0x8a,
+	0x47, 0x89, 0xe8, 0xf8, 0xc2, 0x80, 0xa9, 0x84, 0x18, 0xa3, 0xb7, 0x21, 0xaf, 0x8c, 0x03, 0x3e,
+	0x8e, 0x1e, 0x38, 0xfc, 0xe9, 0x20, 0xfc, 0x2f, 0x2f, 0x6b, 0xd3, 0x83, 0x5e, 0x85, 0x85, 0xae,
+	0x11, 0xd8, 0x65, 0x38, 0xae, 0x10, 0xdc, 0xb9, 0x7b, 0xfd, 0xd6, 0x01, 0x58, 0x7b, 0x6a, 0xc0,
+	0x64, 0xcc, 0xee, 0x4d, 0xb3, 0x46, 0xde, 0x87, 0x7c, 0xdf, 0xf1, 0x6c, 0xde, 0x2f, 0x1f, 0x51,
+	0xae, 0xfe, 0xd7, 0xd0, 0x4d, 0xd9, 0x08, 0x9b, 0xb2, 0xb1, 0x8c, 0x4d, 0xbb, 0x34, 0x1a, 0xd8,
+	0x7d, 0xff, 0xb2, 0x66, 0x58, 0x68, 0x82, 0x10, 0xaf, 0xc2, 0xb4, 0x42, 0x68, 0xd1, 0x7e, 0x82,
+	0xf5, 0x2c, 0xe9, 0x3d, 0x32, 0xe0, 0xc4, 0x6e, 0x63, 0xcc, 0x71, 0x1d, 0xc0, 0xa7, 0xfd, 0x66,
+	0xa2, 0x3e, 0xe7, 0x53, 0xfb, 0x95, 0x0b, 0xc9, 0xec, 0x64, 0x9e, 0xa7, 0xb0, 0x3c, 0xa5, 0x01,
+	0x8f, 0xc2, 0x2a, 0xf8, 0x61, 0x44, 0x84, 0xf2, 0x1e, 0xb6, 0xc8, 0xc7, 0x3e, 0x6d, 0x77, 0x0e,
+	0x94, 0xc4, 0x15, 0x28, 0x25, 0x2d, 0x31, 0x83, 0x32, 0x8c, 0x70, 0x2d, 0x52, 0xf0, 0x0b, 0x56,
+	0xf8, 0x89, 0x76, 0xd3, 0x18, 0xf1, 0x23, 0xe5, 0x2e, 0x6a, 0xd6, 0x3e, 0x94, 0x92, 0x62, 0x74,
+	0x77, 0x0f, 0x46, 0x74, 0xe0, 0x90, 0x8d, 0x33, 0x69, 0x6c, 0x68, 0xcb, 0x88, 0x88, 0x93, 0x48,
+	0xc4, 0xb1, 0xa4, 0x5c, 0x58, 0xa1, 0x3f, 0xc4, 0x73, 0x0d, 0x4e, 0xc6, 0xf2, 0x58, 0x91, 0x54,
+	0x1e, 0x84, 0x85, 0x6f, 0x0c, 0x28, 0xef, 0x35, 0x47, 0xec, 0x1d, 0x18, 0xd3, 0xb9, 0x37, 0x85,
+	0xa4, 0x51, 0x02, 0xa9, 0xe5, 0x1c, 0xe0, 0x62, 0xa7, 0x9c, 0x03, 0x1e, 0x85, 0x55, 0xe4, 0x3b,
+	0x52, 0x04, 0xf4, 0xb7, 0x01, 0x53, 0x03, 0x4a, 0x4f, 0xce, 0xed, 0xc9, 0x65, 0x69, 0x6c, 0x7b,
+	0xab, 0x36, 0xaa, 0xd9, 0xb9, 0xb9, 0xbc, 0x93, 0x19, 0x79, 0x07, 0x26, 0x10, 0x36, 0xb5, 0x6d,
+	0x9f, 0x09, 0xa1, 0xa6, 0xa4, 0x60, 0x8d, 0x6b, 0xe9, 0x75, 0x2d, 0x24, 0xcb, 0xe1, 0x38, 0x1e,
+	0x55, 0xde, 0x1a, 0x01, 0xd2, 0x3f, 0xb6, 0x6a, 0x67, 0xd6, 0x1c, 0xb9, 0xde, 0x6b, 0x35, 0xda,
+	0xdc, 0x35, 0xdb, 0x5c, 0xb8, 0x5c, 0xe0, 0x9f, 0x05, 0x61, 0x6f, 0x98, 0xf2, 0x61, 0x97, 0x89,
+	0xc6, 0x32, 0x6b, 0x87, 0xa3, 0x78, 0x0d, 0xf2, 0x6c, 0xb3, 0xeb, 0xf8, 0x0f, 0xcb, 0x39, 0x35,
+	0x8a, 0x95, 0x3d, 0xa3, 0x78, 0x27, 0xfc, 0x7d, 0xd0, 0xb3, 0xf8, 0x44, 0xcd, 0xa2, 0xb6, 0xa9,
+	0x7f, 0x65, 0x40, 0x69, 0xd0, 0xb8, 0x1f, 0x24, 0xdd, 0x28, 0x8f, 0x23, 0xff, 0x21, 0x8f, 0xfa,
+	0xaf, 0x79, 0x98, 0x48, 0x76, 0xda, 0x41, 0x30, 0x9c, 0x06, 0x68, 0x51, 0xc1, 0x9a, 0x54, 0x08,
+	0x26, 0x91, 0xee, 0x42, 0x20, 0xb9, 0x1e, 0x08, 0x48, 0x0d, 0x8a, 0xf7, 0x7b, 0x5c, 0x86, 0xef,
+	0x8a, 0x70, 0x0b, 0x94, 0x48, 0x2b, 0xc4, 0x86, 0x2e, 0x97, 0x18, 0x3a, 0x72, 0x02, 0xf2, 0xb4,
+	0x2d, 0x9d, 0x07, 0xac, 0x3c, 0x3c, 0x63, 0xcc, 0x8e, 0x5a, 0xf8, 0x45, 0x96, 0xa1, 0x28, 0xfb,
+	0xb4, 0xdb, 0xc4, 0x3d, 0x98, 0xcf, 0xbe, 0x07, 0x21, 0xb0, 0xbb, 0xab, 0xcc, 0xc8, 0x27, 0x70,
+	0xdc, 0xa5, 0x9b, 0x7a, 0x5d, 0x35, 0xdb, 0xeb, 0xd4, 0x5b, 0x63, 0xe5, 0x91, 0x43, 0xd1, 0x38,
+	0xe1, 0xd2, 0x4d, 0x55, 0xc2, 0x1b, 0xca, 0x4b, 0x90, 0xb2, 0xeb, 0x78, 0xcd, 0x30, 0xab, 0xd1,
+	0x19, 0x63, 0x76, 0xdc, 0x02, 0xd7, 0xf1, 0x70, 0xdf, 0x90, 0x12, 0x0c, 0x0b, 0x49, 0x3b, 0xac,
+	0x5c, 0x50, 0x79, 0xe9, 0x0f, 0x72, 0x1b, 0xc6, 0x78, 0x4f, 0x76, 0x1c, 0xe6, 0x37, 0x5b, 0xd4,
+	0xb3, 0xcb, 0x70, 0x28, 0x30, 0x45, 0xf4, 0xb1, 0x44, 0x3d, 0x9b, 0xb4, 0x60, 0x42, 0xf0, 0x9e,
+	0xdf, 0x66, 0xcd, 0x70, 0x11, 0x15, 0xd5, 0x1c, 0xbf, 0x9d, 0x36, 0xc7, 0x2b, 0x4a, 0x5b, 0x57,
+	0x79, 0x69, 0x1a, 0x07, 0x78, 0x3c, 0x2e, 0x15, 0xd6, 0xb8, 0x88, 0x7f, 0x92, 0x15, 0x98, 0xec,
+	0x72, 0xde, 0x41, 0x22, 0xf5, 0x5b, 0x79, 0x4c, 0xd5, 0xe4, 0x6c, 0xfa, 0xf6, 0xe7, 0x1d, 0xc5,
+	0x98, 0xf6, 0x6c, 0x1d, 0xeb, 0x26, 0x05, 0xe4, 0x03, 0x98, 0xf2, 0xd9, 0x2a, 0xf3, 0x99, 0x17,
+	0x61, 0x0f, 0x3a, 0x71, 0x5c, 0x51, 0x32, 0xbd, 0xbd, 0x55, 0x9b, 0xb4, 0xc2, 0xe7, 0xa8, 0x25,
+	0x27, 0xfd, 0x5d, 0x22, 0x9b, 0xac, 0xc2, 0xc9, 0xa0, 0xc6, 0x3b, 0xae, 0x6c, 0xf6, 0xc0, 0x51,
+	0x4d, 0x51, 0x9e, 0x38, 0x14, 0xbb, 0xd3, 0x2e, 0xdd, 0x8c, 0x22, 0x2f, 0x87, 0xce, 0xea, 0xcf,
+	0x0c, 0x98, 0x1a, 0xb4, 0x45, 0xdf, 0xfc, 0xe6, 0x2a, 0xc1, 0x70, 0x97, 0x0b, 0x29, 0xd4, 0x20,
+	0xe5, 0x2c, 0xfd, 0x11, 0x18, 0xbb, 0x8e, 0x10, 0xcc, 0xc6, 0x99, 0x10, 0x6a, 0x23, 0xe5, 0xac,
+	0x71, 0x2d, 0xd5, 0x1d, 0x2f, 0x48, 0x05, 0x46, 0xb1, 0x3b, 0x84, 0x1a, 0xa9, 0x9c, 0x15, 0x7d,
+	0x5f, 0x7c, 0x56, 0x80, 0x61, 0xf5, 0x6b, 0x40, 0xbe, 0x36, 0x20, 0xaf, 0xcf, 0x49, 0x32, 0x97,
+	0x56, 0xc0, 0xbd, 0x17, 0x6c, 0xe5, 0x7c, 0x26, 0x5d, 0x4d, 0x4c, 0xfd, 0xcc, 0x97, 0xbf, 0xfd,
+	0xf5, 0xdd, 0x91, 0x19, 0x52, 0x35, 0x53, 0x2e, 0x66, 0x7d, 0xc1, 0x92, 0x6f, 0x0d, 0x18, 0x56,
+	0x7d, 0x41, 0xce, 0xed, 0xef, 0x3e, 0x76, 0xdb, 0x56, 0xe6, 0xb2, 0xa8, 0x22, 0x90, 0x8b, 0x0a,
+	0xc8, 0x3c, 0x99, 0x4b, 0x05, 0x12, 0x48, 0x84, 0xf9, 0x59, 0x54, 0xc7, 0xcf, 0x35, 0x41, 0x4a,
+	0x4c, 0x32, 0x84, 0xca, 0x4a, 0x50, 0xe2, 0x98, 0xca, 0x40, 0x90, 0x06, 0xf0, 0xd8, 0x80, 0x5c,
+	0x70, 0x69, 0x92, 0xd9, 0x7d, 0xbd, 0xc7, 0x8e, 0xd8, 0xca, 0xb9, 0x0c, 0x9a, 0x88, 0xe2, 0x82,
+	0x42, 0x31, 0x47, 0x66, 0xd3, 0x50, 0x04, 0xfb, 0x34, 0xc1, 0xcd, 0x8f, 0x06, 0x14, 0xa2, 0xd3,
+	0x90, 0x2c, 0xec, 0x1b, 0x6a, 0xf7, 0xfd, 0x59, 0x69, 0x64, 0x55, 0x47, 0x78, 0x97, 0x15, 0x3c,
+	0x93, 0x2c, 0xa4, 0xc1, 0xf3, 0x69, 0x7f, 0x40, 0xfd, 0x7e, 0x30, 0x60, 0x24, 0x5c, 0xc5, 0xfb,
+	0x17, 0x25, 0x79, 0x5a, 0x56, 0xe6, 0xb3, 0x29, 0x23, 0xba, 0x4b, 0x0a, 0xdd, 0x02, 0x39, 0x9f,
+	0x86, 0x0e, 0x7f, 0x20, 0x12, 0xd8, 0x1e, 0x1b, 0x30, 0x12, 0x6e, 0xd6, 0xfd, 0xb1, 0x25, 0x8f,
+	0xd0, 0xca, 0x7c, 0x36, 0x65, 0xc4, 0x76, 0x56, 0x61, 0x7b, 0x8b, 0xd4, 0xd2, 0xb0, 0xe1, 0xef,
+	0x05, 0xf9, 0xd9, 0x80, 0x62, 0x6c, 0xb3, 0x11, 0x33, 0x03, 0x05, 0xf1, 0x43, 0xb4, 0x72, 0x21,
+	0xbb, 0x01, 0x62, 0x7b, 0x57, 0x61, 0x5b, 0x24, 0xe6, 0xbf, 0xf0, 0x16, 0x18, 0xc5, 0xb9, 0x5b,
+	0x5a, 0x79, 0xf5, 0x67, 0xd5, 0xf8, 0x69, 0xbb, 0x6a, 0x3c, 0xdf, 0xae, 0x1a, 0x2f, 0xb6, 0xab,
+	0xc6, 0xab, 0xed, 0xaa, 0xf1, 0xe4, 0x75, 0x75, 0xe8, 0xc5, 0xeb, 0xea, 0xd0, 0xef, 0xaf, 0xab,
+	0x43, 0x9f, 0x2e, 0xc6, 0xd6, 0xbc, 0xcb, 0x37, 0x1c, 0x49, 0x3d, 0x26, 0xfb, 0xdc, 0xdf, 0x50,
+	0xa1, 0x98, 0x6f, 0x6e, 0xc6, 0xc2, 0xa9, 0xad, 0xdf, 0xca, 0xab, 0x7b, 0xe2, 0xd2, 0x3f, 0x03,
+	0x00, 0x49, 0x0f, 0x56, 0x9f, 0x8c, 0x10, 0x00, 0x00,
 }
 
 func (this *QueryParamsRequest) VerboseEqual(that interface{}) error {
@@ -1082,6 +1400,120 @@ func (this *QueryPricesResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *QueryTWAPRequest) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*QueryTWAPRequest)
+	if !ok {
+		that2, ok := that.(QueryTWAPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryTWAPRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryTWAPRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryTWAPRequest but is not nil && this == nil")
+	}
+	if this.MarketId != that1.MarketId {
+		return fmt.Errorf("MarketId this(%v) Not Equal that(%v)", this.MarketId, that1.MarketId)
+	}
+	return nil
+}
+func (this *QueryTWAPRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryTWAPRequest)
+	if !ok {
+		that2, ok := that.(QueryTWAPRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketId != that1.MarketId {
+		return false
+	}
+	return true
+}
+func (this *QueryTWAPResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*QueryTWAPResponse)
+	if !ok {
+		that2, ok := that.(QueryTWAPResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryTWAPResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryTWAPResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryTWAPResponse but is not nil && this == nil")
+	}
+	if !this.Price.Equal(&that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if this.Window != that1.Window {
+		return fmt.Errorf("Window this(%v) Not Equal that(%v)", this.Window, that1.Window)
+	}
+	return nil
+}
+func (this *QueryTWAPResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryTWAPResponse)
+	if !ok {
+		that2, ok := that.(QueryTWAPResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Price.Equal(&that1.Price) {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	return true
+}
 func (this *QueryRawPricesRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
@@ -1430,7 +1862,7 @@ func (this *QueryMarketsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *PostedPriceResponse) VerboseEqual(that interface{}) error {
+func (this *QueryOracleStatsRequest) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1438,7 +1870,125 @@ func (this *PostedPriceResponse) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*PostedPriceResponse)
+	that1, ok := that.(*QueryOracleStatsRequest)
+	if !ok {
+		that2, ok := that.(QueryOracleStatsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryOracleStatsRequest")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryOracleStatsRequest but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryOracleStatsRequest but is not nil && this == nil")
+	}
+	if this.MarketId != that1.MarketId {
+		return fmt.Errorf("MarketId this(%v) Not Equal that(%v)", this.MarketId, that1.MarketId)
+	}
+	return nil
+}
+func (this *QueryOracleStatsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryOracleStatsRequest)
+	if !ok {
+		that2, ok := that.(QueryOracleStatsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketId != that1.MarketId {
+		return false
+	}
+	return true
+}
+func (this *QueryOracleStatsResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*QueryOracleStatsResponse)
+	if !ok {
+		that2, ok := that.(QueryOracleStatsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *QueryOracleStatsResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *QueryOracleStatsResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *QueryOracleStatsResponse but is not nil && this == nil")
+	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return fmt.Errorf("OracleStats this(%v) Not Equal that(%v)", len(this.OracleStats), len(that1.OracleStats))
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return fmt.Errorf("OracleStats this[%v](%v) Not Equal that[%v](%v)", i, this.OracleStats[i], i, that1.OracleStats[i])
+		}
+	}
+	return nil
+}
+func (this *QueryOracleStatsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryOracleStatsResponse)
+	if !ok {
+		that2, ok := that.(QueryOracleStatsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.OracleStats) != len(that1.OracleStats) {
+		return false
+	}
+	for i := range this.OracleStats {
+		if !this.OracleStats[i].Equal(&that1.OracleStats[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PostedPriceResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PostedPriceResponse)
 	if !ok {
 		that2, ok := that.(PostedPriceResponse)
 		if ok {
@@ -1607,6 +2157,38 @@ func (this *MarketResponse) VerboseEqual(that interface{}) error {
 	if this.Active != that1.Active {
 		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
 	}
+	if this.TwapWindow != that1.TwapWindow {
+		return fmt.Errorf("TwapWindow this(%v) Not Equal that(%v)", this.TwapWindow, that1.TwapWindow)
+	}
+	if !this.MaxPriceChange.Equal(that1.MaxPriceChange) {
+		return fmt.Errorf("MaxPriceChange this(%v) Not Equal that(%v)", this.MaxPriceChange, that1.MaxPriceChange)
+	}
+	if this.MinOracles != that1.MinOracles {
+		return fmt.Errorf("MinOracles this(%v) Not Equal that(%v)", this.MinOracles, that1.MinOracles)
+	}
+	if this.Stale != that1.Stale {
+		return fmt.Errorf("Stale this(%v) Not Equal that(%v)", this.Stale, that1.Stale)
+	}
+	if !this.OutlierBand.Equal(that1.OutlierBand) {
+		return fmt.Errorf("OutlierBand this(%v) Not Equal that(%v)", this.OutlierBand, that1.OutlierBand)
+	}
+	if len(this.SourceMarkets) != len(that1.SourceMarkets) {
+		return fmt.Errorf("SourceMarkets this(%v) Not Equal that(%v)", len(this.SourceMarkets), len(that1.SourceMarkets))
+	}
+	for i := range this.SourceMarkets {
+		if !this.SourceMarkets[i].Equal(&that1.SourceMarkets[i]) {
+			return fmt.Errorf("SourceMarkets this[%v](%v) Not Equal that[%v](%v)", i, this.SourceMarkets[i], i, that1.SourceMarkets[i])
+		}
+	}
+	if !this.PoolPriceSource.Equal(that1.PoolPriceSource) {
+		return fmt.Errorf("PoolPriceSource this(%v) Not Equal that(%v)", this.PoolPriceSource, that1.PoolPriceSource)
+	}
+	if this.ReferenceMarketID != that1.ReferenceMarketID {
+		return fmt.Errorf("ReferenceMarketID this(%v) Not Equal that(%v)", this.ReferenceMarketID, that1.ReferenceMarketID)
+	}
+	if !this.MaxReferenceDeviation.Equal(that1.MaxReferenceDeviation) {
+		return fmt.Errorf("MaxReferenceDeviation this(%v) Not Equal that(%v)", this.MaxReferenceDeviation, that1.MaxReferenceDeviation)
+	}
 	return nil
 }
 func (this *MarketResponse) Equal(that interface{}) bool {
@@ -1648,6 +2230,116 @@ func (this *MarketResponse) Equal(that interface{}) bool {
 	if this.Active != that1.Active {
 		return false
 	}
+	if this.TwapWindow != that1.TwapWindow {
+		return false
+	}
+	if !this.MaxPriceChange.Equal(that1.MaxPriceChange) {
+		return false
+	}
+	if this.MinOracles != that1.MinOracles {
+		return false
+	}
+	if this.Stale != that1.Stale {
+		return false
+	}
+	if !this.OutlierBand.Equal(that1.OutlierBand) {
+		return false
+	}
+	if len(this.SourceMarkets) != len(that1.SourceMarkets) {
+		return false
+	}
+	for i := range this.SourceMarkets {
+		if !this.SourceMarkets[i].Equal(&that1.SourceMarkets[i]) {
+			return false
+		}
+	}
+	if !this.PoolPriceSource.Equal(that1.PoolPriceSource) {
+		return false
+	}
+	if this.ReferenceMarketID != that1.ReferenceMarketID {
+		return false
+	}
+	if !this.MaxReferenceDeviation.Equal(that1.MaxReferenceDeviation) {
+		return false
+	}
+	return true
+}
+func (this *OracleStatsResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleStatsResponse)
+	if !ok {
+		that2, ok := that.(OracleStatsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleStatsResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleStatsResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleStatsResponse but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if this.Posts != that1.Posts {
+		return fmt.Errorf("Posts this(%v) Not Equal that(%v)", this.Posts, that1.Posts)
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return fmt.Errorf("MissedWindows this(%v) Not Equal that(%v)", this.MissedWindows, that1.MissedWindows)
+	}
+	if this.Outliers != that1.Outliers {
+		return fmt.Errorf("Outliers this(%v) Not Equal that(%v)", this.Outliers, that1.Outliers)
+	}
+	return nil
+}
+func (this *OracleStatsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleStatsResponse)
+	if !ok {
+		that2, ok := that.(OracleStatsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.OracleAddress != that1.OracleAddress {
+		return false
+	}
+	if this.Posts != that1.Posts {
+		return false
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return false
+	}
+	if this.Outliers != that1.Outliers {
+		return false
+	}
 	return true
 }
 
@@ -1669,12 +2361,16 @@ type QueryClient interface {
 	Price(ctx context.Context, in *QueryPriceRequest, opts ...grpc.CallOption) (*QueryPriceResponse, error)
 	// Prices queries all prices
 	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// TWAP queries the time-weighted average price of a market
+	TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error)
 	// RawPrices queries all raw prices based on a market
 	RawPrices(ctx context.Context, in *QueryRawPricesRequest, opts ...grpc.CallOption) (*QueryRawPricesResponse, error)
 	// Oracles queries all oracles based on a market
 	Oracles(ctx context.Context, in *QueryOraclesRequest, opts ...grpc.CallOption) (*QueryOraclesResponse, error)
 	// Markets queries all markets
 	Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
+	// OracleStats queries the reliability statistics of each oracle of a market
+	OracleStats(ctx context.Context, in *QueryOracleStatsRequest, opts ...grpc.CallOption) (*QueryOracleStatsResponse, error)
 }
 
 type queryClient struct {
@@ -1712,6 +2408,15 @@ func (c *queryClient) Prices(ctx context.Context, in *QueryPricesRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TWAP(ctx context.Context, in *QueryTWAPRequest, opts ...grpc.CallOption) (*QueryTWAPResponse, error) {
+	out := new(QueryTWAPResponse)
+	err := c.cc.Invoke(ctx, "/aeth.pricefeed.v1beta1.Query/TWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RawPrices(ctx context.Context, in *QueryRawPricesRequest, opts ...grpc.CallOption) (*QueryRawPricesResponse, error) {
 	out := new(QueryRawPricesResponse)
 	err := c.cc.Invoke(ctx, "/aeth.pricefeed.v1beta1.Query/RawPrices", in, out, opts...)
@@ -1739,6 +2444,15 @@ func (c *queryClient) Markets(ctx context.Context, in *QueryMarketsRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) OracleStats(ctx context.Context, in *QueryOracleStatsRequest, opts ...grpc.CallOption) (*QueryOracleStatsResponse, error) {
+	out := new(QueryOracleStatsResponse)
+	err := c.cc.Invoke(ctx, "/aeth.pricefeed.v1beta1.Query/OracleStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the pricefeed module.
@@ -1747,12 +2461,16 @@ type QueryServer interface {
 	Price(context.Context, *QueryPriceRequest) (*QueryPriceResponse, error)
 	// Prices queries all prices
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// TWAP queries the time-weighted average price of a market
+	TWAP(context.Context, *QueryTWAPRequest) (*QueryTWAPResponse, error)
 	// RawPrices queries all raw prices based on a market
 	RawPrices(context.Context, *QueryRawPricesRequest) (*QueryRawPricesResponse, error)
 	// Oracles queries all oracles based on a market
 	Oracles(context.Context, *QueryOraclesRequest) (*QueryOraclesResponse, error)
 	// Markets queries all markets
 	Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
+	// OracleStats queries the reliability statistics of each oracle of a market
+	OracleStats(context.Context, *QueryOracleStatsRequest) (*QueryOracleStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1768,6 +2486,9 @@ func (*UnimplementedQueryServer) Price(ctx context.Context, req *QueryPriceReque
 func (*UnimplementedQueryServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (*UnimplementedQueryServer) TWAP(ctx context.Context, req *QueryTWAPRequest) (*QueryTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TWAP not implemented")
+}
 func (*UnimplementedQueryServer) RawPrices(ctx context.Context, req *QueryRawPricesRequest) (*QueryRawPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RawPrices not implemented")
 }
@@ -1777,6 +2498,9 @@ func (*UnimplementedQueryServer) Oracles(ctx context.Context, req *QueryOraclesR
 func (*UnimplementedQueryServer) Markets(ctx context.Context, req *QueryMarketsRequest) (*QueryMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
 }
+func (*UnimplementedQueryServer) OracleStats(ctx context.Context, req *QueryOracleStatsRequest) (*QueryOracleStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1836,6 +2560,24 @@ func _Query_Prices_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.pricefeed.v1beta1.Query/TWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TWAP(ctx, req.(*QueryTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RawPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRawPricesRequest)
 	if err := dec(in); err != nil {
@@ -1890,6 +2632,24 @@ func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OracleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.pricefeed.v1beta1.Query/OracleStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleStats(ctx, req.(*QueryOracleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "aeth.pricefeed.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1906,6 +2666,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Prices",
 			Handler:    _Query_Prices_Handler,
 		},
+		{
+			MethodName: "TWAP",
+			Handler:    _Query_TWAP_Handler,
+		},
 		{
 			MethodName: "RawPrices",
 			Handler:    _Query_RawPrices_Handler,
@@ -1918,6 +2682,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Markets",
 			Handler:    _Query_Markets_Handler,
 		},
+		{
+			MethodName: "OracleStats",
+			Handler:    _Query_OracleStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aeth/pricefeed/v1beta1/query.proto",
@@ -2102,7 +2870,7 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRawPricesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTWAPRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2112,12 +2880,12 @@ func (m *QueryRawPricesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRawPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRawPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2132,7 +2900,7 @@ func (m *QueryRawPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRawPricesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTWAPResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2142,35 +2910,106 @@ func (m *QueryRawPricesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRawPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRawPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RawPrices) > 0 {
-		for iNdEx := len(m.RawPrices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RawPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err3 != nil {
+		return 0, err3
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryOraclesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawPrices) > 0 {
+		for iNdEx := len(m.RawPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOraclesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -2291,6 +3130,73 @@ func (m *QueryMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOracleStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleStats) > 0 {
+		for iNdEx := len(m.OracleStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PostedPriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2311,12 +3217,12 @@ func (m *PostedPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x22
 	{
@@ -2406,6 +3312,92 @@ func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxReferenceDeviation.Size()
+		i -= size
+		if _, err := m.MaxReferenceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.ReferenceMarketID) > 0 {
+		i -= len(m.ReferenceMarketID)
+		copy(dAtA[i:], m.ReferenceMarketID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReferenceMarketID)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PoolPriceSource != nil {
+		{
+			size, err := m.PoolPriceSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.SourceMarkets) > 0 {
+		for iNdEx := len(m.SourceMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size := m.OutlierBand.Size()
+		i -= size
+		if _, err := m.OutlierBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinOracles != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinOracles))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxPriceChange.Size()
+		i -= size
+		if _, err := m.MaxPriceChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
 	if m.Active {
 		i--
 		if m.Active {
@@ -2449,6 +3441,58 @@ func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OracleStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outliers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Outliers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedWindows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedWindows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Posts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Posts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2528,7 +3572,7 @@ func (m *QueryPricesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryRawPricesRequest) Size() (n int) {
+func (m *QueryTWAPRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2541,22 +3585,20 @@ func (m *QueryRawPricesRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryRawPricesResponse) Size() (n int) {
+func (m *QueryTWAPResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.RawPrices) > 0 {
-		for _, e := range m.RawPrices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryOraclesRequest) Size() (n int) {
+func (m *QueryRawPricesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2569,14 +3611,42 @@ func (m *QueryOraclesRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryOraclesResponse) Size() (n int) {
+func (m *QueryRawPricesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Oracles) > 0 {
-		for _, s := range m.Oracles {
+	if len(m.RawPrices) > 0 {
+		for _, e := range m.RawPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOraclesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOraclesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Oracles) > 0 {
+		for _, s := range m.Oracles {
 			l = len(s)
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2608,6 +3678,34 @@ func (m *QueryMarketsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOracleStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OracleStats) > 0 {
+		for _, e := range m.OracleStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *PostedPriceResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2671,6 +3769,60 @@ func (m *MarketResponse) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxPriceChange.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MinOracles != 0 {
+		n += 1 + sovQuery(uint64(m.MinOracles))
+	}
+	if m.Stale {
+		n += 2
+	}
+	l = m.OutlierBand.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SourceMarkets) > 0 {
+		for _, e := range m.SourceMarkets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PoolPriceSource != nil {
+		l = m.PoolPriceSource.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReferenceMarketID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MaxReferenceDeviation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *OracleStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Posts != 0 {
+		n += 1 + sovQuery(uint64(m.Posts))
+	}
+	if m.MissedWindows != 0 {
+		n += 1 + sovQuery(uint64(m.MissedWindows))
+	}
+	if m.Outliers != 0 {
+		n += 1 + sovQuery(uint64(m.Outliers))
+	}
 	return n
 }
 
@@ -3112,7 +4264,7 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3135,10 +4287,10 @@ func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3194,7 +4346,7 @@ func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3217,15 +4369,15 @@ func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3252,8 +4404,40 @@ func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawPrices = append(m.RawPrices, PostedPriceResponse{})
-			if err := m.RawPrices[len(m.RawPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3278,7 +4462,7 @@ func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRawPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3301,10 +4485,10 @@ func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOraclesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRawPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOraclesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRawPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3360,7 +4544,7 @@ func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRawPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3383,17 +4567,17 @@ func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOraclesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRawPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOraclesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRawPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPrices", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3403,23 +4587,25 @@ func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
+			m.RawPrices = append(m.RawPrices, PostedPriceResponse{})
+			if err := m.RawPrices[len(m.RawPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3442,7 +4628,7 @@ func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOraclesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3465,12 +4651,44 @@ func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOraclesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOraclesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3492,7 +4710,7 @@ func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOraclesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3515,17 +4733,17 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOraclesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOraclesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3535,25 +4753,23 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, MarketResponse{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3576,7 +4792,7 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3599,47 +4815,708 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostedPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostedPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, MarketResponse{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleStats = append(m.OracleStats, OracleStatsResponse{})
+			if err := m.OracleStats[len(m.OracleStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostedPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostedPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3667,11 +5544,64 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleAddress = string(dAtA[iNdEx:postIndex])
+			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChange", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3699,13 +5629,86 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPriceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOracles", wireType)
+			}
+			m.MinOracles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOracles |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutlierBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceMarkets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3732,63 +5735,50 @@ func (m *PostedPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+			m.SourceMarkets = append(m.SourceMarkets, SourceMarket{})
+			if err := m.SourceMarkets[len(m.SourceMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPriceSource", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.PoolPriceSource == nil {
+				m.PoolPriceSource = &PoolPriceSource{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentPriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.PoolPriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceMarketID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3816,11 +5806,11 @@ func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketID = string(dAtA[iNdEx:postIndex])
+			m.ReferenceMarketID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReferenceDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3848,7 +5838,7 @@ func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxReferenceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3873,7 +5863,7 @@ func (m *CurrentPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketResponse) Unmarshal(dAtA []byte) error {
+func (m *OracleStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3896,10 +5886,10 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3936,7 +5926,7 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3964,13 +5954,13 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			m.OracleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
 			}
-			var stringLen uint64
+			m.Posts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3980,29 +5970,16 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Posts |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedWindows", wireType)
 			}
-			var stringLen uint64
+			m.MissedWindows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4012,29 +5989,16 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissedWindows |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outliers", wireType)
 			}
-			var v int
+			m.Outliers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4044,12 +6008,11 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Outliers |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -39,7 +40,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40639f5e16f9a, []int{0}
+	return fileDescriptor_f1985a9cd1a25743, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -82,13 +83,45 @@ type Market struct {
 	QuoteAsset string                                          `protobuf:"bytes,3,opt,name=quote_asset,json=quoteAsset,proto3" json:"quote_asset,omitempty"`
 	Oracles    []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=oracles,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracles,omitempty"`
 	Active     bool                                            `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// twap_window is the length of the time-weighted average price window. A
+	// zero window disables price history and TWAP tracking for the market.
+	TwapWindow time.Duration `protobuf:"bytes,6,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window"`
+	// max_price_change is the largest change in the market's price, as a fraction
+	// of the previous price, accepted in a single block. A larger change flags the
+	// market as stale. Zero disables the check.
+	MaxPriceChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_price_change,json=maxPriceChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_change"`
+	// min_oracles is the number of oracles with unexpired prices required to
+	// update the market's price. Fewer flags the market as stale. Zero disables
+	// the check.
+	MinOracles uint32 `protobuf:"varint,8,opt,name=min_oracles,json=minOracles,proto3" json:"min_oracles,omitempty"`
+	// outlier_band is the largest deviation of an oracle's price from the median
+	// of all unexpired prices, as a fraction of the median, that is included in
+	// the market's price. Prices outside the band are excluded and counted as
+	// outliers in the oracle's stats. Zero disables the check.
+	OutlierBand github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=outlier_band,json=outlierBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"outlier_band"`
+	// source_markets are the markets a derived market's price is computed from.
+	// The price is the product of the source prices, with inverted sources
+	// dividing instead of multiplying. Markets with sources have no oracles.
+	SourceMarkets SourceMarkets `protobuf:"bytes,10,rep,name=source_markets,json=sourceMarkets,proto3,castrepeated=SourceMarkets" json:"source_markets"`
+	// pool_price_source is the x/swap pool a pool market's price is computed
+	// from. Markets with a pool price source have no oracles.
+	PoolPriceSource *PoolPriceSource `protobuf:"bytes,11,opt,name=pool_price_source,json=poolPriceSource,proto3" json:"pool_price_source,omitempty"`
+	// reference_market_id is the market, or TWAP market, whose price is used
+	// when the market has no valid price and to check the market's price against.
+	// Empty disables both.
+	ReferenceMarketID string `protobuf:"bytes,12,opt,name=reference_market_id,json=referenceMarketId,proto3" json:"reference_market_id,omitempty"`
+	// max_reference_deviation is the largest deviation of the market's price from
+	// the price of its reference market, as a fraction of the reference price,
+	// that is accepted. A larger deviation flags the market as stale. Zero
+	// disables the check.
+	MaxReferenceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_reference_deviation,json=maxReferenceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reference_deviation"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
 func (m *Market) String() string { return proto.CompactTextString(m) }
 func (*Market) ProtoMessage()    {}
 func (*Market) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40639f5e16f9a, []int{1}
+	return fileDescriptor_f1985a9cd1a25743, []int{1}
 }
 func (m *Market) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,6 +185,156 @@ func (m *Market) GetActive() bool {
 	return false
 }
 
+func (m *Market) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *Market) GetMinOracles() uint32 {
+	if m != nil {
+		return m.MinOracles
+	}
+	return 0
+}
+
+func (m *Market) GetSourceMarkets() SourceMarkets {
+	if m != nil {
+		return m.SourceMarkets
+	}
+	return nil
+}
+
+func (m *Market) GetPoolPriceSource() *PoolPriceSource {
+	if m != nil {
+		return m.PoolPriceSource
+	}
+	return nil
+}
+
+func (m *Market) GetReferenceMarketID() string {
+	if m != nil {
+		return m.ReferenceMarketID
+	}
+	return ""
+}
+
+// SourceMarket defines a market a derived market's price is computed from.
+type SourceMarket struct {
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// invert divides by the source market's price instead of multiplying by it
+	Invert bool `protobuf:"varint,2,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (m *SourceMarket) Reset()         { *m = SourceMarket{} }
+func (m *SourceMarket) String() string { return proto.CompactTextString(m) }
+func (*SourceMarket) ProtoMessage()    {}
+func (*SourceMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1985a9cd1a25743, []int{2}
+}
+func (m *SourceMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceMarket.Merge(m, src)
+}
+func (m *SourceMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceMarket proto.InternalMessageInfo
+
+func (m *SourceMarket) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *SourceMarket) GetInvert() bool {
+	if m != nil {
+		return m.Invert
+	}
+	return false
+}
+
+// PoolPriceSource defines an x/swap pool a market's price is computed from.
+type PoolPriceSource struct {
+	// base_denom is the denom of the pool reserves priced by the market
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the denom of the pool reserves the price is quoted in
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// conversion_factor converts the ratio of quote to base reserves into the
+	// market's price, accounting for differences in the precision of the denoms
+	ConversionFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_factor"`
+	// min_liquidity is the amount of quote_denom reserves the pool must hold
+	// before its price is used
+	MinLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_liquidity,json=minLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_liquidity"`
+}
+
+func (m *PoolPriceSource) Reset()         { *m = PoolPriceSource{} }
+func (m *PoolPriceSource) String() string { return proto.CompactTextString(m) }
+func (*PoolPriceSource) ProtoMessage()    {}
+func (*PoolPriceSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1985a9cd1a25743, []int{3}
+}
+func (m *PoolPriceSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPriceSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPriceSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPriceSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPriceSource.Merge(m, src)
+}
+func (m *PoolPriceSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPriceSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPriceSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPriceSource proto.InternalMessageInfo
+
+func (m *PoolPriceSource) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *PoolPriceSource) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
 // PostedPrice defines a price for market posted by a specific oracle.
 type PostedPrice struct {
 	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -164,7 +347,7 @@ func (m *PostedPrice) Reset()         { *m = PostedPrice{} }
 func (m *PostedPrice) String() string { return proto.CompactTextString(m) }
 func (*PostedPrice) ProtoMessage()    {}
 func (*PostedPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40639f5e16f9a, []int{2}
+	return fileDescriptor_f1985a9cd1a25743, []int{4}
 }
 func (m *PostedPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,7 +408,7 @@ func (m *CurrentPrice) Reset()         { *m = CurrentPrice{} }
 func (m *CurrentPrice) String() string { return proto.CompactTextString(m) }
 func (*CurrentPrice) ProtoMessage()    {}
 func (*CurrentPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40639f5e16f9a, []int{3}
+	return fileDescriptor_f1985a9cd1a25743, []int{5}
 }
 func (m *CurrentPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,51 +444,280 @@ func (m *CurrentPrice) GetMarketID() string {
 	return ""
 }
 
+// PriceSnapshot defines the current price of a market recorded at a block time,
+// used to calculate time-weighted average prices.
+type PriceSnapshot struct {
+	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Time     time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// cumulative_price is the sum of each previous snapshot price multiplied by
+	// the nanoseconds it was held, up to the time of this snapshot.
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
+}
+
+func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
+func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PriceSnapshot) ProtoMessage()    {}
+func (*PriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1985a9cd1a25743, []int{6}
+}
+func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSnapshot.Merge(m, src)
+}
+func (m *PriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSnapshot proto.InternalMessageInfo
+
+func (m *PriceSnapshot) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PriceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// StaleMarket defines a market flagged as stale by the circuit breaker and the
+// reason it was flagged.
+type StaleMarket struct {
+	MarketID string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *StaleMarket) Reset()         { *m = StaleMarket{} }
+func (m *StaleMarket) String() string { return proto.CompactTextString(m) }
+func (*StaleMarket) ProtoMessage()    {}
+func (*StaleMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1985a9cd1a25743, []int{7}
+}
+func (m *StaleMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StaleMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StaleMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StaleMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleMarket.Merge(m, src)
+}
+func (m *StaleMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *StaleMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StaleMarket proto.InternalMessageInfo
+
+func (m *StaleMarket) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *StaleMarket) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// OracleStats defines the reliability statistics of an oracle for a market.
+type OracleStats struct {
+	MarketID      string                                        `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OracleAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle_address,json=oracleAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle_address,omitempty"`
+	// posts is the number of prices posted by the oracle.
+	Posts uint64 `protobuf:"varint,3,opt,name=posts,proto3" json:"posts,omitempty"`
+	// missed_windows is the number of times the market's price was calculated
+	// without an unexpired price from the oracle.
+	MissedWindows uint64 `protobuf:"varint,4,opt,name=missed_windows,json=missedWindows,proto3" json:"missed_windows,omitempty"`
+	// outliers is the number of times the oracle's price was excluded from the
+	// market's price for falling outside the market's outlier band.
+	Outliers uint64 `protobuf:"varint,5,opt,name=outliers,proto3" json:"outliers,omitempty"`
+}
+
+func (m *OracleStats) Reset()         { *m = OracleStats{} }
+func (m *OracleStats) String() string { return proto.CompactTextString(m) }
+func (*OracleStats) ProtoMessage()    {}
+func (*OracleStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1985a9cd1a25743, []int{8}
+}
+func (m *OracleStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleStats.Merge(m, src)
+}
+func (m *OracleStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleStats proto.InternalMessageInfo
+
+func (m *OracleStats) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *OracleStats) GetOracleAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OracleAddress
+	}
+	return nil
+}
+
+func (m *OracleStats) GetPosts() uint64 {
+	if m != nil {
+		return m.Posts
+	}
+	return 0
+}
+
+func (m *OracleStats) GetMissedWindows() uint64 {
+	if m != nil {
+		return m.MissedWindows
+	}
+	return 0
+}
+
+func (m *OracleStats) GetOutliers() uint64 {
+	if m != nil {
+		return m.Outliers
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "aeth.pricefeed.v1beta1.Params")
 	proto.RegisterType((*Market)(nil), "aeth.pricefeed.v1beta1.Market")
+	proto.RegisterType((*SourceMarket)(nil), "aeth.pricefeed.v1beta1.SourceMarket")
+	proto.RegisterType((*PoolPriceSource)(nil), "aeth.pricefeed.v1beta1.PoolPriceSource")
 	proto.RegisterType((*PostedPrice)(nil), "aeth.pricefeed.v1beta1.PostedPrice")
 	proto.RegisterType((*CurrentPrice)(nil), "aeth.pricefeed.v1beta1.CurrentPrice")
+	proto.RegisterType((*PriceSnapshot)(nil), "aeth.pricefeed.v1beta1.PriceSnapshot")
+	proto.RegisterType((*StaleMarket)(nil), "aeth.pricefeed.v1beta1.StaleMarket")
+	proto.RegisterType((*OracleStats)(nil), "aeth.pricefeed.v1beta1.OracleStats")
 }
 
 func init() {
-	proto.RegisterFile("aeth/pricefeed/v1beta1/store.proto", fileDescriptor_9df40639f5e16f9a)
-}
-
-var fileDescriptor_9df40639f5e16f9a = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x25, 0x6d, 0xfe, 0x5c, 0x02, 0x48, 0x06, 0x55, 0x26, 0x12, 0x76, 0x94, 0x01, 0x19,
-	0xa1, 0x9c, 0xd5, 0xb2, 0xb2, 0xc4, 0x64, 0x20, 0x43, 0xa5, 0xc8, 0x30, 0xb1, 0x44, 0x67, 0xfb,
-	0xd5, 0x58, 0x89, 0x39, 0x73, 0x77, 0x89, 0x9a, 0x89, 0xaf, 0xd0, 0x8f, 0x81, 0x90, 0xd8, 0xf8,
-	0x10, 0x1d, 0x2b, 0x26, 0xc4, 0x90, 0x16, 0xe7, 0x03, 0xb0, 0x33, 0x21, 0xdf, 0xd9, 0x55, 0x07,
-	0x06, 0x2a, 0x98, 0xee, 0xde, 0xef, 0xfd, 0xde, 0xbf, 0xdf, 0xbd, 0xc3, 0xc3, 0x05, 0x5d, 0x53,
-	0x37, 0xe3, 0x49, 0x08, 0x27, 0x00, 0x91, 0xbb, 0x3e, 0x0c, 0x40, 0xd2, 0x43, 0x57, 0x48, 0xc6,
-	0x81, 0x64, 0x9c, 0x49, 0x66, 0x1c, 0x14, 0x1c, 0x72, 0xcd, 0x21, 0x25, 0xa7, 0xff, 0x30, 0x64,
-	0x22, 0x65, 0x62, 0xae, 0x58, 0xae, 0x36, 0x74, 0x48, 0xff, 0x41, 0xcc, 0x62, 0xa6, 0xf1, 0xe2,
-	0x56, 0xa2, 0x76, 0xcc, 0x58, 0xbc, 0x04, 0x57, 0x59, 0xc1, 0xea, 0xc4, 0x95, 0x49, 0x0a, 0x42,
-	0xd2, 0x34, 0xd3, 0x84, 0xe1, 0x2b, 0xdc, 0x9c, 0x51, 0x4e, 0x53, 0x61, 0x4c, 0x71, 0x2b, 0xa5,
-	0x7c, 0x01, 0x52, 0x98, 0x68, 0xd0, 0x70, 0xba, 0x47, 0x16, 0xf9, 0x73, 0x17, 0xe4, 0x58, 0xd1,
-	0xbc, 0x7b, 0xe7, 0x5b, 0xbb, 0xf6, 0xe9, 0xd2, 0x6e, 0x69, 0x5b, 0xf8, 0x55, 0xfc, 0xf0, 0x27,
-	0xc2, 0x4d, 0x0d, 0x1a, 0x4f, 0x70, 0x47, 0xa3, 0xf3, 0x24, 0x32, 0xd1, 0x00, 0x39, 0x1d, 0xaf,
-	0x97, 0x6f, 0xed, 0xb6, 0x76, 0x4f, 0x27, 0x7e, 0x5b, 0xbb, 0xa7, 0x91, 0xf1, 0x08, 0xe3, 0x80,
-	0x0a, 0x98, 0x53, 0x21, 0x40, 0x9a, 0xf5, 0x82, 0xeb, 0x77, 0x0a, 0x64, 0x5c, 0x00, 0x86, 0x8d,
-	0xbb, 0xef, 0x57, 0x4c, 0x56, 0xfe, 0x86, 0xf2, 0x63, 0x05, 0x69, 0x42, 0x80, 0x5b, 0x8c, 0xd3,
-	0x70, 0x09, 0xc2, 0xdc, 0x1b, 0x34, 0x9c, 0x9e, 0xf7, 0xf2, 0xd7, 0xd6, 0x1e, 0xc5, 0x89, 0x7c,
-	0xbb, 0x0a, 0x48, 0xc8, 0xd2, 0x52, 0xaf, 0xf2, 0x18, 0x89, 0x68, 0xe1, 0xca, 0x4d, 0x06, 0x82,
-	0x8c, 0xc3, 0x70, 0x1c, 0x45, 0x1c, 0x84, 0xf8, 0xfa, 0x65, 0x74, 0xbf, 0x54, 0xb5, 0x44, 0xbc,
-	0x8d, 0x04, 0xe1, 0x57, 0x89, 0x8d, 0x03, 0xdc, 0xa4, 0xa1, 0x4c, 0xd6, 0x60, 0xee, 0x0f, 0x90,
-	0xd3, 0xf6, 0x4b, 0x6b, 0xf8, 0xb9, 0x8e, 0xbb, 0x33, 0x26, 0x24, 0x44, 0xb3, 0x42, 0xae, 0xdb,
-	0x8c, 0xcd, 0xf0, 0x5d, 0x9d, 0x7d, 0x4e, 0x75, 0x49, 0x35, 0xfa, 0xff, 0xec, 0xfe, 0x8e, 0xce,
-	0x5f, 0x62, 0xc6, 0x04, 0xef, 0xab, 0x37, 0xd5, 0x12, 0x7a, 0xa4, 0x78, 0xc6, 0xef, 0x5b, 0xfb,
-	0xf1, 0x5f, 0xd4, 0x9a, 0x40, 0xe8, 0xeb, 0x60, 0xe3, 0x39, 0x6e, 0xc2, 0x69, 0x96, 0xf0, 0x8d,
-	0xb9, 0x37, 0x40, 0x4e, 0xf7, 0xa8, 0x4f, 0xf4, 0xaa, 0x91, 0x6a, 0xd5, 0xc8, 0xeb, 0x6a, 0xd5,
-	0xbc, 0x76, 0x51, 0xe2, 0xec, 0xd2, 0x46, 0x7e, 0x19, 0x33, 0xfc, 0x80, 0x7b, 0x2f, 0x56, 0x9c,
-	0xc3, 0x3b, 0x79, 0x6b, 0xbd, 0xae, 0xdb, 0xaf, 0xff, 0x43, 0xfb, 0xde, 0xf1, 0xd5, 0x0f, 0x0b,
-	0x7d, 0xcc, 0x2d, 0x74, 0x9e, 0x5b, 0xe8, 0x22, 0xb7, 0xd0, 0x55, 0x6e, 0xa1, 0xb3, 0x9d, 0x55,
-	0xbb, 0xd8, 0x59, 0xb5, 0x6f, 0x3b, 0xab, 0xf6, 0xe6, 0xe9, 0x8d, 0x84, 0xc5, 0x47, 0x18, 0x2d,
-	0x69, 0x20, 0xd4, 0xcd, 0x3d, 0xbd, 0xf1, 0x7d, 0x55, 0xe6, 0xa0, 0xa9, 0xa6, 0x7e, 0xf6, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0x18, 0xb5, 0x5b, 0xc1, 0xdd, 0x03, 0x00, 0x00,
+	proto.RegisterFile("aeth/pricefeed/v1beta1/store.proto", fileDescriptor_f1985a9cd1a25743)
+}
+
+var fileDescriptor_f1985a9cd1a25743 = []byte{
+	// 985 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcb, 0x6e, 0x23, 0x45,
+	0x17, 0x4e, 0x3b, 0x8e, 0xe3, 0x9c, 0xb6, 0x73, 0xe9, 0x99, 0xe4, 0xef, 0x89, 0xf4, 0xb7, 0xad,
+	0x16, 0x17, 0xb3, 0x48, 0x5b, 0x09, 0x1b, 0x16, 0xb3, 0x89, 0xc7, 0x20, 0x22, 0x81, 0xc8, 0xb4,
+	0x91, 0xb8, 0x2d, 0x5a, 0xe5, 0xee, 0x8a, 0x53, 0x8a, 0xbb, 0xab, 0xa7, 0xaa, 0x3a, 0x97, 0x15,
+	0x12, 0x4f, 0x30, 0x2c, 0x90, 0x78, 0x04, 0x84, 0xc4, 0x6e, 0x1e, 0x62, 0x96, 0xa3, 0x59, 0x21,
+	0x16, 0x99, 0xc1, 0x59, 0xf2, 0x06, 0xac, 0x50, 0x5d, 0xda, 0x31, 0x81, 0x91, 0x88, 0x41, 0x88,
+	0x55, 0x72, 0xbe, 0x73, 0xce, 0xd7, 0x55, 0x5f, 0x9d, 0x8b, 0xc1, 0x47, 0x58, 0x1c, 0x77, 0x73,
+	0x46, 0x62, 0x7c, 0x84, 0x71, 0xd2, 0x3d, 0xdd, 0x1d, 0x62, 0x81, 0x76, 0xbb, 0x5c, 0x50, 0x86,
+	0x83, 0x9c, 0x51, 0x41, 0x9d, 0x2d, 0x19, 0x13, 0x4c, 0x63, 0x02, 0x13, 0xb3, 0x7d, 0x2f, 0xa6,
+	0x3c, 0xa5, 0x3c, 0x52, 0x51, 0x5d, 0x6d, 0xe8, 0x94, 0xed, 0xbb, 0x23, 0x3a, 0xa2, 0x1a, 0x97,
+	0xff, 0x19, 0xd4, 0x1b, 0x51, 0x3a, 0x1a, 0xe3, 0xae, 0xb2, 0x86, 0xc5, 0x51, 0x37, 0x29, 0x18,
+	0x12, 0x84, 0x66, 0xc6, 0xdf, 0xba, 0xe9, 0x17, 0x24, 0xc5, 0x5c, 0xa0, 0x34, 0xd7, 0x01, 0xfe,
+	0x00, 0x6a, 0x87, 0x88, 0xa1, 0x94, 0x3b, 0x07, 0xb0, 0x9c, 0x22, 0x76, 0x82, 0x05, 0x77, 0xad,
+	0xf6, 0x62, 0xc7, 0xde, 0xf3, 0x82, 0x3f, 0x3f, 0x65, 0xf0, 0xa1, 0x0a, 0xeb, 0xad, 0x3d, 0xbd,
+	0x6c, 0x2d, 0x7c, 0xff, 0xa2, 0xb5, 0xac, 0x6d, 0x1e, 0x96, 0xf9, 0xfe, 0x2f, 0x35, 0xa8, 0x69,
+	0xd0, 0x79, 0x0b, 0x56, 0x34, 0x1a, 0x91, 0xc4, 0xb5, 0xda, 0x56, 0x67, 0xa5, 0xd7, 0x98, 0x5c,
+	0xb6, 0xea, 0xda, 0x7d, 0xd0, 0x0f, 0xeb, 0xda, 0x7d, 0x90, 0x38, 0xff, 0x07, 0x18, 0x22, 0x8e,
+	0x23, 0xc4, 0x39, 0x16, 0x6e, 0x45, 0xc6, 0x86, 0x2b, 0x12, 0xd9, 0x97, 0x80, 0xd3, 0x02, 0xfb,
+	0x51, 0x41, 0x45, 0xe9, 0x5f, 0x54, 0x7e, 0x50, 0x90, 0x0e, 0x18, 0xc2, 0x32, 0x65, 0x28, 0x1e,
+	0x63, 0xee, 0x56, 0xdb, 0x8b, 0x9d, 0x46, 0xef, 0xfd, 0x5f, 0x2f, 0x5b, 0x3b, 0x23, 0x22, 0x8e,
+	0x8b, 0x61, 0x10, 0xd3, 0xd4, 0xe8, 0x69, 0xfe, 0xec, 0xf0, 0xe4, 0xa4, 0x2b, 0x2e, 0x72, 0xcc,
+	0x83, 0xfd, 0x38, 0xde, 0x4f, 0x12, 0x86, 0x39, 0x7f, 0xfe, 0x64, 0xe7, 0x8e, 0x51, 0xdd, 0x20,
+	0xbd, 0x0b, 0x81, 0x79, 0x58, 0x12, 0x3b, 0x5b, 0x50, 0x43, 0xb1, 0x20, 0xa7, 0xd8, 0x5d, 0x6a,
+	0x5b, 0x9d, 0x7a, 0x68, 0x2c, 0xa7, 0x0f, 0xb6, 0x38, 0x43, 0x79, 0x74, 0x46, 0xb2, 0x84, 0x9e,
+	0xb9, 0xb5, 0xb6, 0xd5, 0xb1, 0xf7, 0xee, 0x05, 0x5a, 0xfd, 0xa0, 0x54, 0x3f, 0xe8, 0x9b, 0xd7,
+	0xe9, 0xd5, 0xa5, 0x76, 0xdf, 0xbe, 0x68, 0x59, 0x21, 0xc8, 0xbc, 0x4f, 0x54, 0x9a, 0xf3, 0x29,
+	0xac, 0xa7, 0xe8, 0x3c, 0x52, 0x8a, 0x47, 0xf1, 0x31, 0xca, 0x46, 0xd8, 0x5d, 0x56, 0x9a, 0x05,
+	0x32, 0xfe, 0xa7, 0xcb, 0xd6, 0x1b, 0x7f, 0xe1, 0x3a, 0x7d, 0x1c, 0x87, 0xab, 0x29, 0x3a, 0x3f,
+	0x94, 0x34, 0x0f, 0x14, 0x8b, 0x14, 0x2f, 0x25, 0x59, 0x54, 0xea, 0x53, 0x6f, 0x5b, 0x9d, 0x66,
+	0x08, 0x29, 0xc9, 0x3e, 0x32, 0x17, 0x7b, 0x08, 0x0d, 0x5a, 0x88, 0x31, 0xc1, 0x2c, 0x1a, 0xa2,
+	0x2c, 0x71, 0x57, 0xe6, 0xfa, 0xac, 0x6d, 0x38, 0x7a, 0x28, 0x4b, 0x9c, 0x21, 0xac, 0x72, 0x5a,
+	0xb0, 0x18, 0x47, 0x65, 0x5d, 0x81, 0xaa, 0xab, 0xd7, 0x5e, 0x55, 0x57, 0x03, 0x15, 0x6d, 0xaa,
+	0x6b, 0xd3, 0x54, 0x57, 0x73, 0x16, 0xe5, 0x61, 0x93, 0xcf, 0x9a, 0xce, 0x00, 0x36, 0x72, 0x4a,
+	0xc7, 0x46, 0x32, 0xed, 0x73, 0x6d, 0xa5, 0xfe, 0x9b, 0xaf, 0xfa, 0xcc, 0x21, 0xa5, 0x63, 0xa5,
+	0x8d, 0x66, 0x0e, 0xd7, 0xf2, 0xdf, 0x03, 0xce, 0xbb, 0x70, 0x87, 0xe1, 0x23, 0xcc, 0x70, 0x36,
+	0x3d, 0xbb, 0xac, 0xde, 0x86, 0x92, 0x64, 0x73, 0x72, 0xd9, 0xda, 0x08, 0x4b, 0xf7, 0xb4, 0x8c,
+	0x37, 0xd8, 0x0d, 0x28, 0x71, 0x8e, 0xe0, 0x7f, 0xf2, 0x35, 0xaf, 0xa9, 0x12, 0x7c, 0x4a, 0xd4,
+	0xf3, 0xbb, 0xcd, 0xb9, 0xd4, 0xdd, 0x4c, 0xd1, 0xf9, 0xf4, 0xcb, 0xfd, 0x92, 0xcc, 0x7f, 0x08,
+	0x8d, 0x59, 0x8d, 0x6e, 0xd3, 0x72, 0x5b, 0x50, 0x23, 0xd9, 0x29, 0x66, 0xba, 0xdd, 0xea, 0xa1,
+	0xb1, 0xfc, 0xaf, 0x2b, 0xb0, 0x76, 0x43, 0xa6, 0x69, 0x7b, 0x26, 0x38, 0xa3, 0xa9, 0x6b, 0x5d,
+	0xb7, 0x67, 0x5f, 0x02, 0xd7, 0xed, 0xa9, 0xfd, 0x95, 0x99, 0xf6, 0xd4, 0x01, 0x5f, 0xc0, 0x46,
+	0x4c, 0x25, 0x3d, 0x27, 0x34, 0x8b, 0x8e, 0x50, 0x2c, 0x28, 0x73, 0x17, 0xe7, 0x12, 0x62, 0xfd,
+	0x9a, 0xe8, 0x3d, 0xc5, 0xe3, 0x20, 0x68, 0xca, 0xfa, 0x1e, 0x93, 0x47, 0x05, 0x49, 0x88, 0xb8,
+	0x70, 0xab, 0x8a, 0xf8, 0xfe, 0x2d, 0x88, 0x0f, 0x32, 0xf1, 0xfc, 0xc9, 0x0e, 0x68, 0x5c, 0x5a,
+	0x61, 0x23, 0x25, 0xd9, 0x07, 0x25, 0xa3, 0xff, 0x43, 0x05, 0xec, 0x43, 0xca, 0x05, 0x4e, 0x94,
+	0x2a, 0xb7, 0x91, 0x99, 0xc2, 0xaa, 0xee, 0xbc, 0x08, 0xe9, 0xa9, 0xa2, 0xe4, 0xf9, 0x27, 0x07,
+	0x54, 0x53, 0xf3, 0x1b, 0xcc, 0xe9, 0xc3, 0x92, 0xaa, 0xfb, 0x39, 0xf5, 0xd5, 0xc9, 0xce, 0x7d,
+	0xa8, 0xe1, 0xf3, 0x9c, 0x30, 0xad, 0xa6, 0xbd, 0xb7, 0xfd, 0x87, 0x79, 0xf6, 0x71, 0xb9, 0x4d,
+	0xf4, 0x40, 0x7b, 0x2c, 0x07, 0x9a, 0xc9, 0xf1, 0xbf, 0x84, 0xc6, 0x83, 0x82, 0x31, 0x9c, 0x89,
+	0x5b, 0xeb, 0x35, 0x3d, 0x7e, 0xe5, 0x6f, 0x1c, 0xdf, 0xff, 0xa6, 0x02, 0x4d, 0x5d, 0xc0, 0x19,
+	0xca, 0xf9, 0x31, 0x15, 0xff, 0xfa, 0x11, 0x9c, 0x77, 0xa0, 0x2a, 0x17, 0xae, 0xbb, 0x78, 0x0b,
+	0xfd, 0x54, 0x86, 0xf3, 0x19, 0xac, 0xc7, 0x45, 0x5a, 0x8c, 0x91, 0x5c, 0x2f, 0x7a, 0xbc, 0xb9,
+	0xd5, 0xb9, 0x8e, 0xb2, 0x76, 0xcd, 0xa3, 0xd4, 0xf0, 0x0f, 0xc1, 0x1e, 0x08, 0x34, 0x9e, 0x6f,
+	0x5c, 0x30, 0x8c, 0x38, 0xcd, 0x4c, 0x7b, 0x1b, 0xcb, 0xff, 0xaa, 0x02, 0xb6, 0x5e, 0x24, 0x03,
+	0x81, 0x04, 0xff, 0x4f, 0xb7, 0xc6, 0x5d, 0x58, 0xca, 0x29, 0x17, 0x5c, 0xbd, 0x49, 0x35, 0xd4,
+	0x86, 0xf3, 0x3a, 0xac, 0xa6, 0x84, 0x73, 0x9c, 0x98, 0x0d, 0xce, 0x95, 0xd8, 0xd5, 0xb0, 0xa9,
+	0x51, 0xbd, 0x9f, 0xb9, 0xb3, 0x0d, 0x75, 0xb3, 0xe1, 0xb8, 0xfa, 0x01, 0x50, 0x0d, 0xa7, 0x76,
+	0x6f, 0xf0, 0xf2, 0x67, 0xcf, 0xfa, 0x6e, 0xe2, 0x59, 0x4f, 0x27, 0x9e, 0xf5, 0x6c, 0xe2, 0x59,
+	0x2f, 0x27, 0x9e, 0xf5, 0xf8, 0xca, 0x5b, 0x78, 0x76, 0xe5, 0x2d, 0xfc, 0x78, 0xe5, 0x2d, 0x7c,
+	0xbe, 0x3b, 0x73, 0x9f, 0x94, 0x9e, 0x10, 0x81, 0x32, 0x2c, 0xce, 0x28, 0x3b, 0xe9, 0xca, 0x4d,
+	0x85, 0x59, 0xf7, 0x7c, 0xe6, 0x67, 0xa3, 0xba, 0xde, 0xb0, 0xa6, 0x4a, 0xe5, 0xed, 0xdf, 0x06,
+	0x00, 0xce, 0xb9, 0xd7, 0x1f, 0x55, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) VerboseEqual(that interface{}) error {
@@ -417,6 +829,35 @@ func (this *Market) VerboseEqual(that interface{}) error {
 	if this.Active != that1.Active {
 		return fmt.Errorf("Active this(%v) Not Equal that(%v)", this.Active, that1.Active)
 	}
+	if this.TwapWindow != that1.TwapWindow {
+		return fmt.Errorf("TwapWindow this(%v) Not Equal that(%v)", this.TwapWindow, that1.TwapWindow)
+	}
+	if !this.MaxPriceChange.Equal(that1.MaxPriceChange) {
+		return fmt.Errorf("MaxPriceChange this(%v) Not Equal that(%v)", this.MaxPriceChange, that1.MaxPriceChange)
+	}
+	if this.MinOracles != that1.MinOracles {
+		return fmt.Errorf("MinOracles this(%v) Not Equal that(%v)", this.MinOracles, that1.MinOracles)
+	}
+	if !this.OutlierBand.Equal(that1.OutlierBand) {
+		return fmt.Errorf("OutlierBand this(%v) Not Equal that(%v)", this.OutlierBand, that1.OutlierBand)
+	}
+	if len(this.SourceMarkets) != len(that1.SourceMarkets) {
+		return fmt.Errorf("SourceMarkets this(%v) Not Equal that(%v)", len(this.SourceMarkets), len(that1.SourceMarkets))
+	}
+	for i := range this.SourceMarkets {
+		if !this.SourceMarkets[i].Equal(&that1.SourceMarkets[i]) {
+			return fmt.Errorf("SourceMarkets this[%v](%v) Not Equal that[%v](%v)", i, this.SourceMarkets[i], i, that1.SourceMarkets[i])
+		}
+	}
+	if !this.PoolPriceSource.Equal(that1.PoolPriceSource) {
+		return fmt.Errorf("PoolPriceSource this(%v) Not Equal that(%v)", this.PoolPriceSource, that1.PoolPriceSource)
+	}
+	if this.ReferenceMarketID != that1.ReferenceMarketID {
+		return fmt.Errorf("ReferenceMarketID this(%v) Not Equal that(%v)", this.ReferenceMarketID, that1.ReferenceMarketID)
+	}
+	if !this.MaxReferenceDeviation.Equal(that1.MaxReferenceDeviation) {
+		return fmt.Errorf("MaxReferenceDeviation this(%v) Not Equal that(%v)", this.MaxReferenceDeviation, that1.MaxReferenceDeviation)
+	}
 	return nil
 }
 func (this *Market) Equal(that interface{}) bool {
@@ -458,9 +899,38 @@ func (this *Market) Equal(that interface{}) bool {
 	if this.Active != that1.Active {
 		return false
 	}
+	if this.TwapWindow != that1.TwapWindow {
+		return false
+	}
+	if !this.MaxPriceChange.Equal(that1.MaxPriceChange) {
+		return false
+	}
+	if this.MinOracles != that1.MinOracles {
+		return false
+	}
+	if !this.OutlierBand.Equal(that1.OutlierBand) {
+		return false
+	}
+	if len(this.SourceMarkets) != len(that1.SourceMarkets) {
+		return false
+	}
+	for i := range this.SourceMarkets {
+		if !this.SourceMarkets[i].Equal(&that1.SourceMarkets[i]) {
+			return false
+		}
+	}
+	if !this.PoolPriceSource.Equal(that1.PoolPriceSource) {
+		return false
+	}
+	if this.ReferenceMarketID != that1.ReferenceMarketID {
+		return false
+	}
+	if !this.MaxReferenceDeviation.Equal(that1.MaxReferenceDeviation) {
+		return false
+	}
 	return true
 }
-func (this *PostedPrice) VerboseEqual(that interface{}) error {
+func (this *SourceMarket) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -468,45 +938,39 @@ func (this *PostedPrice) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*PostedPrice)
+	that1, ok := that.(*SourceMarket)
 	if !ok {
-		that2, ok := that.(PostedPrice)
+		that2, ok := that.(SourceMarket)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *PostedPrice")
+			return fmt.Errorf("that is not of type *SourceMarket")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *PostedPrice but is nil && this != nil")
+		return fmt.Errorf("that is type *SourceMarket but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *PostedPrice but is not nil && this == nil")
+		return fmt.Errorf("that is type *SourceMarket but is not nil && this == nil")
 	}
 	if this.MarketID != that1.MarketID {
 		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
 	}
-	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
-		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
-	}
-	if !this.Price.Equal(that1.Price) {
-		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
-	}
-	if !this.Expiry.Equal(that1.Expiry) {
-		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+	if this.Invert != that1.Invert {
+		return fmt.Errorf("Invert this(%v) Not Equal that(%v)", this.Invert, that1.Invert)
 	}
 	return nil
 }
-func (this *PostedPrice) Equal(that interface{}) bool {
+func (this *SourceMarket) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PostedPrice)
+	that1, ok := that.(*SourceMarket)
 	if !ok {
-		that2, ok := that.(PostedPrice)
+		that2, ok := that.(SourceMarket)
 		if ok {
 			that1 = &that2
 		} else {
@@ -521,18 +985,12 @@ func (this *PostedPrice) Equal(that interface{}) bool {
 	if this.MarketID != that1.MarketID {
 		return false
 	}
-	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
-		return false
-	}
-	if !this.Price.Equal(that1.Price) {
-		return false
-	}
-	if !this.Expiry.Equal(that1.Expiry) {
+	if this.Invert != that1.Invert {
 		return false
 	}
 	return true
 }
-func (this *CurrentPrice) VerboseEqual(that interface{}) error {
+func (this *PoolPriceSource) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -540,7 +998,151 @@ func (this *CurrentPrice) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*CurrentPrice)
+	that1, ok := that.(*PoolPriceSource)
+	if !ok {
+		that2, ok := that.(PoolPriceSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PoolPriceSource")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PoolPriceSource but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PoolPriceSource but is not nil && this == nil")
+	}
+	if this.BaseDenom != that1.BaseDenom {
+		return fmt.Errorf("BaseDenom this(%v) Not Equal that(%v)", this.BaseDenom, that1.BaseDenom)
+	}
+	if this.QuoteDenom != that1.QuoteDenom {
+		return fmt.Errorf("QuoteDenom this(%v) Not Equal that(%v)", this.QuoteDenom, that1.QuoteDenom)
+	}
+	if !this.ConversionFactor.Equal(that1.ConversionFactor) {
+		return fmt.Errorf("ConversionFactor this(%v) Not Equal that(%v)", this.ConversionFactor, that1.ConversionFactor)
+	}
+	if !this.MinLiquidity.Equal(that1.MinLiquidity) {
+		return fmt.Errorf("MinLiquidity this(%v) Not Equal that(%v)", this.MinLiquidity, that1.MinLiquidity)
+	}
+	return nil
+}
+func (this *PoolPriceSource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolPriceSource)
+	if !ok {
+		that2, ok := that.(PoolPriceSource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BaseDenom != that1.BaseDenom {
+		return false
+	}
+	if this.QuoteDenom != that1.QuoteDenom {
+		return false
+	}
+	if !this.ConversionFactor.Equal(that1.ConversionFactor) {
+		return false
+	}
+	if !this.MinLiquidity.Equal(that1.MinLiquidity) {
+		return false
+	}
+	return true
+}
+func (this *PostedPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PostedPrice)
+	if !ok {
+		that2, ok := that.(PostedPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PostedPrice")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PostedPrice but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PostedPrice but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+	}
+	return nil
+}
+func (this *PostedPrice) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PostedPrice)
+	if !ok {
+		that2, ok := that.(PostedPrice)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	return true
+}
+func (this *CurrentPrice) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*CurrentPrice)
 	if !ok {
 		that2, ok := that.(CurrentPrice)
 		if ok {
@@ -592,162 +1194,254 @@ func (this *CurrentPrice) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStore(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (this *PriceSnapshot) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Market) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Market) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
+	that1, ok := that.(*PriceSnapshot)
+	if !ok {
+		that2, ok := that.(PriceSnapshot)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return fmt.Errorf("that is not of type *PriceSnapshot")
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	if len(m.Oracles) > 0 {
-		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Oracles[iNdEx])
-			copy(dAtA[i:], m.Oracles[iNdEx])
-			i = encodeVarintStore(dAtA, i, uint64(len(m.Oracles[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *PriceSnapshot but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceSnapshot but is not nil && this == nil")
 	}
-	if len(m.QuoteAsset) > 0 {
-		i -= len(m.QuoteAsset)
-		copy(dAtA[i:], m.QuoteAsset)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteAsset)))
-		i--
-		dAtA[i] = 0x1a
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
 	}
-	if len(m.BaseAsset) > 0 {
-		i -= len(m.BaseAsset)
-		copy(dAtA[i:], m.BaseAsset)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseAsset)))
-		i--
-		dAtA[i] = 0x12
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Time.Equal(that1.Time) {
+		return fmt.Errorf("Time this(%v) Not Equal that(%v)", this.Time, that1.Time)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.CumulativePrice.Equal(that1.CumulativePrice) {
+		return fmt.Errorf("CumulativePrice this(%v) Not Equal that(%v)", this.CumulativePrice, that1.CumulativePrice)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PostedPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
+func (this *PriceSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
-	if err1 != nil {
-		return 0, err1
+	that1, ok := that.(*PriceSnapshot)
+	if !ok {
+		that2, ok := that.(PriceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i -= n1
-	i = encodeVarintStore(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.CumulativePrice.Equal(that1.CumulativePrice) {
+		return false
+	}
+	return true
+}
+func (this *StaleMarket) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
-		i = encodeVarintStore(dAtA, i, uint64(size))
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.OracleAddress) > 0 {
-		i -= len(m.OracleAddress)
-		copy(dAtA[i:], m.OracleAddress)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
-		i--
-		dAtA[i] = 0x12
+
+	that1, ok := that.(*StaleMarket)
+	if !ok {
+		that2, ok := that.(StaleMarket)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *StaleMarket")
+		}
 	}
-	if len(m.MarketID) > 0 {
-		i -= len(m.MarketID)
-		copy(dAtA[i:], m.MarketID)
-		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *StaleMarket but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *StaleMarket but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if this.Reason != that1.Reason {
+		return fmt.Errorf("Reason this(%v) Not Equal that(%v)", this.Reason, that1.Reason)
+	}
+	return nil
+}
+func (this *StaleMarket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StaleMarket)
+	if !ok {
+		that2, ok := that.(StaleMarket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *OracleStats) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*OracleStats)
+	if !ok {
+		that2, ok := that.(OracleStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *OracleStats")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *OracleStats but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *OracleStats but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return fmt.Errorf("OracleAddress this(%v) Not Equal that(%v)", this.OracleAddress, that1.OracleAddress)
+	}
+	if this.Posts != that1.Posts {
+		return fmt.Errorf("Posts this(%v) Not Equal that(%v)", this.Posts, that1.Posts)
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return fmt.Errorf("MissedWindows this(%v) Not Equal that(%v)", this.MissedWindows, that1.MissedWindows)
+	}
+	if this.Outliers != that1.Outliers {
+		return fmt.Errorf("Outliers this(%v) Not Equal that(%v)", this.Outliers, that1.Outliers)
+	}
+	return nil
+}
+func (this *OracleStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleStats)
+	if !ok {
+		that2, ok := that.(OracleStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !bytes.Equal(this.OracleAddress, that1.OracleAddress) {
+		return false
+	}
+	if this.Posts != that1.Posts {
+		return false
+	}
+	if this.MissedWindows != that1.MissedWindows {
+		return false
+	}
+	if this.Outliers != that1.Outliers {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
+func (m *Market) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -757,26 +1451,125 @@ func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Price.Size()
+		size := m.MaxReferenceDeviation.Size()
 		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxReferenceDeviation.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStore(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x6a
+	if len(m.ReferenceMarketID) > 0 {
+		i -= len(m.ReferenceMarketID)
+		copy(dAtA[i:], m.ReferenceMarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.ReferenceMarketID)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.PoolPriceSource != nil {
+		{
+			size, err := m.PoolPriceSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStore(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.SourceMarkets) > 0 {
+		for iNdEx := len(m.SourceMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceMarkets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.OutlierBand.Size()
+		i -= size
+		if _, err := m.OutlierBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MinOracles != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MinOracles))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxPriceChange.Size()
+		i -= size
+		if _, err := m.MaxPriceChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStore(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Oracles[iNdEx])
+			copy(dAtA[i:], m.Oracles[iNdEx])
+			i = encodeVarintStore(dAtA, i, uint64(len(m.Oracles[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.MarketID) > 0 {
 		i -= len(m.MarketID)
 		copy(dAtA[i:], m.MarketID)
@@ -787,105 +1580,1573 @@ func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStore(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SourceMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SourceMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovStore(uint64(l))
+	if m.Invert {
+		i--
+		if m.Invert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolPriceSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPriceSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPriceSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	{
+		size := m.MinLiquidity.Size()
+		i -= size
+		if _, err := m.MinLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
 	}
-	l = len(m.BaseAsset)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ConversionFactor.Size()
+		i -= size
+		if _, err := m.ConversionFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
 	}
-	l = len(m.QuoteAsset)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Oracles) > 0 {
-		for _, b := range m.Oracles {
-			l = len(b)
-			n += 1 + l + sovStore(uint64(l))
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostedPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostedPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStore(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
 	}
-	if m.Active {
-		n += 2
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PostedPrice) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CurrentPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
 	}
-	l = len(m.OracleAddress)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovStore(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
-	n += 1 + l + sovStore(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CurrentPrice) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketID)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovStore(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStore(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovStore(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *StaleMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozStore(x uint64) (n int) {
-	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *StaleMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StaleMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outliers != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Outliers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedWindows != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.MissedWindows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Posts != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Posts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleAddress) > 0 {
+		i -= len(m.OracleAddress)
+		copy(dAtA[i:], m.OracleAddress)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.OracleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStore(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if len(m.Oracles) > 0 {
+		for _, b := range m.Oracles {
+			l = len(b)
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if m.Active {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.MaxPriceChange.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if m.MinOracles != 0 {
+		n += 1 + sovStore(uint64(m.MinOracles))
+	}
+	l = m.OutlierBand.Size()
+	n += 1 + l + sovStore(uint64(l))
+	if len(m.SourceMarkets) > 0 {
+		for _, e := range m.SourceMarkets {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if m.PoolPriceSource != nil {
+		l = m.PoolPriceSource.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.ReferenceMarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.MaxReferenceDeviation.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *SourceMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Invert {
+		n += 2
+	}
+	return n
+}
+
+func (m *PoolPriceSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.ConversionFactor.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.MinLiquidity.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *PostedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *CurrentPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *StaleMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	return n
+}
+
+func (m *OracleStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.OracleAddress)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Posts != 0 {
+		n += 1 + sovStore(uint64(m.Posts))
+	}
+	if m.MissedWindows != 0 {
+		n += 1 + sovStore(uint64(m.MissedWindows))
+	}
+	if m.Outliers != 0 {
+		n += 1 + sovStore(uint64(m.Outliers))
+	}
+	return n
+}
+
+func sovStore(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStore(x uint64) (n int) {
+	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
+			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOracles", wireType)
+			}
+			m.MinOracles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOracles |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutlierBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceMarkets = append(m.SourceMarkets, SourceMarket{})
+			if err := m.SourceMarkets[len(m.SourceMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPriceSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolPriceSource == nil {
+				m.PoolPriceSource = &PoolPriceSource{}
+			}
+			if err := m.PoolPriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReferenceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxReferenceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolPriceSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPriceSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPriceSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -908,17 +3169,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrentPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -928,23 +3189,55 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -969,7 +3262,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,10 +3285,10 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1032,7 +3325,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1060,13 +3353,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1076,29 +3371,30 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1108,44 +3404,26 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracles = append(m.Oracles, make([]byte, postIndex-iNdEx))
-			copy(m.Oracles[len(m.Oracles)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStore
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Active = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -1167,7 +3445,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostedPrice) Unmarshal(dAtA []byte) error {
+func (m *StaleMarket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,10 +3468,10 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostedPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: StaleMarket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StaleMarket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1230,41 +3508,7 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStore
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStore
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStore
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.OracleAddress == nil {
-				m.OracleAddress = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1292,42 +3536,7 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStore
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStore
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStore
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1350,7 +3559,7 @@ func (m *PostedPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
+func (m *OracleStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1373,10 +3582,10 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1413,9 +3622,9 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStore
@@ -1425,26 +3634,83 @@ func (m *CurrentPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStore
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStore
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OracleAddress = append(m.OracleAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OracleAddress == nil {
+				m.OracleAddress = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			m.Posts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Posts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedWindows", wireType)
+			}
+			m.MissedWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outliers", wireType)
+			}
+			m.Outliers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outliers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
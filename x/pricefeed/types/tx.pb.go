@@ -46,7 +46,7 @@ func (m *MsgPostPrice) Reset()         { *m = MsgPostPrice{} }
 func (m *MsgPostPrice) String() string { return proto.CompactTextString(m) }
 func (*MsgPostPrice) ProtoMessage()    {}
 func (*MsgPostPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_afd93c8e4685da16, []int{0}
+	return fileDescriptor_98fa0f20b02259f3, []int{0}
 }
 func (m *MsgPostPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,7 +83,7 @@ func (m *MsgPostPriceResponse) Reset()         { *m = MsgPostPriceResponse{} }
 func (m *MsgPostPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPostPriceResponse) ProtoMessage()    {}
 func (*MsgPostPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_afd93c8e4685da16, []int{1}
+	return fileDescriptor_98fa0f20b02259f3, []int{1}
 }
 func (m *MsgPostPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -112,39 +112,180 @@ func (m *MsgPostPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPostPriceResponse proto.InternalMessageInfo
 
+// MsgPostPrices represents a method for posting prices for many markets at once.
+// Every price is posted or none are.
+type MsgPostPrices struct {
+	// address of client
+	From   string      `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Prices PriceInputs `protobuf:"bytes,2,rep,name=prices,proto3,castrepeated=PriceInputs" json:"prices"`
+}
+
+func (m *MsgPostPrices) Reset()         { *m = MsgPostPrices{} }
+func (m *MsgPostPrices) String() string { return proto.CompactTextString(m) }
+func (*MsgPostPrices) ProtoMessage()    {}
+func (*MsgPostPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98fa0f20b02259f3, []int{2}
+}
+func (m *MsgPostPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostPrices.Merge(m, src)
+}
+func (m *MsgPostPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostPrices proto.InternalMessageInfo
+
+// PriceInput defines a price for a market posted in a MsgPostPrices.
+type PriceInput struct {
+	MarketID string                                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Expiry   time.Time                              `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *PriceInput) Reset()         { *m = PriceInput{} }
+func (m *PriceInput) String() string { return proto.CompactTextString(m) }
+func (*PriceInput) ProtoMessage()    {}
+func (*PriceInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98fa0f20b02259f3, []int{3}
+}
+func (m *PriceInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceInput.Merge(m, src)
+}
+func (m *PriceInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceInput proto.InternalMessageInfo
+
+func (m *PriceInput) GetMarketID() string {
+	if m != nil {
+		return m.MarketID
+	}
+	return ""
+}
+
+func (m *PriceInput) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+// MsgPostPricesResponse defines the Msg/PostPrices response type.
+type MsgPostPricesResponse struct {
+}
+
+func (m *MsgPostPricesResponse) Reset()         { *m = MsgPostPricesResponse{} }
+func (m *MsgPostPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPostPricesResponse) ProtoMessage()    {}
+func (*MsgPostPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98fa0f20b02259f3, []int{4}
+}
+func (m *MsgPostPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostPricesResponse.Merge(m, src)
+}
+func (m *MsgPostPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostPricesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPostPrice)(nil), "aeth.pricefeed.v1beta1.MsgPostPrice")
 	proto.RegisterType((*MsgPostPriceResponse)(nil), "aeth.pricefeed.v1beta1.MsgPostPriceResponse")
+	proto.RegisterType((*MsgPostPrices)(nil), "aeth.pricefeed.v1beta1.MsgPostPrices")
+	proto.RegisterType((*PriceInput)(nil), "aeth.pricefeed.v1beta1.PriceInput")
+	proto.RegisterType((*MsgPostPricesResponse)(nil), "aeth.pricefeed.v1beta1.MsgPostPricesResponse")
 }
 
-func init() { proto.RegisterFile("aeth/pricefeed/v1beta1/tx.proto", fileDescriptor_afd93c8e4685da16) }
-
-var fileDescriptor_afd93c8e4685da16 = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0x6e, 0xea, 0x30,
-	0x14, 0x80, 0xe3, 0x0b, 0x17, 0x81, 0x2f, 0x53, 0x84, 0x50, 0x94, 0xc1, 0x41, 0xe8, 0xaa, 0xa2,
-	0x6a, 0xb1, 0x05, 0xdd, 0xaa, 0x4e, 0x88, 0x85, 0x21, 0x12, 0x8a, 0x3a, 0x75, 0x41, 0xf9, 0x71,
-	0xd2, 0x08, 0x52, 0x47, 0xb1, 0x41, 0xf0, 0x06, 0x1d, 0x79, 0x84, 0x8e, 0x7d, 0x14, 0x46, 0xb6,
-	0x56, 0x1d, 0x28, 0x0d, 0x2f, 0x52, 0xc5, 0x81, 0x36, 0x43, 0x87, 0x4e, 0x39, 0xf1, 0xf9, 0xce,
-	0x39, 0xfe, 0x8e, 0x0c, 0x8d, 0xa9, 0xbd, 0xb0, 0x49, 0x9c, 0x84, 0x2e, 0xf5, 0x29, 0xf5, 0xc8,
-	0xa2, 0xe7, 0x50, 0x61, 0xf7, 0x88, 0x58, 0xe2, 0x38, 0x61, 0x82, 0xa9, 0xcd, 0x0c, 0xc0, 0x5f,
-	0x00, 0x3e, 0x02, 0x7a, 0x23, 0x60, 0x01, 0x93, 0x08, 0xc9, 0xa2, 0x9c, 0xd6, 0x8d, 0x80, 0xb1,
-	0x60, 0x46, 0x89, 0xfc, 0x73, 0xe6, 0x3e, 0x11, 0x61, 0x44, 0xb9, 0xb0, 0xa3, 0x38, 0x07, 0xda,
-	0x2f, 0x00, 0xd6, 0x4d, 0x1e, 0x8c, 0x19, 0x17, 0xe3, 0xac, 0xa7, 0xaa, 0xc2, 0xb2, 0x9f, 0xb0,
-	0x48, 0x03, 0x2d, 0xd0, 0xa9, 0x59, 0x32, 0x56, 0xcf, 0x61, 0x2d, 0xb2, 0x93, 0x29, 0x15, 0x93,
-	0xd0, 0xd3, 0xfe, 0x64, 0x89, 0x41, 0x3d, 0xdd, 0x19, 0x55, 0x53, 0x1e, 0x8e, 0x86, 0x56, 0x35,
-	0x4f, 0x8f, 0x3c, 0x75, 0x08, 0xff, 0xca, 0xbb, 0x69, 0x25, 0x89, 0xe1, 0xcd, 0xce, 0x50, 0xde,
-	0x76, 0xc6, 0x59, 0x10, 0x8a, 0xfb, 0xb9, 0x83, 0x5d, 0x16, 0x11, 0x97, 0xf1, 0x88, 0xf1, 0xe3,
-	0xa7, 0xcb, 0xbd, 0x29, 0x11, 0xab, 0x98, 0x72, 0x3c, 0xa4, 0xae, 0x95, 0x17, 0xab, 0x37, 0xb0,
-	0x42, 0x97, 0x71, 0x98, 0xac, 0xb4, 0x72, 0x0b, 0x74, 0xfe, 0xf5, 0x75, 0x9c, 0x7b, 0xe0, 0x93,
-	0x07, 0xbe, 0x3d, 0x79, 0x0c, 0xaa, 0xd9, 0x88, 0xf5, 0xbb, 0x01, 0xac, 0x63, 0xcd, 0x75, 0xf9,
-	0xf1, 0xc9, 0x50, 0xda, 0x4d, 0xd8, 0x28, 0x8a, 0x59, 0x94, 0xc7, 0xec, 0x81, 0xd3, 0xbe, 0x0f,
-	0x4b, 0x26, 0x0f, 0xd4, 0x09, 0xac, 0x7d, 0x4b, 0xff, 0xc7, 0x3f, 0x6f, 0x15, 0x17, 0x3b, 0xe8,
-	0x97, 0xbf, 0xa1, 0x4e, 0x73, 0x06, 0xe6, 0xfe, 0x03, 0x81, 0xe7, 0x14, 0x81, 0x4d, 0x8a, 0xc0,
-	0x36, 0x45, 0x60, 0x9f, 0x22, 0xb0, 0x3e, 0x20, 0x65, 0x7b, 0x40, 0xca, 0xeb, 0x01, 0x29, 0x77,
-	0x17, 0x85, 0xa5, 0x64, 0x9d, 0xbb, 0x33, 0xdb, 0xe1, 0x32, 0x22, 0xcb, 0xc2, 0x13, 0x90, 0xdb,
-	0x71, 0x2a, 0x52, 0xfd, 0xea, 0x33, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x4a, 0x12, 0x82, 0x21, 0x02,
-	0x00, 0x00,
+func init() { proto.RegisterFile("aeth/pricefeed/v1beta1/tx.proto", fileDescriptor_98fa0f20b02259f3) }
+
+var fileDescriptor_98fa0f20b02259f3 = []byte{
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x7d, 0x49, 0x88, 0x92, 0x37, 0x65, 0x31, 0xa5, 0x58, 0x1e, 0x7c, 0x91, 0x05, 0x28,
+	0x48, 0xf4, 0x4e, 0x09, 0x1b, 0x62, 0x8a, 0xb2, 0x64, 0x88, 0xa8, 0x0c, 0x13, 0x4b, 0x65, 0x27,
+	0x17, 0xd7, 0x0a, 0xce, 0x59, 0xbe, 0x0b, 0xa4, 0x7c, 0x02, 0xc6, 0x7e, 0x04, 0x46, 0xc4, 0x57,
+	0xe0, 0x0b, 0x54, 0x4c, 0xdd, 0x40, 0x0c, 0x69, 0x71, 0xbe, 0x08, 0xf2, 0xd9, 0x26, 0xae, 0x14,
+	0x24, 0x23, 0x31, 0xf9, 0xfc, 0xbe, 0xcf, 0xfb, 0xe7, 0xf9, 0x9d, 0x0d, 0xd8, 0x65, 0xf2, 0x8c,
+	0x46, 0x71, 0x30, 0x65, 0x73, 0xc6, 0x66, 0xf4, 0x5d, 0xdf, 0x63, 0xd2, 0xed, 0x53, 0xb9, 0x26,
+	0x51, 0xcc, 0x25, 0xd7, 0x8f, 0x52, 0x01, 0xf9, 0x23, 0x20, 0xb9, 0xc0, 0x3c, 0xf4, 0xb9, 0xcf,
+	0x95, 0x84, 0xa6, 0xa7, 0x4c, 0x6d, 0x62, 0x9f, 0x73, 0xff, 0x2d, 0xa3, 0xea, 0xcd, 0x5b, 0xcd,
+	0xa9, 0x0c, 0x42, 0x26, 0xa4, 0x1b, 0x46, 0x99, 0xc0, 0xfe, 0x8e, 0xe0, 0x60, 0x22, 0xfc, 0x13,
+	0x2e, 0xe4, 0x49, 0xda, 0x53, 0xd7, 0xa1, 0x31, 0x8f, 0x79, 0x68, 0xa0, 0x2e, 0xea, 0xb5, 0x1d,
+	0x75, 0xd6, 0x9f, 0x40, 0x3b, 0x74, 0xe3, 0x05, 0x93, 0xa7, 0xc1, 0xcc, 0xa8, 0xa5, 0x89, 0xe1,
+	0x41, 0xb2, 0xc1, 0xad, 0x89, 0x0a, 0x8e, 0x47, 0x4e, 0x2b, 0x4b, 0x8f, 0x67, 0xfa, 0x08, 0xee,
+	0xa8, 0xdd, 0x8c, 0xba, 0x92, 0x91, 0xcb, 0x0d, 0xd6, 0x7e, 0x6e, 0xf0, 0x63, 0x3f, 0x90, 0x67,
+	0x2b, 0x8f, 0x4c, 0x79, 0x48, 0xa7, 0x5c, 0x84, 0x5c, 0xe4, 0x8f, 0x63, 0x31, 0x5b, 0x50, 0x79,
+	0x1e, 0x31, 0x41, 0x46, 0x6c, 0xea, 0x64, 0xc5, 0xfa, 0x0b, 0x68, 0xb2, 0x75, 0x14, 0xc4, 0xe7,
+	0x46, 0xa3, 0x8b, 0x7a, 0x9d, 0x81, 0x49, 0x32, 0x1f, 0xa4, 0xf0, 0x41, 0x5e, 0x17, 0x3e, 0x86,
+	0xad, 0x74, 0xc4, 0xc5, 0x35, 0x46, 0x4e, 0x5e, 0xf3, 0xbc, 0xf1, 0xf1, 0x13, 0xd6, 0xec, 0x23,
+	0x38, 0x2c, 0x1b, 0x73, 0x98, 0x88, 0xf8, 0x52, 0x30, 0xfb, 0x03, 0xdc, 0x2d, 0xc7, 0xc5, 0x5e,
+	0xc7, 0x2f, 0xa1, 0xa9, 0x36, 0x11, 0x46, 0xad, 0x5b, 0xef, 0x75, 0x06, 0x36, 0xd9, 0x8f, 0x9d,
+	0xa8, 0x1e, 0xe3, 0x65, 0xb4, 0x92, 0xc3, 0x7b, 0xe9, 0x22, 0x5f, 0xae, 0x71, 0x67, 0x17, 0x13,
+	0x4e, 0xde, 0x26, 0xdf, 0xe9, 0x2b, 0x02, 0xd8, 0x65, 0x6f, 0x73, 0x45, 0xd5, 0xb8, 0xd6, 0xfe,
+	0x0f, 0xd7, 0xfa, 0xbf, 0x73, 0xb5, 0x1f, 0xc0, 0xfd, 0x5b, 0xe4, 0x0a, 0xa4, 0x83, 0x6f, 0x08,
+	0xea, 0x13, 0xe1, 0xeb, 0xa7, 0xd0, 0xde, 0x7d, 0x48, 0x0f, 0xff, 0x86, 0xac, 0xdc, 0xc3, 0x7c,
+	0x5a, 0x45, 0x55, 0x0c, 0xd2, 0x3d, 0x80, 0xd2, 0xc5, 0x3d, 0xaa, 0x52, 0x2b, 0xcc, 0xe3, 0x4a,
+	0xb2, 0x62, 0xc6, 0xf0, 0xd5, 0xcd, 0x2f, 0x0b, 0x7d, 0x4e, 0x2c, 0x74, 0x99, 0x58, 0xe8, 0x2a,
+	0xb1, 0xd0, 0x4d, 0x62, 0xa1, 0x8b, 0xad, 0xa5, 0x5d, 0x6d, 0x2d, 0xed, 0xc7, 0xd6, 0xd2, 0xde,
+	0xf4, 0x4b, 0xd0, 0x43, 0xbe, 0x08, 0xa4, 0xbb, 0x64, 0xf2, 0x3d, 0x8f, 0x17, 0x34, 0x1d, 0xc4,
+	0x62, 0xba, 0x2e, 0xfd, 0xc0, 0xea, 0x0e, 0xbc, 0xa6, 0x02, 0xfc, 0xec, 0xf7, 0x00, 0x23, 0xb1,
+	0x68, 0x6f, 0xdf, 0x03, 0x00, 0x00,
 }
 
 func (this *MsgPostPrice) VerboseEqual(that interface{}) error {
@@ -267,6 +408,190 @@ func (this *MsgPostPriceResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgPostPrices) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MsgPostPrices)
+	if !ok {
+		that2, ok := that.(MsgPostPrices)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgPostPrices")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgPostPrices but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgPostPrices but is not nil && this == nil")
+	}
+	if this.From != that1.From {
+		return fmt.Errorf("From this(%v) Not Equal that(%v)", this.From, that1.From)
+	}
+	if len(this.Prices) != len(that1.Prices) {
+		return fmt.Errorf("Prices this(%v) Not Equal that(%v)", len(this.Prices), len(that1.Prices))
+	}
+	for i := range this.Prices {
+		if !this.Prices[i].Equal(&that1.Prices[i]) {
+			return fmt.Errorf("Prices this[%v](%v) Not Equal that[%v](%v)", i, this.Prices[i], i, that1.Prices[i])
+		}
+	}
+	return nil
+}
+func (this *MsgPostPrices) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgPostPrices)
+	if !ok {
+		that2, ok := that.(MsgPostPrices)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if len(this.Prices) != len(that1.Prices) {
+		return false
+	}
+	for i := range this.Prices {
+		if !this.Prices[i].Equal(&that1.Prices[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PriceInput) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*PriceInput)
+	if !ok {
+		that2, ok := that.(PriceInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PriceInput")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PriceInput but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PriceInput but is not nil && this == nil")
+	}
+	if this.MarketID != that1.MarketID {
+		return fmt.Errorf("MarketID this(%v) Not Equal that(%v)", this.MarketID, that1.MarketID)
+	}
+	if !this.Price.Equal(that1.Price) {
+		return fmt.Errorf("Price this(%v) Not Equal that(%v)", this.Price, that1.Price)
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return fmt.Errorf("Expiry this(%v) Not Equal that(%v)", this.Expiry, that1.Expiry)
+	}
+	return nil
+}
+func (this *PriceInput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriceInput)
+	if !ok {
+		that2, ok := that.(PriceInput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketID != that1.MarketID {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	if !this.Expiry.Equal(that1.Expiry) {
+		return false
+	}
+	return true
+}
+func (this *MsgPostPricesResponse) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*MsgPostPricesResponse)
+	if !ok {
+		that2, ok := that.(MsgPostPricesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *MsgPostPricesResponse")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *MsgPostPricesResponse but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *MsgPostPricesResponse but is not nil && this == nil")
+	}
+	return nil
+}
+func (this *MsgPostPricesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgPostPricesResponse)
+	if !ok {
+		that2, ok := that.(MsgPostPricesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -282,6 +607,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// PostPrice defines a method for creating a new post price
 	PostPrice(ctx context.Context, in *MsgPostPrice, opts ...grpc.CallOption) (*MsgPostPriceResponse, error)
+	// PostPrices defines a method for posting prices for many markets at once
+	PostPrices(ctx context.Context, in *MsgPostPrices, opts ...grpc.CallOption) (*MsgPostPricesResponse, error)
 }
 
 type msgClient struct {
@@ -301,10 +628,21 @@ func (c *msgClient) PostPrice(ctx context.Context, in *MsgPostPrice, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) PostPrices(ctx context.Context, in *MsgPostPrices, opts ...grpc.CallOption) (*MsgPostPricesResponse, error) {
+	out := new(MsgPostPricesResponse)
+	err := c.cc.Invoke(ctx, "/aeth.pricefeed.v1beta1.Msg/PostPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// PostPrice defines a method for creating a new post price
 	PostPrice(context.Context, *MsgPostPrice) (*MsgPostPriceResponse, error)
+	// PostPrices defines a method for posting prices for many markets at once
+	PostPrices(context.Context, *MsgPostPrices) (*MsgPostPricesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -314,6 +652,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) PostPrice(ctx context.Context, req *MsgPostPrice) (*MsgPostPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostPrice not implemented")
 }
+func (*UnimplementedMsgServer) PostPrices(ctx context.Context, req *MsgPostPrices) (*MsgPostPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostPrices not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -337,6 +678,24 @@ func _Msg_PostPrice_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PostPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPostPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PostPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aeth.pricefeed.v1beta1.Msg/PostPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PostPrices(ctx, req.(*MsgPostPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "aeth.pricefeed.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -345,6 +704,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PostPrice",
 			Handler:    _Msg_PostPrice_Handler,
 		},
+		{
+			MethodName: "PostPrices",
+			Handler:    _Msg_PostPrices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aeth/pricefeed/v1beta1/tx.proto",
@@ -428,16 +791,131 @@ func (m *MsgPostPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPostPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MarketID) > 0 {
+		i -= len(m.MarketID)
+		copy(dAtA[i:], m.MarketID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPostPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *MsgPostPrice) Size() (n int) {
 	if m == nil {
@@ -469,6 +947,51 @@ func (m *MsgPostPriceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPostPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PriceInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPostPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -706,6 +1229,321 @@ func (m *MsgPostPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPostPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPostPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPostPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, PriceInput{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPostPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPostPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPostPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0